@@ -41,9 +41,36 @@ type Config struct {
 	// TTL настройки времи действия
 	TTL *TTL
 
+	// SessionCache настройки кэша сессий
+	SessionCache *SessionCache
+	// SessionActivity настройки учета активности сессий
+	SessionActivity *SessionActivity
+	// Redis настройки подключения к Redis
+	Redis *Redis
+	// Readiness настройки проверки готовности
+	Readiness *Readiness
+	// JWT настройки локальной проверки токенов доступа
+	JWT *JWT
+	// NewsWorkflow настройки публикации новостей
+	NewsWorkflow *NewsWorkflow
+	// NewsViews настройки учета просмотров новостей
+	NewsViews *NewsViews
+	// NewsComments настройки комментариев к новостям
+	NewsComments *NewsComments
+	// NewsScheduler настройки публикации новостей по расписанию
+	NewsScheduler *NewsScheduler
+	// NewsRevisions настройки версий и черновиков новостей
+	NewsRevisions *NewsRevisions
+	// Surveys настройки прохождения опросов
+	Surveys *Surveys
+
 	WebAuthRedirectURI string `long:"web-auth-redirect-uri" description:"Old portal handler uri for short redirect session" env:"WEB_AUTH_REDIRECT_URI" required:"true"`
 
 	AccessListFile string `long:"access-list-file" env:"ACCESS_LIST_FILE"`
+	// AccessListReloadInterval интервал проверки изменения файла списка доступа
+	AccessListReloadInterval time.Duration `long:"access-list-reload-interval" description:"Access list file change check interval" env:"ACCESS_LIST_RELOAD_INTERVAL" default:"30s"`
+
+	AdminPolicyFile string `long:"admin-policy-file" description:"Roles and permissions for admin routes" env:"ADMIN_POLICY_FILE" required:"true"`
 }
 
 type HttpServerConfig struct {
@@ -70,11 +97,125 @@ type Endpoints struct {
 	NewsEndpoint            string `long:"news-endpoint" description:"News gRpc endpoint address" env:"NEWS_ENDPOINT" required:"true"`
 	BannersEndpoint         string `long:"banners-endpoint" description:"Banners gRPC endpoint address" env:"BANNERS_ENDPOINT" required:"true"`
 	NewsFacadeEndpoint      string `long:"news-facade-endpoint" description:"News-facade gRpc endpoint address" env:"NEWS_FACADE_ENDPOINT" required:"true"`
+
+	// CertReloadInterval интервал проверки изменения файлов сертификатов клиентов
+	CertReloadInterval time.Duration `long:"cert-reload-interval" description:"Client certificate files change check interval" env:"CERT_RELOAD_INTERVAL" default:"1m"`
+
+	// Настройки клиентов зависимых сервисов
+	PortalsClient         GRPCClient `group:"portals client" namespace:"portals-client" env-namespace:"PORTALS_CLIENT"`
+	PortalsV2Client       GRPCClient `group:"portals v2 client" namespace:"portals-v2-client" env-namespace:"PORTALSV2_CLIENT"`
+	SurveysClient         GRPCClient `group:"surveys client" namespace:"surveys-client" env-namespace:"SURVEYS_CLIENT"`
+	AuthFacadeClient      GRPCClient `group:"auth-facade client" namespace:"auth-facade-client" env-namespace:"AUTH_FACADE_CLIENT"`
+	ProxyFacadeClient     GRPCClient `group:"proxy-facade client" namespace:"proxy-facade-client" env-namespace:"PROXY_FACADE_CLIENT"`
+	EmployeesSearchClient GRPCClient `group:"employees search client" namespace:"employees-search-client" env-namespace:"EMPLOYEES_SEARCH_CLIENT"`
+	FilesClient           GRPCClient `group:"files client" namespace:"files-client" env-namespace:"FILES_CLIENT"`
+	EmployeesClient       GRPCClient `group:"employees client" namespace:"employees-client" env-namespace:"EMPLOYEES_CLIENT"`
+	AnalyticsClient       GRPCClient `group:"analytics client" namespace:"analytics-client" env-namespace:"ANALYTICS_CLIENT"`
+	PortalsFacadeClient   GRPCClient `group:"portals-facade client" namespace:"portals-facade-client" env-namespace:"PORTALS_FACADE_CLIENT"`
+	NewsFacadeClient      GRPCClient `group:"news-facade client" namespace:"news-facade-client" env-namespace:"NEWS_FACADE_CLIENT"`
+	BannersClient         GRPCClient `group:"banners client" namespace:"banners-client" env-namespace:"BANNERS_CLIENT"`
+}
+
+// GRPCClient настройки клиента зависимого gRPC-сервиса.
+//
+//	Имена флагов и переменных окружения получают префикс сервиса, например PORTALS_CLIENT_TIMEOUT
+type GRPCClient struct {
+	Timeout            time.Duration `long:"timeout" description:"Request deadline if the caller has not set an earlier one" env:"TIMEOUT" default:"10s"`
+	MaxRetries         int           `long:"max-retries" description:"Retries of read methods (Get, Filter, Search) on unavailable service" env:"MAX_RETRIES" default:"2"`
+	RetryBackoff       time.Duration `long:"retry-backoff" description:"Initial pause between retries" env:"RETRY_BACKOFF" default:"100ms"`
+	BreakerFailures    int           `long:"breaker-failures" description:"Consecutive failures to open circuit breaker, 0 disables the breaker" env:"BREAKER_FAILURES" default:"5"`
+	BreakerOpenTimeout time.Duration `long:"breaker-open-timeout" description:"Time before open circuit breaker lets a probe request through" env:"BREAKER_OPEN_TIMEOUT" default:"30s"`
+
+	// Настройки TLS. Без CAFile сертификат сервера проверяется по системным удостоверяющим центрам
+	Insecure   bool   `long:"insecure" description:"Connect without TLS" env:"INSECURE"`
+	CAFile     string `long:"ca-file" description:"PEM file with CA certificates to verify the server" env:"CA_FILE"`
+	CertFile   string `long:"cert-file" description:"PEM file with client certificate for mTLS" env:"CERT_FILE"`
+	KeyFile    string `long:"key-file" description:"PEM file with client certificate private key" env:"KEY_FILE"`
+	ServerName string `long:"server-name" description:"Server name to verify the certificate against instead of the endpoint host" env:"SERVER_NAME"`
 }
 
 type TTL struct {
 	AccessToken  time.Duration `long:"access-token-ttl" description:"Access token expiration time" env:"ACCESS_TOKEN_TTL" default:"2160h"`
 	RefreshToken time.Duration `long:"refresh-token-ttl" description:"Refresh token expiration time" env:"REFRESH_TOKEN_TTL" default:"2160h"`
+	// LastPortal время хранения последнего выбранного пользователем портала
+	LastPortal time.Duration `long:"last-portal-ttl" description:"Last selected portal retention time" env:"LAST_PORTAL_TTL" default:"2160h"`
+}
+
+// SessionCache настройки кэша сессий
+type SessionCache struct {
+	Enabled bool          `long:"session-cache-enabled" description:"Enable session cache" env:"SESSION_CACHE_ENABLED"`
+	Size    int           `long:"session-cache-size" description:"In-process session cache capacity" env:"SESSION_CACHE_SIZE" default:"10000"`
+	TTL     time.Duration `long:"session-cache-ttl" description:"Max time to keep session in cache" env:"SESSION_CACHE_TTL" default:"1m"`
+}
+
+// SessionActivity настройки учета активности сессий
+type SessionActivity struct {
+	IdleTimeout  time.Duration `long:"session-idle-timeout" description:"Session idle timeout, 0 disables the check" env:"SESSION_IDLE_TIMEOUT" default:"0"`
+	SyncInterval time.Duration `long:"session-activity-sync-interval" description:"Min interval between session activity writes" env:"SESSION_ACTIVITY_SYNC_INTERVAL" default:"1m"`
+}
+
+// Redis настройки подключения к Redis. Redis обязателен: в нем хранится общее для реплик состояние сервиса
+type Redis struct {
+	Addrs     string `long:"redis-addrs" description:"Redis addresses separated by comma" env:"REDIS_ADDRS" required:"true"`
+	Username  string `long:"redis-username" env:"REDIS_USERNAME"`
+	Password  string `long:"redis-password" env:"REDIS_PASSWORD"`
+	DB        int    `long:"redis-db" env:"REDIS_DB" default:"0"`
+	KeyPrefix string `long:"redis-key-prefix" description:"Prefix for all redis keys" env:"REDIS_KEY_PREFIX" default:"web-api:"`
+}
+
+// Readiness настройки проверки готовности
+type Readiness struct {
+	Required     string        `long:"readiness-required" description:"Comma separated dependencies required for readiness: gRPC service names (portals, auth-facade, files, ...), redis, access-list" env:"READINESS_REQUIRED"`
+	GracePeriod  time.Duration `long:"readiness-grace-period" description:"Time after start for required dependencies to become available, the app stops if they are still unavailable" env:"READINESS_GRACE_PERIOD" default:"1m"`
+	CheckTimeout time.Duration `long:"readiness-check-timeout" description:"Timeout of a single dependency check" env:"READINESS_CHECK_TIMEOUT" default:"2s"`
+}
+
+// JWT настройки локальной проверки токенов доступа.
+//
+//	Ключ проверки подписи берется из первого заданного источника: JWKSFile, PublicKeyFile, Secret
+type JWT struct {
+	JWKSFile      string        `long:"jwt-jwks-file" description:"JWKS file with access token signing keys" env:"JWT_JWKS_FILE"`
+	PublicKeyFile string        `long:"jwt-public-key-file" description:"PEM file with access token signing public key" env:"JWT_PUBLIC_KEY_FILE"`
+	Secret        string        `long:"jwt-secret" description:"HMAC secret for access token signature" env:"JWT_SECRET"`
+	Issuer        string        `long:"jwt-issuer" description:"Expected access token issuer" env:"JWT_ISSUER"`
+	Leeway        time.Duration `long:"jwt-leeway" description:"Allowed clock skew for access token expiration check" env:"JWT_LEEWAY" default:"30s"`
+}
+
+// NewsWorkflow настройки публикации новостей
+type NewsWorkflow struct {
+	// TwoPersonReview новость публикует редактор, не отправлявший ее на публикацию
+	TwoPersonReview bool `long:"news-two-person-review" description:"News must be published by another editor than the one who submitted it" env:"NEWS_TWO_PERSON_REVIEW"`
+}
+
+// NewsViews настройки учета просмотров новостей
+type NewsViews struct {
+	DedupWindow   time.Duration `long:"news-views-dedup-window" description:"Repeated views of a news by the same employee within the window are not counted" env:"NEWS_VIEWS_DEDUP_WINDOW" default:"1h"`
+	FlushInterval time.Duration `long:"news-views-flush-interval" description:"Interval of saving buffered news views" env:"NEWS_VIEWS_FLUSH_INTERVAL" default:"10s"`
+	BatchSize     int           `long:"news-views-batch-size" description:"Max news views saved at once, a full batch is saved without waiting for the interval" env:"NEWS_VIEWS_BATCH_SIZE" default:"500"`
+}
+
+// NewsComments настройки комментариев к новостям
+type NewsComments struct {
+	EditWindow time.Duration `long:"news-comments-edit-window" description:"Time after creation while the author can edit or delete a comment" env:"NEWS_COMMENTS_EDIT_WINDOW" default:"15m"`
+}
+
+// NewsScheduler настройки публикации новостей по расписанию
+type NewsScheduler struct {
+	Interval     time.Duration `long:"news-scheduler-interval" description:"Interval of publishing news whose publication time has come" env:"NEWS_SCHEDULER_INTERVAL" default:"30s"`
+	LeaseTTL     time.Duration `long:"news-scheduler-lease-ttl" description:"Scheduler lease TTL, another replica takes over the scheduler if the lease is not renewed" env:"NEWS_SCHEDULER_LEASE_TTL" default:"90s"`
+	RetryBackoff time.Duration `long:"news-scheduler-retry-backoff" description:"Initial delay before retrying a failed publication, doubled after each failure" env:"NEWS_SCHEDULER_RETRY_BACKOFF" default:"30s"`
+}
+
+// NewsRevisions настройки версий и черновиков новостей
+type NewsRevisions struct {
+	Limit    int           `long:"news-revisions-limit" description:"Max stored revisions of a news, older revisions are removed" env:"NEWS_REVISIONS_LIMIT" default:"50"`
+	DraftTTL time.Duration `long:"news-draft-ttl" description:"Autosaved news draft is removed after this period without changes" env:"NEWS_DRAFT_TTL" default:"720h"`
+}
+
+// Surveys настройки прохождения опросов
+type Surveys struct {
+	// RespondentSalt соль ключа респондента, по которому отмечается прохождение опроса
+	RespondentSalt string `long:"surveys-respondent-salt" description:"Secret salt of the survey respondent key used to reject repeat submissions" env:"SURVEYS_RESPONDENT_SALT" required:"true"`
 }
 
 // NewConfig ...
@@ -86,6 +227,27 @@ func NewConfig() (*Config, error) {
 		parser.WriteHelp(log.Writer())
 		return nil, fmt.Errorf("config parse failed: %w", err)
 	}
+	if err := cfg.validate(); err != nil {
+		return nil, fmt.Errorf("config validation failed: %w", err)
+	}
 
 	return &cfg, nil
 }
+
+// validate проверяет значения, которые парсер принимает, но с которыми сервис не может работать
+func (c *Config) validate() error {
+	// Интервалы используются в time.NewTicker, который паникует на неположительном значении
+	intervals := []struct {
+		name  string
+		value time.Duration
+	}{
+		{name: "news-views-flush-interval", value: c.NewsViews.FlushInterval},
+		{name: "news-scheduler-interval", value: c.NewsScheduler.Interval},
+	}
+	for _, interval := range intervals {
+		if interval.value <= 0 {
+			return fmt.Errorf("%s must be positive, got %s", interval.name, interval.value)
+		}
+	}
+	return nil
+}
@@ -11,6 +11,13 @@ import (
 
 //nolint:funlen
 func TestNewConfig(t *testing.T) {
+	defaultClient := GRPCClient{
+		Timeout:            10 * time.Second,
+		MaxRetries:         2,
+		RetryBackoff:       100 * time.Millisecond,
+		BreakerFailures:    5,
+		BreakerOpenTimeout: 30 * time.Second,
+	}
 	tests := []struct {
 		name    string
 		prepare func() map[string]string
@@ -46,6 +53,9 @@ func TestNewConfig(t *testing.T) {
 					"PORTALSV2_ENDPOINT":        "exampleEndpoint10",
 					"BANNERS_ENDPOINT":          "exampleEndpoint11",
 					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12",
+					"ADMIN_POLICY_FILE":         "policy.json",
+					"REDIS_ADDRS":               "127.0.0.1:6379",
+					"SURVEYS_RESPONDENT_SALT":   "salt",
 				}
 			},
 			want: func() (*Config, error) {
@@ -57,6 +67,127 @@ func TestNewConfig(t *testing.T) {
 					`expected bool): strconv.ParseBool: parsing "%s": invalid syntax`, prefix, "dev-mode", "1234")
 			},
 		},
+		{
+			name: "zero news scheduler interval",
+			prepare: func() map[string]string {
+				return map[string]string{
+					"POSTGRES_DBNAME":           "public",
+					"NEWS_SCHEDULER_INTERVAL":   "0s",
+					"SYSAPIKEY":                 "exampleKey",
+					"AUTH_FACADE_ENDPOINT":      "exampleEndpoint",
+					"ANALYTICS_ENDPOINT":        "exampleEndpoint2",
+					"NOTIFICATIONS_ENDPOINT":    "exampleEndpoint3",
+					"S3_BUCKET":                 "exampleBucket",
+					"S3_ENDPOINT":               "exampleS3Endpoint",
+					"S3_ACCESS_KEY_ID":          "exampleKeyId",
+					"S3_SECRET_ACCESS_KEY":      "exampleAccKey",
+					"S3_USE_SSL":                "true",
+					"UPLOAD_PATH":               "examplePath",
+					"PORTALS_ENDPOINT":          "exampleEndpoint4",
+					"SURVEYS_ENDPOINT":          "exampleEndpoint5",
+					"PROXY_FACADE_ENDPOINT":     "exampleEndpoint6",
+					"FILES_ENDPOINT":            "exampleEndpoint7",
+					"WEB_AUTH_URL":              "http://localhost/auth",
+					"HTTP_EXTERNAL_HOST":        "localhostTest",
+					"WEB_AUTH_REDIRECT_URI":     "test",
+					"EMPLOYEES_SEARCH_ENDPOINT": "exampleEndpoint6",
+					"EMPLOYEES_ENDPOINT":        "exampleEndpoint7",
+					"PORTALS_FACADE_ENDPOINT":   "exampleEndpoint9",
+					"NEWS_ENDPOINT":             "exampleEndpoint10",
+					"PORTALSV2_ENDPOINT":        "exampleEndpoint10",
+					"BANNERS_ENDPOINT":          "exampleEndpoint11",
+					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12",
+					"ADMIN_POLICY_FILE":         "policy.json",
+					"REDIS_ADDRS":               "127.0.0.1:6379",
+					"SURVEYS_RESPONDENT_SALT":   "salt",
+				}
+			},
+			want: func() (*Config, error) {
+				return nil, fmt.Errorf("config validation failed: news-scheduler-interval must be positive, got 0s")
+			},
+		},
+		{
+			name: "missing surveys respondent salt",
+			prepare: func() map[string]string {
+				return map[string]string{
+					"POSTGRES_DBNAME":           "public",
+					"SYSAPIKEY":                 "exampleKey",
+					"AUTH_FACADE_ENDPOINT":      "exampleEndpoint",
+					"ANALYTICS_ENDPOINT":        "exampleEndpoint2",
+					"NOTIFICATIONS_ENDPOINT":    "exampleEndpoint3",
+					"S3_BUCKET":                 "exampleBucket",
+					"S3_ENDPOINT":               "exampleS3Endpoint",
+					"S3_ACCESS_KEY_ID":          "exampleKeyId",
+					"S3_SECRET_ACCESS_KEY":      "exampleAccKey",
+					"S3_USE_SSL":                "true",
+					"UPLOAD_PATH":               "examplePath",
+					"PORTALS_ENDPOINT":          "exampleEndpoint4",
+					"SURVEYS_ENDPOINT":          "exampleEndpoint5",
+					"PROXY_FACADE_ENDPOINT":     "exampleEndpoint6",
+					"FILES_ENDPOINT":            "exampleEndpoint7",
+					"WEB_AUTH_URL":              "http://localhost/auth",
+					"HTTP_EXTERNAL_HOST":        "localhostTest",
+					"WEB_AUTH_REDIRECT_URI":     "test",
+					"EMPLOYEES_SEARCH_ENDPOINT": "exampleEndpoint6",
+					"EMPLOYEES_ENDPOINT":        "exampleEndpoint7",
+					"PORTALS_FACADE_ENDPOINT":   "exampleEndpoint9",
+					"NEWS_ENDPOINT":             "exampleEndpoint10",
+					"PORTALSV2_ENDPOINT":        "exampleEndpoint10",
+					"BANNERS_ENDPOINT":          "exampleEndpoint11",
+					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12",
+					"ADMIN_POLICY_FILE":         "policy.json",
+					"REDIS_ADDRS":               "127.0.0.1:6379",
+				}
+			},
+			want: func() (*Config, error) {
+				prefix := "--"
+				if runtime.GOOS == "windows" {
+					prefix = "/"
+				}
+				return nil, fmt.Errorf("config parse failed: the required flag `%ssurveys-respondent-salt' was not specified", prefix)
+			},
+		},
+		{
+			name: "missing redis addrs",
+			prepare: func() map[string]string {
+				return map[string]string{
+					"POSTGRES_DBNAME":           "public",
+					"SYSAPIKEY":                 "exampleKey",
+					"AUTH_FACADE_ENDPOINT":      "exampleEndpoint",
+					"ANALYTICS_ENDPOINT":        "exampleEndpoint2",
+					"NOTIFICATIONS_ENDPOINT":    "exampleEndpoint3",
+					"S3_BUCKET":                 "exampleBucket",
+					"S3_ENDPOINT":               "exampleS3Endpoint",
+					"S3_ACCESS_KEY_ID":          "exampleKeyId",
+					"S3_SECRET_ACCESS_KEY":      "exampleAccKey",
+					"S3_USE_SSL":                "true",
+					"UPLOAD_PATH":               "examplePath",
+					"PORTALS_ENDPOINT":          "exampleEndpoint4",
+					"SURVEYS_ENDPOINT":          "exampleEndpoint5",
+					"PROXY_FACADE_ENDPOINT":     "exampleEndpoint6",
+					"FILES_ENDPOINT":            "exampleEndpoint7",
+					"WEB_AUTH_URL":              "http://localhost/auth",
+					"HTTP_EXTERNAL_HOST":        "localhostTest",
+					"WEB_AUTH_REDIRECT_URI":     "test",
+					"EMPLOYEES_SEARCH_ENDPOINT": "exampleEndpoint6",
+					"EMPLOYEES_ENDPOINT":        "exampleEndpoint7",
+					"PORTALS_FACADE_ENDPOINT":   "exampleEndpoint9",
+					"NEWS_ENDPOINT":             "exampleEndpoint10",
+					"PORTALSV2_ENDPOINT":        "exampleEndpoint10",
+					"BANNERS_ENDPOINT":          "exampleEndpoint11",
+					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12",
+					"ADMIN_POLICY_FILE":         "policy.json",
+					"SURVEYS_RESPONDENT_SALT":   "salt",
+				}
+			},
+			want: func() (*Config, error) {
+				prefix := "--"
+				if runtime.GOOS == "windows" {
+					prefix = "/"
+				}
+				return nil, fmt.Errorf("config parse failed: the required flag `%sredis-addrs' was not specified", prefix)
+			},
+		},
 		{
 			name: "correct",
 			prepare: func() map[string]string {
@@ -82,7 +213,11 @@ func TestNewConfig(t *testing.T) {
 					"NEWS_ENDPOINT":             "exampleEndpoint10",
 					"PORTALSV2_ENDPOINT":        "exampleEndpoint10",
 					"BANNERS_ENDPOINT":          "exampleEndpoint12",
-					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12"}
+					"NEWS_FACADE_ENDPOINT":      "exampleEndpoint12",
+					"ADMIN_POLICY_FILE":         "policy.json",
+					"REDIS_ADDRS":               "127.0.0.1:6379",
+					"SURVEYS_RESPONDENT_SALT":   "salt",
+					"SESSION_CACHE_ENABLED":     "true"}
 			},
 			want: func() (*Config, error) {
 				return &Config{
@@ -118,12 +253,68 @@ func TestNewConfig(t *testing.T) {
 						PortalsV2Endpoint:       "exampleEndpoint10",
 						BannersEndpoint:         "exampleEndpoint12",
 						NewsFacadeEndpoint:      "exampleEndpoint12",
+						CertReloadInterval:      time.Minute,
+						PortalsClient:           defaultClient,
+						PortalsV2Client:         defaultClient,
+						SurveysClient:           defaultClient,
+						AuthFacadeClient:        defaultClient,
+						ProxyFacadeClient:       defaultClient,
+						EmployeesSearchClient:   defaultClient,
+						FilesClient:             defaultClient,
+						EmployeesClient:         defaultClient,
+						AnalyticsClient:         defaultClient,
+						PortalsFacadeClient:     defaultClient,
+						NewsFacadeClient:        defaultClient,
+						BannersClient:           defaultClient,
 					},
 					TTL: &TTL{
 						AccessToken:  time.Duration(7776000000000000),
 						RefreshToken: time.Duration(7776000000000000),
+						LastPortal:   time.Duration(7776000000000000),
+					},
+					SessionCache: &SessionCache{
+						Enabled: true,
+						Size:    10000,
+						TTL:     time.Minute,
+					},
+					SessionActivity: &SessionActivity{
+						SyncInterval: time.Minute,
+					},
+					Redis: &Redis{
+						Addrs:     "127.0.0.1:6379",
+						KeyPrefix: "web-api:",
+					},
+					Readiness: &Readiness{
+						GracePeriod:  time.Minute,
+						CheckTimeout: 2 * time.Second,
+					},
+					JWT: &JWT{
+						Leeway: 30 * time.Second,
+					},
+					NewsWorkflow: &NewsWorkflow{},
+					NewsViews: &NewsViews{
+						DedupWindow:   time.Hour,
+						FlushInterval: 10 * time.Second,
+						BatchSize:     500,
+					},
+					NewsComments: &NewsComments{
+						EditWindow: 15 * time.Minute,
+					},
+					NewsScheduler: &NewsScheduler{
+						Interval:     30 * time.Second,
+						LeaseTTL:     90 * time.Second,
+						RetryBackoff: 30 * time.Second,
+					},
+					NewsRevisions: &NewsRevisions{
+						Limit:    50,
+						DraftTTL: 720 * time.Hour,
+					},
+					Surveys: &Surveys{
+						RespondentSalt: "salt",
 					},
-					WebAuthRedirectURI: "test",
+					WebAuthRedirectURI:       "test",
+					AdminPolicyFile:          "policy.json",
+					AccessListReloadInterval: 30 * time.Second,
 				}, nil
 			},
 		},
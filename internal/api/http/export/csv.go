@@ -0,0 +1,58 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// utf8BOM метка порядка байтов, без нее Excel открывает CSV не в UTF-8
+const utf8BOM = "\xEF\xBB\xBF"
+
+type csvWriter struct {
+	writer *csv.Writer
+	row    []string
+}
+
+// NewCSVWriter запись таблицы в CSV.
+//
+//	Значения, которые табличный редактор выполнит как формулу, экранируются апострофом
+func NewCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, utf8BOM); err != nil {
+		return nil, fmt.Errorf("can't write csv bom: %w", err)
+	}
+
+	return &csvWriter{
+		writer: csv.NewWriter(w),
+	}, nil
+}
+
+func (cw *csvWriter) Write(row []string) error {
+	cw.row = cw.row[:0]
+	for _, value := range row {
+		cw.row = append(cw.row, escapeFormula(value))
+	}
+
+	return cw.writer.Write(cw.row)
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+func (cw *csvWriter) Close() error {
+	return cw.Flush()
+}
+
+func escapeFormula(value string) string {
+	if value == "" || !strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return value
+	}
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return "'" + value
+}
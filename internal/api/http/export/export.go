@@ -0,0 +1,54 @@
+// Package export потоковая запись таблиц в форматах CSV и XLSX.
+//
+//	Строки пишутся в io.Writer по мере поступления, таблица целиком в памяти не хранится
+package export
+
+import (
+	"errors"
+	"io"
+)
+
+// Format формат выгрузки
+type Format string
+
+const (
+	FormatCSV  Format = "csv"
+	FormatXLSX Format = "xlsx"
+)
+
+// ErrUnknownFormat неизвестный формат выгрузки
+var ErrUnknownFormat = errors.New("unknown export format")
+
+// Writer запись строк таблицы
+type Writer interface {
+	// Write записывает строку таблицы
+	Write(row []string) error
+	// Flush отправляет записанные строки в io.Writer
+	Flush() error
+	// Close завершает таблицу. После Close запись строк недоступна
+	Close() error
+}
+
+// NewWriter запись таблицы в формате format
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return NewCSVWriter(w)
+	case FormatXLSX:
+		return NewXLSXWriter(w)
+	default:
+		return nil, ErrUnknownFormat
+	}
+}
+
+// ContentType тип содержимого выгрузки для заголовка Content-Type
+func (f Format) ContentType() string {
+	switch f {
+	case FormatCSV:
+		return "text/csv; charset=utf-8"
+	case FormatXLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	default:
+		return "application/octet-stream"
+	}
+}
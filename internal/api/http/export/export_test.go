@@ -0,0 +1,79 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_csvWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(FormatCSV, buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, w.Write([]string{"Вопрос", "Ответ"}))
+	assert.NoError(t, w.Write([]string{"Возраст", "-5"}))
+	assert.NoError(t, w.Write([]string{"Комментарий", "=HYPERLINK(\"x\"), \"да\""}))
+	assert.NoError(t, w.Close())
+
+	assert.Equal(t, utf8BOM+"Вопрос,Ответ\nВозраст,-5\nКомментарий,\"'=HYPERLINK(\"\"x\"\"), \"\"да\"\"\"\n", buf.String())
+}
+
+func Test_xlsxWriter(t *testing.T) {
+	buf := new(bytes.Buffer)
+	w, err := NewWriter(FormatXLSX, buf)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NoError(t, w.Write([]string{"Вопрос", "Ответ"}))
+	assert.NoError(t, w.Flush())
+	assert.NoError(t, w.Write([]string{"Комментарий", "<b>&\x01"}))
+	assert.NoError(t, w.Close())
+
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	names := make([]string, 0, len(archive.File))
+	var sheet []byte
+	for _, file := range archive.File {
+		names = append(names, file.Name)
+		r, err := file.Open()
+		if !assert.NoError(t, err) {
+			return
+		}
+		content, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.NoError(t, xml.Unmarshal(content, new(struct{})), file.Name)
+		if file.Name == xlsxSheetName {
+			sheet = content
+		}
+	}
+	assert.Equal(t, []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels", xlsxSheetName}, names)
+
+	var parsed struct {
+		Rows []struct {
+			Cells []string `xml:"c>is>t"`
+		} `xml:"sheetData>row"`
+	}
+	assert.NoError(t, xml.Unmarshal(sheet, &parsed))
+	if !assert.Len(t, parsed.Rows, 2) {
+		return
+	}
+	assert.Equal(t, []string{"Вопрос", "Ответ"}, parsed.Rows[0].Cells)
+	assert.Equal(t, []string{"Комментарий", "<b>&�"}, parsed.Rows[1].Cells)
+}
+
+func Test_NewWriter_unknownFormat(t *testing.T) {
+	w, err := NewWriter("pdf", new(bytes.Buffer))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+	assert.Nil(t, w)
+}
@@ -0,0 +1,123 @@
+package export
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+)
+
+// xlsxParts служебные части книги, лист с данными пишется последним
+var xlsxParts = []struct {
+	name    string
+	content string
+}{
+	{
+		name: "[Content_Types].xml",
+		content: xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+			`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+			`<Default Extension="xml" ContentType="application/xml"/>` +
+			`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+			`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+			`</Types>`,
+	},
+	{
+		name: "_rels/.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+			`</Relationships>`,
+	},
+	{
+		name: "xl/workbook.xml",
+		content: xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" ` +
+			`xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+			`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+			`</workbook>`,
+	},
+	{
+		name: "xl/_rels/workbook.xml.rels",
+		content: xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+			`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+			`</Relationships>`,
+	},
+}
+
+const (
+	xlsxSheetName  = "xl/worksheets/sheet1.xml"
+	xlsxSheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetEnd   = `</sheetData></worksheet>`
+)
+
+type xlsxWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+}
+
+// NewXLSXWriter запись таблицы в XLSX с одним листом.
+//
+//	Значения записываются строками без общей таблицы строк (inlineStr), поэтому книгу не нужно
+//	собирать в памяти: лист сжимается и отправляется по мере записи строк
+func NewXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		partWriter, err := archive.Create(part.name)
+		if err != nil {
+			return nil, fmt.Errorf("can't create xlsx part %s: %w", part.name, err)
+		}
+		if _, err := io.WriteString(partWriter, part.content); err != nil {
+			return nil, fmt.Errorf("can't write xlsx part %s: %w", part.name, err)
+		}
+	}
+
+	sheetWriter, err := archive.Create(xlsxSheetName)
+	if err != nil {
+		return nil, fmt.Errorf("can't create xlsx sheet: %w", err)
+	}
+	sheet := bufio.NewWriter(sheetWriter)
+	if _, err := sheet.WriteString(xlsxSheetStart); err != nil {
+		return nil, fmt.Errorf("can't write xlsx sheet: %w", err)
+	}
+
+	return &xlsxWriter{
+		archive: archive,
+		sheet:   sheet,
+	}, nil
+}
+
+func (xw *xlsxWriter) Write(row []string) error {
+	if _, err := xw.sheet.WriteString("<row>"); err != nil {
+		return err
+	}
+	for _, value := range row {
+		if _, err := xw.sheet.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`); err != nil {
+			return err
+		}
+		// недопустимые в XML символы заменяются на U+FFFD
+		if err := xml.EscapeText(xw.sheet, []byte(value)); err != nil {
+			return err
+		}
+		if _, err := xw.sheet.WriteString("</t></is></c>"); err != nil {
+			return err
+		}
+	}
+	_, err := xw.sheet.WriteString("</row>")
+	return err
+}
+
+func (xw *xlsxWriter) Flush() error {
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.archive.Flush()
+}
+
+func (xw *xlsxWriter) Close() error {
+	if _, err := xw.sheet.WriteString(xlsxSheetEnd); err != nil {
+		return err
+	}
+	if err := xw.sheet.Flush(); err != nil {
+		return err
+	}
+	return xw.archive.Close()
+}
@@ -17,11 +17,14 @@ import (
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	viewNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/news"
 	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
 	usecaseNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/news"
 )
 
 type newsAdminHandlers struct {
 	categoryInteractor NewsCategoryInteractor
+	commentsInteractor NewsCommentsInteractor
+	commentsPresenter  NewsCommentsPresenter
 	newsInteractor     NewsAdminInteractor
 	newsPresenter      NewsAdminPresenter
 	logger             ditzap.Logger
@@ -29,12 +32,16 @@ type newsAdminHandlers struct {
 
 func NewNewsAdminHandlers(
 	categoryInteractor NewsCategoryInteractor,
+	commentsInteractor NewsCommentsInteractor,
+	commentsPresenter NewsCommentsPresenter,
 	newsInteractor NewsAdminInteractor,
 	newsPresenter NewsAdminPresenter,
 	logger ditzap.Logger,
 ) *newsAdminHandlers {
 	return &newsAdminHandlers{
 		categoryInteractor: categoryInteractor,
+		commentsInteractor: commentsInteractor,
+		commentsPresenter:  commentsPresenter,
 		newsInteractor:     newsInteractor,
 		newsPresenter:      newsPresenter,
 		logger:             logger,
@@ -56,6 +63,7 @@ func (h *newsAdminHandlers) createNews(c *gin.Context) {
 	if err != nil {
 		h.logger.Error("can't marshal news content", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		return
 	}
 	dto.Body = b
 
@@ -83,6 +91,9 @@ func (h *newsAdminHandlers) createNews(c *gin.Context) {
 		case errors.Is(err, dtoNews.ErrPublishTimeBeforeNow):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(viewNews.ErrPublishTimeBeforeNow)
+		case errors.Is(err, dtoNews.ErrNewsBody):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrContent)
 		case errors.As(err, &alreadyExists):
 			resp.code = http.StatusConflict
 			resp.response = view.NewErrorResponse(alreadyExists)
@@ -91,6 +102,9 @@ func (h *newsAdminHandlers) createNews(c *gin.Context) {
 		case errors.As(err, new(diterrors.ValidationError)):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
 		default:
 			resp.code = http.StatusInternalServerError
 			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
@@ -99,14 +113,8 @@ func (h *newsAdminHandlers) createNews(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		ID        uuid.UUID  `json:"id"`
-		UpdatedAt *time.Time `json:"-"`
-	}
-
-	resp := &response{
+	resp := &viewNews.CreateNewsResponse{
 		ID: newsID,
-		// UpdatedAt Неоткуда взять. Не предусмотрено.
 	}
 
 	c.JSON(http.StatusOK, view.NewSuccessResponse(resp))
@@ -139,7 +147,17 @@ func (h *newsAdminHandlers) updateNews(c *gin.Context) {
 		}
 		resp := &errResponse{}
 		var alreadyExists diterrors.AlreadyExistsError
+		var conflict *usecaseNews.ConflictError
 		switch {
+		case errors.As(err, &conflict):
+			resp.code = http.StatusConflict
+			resp.response = h.conflictResponse(conflict)
+		case errors.Is(err, usecaseNews.ErrNewsVersionRequired):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsVersionRequired)
+		case errors.Is(err, usecaseNews.ErrNewsLocked):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsLocked)
 		case errors.Is(err, dtoNews.ErrNewsSlugRequired):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(viewNews.ErrSlugRequired)
@@ -155,6 +173,9 @@ func (h *newsAdminHandlers) updateNews(c *gin.Context) {
 		case errors.Is(err, dtoNews.ErrPublishTimeBeforeNow):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(viewNews.ErrPublishTimeBeforeNow)
+		case errors.Is(err, dtoNews.ErrNewsBody):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrContent)
 		case errors.As(err, &alreadyExists):
 			resp.code = http.StatusConflict
 			resp.response = view.NewErrorResponse(alreadyExists)
@@ -166,6 +187,9 @@ func (h *newsAdminHandlers) updateNews(c *gin.Context) {
 		case errors.Is(err, diterrors.ErrNotFound):
 			resp.code = http.StatusNotFound
 			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
 		default:
 			resp.code = http.StatusInternalServerError
 			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
@@ -174,11 +198,7 @@ func (h *newsAdminHandlers) updateNews(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		UpdatedAt *time.Time `json:"updatedAt"`
-	}
-
-	resp := &response{
+	resp := &viewNews.UpdateNewsResponse{
 		UpdatedAt: updatedNews.GetUpdatedAtPtr(),
 	}
 
@@ -196,9 +216,7 @@ func (h *newsAdminHandlers) setStatusNews(c *gin.Context) {
 		return
 	}
 
-	var req struct {
-		Status viewNews.NewsStatus `json:"status"`
-	}
+	var req viewNews.SetNewsStatusRequest
 	if err := c.BindJSON(&req); err != nil {
 		h.logger.Error("failed to bind JSON for status update", zap.Error(err))
 		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
@@ -212,7 +230,7 @@ func (h *newsAdminHandlers) setStatusNews(c *gin.Context) {
 		return
 	}
 
-	updatedNews, err := h.newsInteractor.ChangeStatus(ctx, id, status)
+	updatedNews, err := h.newsInteractor.ChangeStatus(ctx, id, status, req.Comment)
 	if err != nil {
 		type errResponse struct {
 			code     int
@@ -220,6 +238,24 @@ func (h *newsAdminHandlers) setStatusNews(c *gin.Context) {
 		}
 		resp := &errResponse{}
 		switch {
+		case errors.Is(err, usecaseNews.ErrStatusTransition):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrStatusTransition)
+		case errors.Is(err, usecaseNews.ErrSelfApproval):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(viewNews.ErrSelfApproval)
+		case errors.Is(err, usecaseNews.ErrSubmitterUnknown):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrSubmitterUnknown)
+		case errors.Is(err, usecaseNews.ErrNewsLocked):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsLocked)
+		case errors.Is(err, usecaseNews.ErrPublicationAtRequired):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrPublicationAtRequired)
+		case errors.Is(err, dtoNews.ErrNewsTitleRequired):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrTitleRequired)
 		case errors.Is(err, dtoNews.ErrNewsStatus):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(viewNews.ErrStatus)
@@ -234,6 +270,9 @@ func (h *newsAdminHandlers) setStatusNews(c *gin.Context) {
 		case errors.Is(err, diterrors.ErrNotFound):
 			resp.code = http.StatusNotFound
 			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
 		default:
 			resp.code = http.StatusInternalServerError
 			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
@@ -242,11 +281,7 @@ func (h *newsAdminHandlers) setStatusNews(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		UpdatedAt *time.Time `json:"updatedAt"`
-	}
-
-	resp := &response{
+	resp := &viewNews.UpdateNewsResponse{
 		UpdatedAt: updatedNews.GetUpdatedAtPtr(),
 	}
 
@@ -296,11 +331,7 @@ func (h *newsAdminHandlers) setFlagsNews(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		UpdatedAt *time.Time `json:"updatedAt"`
-	}
-
-	resp := &response{
+	resp := &viewNews.UpdateNewsResponse{
 		UpdatedAt: updatedNews.GetUpdatedAtPtr(),
 	}
 
@@ -333,6 +364,9 @@ func (h *newsAdminHandlers) getNews(c *gin.Context) {
 		case errors.As(err, new(diterrors.ValidationError)):
 			resp.code = http.StatusBadRequest
 			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
 		default:
 			resp.code = http.StatusInternalServerError
 			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
@@ -344,6 +378,104 @@ func (h *newsAdminHandlers) getNews(c *gin.Context) {
 	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.FullNewsToView(news)))
 }
 
+func (h *newsAdminHandlers) history(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	history, err := h.newsInteractor.History(ctx, id)
+	if err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		switch {
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.Is(err, diterrors.ErrFailedPrecondition):
+			fallthrough
+		case errors.As(err, new(diterrors.ValidationError)):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
+		default:
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.HistoryToView(history)))
+}
+
+func (h *newsAdminHandlers) viewsStats(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	// По умолчанию статистика за последние 30 дней
+	to := time.Now()
+	if value, ok := c.GetQuery("to"); ok {
+		if to, err = time.Parse(viewNews.ViewsDateLayout, value); err != nil {
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(viewNews.ErrViewsPeriod))
+			return
+		}
+	}
+	from := to.AddDate(0, 0, -29)
+	if value, ok := c.GetQuery("from"); ok {
+		if from, err = time.Parse(viewNews.ViewsDateLayout, value); err != nil {
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(viewNews.ErrViewsPeriod))
+			return
+		}
+	}
+
+	stats, err := h.newsInteractor.ViewsStats(ctx, id, from, to)
+	if err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		switch {
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.Is(err, usecaseNews.ErrViewsPeriod):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrViewsPeriod)
+		case errors.Is(err, diterrors.ErrFailedPrecondition):
+			fallthrough
+		case errors.As(err, new(diterrors.ValidationError)):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
+		default:
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.ViewsStatsToView(stats)))
+}
+
 func (h *newsAdminHandlers) searchNews(c *gin.Context) {
 	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
 	defer cancelCtx()
@@ -354,7 +486,11 @@ func (h *newsAdminHandlers) searchNews(c *gin.Context) {
 		return
 	}
 
-	req := h.newsPresenter.SearchNewsToDTO(search)
+	req, err := h.newsPresenter.SearchNewsToDTO(search)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(viewNews.ErrSearchCursor))
+		return
+	}
 
 	news, err := h.newsInteractor.Search(ctx, req)
 	if err != nil {
@@ -364,6 +500,12 @@ func (h *newsAdminHandlers) searchNews(c *gin.Context) {
 		}
 		resp := &errResponse{}
 		switch {
+		case errors.Is(err, dtoNews.ErrSearchCursor):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrSearchCursor)
+		case errors.Is(err, dtoNews.ErrSearchPageTooDeep):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrSearchPageTooDeep)
 		case errors.Is(err, diterrors.ErrFailedPrecondition):
 			fallthrough
 		case errors.As(err, new(diterrors.ValidationError)):
@@ -378,8 +520,9 @@ func (h *newsAdminHandlers) searchNews(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, view.NewSuccessResponse(&viewNews.SearchNewsResponse{
-		Total: news.Total,
-		Data:  h.newsPresenter.FullNewsToSearchItems(news.News),
+		Total:      news.Total,
+		NextCursor: h.newsPresenter.ScrollToCursor(news.Next),
+		Data:       h.newsPresenter.FullNewsToSearchItems(news.News),
 	}))
 }
 
@@ -401,6 +544,10 @@ func (h *newsAdminHandlers) deleteNews(c *gin.Context) {
 			fallthrough
 		case errors.As(err, new(diterrors.ValidationError)):
 			c.JSON(http.StatusBadRequest, view.NewErrorResponse(err))
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			c.JSON(http.StatusForbidden, view.NewErrorResponse(view.ErrPermissionDenied))
+		default:
+			c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
 		}
 		return
 	}
@@ -574,16 +721,333 @@ func (h *newsAdminHandlers) getCategory(c *gin.Context) {
 		return
 	}
 
-	// TODO: когда устаканится контракт переделать на нормальную структуру
-	type response struct {
-		Id        uuid.UUID  `json:"id"`
-		Title     string     `json:"title"`
-		UpdatedAt *time.Time `json:"updatedAt"`
-	}
-
-	resp := &response{
-		Id:    res.ID,
+	resp := &viewNews.CategoryInfo{
+		ID:    res.ID,
 		Title: res.Name,
 	}
 	c.JSON(http.StatusOK, view.NewSuccessResponse(resp))
 }
+
+func (h *newsAdminHandlers) hideComment(c *gin.Context) {
+	h.setCommentHidden(c, true)
+}
+
+func (h *newsAdminHandlers) restoreComment(c *gin.Context) {
+	h.setCommentHidden(c, false)
+}
+
+// setCommentHidden скрывает или восстанавливает комментарий к новости
+func (h *newsAdminHandlers) setCommentHidden(c *gin.Context, hidden bool) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	if err = h.commentsInteractor.SetHidden(ctx, newsID, commentID, hidden); err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		switch {
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.Is(err, usecaseNews.ErrCommentNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrCommentNotFound)
+		case errors.Is(err, usecaseNews.ErrForeignPortal):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(viewNews.ErrForeignPortal)
+		default:
+			h.logger.Error("set comment hidden failed", zap.Error(err))
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+func (h *newsAdminHandlers) commentReports(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	reports, err := h.commentsInteractor.Reports(ctx)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.commentsPresenter.ReportsToView(reports)))
+}
+
+func (h *newsAdminHandlers) dismissCommentReport(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param("reportId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	if err = h.commentsInteractor.DismissReport(ctx, id); err != nil {
+		if errors.Is(err, usecaseNews.ErrReportNotFound) {
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrReportNotFound))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+func (h *newsAdminHandlers) getDraft(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	draft, err := h.newsInteractor.Draft(ctx, id)
+	if err != nil {
+		if errors.Is(err, usecaseNews.ErrDraftNotFound) {
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrDraftNotFound))
+			return
+		}
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.DraftToView(draft)))
+}
+
+func (h *newsAdminHandlers) saveDraft(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	draftNews := new(viewNews.UpdateNews)
+	if err := c.BindJSON(draftNews); err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	draft, err := h.newsInteractor.SaveDraft(ctx, id, h.newsPresenter.UpdateNewsToDTO(draftNews))
+	if err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		switch {
+		case errors.Is(err, dtoNews.ErrNewsBody):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrContent)
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.As(err, new(diterrors.ValidationError)):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
+		default:
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.DraftToView(draft)))
+}
+
+func (h *newsAdminHandlers) deleteDraft(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	if err = h.newsInteractor.DeleteDraft(ctx, id); err != nil {
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+func (h *newsAdminHandlers) revisions(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	revisions, err := h.newsInteractor.Revisions(ctx, id)
+	if err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		switch {
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.As(err, new(diterrors.ValidationError)):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
+		default:
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.RevisionsToView(revisions)))
+}
+
+func (h *newsAdminHandlers) revision(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	revision, err := h.newsInteractor.Revision(ctx, id, revisionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecaseNews.ErrRevisionNotFound):
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrRevisionNotFound))
+		case errors.Is(err, diterrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrNewsNotFound))
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			c.JSON(http.StatusForbidden, view.NewErrorResponse(view.ErrPermissionDenied))
+		default:
+			c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.newsPresenter.RevisionToView(revision)))
+}
+
+func (h *newsAdminHandlers) restoreRevision(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	revisionID, err := uuid.Parse(c.Param("revisionId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	// Время изменения новости обязательно, по нему проверяется конфликт правок
+	req := new(viewNews.RestoreRevisionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	updatedNews, err := h.newsInteractor.RestoreRevision(ctx, id, revisionID, req.UpdatedAt)
+	if err != nil {
+		type errResponse struct {
+			code     int
+			response *view.Response
+		}
+		resp := &errResponse{}
+		var alreadyExists diterrors.AlreadyExistsError
+		var conflict *usecaseNews.ConflictError
+		switch {
+		case errors.As(err, &conflict):
+			resp.code = http.StatusConflict
+			resp.response = h.conflictResponse(conflict)
+		case errors.Is(err, usecaseNews.ErrNewsVersionRequired):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsVersionRequired)
+		case errors.Is(err, usecaseNews.ErrNewsLocked):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsLocked)
+		case errors.Is(err, usecaseNews.ErrRevisionNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrRevisionNotFound)
+		case errors.Is(err, diterrors.ErrNotFound):
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+		case errors.As(err, &alreadyExists):
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(alreadyExists)
+		case errors.Is(err, diterrors.ErrFailedPrecondition):
+			fallthrough
+		case errors.As(err, new(diterrors.ValidationError)):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(err)
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(view.ErrPermissionDenied)
+		default:
+			resp.code = http.StatusInternalServerError
+			resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+		}
+		c.JSON(resp.code, resp.response)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(&viewNews.UpdateNewsResponse{
+		UpdatedAt: updatedNews.GetUpdatedAtPtr(),
+	}))
+}
+
+// conflictResponse ошибка конфликта правок с текущей версией новости, чтобы редактор мог сверить изменения
+func (h *newsAdminHandlers) conflictResponse(conflict *usecaseNews.ConflictError) *view.Response {
+	response := view.NewErrorResponse(viewNews.ErrNewsConflict)
+	response.Data = h.newsPresenter.FullNewsToView(conflict.Current)
+	return response
+}
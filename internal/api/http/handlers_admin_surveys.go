@@ -0,0 +1,404 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/export"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
+	viewAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
+	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+// surveyImageMaxBodySize ограничение тела запроса загрузки изображения: изображение в base64 и описание
+const surveyImageMaxBodySize = surveys.ImageMaxSize*4/3 + 64<<10
+
+type surveysAdminHandlers struct {
+	surveysInteractor SurveysAdminInteractor
+	surveysPresenter  SurveysPresenter
+	imagesInteractor  SurveysImagesInteractor
+	imagesPresenter   SurveysImagesPresenter
+	resultsInteractor SurveysResultsInteractor
+	resultsPresenter  SurveysResultsPresenter
+	logger            ditzap.Logger
+}
+
+func NewSurveysAdminHandlers(
+	surveysInteractor SurveysAdminInteractor,
+	surveysPresenter SurveysPresenter,
+	imagesInteractor SurveysImagesInteractor,
+	imagesPresenter SurveysImagesPresenter,
+	resultsInteractor SurveysResultsInteractor,
+	resultsPresenter SurveysResultsPresenter,
+	logger ditzap.Logger,
+) *surveysAdminHandlers {
+	return &surveysAdminHandlers{
+		surveysInteractor: surveysInteractor,
+		surveysPresenter:  surveysPresenter,
+		imagesInteractor:  imagesInteractor,
+		imagesPresenter:   imagesPresenter,
+		resultsInteractor: resultsInteractor,
+		resultsPresenter:  resultsPresenter,
+		logger:            logger,
+	}
+}
+
+func (h *surveysAdminHandlers) create(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	newSurvey := new(viewSurveys.NewSurvey)
+	if err := c.ShouldBindJSON(newSurvey); err != nil {
+		h.logger.Debug("can't unbind NewSurvey json", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	id, err := h.surveysInteractor.Create(ctx, h.surveysPresenter.ToNewEntity(newSurvey))
+	if err != nil {
+		h.errorResponse(c, "can't create survey", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, view.NewSuccessResponse(h.surveysPresenter.IDToView(id)))
+}
+
+func (h *surveysAdminHandlers) get(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+
+	survey, err := h.surveysInteractor.Get(ctx, id)
+	if err != nil {
+		h.errorResponse(c, "can't get survey", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.surveysPresenter.ToView(survey)))
+}
+
+func (h *surveysAdminHandlers) search(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	req := new(viewSurveys.GetAllSurveys)
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Debug("can't unbind GetAllSurveys json", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	result, err := h.surveysInteractor.GetAll(
+		ctx,
+		h.surveysPresenter.IDsToEntities(req.SurveyIDs),
+		h.surveysPresenter.RespondentToEntity(req.Respondents),
+		h.surveysPresenter.OptionsToEntity(&req.Options),
+		h.surveysPresenter.PaginationToEntity(&req.Pagination),
+	)
+	if err != nil {
+		h.errorResponse(c, "can't get surveys", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.surveysPresenter.SurveysWithPaginationToView(result)))
+}
+
+// update изменение опроса. Вопросы и варианты ответа передаются полным списком,
+// порядок задается полем weight
+func (h *surveysAdminHandlers) update(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+
+	req := new(viewSurveys.Survey)
+	if err := c.ShouldBindJSON(req); err != nil {
+		h.logger.Debug("can't unbind Survey json", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	req.ID = uuid.UUID(id)
+
+	survey, err := h.surveysInteractor.Update(ctx, h.surveysPresenter.ToEntity(req))
+	if err != nil {
+		h.errorResponse(c, "can't update survey", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.surveysPresenter.ToView(survey)))
+}
+
+func (h *surveysAdminHandlers) publish(c *gin.Context) {
+	h.setPublished(c, true)
+}
+
+func (h *surveysAdminHandlers) unpublish(c *gin.Context) {
+	h.setPublished(c, false)
+}
+
+func (h *surveysAdminHandlers) setPublished(c *gin.Context, published bool) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+
+	survey, err := h.surveysInteractor.SetPublished(ctx, id, published)
+	if err != nil {
+		h.errorResponse(c, "can't change survey publication", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.surveysPresenter.ToView(survey)))
+}
+
+func (h *surveysAdminHandlers) delete(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+
+	if err := h.surveysInteractor.Delete(ctx, id); err != nil {
+		h.errorResponse(c, "can't delete survey", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+// uploadImage загрузка изображения для вариантов ответа вопросов checkboxImg и radioImg.
+// Полученный объект передается в поле image варианта ответа
+func (h *surveysAdminHandlers) uploadImage(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, surveyImageMaxBodySize)
+	newImage := new(viewSurveys.NewSurveyImageObject)
+	if err := c.ShouldBindJSON(newImage); err != nil {
+		h.logger.Debug("can't unbind NewSurveyImageObject json", zap.Error(err))
+		if errors.As(err, new(*http.MaxBytesError)) {
+			c.JSON(http.StatusRequestEntityTooLarge, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+			return
+		}
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	image, err := h.imagesInteractor.Add(ctx, h.imagesPresenter.ToNewEntity(newImage))
+	if err != nil {
+		h.errorResponse(c, "can't upload survey image", err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, view.NewSuccessResponse(h.imagesPresenter.ToView(image)))
+}
+
+// results статистика ответов на опрос по вопросам.
+// Параметры portal_id и organization_id (можно указать несколько раз) ограничивают ответы порталами
+// и организациями респондентов
+func (h *surveysAdminHandlers) results(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+	filter, ok := h.answersFilter(c, id)
+	if !ok {
+		return
+	}
+
+	results, err := h.resultsInteractor.Results(ctx, filter)
+	if err != nil {
+		h.errorResponse(c, "can't get survey results", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.resultsPresenter.ToView(results)))
+}
+
+// textAnswers ответы с произвольным текстом на вопрос постранично.
+// Следующая страница запрашивается с last_id из предыдущего ответа
+func (h *surveysAdminHandlers) textAnswers(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+	questionID, err := uuid.Parse(c.Param(QUESTION_ID_PARAM_KEY))
+	if err != nil {
+		h.logger.Debug("can't parse param question_id into uuid", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	filter, ok := h.answersFilter(c, id)
+	if !ok {
+		return
+	}
+
+	var pagination surveys.AnswersPagination
+	if limit := c.Query("limit"); limit != "" {
+		value, err := strconv.ParseUint(limit, 10, 32)
+		if err != nil {
+			h.logger.Debug("can't parse query limit", zap.Error(err))
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+			return
+		}
+		pagination.Limit = uint32(value)
+	}
+	if lastID := c.Query("last_id"); lastID != "" {
+		value, err := uuid.Parse(lastID)
+		if err != nil {
+			h.logger.Debug("can't parse query last_id into uuid", zap.Error(err))
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+			return
+		}
+		pagination.LastID = &value
+	}
+
+	qID := surveys.QuestionID(questionID)
+	filter.QuestionID = &qID
+	answers, err := h.resultsInteractor.TextAnswers(ctx, filter, pagination)
+	if err != nil {
+		h.errorResponse(c, "can't get survey text answers", err)
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(h.resultsPresenter.TextAnswersToView(answers)))
+}
+
+// exportResults выгрузка ответов на опрос в CSV или XLSX (параметр format, по умолчанию csv).
+//
+//	Файл отдается потоком: каждая страница ответов отправляется клиенту сразу после чтения из хранилища.
+//	Ошибка после начала отправки не может изменить статус ответа, поэтому она только логируется,
+//	а файл остается незавершенным
+func (h *surveysAdminHandlers) exportResults(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), ExportTimeOut)
+	defer cancelCtx()
+
+	id, ok := h.surveyID(c)
+	if !ok {
+		return
+	}
+	filter, ok := h.answersFilter(c, id)
+	if !ok {
+		return
+	}
+	format := export.Format(c.DefaultQuery("format", string(export.FormatCSV)))
+	if format != export.FormatCSV && format != export.FormatXLSX {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	var writer export.Writer
+	start := func() error {
+		c.Header("Content-Type", format.ContentType())
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="survey-%s.%s"`, id, format))
+		c.Status(http.StatusOK)
+
+		var err error
+		if writer, err = export.NewWriter(format, c.Writer); err != nil {
+			return err
+		}
+		return writer.Write(h.resultsPresenter.ResponseRowsHeader())
+	}
+
+	err := h.resultsInteractor.Export(ctx, filter, func(rows []*surveys.ResponseRow) error {
+		if writer == nil {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		for _, row := range rows {
+			if err := writer.Write(h.resultsPresenter.ResponseRowToView(row)); err != nil {
+				return err
+			}
+		}
+		if err := writer.Flush(); err != nil {
+			return err
+		}
+		c.Writer.Flush()
+		return nil
+	})
+	if err == nil && writer == nil {
+		err = start()
+	}
+	if err != nil {
+		if writer == nil {
+			h.errorResponse(c, "can't export survey results", err)
+			return
+		}
+		h.logger.Error("can't export survey results", zap.Error(err))
+		return
+	}
+
+	if err := writer.Close(); err != nil {
+		h.logger.Error("can't close survey results export", zap.Error(err))
+	}
+}
+
+// answersFilter фильтр ответов по респондентам из параметров portal_id и organization_id
+func (h *surveysAdminHandlers) answersFilter(c *gin.Context, id surveys.SurveyID) (surveys.AnswersFilter, bool) {
+	filter, err := h.resultsPresenter.FilterToEntity(id, &viewSurveys.SurveyResultsFilter{
+		PortalIDs:       c.QueryArray("portal_id"),
+		OrganizationIDs: c.QueryArray("organization_id"),
+	})
+	if err != nil {
+		h.logger.Debug("can't parse survey answers filter", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return surveys.AnswersFilter{}, false
+	}
+	return filter, true
+}
+
+func (h *surveysAdminHandlers) surveyID(c *gin.Context) (surveys.SurveyID, bool) {
+	id, err := uuid.Parse(c.Param(ID_PARAM_KEY))
+	if err != nil {
+		h.logger.Debug("can't parse param id into uuid", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return surveys.SurveyID{}, false
+	}
+	return surveys.SurveyID(id), true
+}
+
+func (h *surveysAdminHandlers) errorResponse(c *gin.Context, msg string, err error) {
+	switch {
+	case errors.Is(err, diterrors.ErrNotFound):
+		c.JSON(http.StatusNotFound, view.NewErrorResponse(view.ErrMessageNotFound))
+	case errors.As(err, new(diterrors.ValidationError)):
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(err))
+	case errors.Is(err, usecase.ErrGetSessionFromContext):
+		c.JSON(http.StatusUnauthorized, view.NewErrorResponse(viewAuth.ErrMessageFrontUnauthenticated))
+	case errors.Is(err, usecase.ErrPermissionDenied):
+		c.JSON(http.StatusForbidden, view.NewErrorResponse(view.ErrPermissionDenied))
+	default:
+		h.logger.Error(msg, zap.Error(err))
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+	}
+}
@@ -0,0 +1,338 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/export"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
+	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+)
+
+type surveysResultsFields struct {
+	interactor *MockSurveysResultsInteractor
+	presenter  *MockSurveysResultsPresenter
+	logger     *ditzap.MockLogger
+}
+
+// serveSurveysResults выполняет запрос target к ручке, зарегистрированной по пути path
+func serveSurveysResults(
+	t *testing.T,
+	path, target string,
+	handler func(h *surveysAdminHandlers) gin.HandlerFunc,
+	prepare func(f surveysResultsFields),
+) *httptest.ResponseRecorder {
+	ctrl := gomock.NewController(t)
+	f := surveysResultsFields{
+		interactor: NewMockSurveysResultsInteractor(ctrl),
+		presenter:  NewMockSurveysResultsPresenter(ctrl),
+		logger:     ditzap.NewMockLogger(ctrl),
+	}
+	f.logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	if prepare != nil {
+		prepare(f)
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	engine.GET(path, handler(NewSurveysAdminHandlers(nil, nil, nil, nil, f.interactor, f.presenter, f.logger)))
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, target, nil))
+	return w
+}
+
+func jsonBody(t *testing.T, v any) string {
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func Test_surveysAdminHandlers_results(t *testing.T) {
+	surveyID := uuid.New()
+	organizationID := uuid.New()
+	testErr := errors.New("test")
+	results := &surveys.Results{SurveyID: surveys.SurveyID(surveyID), AnswersCount: 1}
+	resultsView := &viewSurveys.SurveyResults{SurveyID: surveyID, AnswersCount: 1}
+	filter := surveys.AnswersFilter{SurveyID: surveys.SurveyID(surveyID)}
+	noFilter := func(f surveysResultsFields) {
+		f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{}).Return(filter, nil)
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		prepare  func(f surveysResultsFields)
+		wantCode int
+		wantBody any
+	}{
+		{
+			name:   "correct",
+			target: "/surveys/" + surveyID.String() + "/results?portal_id=1&portal_id=7&organization_id=" + organizationID.String(),
+			prepare: func(f surveysResultsFields) {
+				byRespondent := surveys.AnswersFilter{
+					SurveyID:        surveys.SurveyID(surveyID),
+					PortalIDs:       []int{1, 7},
+					OrganizationIDs: []uuid.UUID{organizationID},
+				}
+				f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{
+					PortalIDs:       []string{"1", "7"},
+					OrganizationIDs: []string{organizationID.String()},
+				}).Return(byRespondent, nil)
+				f.interactor.EXPECT().Results(gomock.Any(), byRespondent).Return(results, nil)
+				f.presenter.EXPECT().ToView(results).Return(resultsView)
+			},
+			wantCode: http.StatusOK,
+			wantBody: view.NewSuccessResponse(resultsView),
+		},
+		{
+			name:   "bad filter",
+			target: "/surveys/" + surveyID.String() + "/results?portal_id=abc",
+			prepare: func(f surveysResultsFields) {
+				f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{PortalIDs: []string{"abc"}}).
+					Return(surveys.AnswersFilter{}, testErr)
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: view.NewErrorResponse(view.ErrMessageInvalidRequest),
+		},
+		{
+			name:   "not found",
+			target: "/surveys/" + surveyID.String() + "/results",
+			prepare: func(f surveysResultsFields) {
+				noFilter(f)
+				f.interactor.EXPECT().Results(gomock.Any(), filter).Return(nil, diterrors.ErrNotFound)
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: view.NewErrorResponse(view.ErrMessageNotFound),
+		},
+		{
+			name:   "invalid filter",
+			target: "/surveys/" + surveyID.String() + "/results",
+			prepare: func(f surveysResultsFields) {
+				noFilter(f)
+				f.interactor.EXPECT().Results(gomock.Any(), filter).Return(nil, diterrors.NewValidationError(testErr))
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: view.NewErrorResponse(diterrors.NewValidationError(testErr)),
+		},
+		{
+			name:   "internal err",
+			target: "/surveys/" + surveyID.String() + "/results",
+			prepare: func(f surveysResultsFields) {
+				noFilter(f)
+				f.interactor.EXPECT().Results(gomock.Any(), filter).Return(nil, testErr)
+				f.logger.EXPECT().Error("can't get survey results", zap.Error(testErr))
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: view.NewErrorResponse(view.ErrMessageInternalError),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveSurveysResults(t, "/surveys/:id/results", tt.target, func(h *surveysAdminHandlers) gin.HandlerFunc {
+				return h.results
+			}, tt.prepare)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.JSONEq(t, jsonBody(t, tt.wantBody), w.Body.String())
+		})
+	}
+}
+
+func Test_surveysAdminHandlers_textAnswers(t *testing.T) {
+	surveyID := uuid.New()
+	questionID := uuid.New()
+	lastID := uuid.New()
+	qID := surveys.QuestionID(questionID)
+	answers := &surveys.AnswersWithPagination{}
+	answersView := &viewSurveys.SurveyTextAnswers{LastID: &lastID}
+	path := "/surveys/" + surveyID.String() + "/results/questions/" + questionID.String() + "/texts"
+	noFilter := func(f surveysResultsFields) {
+		f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{}).
+			Return(surveys.AnswersFilter{SurveyID: surveys.SurveyID(surveyID)}, nil)
+	}
+
+	tests := []struct {
+		name     string
+		target   string
+		prepare  func(f surveysResultsFields)
+		wantCode int
+		wantBody any
+	}{
+		{
+			name:   "correct",
+			target: path + "?limit=20&last_id=" + lastID.String() + "&portal_id=7",
+			prepare: func(f surveysResultsFields) {
+				f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{PortalIDs: []string{"7"}}).
+					Return(surveys.AnswersFilter{SurveyID: surveys.SurveyID(surveyID), PortalIDs: []int{7}}, nil)
+				f.interactor.EXPECT().TextAnswers(
+					gomock.Any(),
+					surveys.AnswersFilter{SurveyID: surveys.SurveyID(surveyID), QuestionID: &qID, PortalIDs: []int{7}},
+					surveys.AnswersPagination{Limit: 20, LastID: &lastID},
+				).Return(answers, nil)
+				f.presenter.EXPECT().TextAnswersToView(answers).Return(answersView)
+			},
+			wantCode: http.StatusOK,
+			wantBody: view.NewSuccessResponse(answersView),
+		},
+		{
+			name:     "bad limit",
+			target:   path + "?limit=-1",
+			prepare:  noFilter,
+			wantCode: http.StatusBadRequest,
+			wantBody: view.NewErrorResponse(view.ErrMessageInvalidRequest),
+		},
+		{
+			name:     "bad last id",
+			target:   path + "?limit=20&last_id=abc",
+			prepare:  noFilter,
+			wantCode: http.StatusBadRequest,
+			wantBody: view.NewErrorResponse(view.ErrMessageInvalidRequest),
+		},
+		{
+			name:     "bad question id",
+			target:   "/surveys/" + surveyID.String() + "/results/questions/abc/texts",
+			wantCode: http.StatusBadRequest,
+			wantBody: view.NewErrorResponse(view.ErrMessageInvalidRequest),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := serveSurveysResults(t, "/surveys/:id/results/questions/:question_id/texts", tt.target, func(h *surveysAdminHandlers) gin.HandlerFunc {
+				return h.textAnswers
+			}, tt.prepare)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			assert.JSONEq(t, jsonBody(t, tt.wantBody), w.Body.String())
+		})
+	}
+}
+
+func Test_surveysAdminHandlers_exportResults(t *testing.T) {
+	surveyID := uuid.New()
+	testErr := errors.New("test")
+	rows := []*surveys.ResponseRow{{Content: "первый"}, {Content: "второй"}}
+	header := []string{"Ответ"}
+	filter := surveys.AnswersFilter{SurveyID: surveys.SurveyID(surveyID)}
+	exportRows := func(pages ...[]*surveys.ResponseRow) func(f surveysResultsFields) {
+		return func(f surveysResultsFields) {
+			f.interactor.EXPECT().Export(gomock.Any(), filter, gomock.Any()).
+				DoAndReturn(func(_ any, _ surveys.AnswersFilter, write func([]*surveys.ResponseRow) error) error {
+					for _, page := range pages {
+						if err := write(page); err != nil {
+							return err
+						}
+					}
+					return nil
+				})
+			f.presenter.EXPECT().ResponseRowsHeader().Return(header)
+			f.presenter.EXPECT().ResponseRowToView(gomock.Any()).DoAndReturn(func(row *surveys.ResponseRow) []string {
+				return []string{row.Content}
+			}).AnyTimes()
+		}
+	}
+
+	tests := []struct {
+		name            string
+		query           string
+		prepare         func(f surveysResultsFields)
+		wantCode        int
+		wantContentType string
+		wantBody        string
+	}{
+		{
+			name:            "csv",
+			prepare:         exportRows(rows[:1], rows[1:]),
+			wantCode:        http.StatusOK,
+			wantContentType: export.FormatCSV.ContentType(),
+			wantBody:        "\xEF\xBB\xBFОтвет\nпервый\nвторой\n",
+		},
+		{
+			name:            "no answers",
+			query:           "?format=csv",
+			prepare:         exportRows(),
+			wantCode:        http.StatusOK,
+			wantContentType: export.FormatCSV.ContentType(),
+			wantBody:        "\xEF\xBB\xBFОтвет\n",
+		},
+		{
+			name:            "xlsx",
+			query:           "?format=xlsx",
+			prepare:         exportRows(rows),
+			wantCode:        http.StatusOK,
+			wantContentType: export.FormatXLSX.ContentType(),
+		},
+		{
+			name:     "unknown format",
+			query:    "?format=pdf",
+			wantCode: http.StatusBadRequest,
+			wantBody: jsonBody(t, view.NewErrorResponse(view.ErrMessageInvalidRequest)),
+		},
+		{
+			name: "not found",
+			prepare: func(f surveysResultsFields) {
+				f.interactor.EXPECT().Export(gomock.Any(), filter, gomock.Any()).Return(diterrors.ErrNotFound)
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: jsonBody(t, view.NewErrorResponse(view.ErrMessageNotFound)),
+		},
+		{
+			// ошибка после отправки первой страницы только логируется
+			name: "error after start",
+			prepare: func(f surveysResultsFields) {
+				f.interactor.EXPECT().Export(gomock.Any(), filter, gomock.Any()).
+					DoAndReturn(func(_ any, _ surveys.AnswersFilter, write func([]*surveys.ResponseRow) error) error {
+						if err := write(rows); err != nil {
+							return err
+						}
+						return testErr
+					})
+				f.presenter.EXPECT().ResponseRowsHeader().Return(header)
+				f.presenter.EXPECT().ResponseRowToView(gomock.Any()).Return([]string{"row"}).Times(len(rows))
+				f.logger.EXPECT().Error("can't export survey results", zap.Error(testErr))
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: export.FormatCSV.ContentType(),
+			wantBody:        "\xEF\xBB\xBFОтвет\nrow\nrow\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prepare := func(f surveysResultsFields) {
+				f.presenter.EXPECT().FilterToEntity(surveys.SurveyID(surveyID), &viewSurveys.SurveyResultsFilter{}).Return(filter, nil)
+				if tt.prepare != nil {
+					tt.prepare(f)
+				}
+			}
+			w := serveSurveysResults(t, "/surveys/:id/results/export", "/surveys/"+surveyID.String()+"/results/export"+tt.query, func(h *surveysAdminHandlers) gin.HandlerFunc {
+				return h.exportResults
+			}, prepare)
+
+			assert.Equal(t, tt.wantCode, w.Code)
+			if tt.wantContentType != "" {
+				assert.Equal(t, tt.wantContentType, w.Header().Get("Content-Type"))
+				assert.Contains(t, w.Header().Get("Content-Disposition"), "survey-"+surveyID.String())
+			}
+			if tt.wantBody != "" {
+				if tt.wantCode == http.StatusOK {
+					assert.Equal(t, tt.wantBody, w.Body.String())
+				} else {
+					assert.JSONEq(t, tt.wantBody, w.Body.String())
+				}
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -32,6 +33,10 @@ const (
 	//  Get параметр callback_uri страница портала на которую будет
 	//  перенаправлен пользователь после успешной аутентификации в СУДИР
 	paramCallbackURI = "callback_uri"
+	// paramPortalID
+	//  Get параметр portal_id необязательный идентификатор портала, на котором
+	//  пользователь хочет работать после аутентификации
+	paramPortalID = "portal_id"
 )
 
 const defaultCookieTTL = time.Minute
@@ -54,13 +59,16 @@ func NewAuthHandlers(
 	}
 }
 
-// @Summary Получение отфильтрованного списка порталов
-// @Description Выдаются порталы, удовлетворяющие переданным фильтрам.
-// @Tags     Порталы
+// @Summary Аутентификация через СУДИР
+// @Description Без параметра state выполняется перенаправление в СУДИР, иначе code обменивается на токены.
+// @Tags     Аутентификация
 // @Produce  json
-// @Param    options body PortalsFilterOptions true "фильтры"
+// @Param    code query string false "код аутентификации, возвращается СУДИР"
+// @Param    state query string false "идентификатор сессии аутентификации"
+// @Param    callback_uri query string false "страница портала для перенаправления после аутентификации"
+// @Param    portal_id query int false "идентификатор портала для входа"
 // @Router   /auth/v1/auth [get]
-// @Success  200 {object} AuthResponse
+// @Success  200 {object} view.Response{data=AuthResponse}
 // @Failure  400,401,403,404,500 {object} ErrorResponse
 func (ah *authHandlers) auth(c *gin.Context) {
 	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
@@ -89,7 +97,18 @@ func (ah *authHandlers) auth(c *gin.Context) {
 		return
 	}
 
-	authInfo, err := ah.interactor.Auth(ctx, codeParam, stateParam, callbackURIParam)
+	var portalID int
+	if portalIDParam := c.Query(paramPortalID); portalIDParam != "" {
+		var err error
+		if portalID, err = strconv.Atoi(portalIDParam); err != nil || portalID < 1 {
+			// Неверный get-параметр portal_id
+			c.Header(StatusCodeHeader, "WAA_13")
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(authView.ErrMessageFrontInvalidParams))
+			return
+		}
+	}
+
+	authInfo, err := ah.interactor.Auth(ctx, codeParam, stateParam, callbackURIParam, portalID)
 	if err != nil {
 		switch {
 		case errors.Is(err, diterrors.ErrUnauthenticated):
@@ -102,6 +121,8 @@ func (ah *authHandlers) auth(c *gin.Context) {
 			c.JSON(http.StatusBadRequest, view.NewErrorResponse(authView.ErrMessageFrontInvalidSUDIRRedirect))
 		case errors.Is(err, authUseCase.ErrPortalsNotFound):
 			c.JSON(http.StatusForbidden, view.NewErrorResponse(authView.ErrMessageFrontPortalForUserNotFound))
+		case errors.Is(err, authUseCase.ErrUnavailablePortal):
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(authView.ErrMessageFrontUnavailablePortal))
 		case errors.Is(err, authUseCase.ErrEmployeesNotFound):
 			c.JSON(http.StatusForbidden, view.NewErrorResponse(authView.ErrMessageFrontSKSEmployeeNotFound))
 		case errors.Is(err, authUseCase.ErrSUDIRNoCloudID):
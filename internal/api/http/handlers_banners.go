@@ -58,13 +58,7 @@ func (h *bannersHandlers) list(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		Promo  *viewBanners.Banner   `json:"promo,omitempty"`
-		Slider []*viewBanners.Banner `json:"slider"`
-		Bottom *viewBanners.Banner   `json:"bottom,omitempty"`
-	}
-
-	resp := &response{}
+	resp := &viewBanners.BannersList{}
 
 	if len(promo) > 0 {
 		resp.Promo = h.bannersPresenter.BannerToView(promo[0])
@@ -113,11 +107,7 @@ func (h *bannersHandlers) set(c *gin.Context) {
 		return
 	}
 
-	type response struct {
-		Banners []*viewBanners.BannerInfo `json:"banners"`
-	}
-
-	resp := &response{
+	resp := &viewBanners.SetBannersResponse{
 		Banners: h.bannersPresenter.BannerInfosToViews(banners),
 	}
 	c.JSON(http.StatusOK, view.NewSuccessResponse(resp))
@@ -27,9 +27,9 @@ func NewFilesHandlers(filesInteractor FilesInteractor) *filesHandlers {
 // @Tags     Файлы
 // @Produce  octet-stream
 // @Param    file_id path string true "Идентификатор файла"
-// @Router   /{file_id} [get]
+// @Router   /files/v1/files/{file_id} [get]
 // @Success  200 {file} octet-stream "Файл"
-// @Failure  400,500 {object} ErrorResponse
+// @Failure  400,401,403,404,500 {object} ErrorResponse
 // @Security ApiKeyAuth
 func (fh *filesHandlers) get(c *gin.Context) {
 	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
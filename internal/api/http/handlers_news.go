@@ -9,7 +9,9 @@ import (
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	viewNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/news"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
-	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	usecaseNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/news"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	"github.com/gin-gonic/gin"
@@ -91,7 +93,11 @@ func (n *newsHandlers) searchNews(c *gin.Context) {
 		return
 	}
 
-	req := n.newsPresenter.SearchNewsToDTO(search)
+	req, err := n.newsPresenter.SearchNewsToDTO(search)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(viewNews.ErrSearchCursor))
+		return
+	}
 
 	news, err := n.newsInteractor.Search(ctx, req)
 	if err != nil {
@@ -101,6 +107,12 @@ func (n *newsHandlers) searchNews(c *gin.Context) {
 		}
 		resp := &errResponse{}
 		switch {
+		case errors.Is(err, dtoNews.ErrSearchCursor):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrSearchCursor)
+		case errors.Is(err, dtoNews.ErrSearchPageTooDeep):
+			resp.code = http.StatusBadRequest
+			resp.response = view.NewErrorResponse(viewNews.ErrSearchPageTooDeep)
 		case errors.Is(err, diterrors.ErrFailedPrecondition):
 			fallthrough
 		case errors.As(err, new(diterrors.ValidationError)):
@@ -115,8 +127,9 @@ func (n *newsHandlers) searchNews(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, view.NewSuccessResponse(&viewNews.SearchNewsResponse{
-		Total: news.Total,
-		Data:  n.newsPresenter.FullNewsToSearchItems(news.News),
+		Total:      news.Total,
+		NextCursor: n.newsPresenter.ScrollToCursor(news.Next),
+		Data:       n.newsPresenter.FullNewsToSearchItems(news.News),
 	}))
 }
 
@@ -166,6 +179,18 @@ func (n *newsHandlers) createComment(c *gin.Context) {
 			c.Header(StatusCodeHeader, "ncc_05")
 			resp.code = http.StatusConflict
 			resp.response = view.NewErrorResponse(alreadyExists)
+		case errors.Is(err, usecaseNews.ErrCommentsDisabled):
+			c.Header(StatusCodeHeader, "ncc_07")
+			resp.code = http.StatusForbidden
+			resp.response = view.NewErrorResponse(viewNews.ErrCommentsDisabled)
+		case errors.Is(err, usecaseNews.ErrCommentNotFound):
+			c.Header(StatusCodeHeader, "ncc_08")
+			resp.code = http.StatusNotFound
+			resp.response = view.NewErrorResponse(viewNews.ErrCommentNotFound)
+		case errors.Is(err, usecaseNews.ErrCommentRemoved):
+			c.Header(StatusCodeHeader, "ncc_09")
+			resp.code = http.StatusConflict
+			resp.response = view.NewErrorResponse(viewNews.ErrCommentRemoved)
 		default:
 			c.Header(StatusCodeHeader, "ncc_06")
 			n.logger.Error("create comment failed", zap.Error(err))
@@ -177,15 +202,8 @@ func (n *newsHandlers) createComment(c *gin.Context) {
 	}
 
 	// 5) Ответ целевого вида
-	type commentsPayload struct {
-		Count      int  `json:"count"`
-		IsUserMade bool `json:"isUserMade"`
-	}
-	type dataPayload struct {
-		Comments commentsPayload `json:"comments"`
-	}
-	resp := &dataPayload{
-		Comments: commentsPayload{
+	resp := &viewNews.CreateCommentResponse{
+		Comments: viewNews.CommentsCounter{
 			Count:      count,
 			IsUserMade: true,
 		},
@@ -256,16 +274,39 @@ func (n *newsHandlers) listComments(c *gin.Context) {
 	}
 	// POKAZ: end
 
-	params := &news.FilterComments{
+	// parentId - ответы на комментарий вместо комментариев верхнего уровня
+	var parentID *uuid.UUID
+	if parent, ok := c.GetQuery("parentId"); ok && parent != "" {
+		pID, err := uuid.Parse(parent)
+		if err != nil {
+			c.Header(StatusCodeHeader, "nlc_08")
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+			return
+		}
+		parentID = &pID
+	}
+
+	params := &dtoNews.FilterComments{
 		NewsID:    newsID,
 		SortField: sort,
 		Order:     order,
 		AfterID:   AfterID,
 		Limit:     l,
+		ParentID:  parentID,
 	}
 
 	// пока total нет в контракте
 	list, _, err := n.commentsInteractor.List(ctx, params)
+	if errors.Is(err, usecaseNews.ErrCommentNotFound) {
+		c.Header(StatusCodeHeader, "nlc_09")
+		c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrCommentNotFound))
+		return
+	}
+	if errors.Is(err, diterrors.ErrNotFound) {
+		c.Header(StatusCodeHeader, "nlc_10")
+		c.JSON(http.StatusNotFound, view.NewErrorResponse(viewNews.ErrNewsNotFound))
+		return
+	}
 	if err != nil {
 		c.Header(StatusCodeHeader, "nlc_07")
 		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(err))
@@ -275,3 +316,221 @@ func (n *newsHandlers) listComments(c *gin.Context) {
 	c.JSON(http.StatusOK, view.NewSuccessResponse(n.commentsPresenter.CommentsToView(list)))
 	return
 }
+
+func (n *newsHandlers) addReaction(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancel()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nra_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	req := new(viewNews.NewsReactionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.Header(StatusCodeHeader, "nra_02")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	reactions, err := n.newsInteractor.AddReaction(ctx, newsID, entityNews.ReactionType(req.Type))
+	if err != nil {
+		n.reactionError(c, err, "nra")
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(n.newsPresenter.ReactionsToView(reactions)))
+}
+
+func (n *newsHandlers) removeReaction(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancel()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nrd_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	req := new(viewNews.NewsReactionRequest)
+	if err := c.BindJSON(req); err != nil {
+		c.Header(StatusCodeHeader, "nrd_02")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	reactions, err := n.newsInteractor.RemoveReaction(ctx, newsID, entityNews.ReactionType(req.Type))
+	if err != nil {
+		n.reactionError(c, err, "nrd")
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(n.newsPresenter.ReactionsToView(reactions)))
+}
+
+// reactionError ответ на ошибку добавления или снятия реакции, codePrefix - префикс кода ошибки обработчика
+func (n *newsHandlers) reactionError(c *gin.Context, err error, codePrefix string) {
+	type errResponse struct {
+		code     int
+		response *view.Response
+	}
+	resp := &errResponse{}
+
+	switch {
+	case errors.Is(err, diterrors.ErrNotFound):
+		c.Header(StatusCodeHeader, codePrefix+"_03")
+		resp.code = http.StatusNotFound
+		resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+	case errors.Is(err, usecaseNews.ErrReactionType):
+		c.Header(StatusCodeHeader, codePrefix+"_04")
+		resp.code = http.StatusBadRequest
+		resp.response = view.NewErrorResponse(viewNews.ErrReactionType)
+	case errors.Is(err, usecaseNews.ErrReactionsDisabled):
+		c.Header(StatusCodeHeader, codePrefix+"_05")
+		resp.code = http.StatusForbidden
+		resp.response = view.NewErrorResponse(viewNews.ErrReactionsDisabled)
+	default:
+		c.Header(StatusCodeHeader, codePrefix+"_06")
+		n.logger.Error("news reaction failed", zap.Error(err))
+		resp.code = http.StatusInternalServerError
+		resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+	}
+	c.JSON(resp.code, resp.response)
+}
+
+func (n *newsHandlers) updateComment(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancel()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nuc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nuc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	req := new(viewNews.UpdateNewsComment)
+	if err := c.BindJSON(req); err != nil {
+		c.Header(StatusCodeHeader, "nuc_02")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	comment, err := n.commentsInteractor.Update(ctx, n.commentsPresenter.UpdateCommentToDTO(newsID, commentID, req))
+	if err != nil {
+		n.commentError(c, err, "nuc")
+		return
+	}
+
+	resp := n.commentsPresenter.CommentToView(comment)
+	resp.IsUserMade = true
+	c.JSON(http.StatusOK, view.NewSuccessResponse(resp))
+}
+
+func (n *newsHandlers) deleteComment(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancel()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "ndc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "ndc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	if err = n.commentsInteractor.Delete(ctx, newsID, commentID); err != nil {
+		n.commentError(c, err, "ndc")
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+func (n *newsHandlers) reportComment(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancel()
+
+	newsID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nrc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+	commentID, err := uuid.Parse(c.Param("commentId"))
+	if err != nil {
+		c.Header(StatusCodeHeader, "nrc_01")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	req := new(viewNews.ReportNewsComment)
+	if err := c.BindJSON(req); err != nil {
+		c.Header(StatusCodeHeader, "nrc_02")
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	if err = n.commentsInteractor.Report(ctx, n.commentsPresenter.ReportToDTO(newsID, commentID, req)); err != nil {
+		n.commentError(c, err, "nrc")
+		return
+	}
+
+	c.JSON(http.StatusOK, view.NewSuccessResponse(nil))
+}
+
+// commentError ответ на ошибку изменения комментария или жалобы на него, codePrefix - префикс кода ошибки обработчика
+func (n *newsHandlers) commentError(c *gin.Context, err error, codePrefix string) {
+	type errResponse struct {
+		code     int
+		response *view.Response
+	}
+	resp := &errResponse{}
+
+	switch {
+	case errors.Is(err, diterrors.ErrNotFound):
+		c.Header(StatusCodeHeader, codePrefix+"_03")
+		resp.code = http.StatusNotFound
+		resp.response = view.NewErrorResponse(viewNews.ErrNewsNotFound)
+	case errors.Is(err, usecaseNews.ErrCommentNotFound):
+		c.Header(StatusCodeHeader, codePrefix+"_04")
+		resp.code = http.StatusNotFound
+		resp.response = view.NewErrorResponse(viewNews.ErrCommentNotFound)
+	case errors.As(err, new(diterrors.ValidationError)):
+		c.Header(StatusCodeHeader, codePrefix+"_05")
+		resp.code = http.StatusBadRequest
+		resp.response = view.NewErrorResponse(err)
+	case errors.Is(err, usecaseNews.ErrCommentNotAuthor):
+		c.Header(StatusCodeHeader, codePrefix+"_06")
+		resp.code = http.StatusForbidden
+		resp.response = view.NewErrorResponse(viewNews.ErrCommentNotAuthor)
+	case errors.Is(err, usecaseNews.ErrCommentEditExpired):
+		c.Header(StatusCodeHeader, codePrefix+"_07")
+		resp.code = http.StatusForbidden
+		resp.response = view.NewErrorResponse(viewNews.ErrCommentEditExpired)
+	case errors.Is(err, usecaseNews.ErrCommentRemoved):
+		c.Header(StatusCodeHeader, codePrefix+"_08")
+		resp.code = http.StatusConflict
+		resp.response = view.NewErrorResponse(viewNews.ErrCommentRemoved)
+	default:
+		c.Header(StatusCodeHeader, codePrefix+"_09")
+		n.logger.Error("news comment change failed", zap.Error(err))
+		resp.code = http.StatusInternalServerError
+		resp.response = view.NewErrorResponse(view.ErrMessageInternalError)
+	}
+	c.JSON(resp.code, resp.response)
+}
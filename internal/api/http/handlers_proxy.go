@@ -10,6 +10,7 @@ import (
 	"github.com/gin-gonic/gin"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
+	viewEvents "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/events"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/event"
 	reposiotryProxy "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/proxy"
@@ -173,10 +174,6 @@ func (h proxyHandlers) listCalendarEvents(c *gin.Context) {
 }
 
 func (h proxyHandlers) listCalendarEventsLinks(c *gin.Context) {
-	type request struct {
-		EventIDs []string `json:"ids"`
-	}
-
 	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
 	defer cancelCtx()
 
@@ -207,7 +204,7 @@ func (h proxyHandlers) listCalendarEventsLinks(c *gin.Context) {
 		return
 	}
 
-	var req request
+	var req viewEvents.CalendarEventsLinksRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Header(StatusCodeHeader, "PEL_05")
 		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
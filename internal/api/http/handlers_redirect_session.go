@@ -35,12 +35,7 @@ func (rsh redirectSessionHandler) createSession(c *gin.Context) {
 		return
 	}
 
-	type request struct {
-		PortalURL string `json:"portalUrl" binding:"required"`
-		TargetURL string `json:"targetUrl" binding:"required"`
-	}
-
-	var req request
+	var req viewSession.CreateSessionSessionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		c.Header(StatusCodeHeader, "RSH_02")
 		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
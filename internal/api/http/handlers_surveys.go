@@ -14,6 +14,8 @@ import (
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
 	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+	usecaseSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/surveys"
 )
 
 type surveysHandlers struct {
@@ -23,6 +25,7 @@ type surveysHandlers struct {
 	answersPresenter  SurveysAnswersPresenter
 	imagesInteractor  SurveysImagesInteractor
 	imagesPresenter   SurveysImagesPresenter
+	myInteractor      SurveysMyInteractor
 	logger            ditzap.Logger
 }
 
@@ -33,6 +36,7 @@ func NewSurveysHandlers(
 	answersPresenter SurveysAnswersPresenter,
 	imagesInteractor SurveysImagesInteractor,
 	imagesPresenter SurveysImagesPresenter,
+	myInteractor SurveysMyInteractor,
 	logger ditzap.Logger) *surveysHandlers {
 	return &surveysHandlers{
 		surveysInteractor: surveysInteractor,
@@ -41,6 +45,7 @@ func NewSurveysHandlers(
 		answersPresenter:  answersPresenter,
 		imagesInteractor:  imagesInteractor,
 		imagesPresenter:   imagesPresenter,
+		myInteractor:      myInteractor,
 		logger:            logger,
 	}
 }
@@ -94,6 +99,34 @@ func (sh surveysHandlers) getSurvey(c *gin.Context) {
 	c.JSON(http.StatusOK, sh.surveysPresenter.ToShortView(result))
 }
 
+// @Summary Опросы текущего пользователя
+// @Description Выдаются опубликованные и проводимые сейчас опросы, адресованные пользователю и еще не пройденные им.
+// @Description Опросы упорядочены по окончанию периода проведения, count - количество опросов для отображения на главной странице.
+// @Tags     Опросы
+// @Produce  json
+// @Router   /surveys/v1/my [get]
+// @Success  200 {object} view.MySurveys "Опросы пользователя"
+// @Failure  401,500 {object} ErrorResponse
+func (sh surveysHandlers) mySurveys(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c, RequestTimeOut)
+	defer cancelCtx()
+
+	result, err := sh.myInteractor.My(ctx)
+	if err != nil {
+		if errors.Is(err, usecase.ErrGetSessionFromContext) {
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthenticated))
+			return
+		}
+
+		sh.logger.Error("can't get user surveys", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, sh.surveysPresenter.ToMyView(result))
+}
+
 // @Summary Создание ответов на опрос
 // @Description Добавляет новые ответы на опрос
 // @Tags     Опросы
@@ -101,7 +134,7 @@ func (sh surveysHandlers) getSurvey(c *gin.Context) {
 // @Param    answers body view.NewSurveyAnswers true "ответы"
 // @Router   /survey/answers [post]
 // @Success  201 {array} view.SurveyAnswerInfo "Список идентификаторов ответов"
-// @Failure  400,500 {object} ErrorResponse
+// @Failure  400,401,404,409,500 {object} ErrorResponse
 func (sh surveysHandlers) addAnswers(c *gin.Context) {
 	ctx, cancelCtx := context.WithTimeout(c, RequestTimeOut)
 	defer cancelCtx()
@@ -113,9 +146,18 @@ func (sh surveysHandlers) addAnswers(c *gin.Context) {
 		return
 	}
 
-	result, err := sh.answersInteractor.Add(ctx, sh.answersPresenter.ToNewEntities(&answers))
+	result, err := sh.answersInteractor.Add(ctx, surveys.SurveyID(answers.SurveyId), sh.answersPresenter.ToNewEntities(&answers))
 	if err != nil {
-		if errors.As(err, new(diterrors.ValidationError)) {
+		if errors.Is(err, diterrors.ErrNotFound) {
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(view.ErrMessageNotFound))
+			return
+		} else if errors.Is(err, usecaseSurveys.ErrSurveyCompleted) {
+			c.JSON(http.StatusConflict, view.NewErrorResponse(viewSurveys.ErrSurveyCompleted))
+			return
+		} else if errors.Is(err, usecase.ErrGetSessionFromContext) {
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthenticated))
+			return
+		} else if errors.As(err, new(diterrors.ValidationError)) {
 			c.JSON(http.StatusBadRequest, view.NewErrorResponse(err))
 			return
 		}
@@ -129,6 +171,41 @@ func (sh surveysHandlers) addAnswers(c *gin.Context) {
 	c.JSON(http.StatusCreated, sh.answersPresenter.ToShortViews(result))
 }
 
+// @Summary Статус прохождения опроса
+// @Description Проверяет, проходил ли текущий пользователь опрос
+// @Tags     Опросы
+// @Produce  json
+// @Param    id path string true "идентификатор опроса"
+// @Router   /surveys/v1/surveys/{id}/completion [get]
+// @Success  200 {object} view.SurveyCompletion "Статус прохождения опроса"
+// @Failure  400,401,500 {object} ErrorResponse
+func (sh surveysHandlers) getCompletion(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c, RequestTimeOut)
+	defer cancelCtx()
+
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		sh.logger.Debug("can't parse param id into uuid", zap.Error(err))
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+		return
+	}
+
+	result, err := sh.answersInteractor.Completion(ctx, surveys.SurveyID(id))
+	if err != nil {
+		if errors.Is(err, usecase.ErrGetSessionFromContext) {
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthenticated))
+			return
+		}
+
+		sh.logger.Error("can't get survey completion", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+
+		return
+	}
+
+	c.JSON(http.StatusOK, sh.answersPresenter.CompletionToView(result))
+}
+
 // @Summary Получение изображения по идентификатору
 // @Description Выдаётся изображение по ID.
 // @Tags     Опросы
@@ -4,12 +4,15 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"testing"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
 	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+	usecaseSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/surveys"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/gintest.git"
@@ -185,6 +188,7 @@ func Test_surveyHandlers_getSurvey(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
 				f.logger,
 			)
 			ginTest := gintest.NewGinTest()
@@ -193,6 +197,101 @@ func Test_surveyHandlers_getSurvey(t *testing.T) {
 	}
 }
 
+func Test_surveyHandlers_mySurveys(t *testing.T) {
+	type fields struct {
+		interactor *MockSurveysMyInteractor
+		presenter  *MockSurveysPresenter
+		logger     *ditzap.MockLogger
+	}
+
+	testErr := errors.New("test")
+	surveyID := surveys.SurveyID(uuid.New())
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				list := []*surveys.Survey{{ID: &surveyID, Title: "test"}}
+				result := &viewSurveys.MySurveys{
+					Count:   1,
+					Surveys: []*viewSurveys.MySurvey{{ID: uuid.UUID(surveyID), Title: "test"}},
+				}
+				f.interactor.EXPECT().My(gomock.Any()).Return(list, nil)
+				f.presenter.EXPECT().ToMyView(list).Return(result)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/my",
+					},
+					Response:    gintest.NewResponse(http.StatusOK, nil, nil, nil).JsonBody(result),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "no session",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().My(gomock.Any()).Return(nil, usecase.ErrGetSessionFromContext)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/my",
+					},
+					Response: gintest.NewResponse(http.StatusUnauthorized, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().My(gomock.Any()).Return(nil, testErr)
+				f.logger.EXPECT().Error("can't get user surveys", zap.Error(testErr))
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/my",
+					},
+					Response: gintest.NewResponse(http.StatusInternalServerError, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageInternalError)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			f := fields{
+				interactor: NewMockSurveysMyInteractor(ctrl),
+				presenter:  NewMockSurveysPresenter(ctrl),
+				logger:     ditzap.NewMockLogger(ctrl),
+			}
+
+			ph := NewSurveysHandlers(
+				nil,
+				f.presenter,
+				nil,
+				nil,
+				nil,
+				nil,
+				f.interactor,
+				f.logger,
+			)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(ph.mySurveys, f))
+		})
+	}
+}
+
 func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 	type fields struct {
 		interactor *MockSurveysAnswersInteractor
@@ -214,7 +313,7 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 				b, _ := json.Marshal(answers)
 				data := bytes.NewReader(b)
 				answersEntity := []*surveys.RespondentAnswer{}
-				f.interactor.EXPECT().Add(gomock.Any(), answersEntity).Return([]uuid.UUID{}, nil)
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).Return([]uuid.UUID{}, nil)
 				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
 				result := []*viewSurveys.SurveyAnswerInfo{}
 				f.presenter.EXPECT().ToShortViews([]uuid.UUID{}).Return(result)
@@ -237,7 +336,7 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 				b, _ := json.Marshal(answers)
 				data := bytes.NewReader(b)
 				answersEntity := []*surveys.RespondentAnswer{}
-				f.interactor.EXPECT().Add(gomock.Any(), answersEntity).Return(nil, testErr)
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).Return(nil, testErr)
 				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
 				f.logger.EXPECT().Error("can't add answers", zap.Error(testErr))
 
@@ -260,7 +359,7 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 				b, _ := json.Marshal(answers)
 				data := bytes.NewReader(b)
 				answersEntity := []*surveys.RespondentAnswer{}
-				f.interactor.EXPECT().Add(gomock.Any(), answersEntity).Return(nil, errValidation)
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).Return(nil, errValidation)
 				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
 
 				return &gintest.HandlerTestCase{
@@ -275,13 +374,82 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 				}
 			},
 		},
+		{
+			name: "survey not found",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				answers := viewSurveys.NewSurveyAnswers{SurveyId: uuid.New()}
+				b, _ := json.Marshal(answers)
+				data := bytes.NewReader(b)
+				answersEntity := []*surveys.RespondentAnswer{}
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).
+					Return(nil, fmt.Errorf("can't get survey from repository: %w", diterrors.ErrNotFound))
+				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/survey/answers",
+						Body:   data,
+					},
+					Response: gintest.NewResponse(http.StatusNotFound, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageNotFound)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "already completed",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				answers := viewSurveys.NewSurveyAnswers{SurveyId: uuid.New()}
+				b, _ := json.Marshal(answers)
+				data := bytes.NewReader(b)
+				answersEntity := []*surveys.RespondentAnswer{}
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).
+					Return(nil, usecaseSurveys.ErrSurveyCompleted)
+				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/survey/answers",
+						Body:   data,
+					},
+					Response: gintest.NewResponse(http.StatusConflict, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(viewSurveys.ErrSurveyCompleted)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "no session",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				answers := viewSurveys.NewSurveyAnswers{SurveyId: uuid.New()}
+				b, _ := json.Marshal(answers)
+				data := bytes.NewReader(b)
+				answersEntity := []*surveys.RespondentAnswer{}
+				f.interactor.EXPECT().Add(gomock.Any(), surveys.SurveyID(answers.SurveyId), answersEntity).
+					Return(nil, usecase.ErrGetSessionFromContext)
+				f.presenter.EXPECT().ToNewEntities(&answers).Return(answersEntity)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/survey/answers",
+						Body:   data,
+					},
+					Response: gintest.NewResponse(http.StatusUnauthorized, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
 		{
 			name: "bad req",
 			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
 				type wrong struct {
-					RespondentID *[]int `json:"respondent_id,omitempty"`
+					SurveyID []int `json:"survey_id"`
 				}
-				answers := wrong{RespondentID: &[]int{1, 2}}
+				answers := wrong{SurveyID: []int{1, 2}}
 				b, _ := json.Marshal(answers)
 				data := bytes.NewReader(b)
 				err := json.Unmarshal(b, &viewSurveys.NewSurveyAnswers{})
@@ -317,6 +485,7 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 				f.presenter,
 				nil,
 				nil,
+				nil,
 				f.logger,
 			)
 			ginTest := gintest.NewGinTest()
@@ -325,6 +494,101 @@ func Test_surveyAnswerHandlers_addAnswers(t *testing.T) {
 	}
 }
 
+func Test_surveyAnswerHandlers_getCompletion(t *testing.T) {
+	type fields struct {
+		interactor *MockSurveysAnswersInteractor
+		presenter  *MockSurveysAnswersPresenter
+		logger     *ditzap.MockLogger
+	}
+
+	testErr := errors.New("test")
+	surveyID := uuid.New()
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				completion := &surveys.Completion{SurveyID: surveys.SurveyID(surveyID), Completed: true}
+				result := &viewSurveys.SurveyCompletion{SurveyID: surveyID, Completed: true}
+				f.interactor.EXPECT().Completion(gomock.Any(), surveys.SurveyID(surveyID)).Return(completion, nil)
+				f.presenter.EXPECT().CompletionToView(completion).Return(result)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/surveys/:id/completion",
+					},
+					Params:      []gintest.Param{{Key: "id", Value: surveyID.String()}},
+					Response:    gintest.NewResponse(http.StatusOK, nil, nil, nil).JsonBody(result),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "no session",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().Completion(gomock.Any(), surveys.SurveyID(surveyID)).Return(nil, usecase.ErrGetSessionFromContext)
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/surveys/:id/completion",
+					},
+					Params: []gintest.Param{{Key: "id", Value: surveyID.String()}},
+					Response: gintest.NewResponse(http.StatusUnauthorized, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().Completion(gomock.Any(), surveys.SurveyID(surveyID)).Return(nil, testErr)
+				f.logger.EXPECT().Error("can't get survey completion", zap.Error(testErr))
+
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/surveys/v1/surveys/:id/completion",
+					},
+					Params: []gintest.Param{{Key: "id", Value: surveyID.String()}},
+					Response: gintest.NewResponse(http.StatusInternalServerError, nil, nil,
+						nil).JsonBody(view.NewErrorResponse(view.ErrMessageInternalError)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+
+			f := fields{
+				interactor: NewMockSurveysAnswersInteractor(ctrl),
+				presenter:  NewMockSurveysAnswersPresenter(ctrl),
+				logger:     ditzap.NewMockLogger(ctrl),
+			}
+
+			ph := NewSurveysHandlers(
+				nil,
+				nil,
+				f.interactor,
+				f.presenter,
+				nil,
+				nil,
+				nil,
+				f.logger,
+			)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(ph.getCompletion, f))
+		})
+	}
+}
+
 func Test_surveyImageHandlers_getImage(t *testing.T) {
 	type fields struct {
 		interactor *MockSurveysImagesInteractor
@@ -410,6 +674,7 @@ func Test_surveyImageHandlers_getImage(t *testing.T) {
 				nil,
 				f.interactor,
 				f.presenter,
+				nil,
 				f.logger,
 			)
 			ginTest := gintest.NewGinTest()
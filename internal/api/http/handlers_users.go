@@ -8,9 +8,11 @@ import (
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	authView "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
+	usersView "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/users"
 	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
 	authUseCase "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/auth"
@@ -18,23 +20,26 @@ import (
 )
 
 type usersHandlers struct {
-	authInteractor  AuthInteractor
-	usersInteractor UsersInteractor
-	authPresenter   AuthPresenter
-	usersPresenter  UsersPresenter
+	authInteractor     AuthInteractor
+	usersInteractor    UsersInteractor
+	sessionsInteractor SessionsInteractor
+	authPresenter      AuthPresenter
+	usersPresenter     UsersPresenter
 }
 
 func NewUsersHandlers(
 	authInteractor AuthInteractor,
 	usersInteractor UsersInteractor,
+	sessionsInteractor SessionsInteractor,
 	authPresenter AuthPresenter,
 	usersPresenter UsersPresenter,
 ) *usersHandlers {
 	return &usersHandlers{
-		authInteractor:  authInteractor,
-		usersInteractor: usersInteractor,
-		authPresenter:   authPresenter,
-		usersPresenter:  usersPresenter,
+		authInteractor:     authInteractor,
+		usersInteractor:    usersInteractor,
+		sessionsInteractor: sessionsInteractor,
+		authPresenter:      authPresenter,
+		usersPresenter:     usersPresenter,
 	}
 }
 
@@ -95,3 +100,66 @@ func (uh usersHandlers) changePortal(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, view.NewSuccessResponse(uh.authPresenter.AuthToView(&entityAuth.Auth{PortalSession: selectedPortalSID, Portals: portals})))
 }
+
+// getSessions список действующих сессий пользователя
+func (uh usersHandlers) getSessions(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	sessions, err := uh.sessionsInteractor.ListSessions(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrGetSessionFromContext):
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated))
+		default:
+			c.JSON(http.StatusInternalServerError, view.NewErrorResponse(authView.ErrMessageFrontInternal))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, view.NewSuccessResponse(uh.usersPresenter.SessionsToView(sessions)))
+}
+
+// revokeSession отзыв сессии пользователя по идентификатору
+func (uh usersHandlers) revokeSession(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	sessionID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, view.NewErrorResponse(view.ErrMessageInvalidId))
+		return
+	}
+
+	if err = uh.sessionsInteractor.RevokeSession(ctx, sessionID.String()); err != nil {
+		switch {
+		case errors.As(err, new(diterrors.ValidationError)):
+			c.JSON(http.StatusBadRequest, view.NewErrorResponse(authView.ErrMessageFrontRevokeCurrentSession))
+		case errors.Is(err, usecase.ErrGetSessionFromContext):
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated))
+		case errors.Is(err, diterrors.ErrNotFound):
+			c.JSON(http.StatusNotFound, view.NewErrorResponse(view.ErrMessageNotFound))
+		default:
+			c.JSON(http.StatusInternalServerError, view.NewErrorResponse(authView.ErrMessageFrontInternal))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, view.NewSuccessResponse(&usersView.RevokeSessionsResponse{Revoked: 1}))
+}
+
+// revokeOtherSessions отзыв всех сессий пользователя, кроме текущей
+func (uh usersHandlers) revokeOtherSessions(c *gin.Context) {
+	ctx, cancelCtx := context.WithTimeout(c.Request.Context(), RequestTimeOut)
+	defer cancelCtx()
+
+	revoked, err := uh.sessionsInteractor.RevokeOtherSessions(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrGetSessionFromContext):
+			c.JSON(http.StatusUnauthorized, view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated))
+		default:
+			c.JSON(http.StatusInternalServerError, view.NewErrorResponse(authView.ErrMessageFrontInternal))
+		}
+		return
+	}
+	c.JSON(http.StatusOK, view.NewSuccessResponse(&usersView.RevokeSessionsResponse{Revoked: revoked}))
+}
@@ -105,7 +105,7 @@ func Test_usersHandlers_getMe(t *testing.T) {
 				presenter:  NewMockUsersPresenter(ctrl),
 			}
 
-			ph := NewUsersHandlers(nil, f.interactor, nil, f.presenter)
+			ph := NewUsersHandlers(nil, f.interactor, nil, nil, f.presenter)
 			ginTest := gintest.NewGinTest()
 			ginTest.TestHandler(t, tt.testCase(ph.getMe, f))
 		})
@@ -368,9 +368,291 @@ func Test_usersHandlers_changePortal(t *testing.T) {
 				presenter:  NewMockAuthPresenter(ctrl),
 			}
 
-			ph := NewUsersHandlers(f.interactor, nil, f.presenter, nil)
+			ph := NewUsersHandlers(f.interactor, nil, nil, f.presenter, nil)
 			ginTest := gintest.NewGinTest()
 			ginTest.TestHandler(t, tt.testCase(ph.changePortal, f))
 		})
 	}
 }
+
+func Test_usersHandlers_getSessions(t *testing.T) {
+	type fields struct {
+		interactor *MockSessionsInteractor
+		presenter  *MockUsersPresenter
+	}
+
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "get session err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().ListSessions(gomock.Any()).Return(nil, usecase.ErrGetSessionFromContext)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusUnauthorized, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().ListSessions(gomock.Any()).Return(nil, testErr)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusInternalServerError, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontInternal)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				testSessions := []*entityAuth.SessionActivity{{SessionID: "testSessionID"}}
+				f.interactor.EXPECT().ListSessions(gomock.Any()).Return(testSessions, nil)
+				testSessionsView := []*usersView.Session{{ID: "testSessionID"}}
+				f.presenter.EXPECT().SessionsToView(testSessions).Return(testSessionsView)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodGet,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusOK, nil, nil, nil).
+						JsonBody(view.NewSuccessResponse(testSessionsView)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f := fields{
+				interactor: NewMockSessionsInteractor(ctrl),
+				presenter:  NewMockUsersPresenter(ctrl),
+			}
+
+			ph := NewUsersHandlers(nil, nil, f.interactor, nil, f.presenter)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(ph.getSessions, f))
+		})
+	}
+}
+
+func Test_usersHandlers_revokeSession(t *testing.T) {
+	type fields struct {
+		interactor *MockSessionsInteractor
+	}
+
+	testErr := errors.New("test error")
+	testSessionID := "9b2f5a3e-6d0c-4a8e-9f61-2c4d7b1e0a53"
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "invalid id err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions/:id",
+					},
+					Response: gintest.NewResponse(http.StatusBadRequest, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(view.ErrMessageInvalidId)),
+					Params: []gintest.Param{
+						{
+							Key:   "id",
+							Value: "invalid",
+						},
+					},
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "current session err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeSession(gomock.Any(), testSessionID).
+					Return(diterrors.NewValidationError(authUseCase.ErrRevokeCurrent))
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions/:id",
+					},
+					Response: gintest.NewResponse(http.StatusBadRequest, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontRevokeCurrentSession)),
+					Params: []gintest.Param{
+						{
+							Key:   "id",
+							Value: testSessionID,
+						},
+					},
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "not found err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeSession(gomock.Any(), testSessionID).Return(diterrors.ErrNotFound)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions/:id",
+					},
+					Response: gintest.NewResponse(http.StatusNotFound, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(view.ErrMessageNotFound)),
+					Params: []gintest.Param{
+						{
+							Key:   "id",
+							Value: testSessionID,
+						},
+					},
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeSession(gomock.Any(), testSessionID).Return(testErr)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions/:id",
+					},
+					Response: gintest.NewResponse(http.StatusInternalServerError, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontInternal)),
+					Params: []gintest.Param{
+						{
+							Key:   "id",
+							Value: testSessionID,
+						},
+					},
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeSession(gomock.Any(), testSessionID).Return(nil)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions/:id",
+					},
+					Response: gintest.NewResponse(http.StatusOK, nil, nil, nil).
+						JsonBody(view.NewSuccessResponse(&usersView.RevokeSessionsResponse{Revoked: 1})),
+					Params: []gintest.Param{
+						{
+							Key:   "id",
+							Value: testSessionID,
+						},
+					},
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f := fields{
+				interactor: NewMockSessionsInteractor(ctrl),
+			}
+
+			ph := NewUsersHandlers(nil, nil, f.interactor, nil, nil)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(ph.revokeSession, f))
+		})
+	}
+}
+
+func Test_usersHandlers_revokeOtherSessions(t *testing.T) {
+	type fields struct {
+		interactor *MockSessionsInteractor
+	}
+
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "get session err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeOtherSessions(gomock.Any()).Return(0, usecase.ErrGetSessionFromContext)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusUnauthorized, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeOtherSessions(gomock.Any()).Return(0, testErr)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusInternalServerError, nil, nil, nil).
+						JsonBody(view.NewErrorResponse(authView.ErrMessageFrontInternal)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().RevokeOtherSessions(gomock.Any()).Return(2, nil)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodDelete,
+						Path:   "/users/v1/sessions",
+					},
+					Response: gintest.NewResponse(http.StatusOK, nil, nil, nil).
+						JsonBody(view.NewSuccessResponse(&usersView.RevokeSessionsResponse{Revoked: 2})),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			f := fields{
+				interactor: NewMockSessionsInteractor(ctrl),
+			}
+
+			ph := NewUsersHandlers(nil, nil, f.interactor, nil, nil)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(ph.revokeOtherSessions, f))
+		})
+	}
+}
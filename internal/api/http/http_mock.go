@@ -12,6 +12,7 @@ package http
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	view "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
 	auth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
@@ -1448,6 +1449,18 @@ func (mr *MockSurveysHandlersMockRecorder) addAnswers(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "addAnswers", reflect.TypeOf((*MockSurveysHandlers)(nil).addAnswers), c)
 }
 
+// getCompletion mocks base method.
+func (m *MockSurveysHandlers) getCompletion(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "getCompletion", c)
+}
+
+// getCompletion indicates an expected call of getCompletion.
+func (mr *MockSurveysHandlersMockRecorder) getCompletion(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getCompletion", reflect.TypeOf((*MockSurveysHandlers)(nil).getCompletion), c)
+}
+
 // getImage mocks base method.
 func (m *MockSurveysHandlers) getImage(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -1472,6 +1485,18 @@ func (mr *MockSurveysHandlersMockRecorder) getSurvey(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getSurvey", reflect.TypeOf((*MockSurveysHandlers)(nil).getSurvey), c)
 }
 
+// mySurveys mocks base method.
+func (m *MockSurveysHandlers) mySurveys(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mySurveys", c)
+}
+
+// mySurveys indicates an expected call of mySurveys.
+func (mr *MockSurveysHandlersMockRecorder) mySurveys(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mySurveys", reflect.TypeOf((*MockSurveysHandlers)(nil).mySurveys), c)
+}
+
 // MockSurveysSurveyHandlers is a mock of SurveysSurveyHandlers interface.
 type MockSurveysSurveyHandlers struct {
 	ctrl     *gomock.Controller
@@ -1508,6 +1533,18 @@ func (mr *MockSurveysSurveyHandlersMockRecorder) getSurvey(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getSurvey", reflect.TypeOf((*MockSurveysSurveyHandlers)(nil).getSurvey), c)
 }
 
+// mySurveys mocks base method.
+func (m *MockSurveysSurveyHandlers) mySurveys(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mySurveys", c)
+}
+
+// mySurveys indicates an expected call of mySurveys.
+func (mr *MockSurveysSurveyHandlersMockRecorder) mySurveys(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mySurveys", reflect.TypeOf((*MockSurveysSurveyHandlers)(nil).mySurveys), c)
+}
+
 // MockSurveysAnswerHandlers is a mock of SurveysAnswerHandlers interface.
 type MockSurveysAnswerHandlers struct {
 	ctrl     *gomock.Controller
@@ -1544,6 +1581,18 @@ func (mr *MockSurveysAnswerHandlersMockRecorder) addAnswers(c any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "addAnswers", reflect.TypeOf((*MockSurveysAnswerHandlers)(nil).addAnswers), c)
 }
 
+// getCompletion mocks base method.
+func (m *MockSurveysAnswerHandlers) getCompletion(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "getCompletion", c)
+}
+
+// getCompletion indicates an expected call of getCompletion.
+func (mr *MockSurveysAnswerHandlersMockRecorder) getCompletion(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getCompletion", reflect.TypeOf((*MockSurveysAnswerHandlers)(nil).getCompletion), c)
+}
+
 // MockSurveysImageHandlers is a mock of SurveysImageHandlers interface.
 type MockSurveysImageHandlers struct {
 	ctrl     *gomock.Controller
@@ -1580,6 +1629,162 @@ func (mr *MockSurveysImageHandlersMockRecorder) getImage(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getImage", reflect.TypeOf((*MockSurveysImageHandlers)(nil).getImage), c)
 }
 
+// MockSurveysAdminHandlers is a mock of SurveysAdminHandlers interface.
+type MockSurveysAdminHandlers struct {
+	ctrl     *gomock.Controller
+	recorder *MockSurveysAdminHandlersMockRecorder
+	isgomock struct{}
+}
+
+// MockSurveysAdminHandlersMockRecorder is the mock recorder for MockSurveysAdminHandlers.
+type MockSurveysAdminHandlersMockRecorder struct {
+	mock *MockSurveysAdminHandlers
+}
+
+// NewMockSurveysAdminHandlers creates a new mock instance.
+func NewMockSurveysAdminHandlers(ctrl *gomock.Controller) *MockSurveysAdminHandlers {
+	mock := &MockSurveysAdminHandlers{ctrl: ctrl}
+	mock.recorder = &MockSurveysAdminHandlersMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSurveysAdminHandlers) EXPECT() *MockSurveysAdminHandlersMockRecorder {
+	return m.recorder
+}
+
+// create mocks base method.
+func (m *MockSurveysAdminHandlers) create(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "create", c)
+}
+
+// create indicates an expected call of create.
+func (mr *MockSurveysAdminHandlersMockRecorder) create(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "create", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).create), c)
+}
+
+// delete mocks base method.
+func (m *MockSurveysAdminHandlers) delete(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "delete", c)
+}
+
+// delete indicates an expected call of delete.
+func (mr *MockSurveysAdminHandlersMockRecorder) delete(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "delete", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).delete), c)
+}
+
+// exportResults mocks base method.
+func (m *MockSurveysAdminHandlers) exportResults(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "exportResults", c)
+}
+
+// exportResults indicates an expected call of exportResults.
+func (mr *MockSurveysAdminHandlersMockRecorder) exportResults(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "exportResults", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).exportResults), c)
+}
+
+// get mocks base method.
+func (m *MockSurveysAdminHandlers) get(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "get", c)
+}
+
+// get indicates an expected call of get.
+func (mr *MockSurveysAdminHandlersMockRecorder) get(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "get", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).get), c)
+}
+
+// publish mocks base method.
+func (m *MockSurveysAdminHandlers) publish(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "publish", c)
+}
+
+// publish indicates an expected call of publish.
+func (mr *MockSurveysAdminHandlersMockRecorder) publish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "publish", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).publish), c)
+}
+
+// results mocks base method.
+func (m *MockSurveysAdminHandlers) results(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "results", c)
+}
+
+// results indicates an expected call of results.
+func (mr *MockSurveysAdminHandlersMockRecorder) results(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "results", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).results), c)
+}
+
+// search mocks base method.
+func (m *MockSurveysAdminHandlers) search(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "search", c)
+}
+
+// search indicates an expected call of search.
+func (mr *MockSurveysAdminHandlersMockRecorder) search(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "search", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).search), c)
+}
+
+// textAnswers mocks base method.
+func (m *MockSurveysAdminHandlers) textAnswers(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "textAnswers", c)
+}
+
+// textAnswers indicates an expected call of textAnswers.
+func (mr *MockSurveysAdminHandlersMockRecorder) textAnswers(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "textAnswers", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).textAnswers), c)
+}
+
+// unpublish mocks base method.
+func (m *MockSurveysAdminHandlers) unpublish(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "unpublish", c)
+}
+
+// unpublish indicates an expected call of unpublish.
+func (mr *MockSurveysAdminHandlersMockRecorder) unpublish(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "unpublish", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).unpublish), c)
+}
+
+// update mocks base method.
+func (m *MockSurveysAdminHandlers) update(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "update", c)
+}
+
+// update indicates an expected call of update.
+func (mr *MockSurveysAdminHandlersMockRecorder) update(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "update", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).update), c)
+}
+
+// uploadImage mocks base method.
+func (m *MockSurveysAdminHandlers) uploadImage(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "uploadImage", c)
+}
+
+// uploadImage indicates an expected call of uploadImage.
+func (mr *MockSurveysAdminHandlersMockRecorder) uploadImage(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "uploadImage", reflect.TypeOf((*MockSurveysAdminHandlers)(nil).uploadImage), c)
+}
+
 // MockEmployeesSearchHandlers is a mock of EmployeesSearchHandlers interface.
 type MockEmployeesSearchHandlers struct {
 	ctrl     *gomock.Controller
@@ -1676,6 +1881,42 @@ func (mr *MockUsersHandlersMockRecorder) getMe(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getMe", reflect.TypeOf((*MockUsersHandlers)(nil).getMe), c)
 }
 
+// getSessions mocks base method.
+func (m *MockUsersHandlers) getSessions(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "getSessions", c)
+}
+
+// getSessions indicates an expected call of getSessions.
+func (mr *MockUsersHandlersMockRecorder) getSessions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getSessions", reflect.TypeOf((*MockUsersHandlers)(nil).getSessions), c)
+}
+
+// revokeOtherSessions mocks base method.
+func (m *MockUsersHandlers) revokeOtherSessions(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "revokeOtherSessions", c)
+}
+
+// revokeOtherSessions indicates an expected call of revokeOtherSessions.
+func (mr *MockUsersHandlersMockRecorder) revokeOtherSessions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "revokeOtherSessions", reflect.TypeOf((*MockUsersHandlers)(nil).revokeOtherSessions), c)
+}
+
+// revokeSession mocks base method.
+func (m *MockUsersHandlers) revokeSession(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "revokeSession", c)
+}
+
+// revokeSession indicates an expected call of revokeSession.
+func (mr *MockUsersHandlersMockRecorder) revokeSession(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "revokeSession", reflect.TypeOf((*MockUsersHandlers)(nil).revokeSession), c)
+}
+
 // MockEmployeesHandlers is a mock of EmployeesHandlers interface.
 type MockEmployeesHandlers struct {
 	ctrl     *gomock.Controller
@@ -2297,6 +2538,45 @@ func (mr *MockSurveysSurveysInteractorMockRecorder) Get(ctx, id, options any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSurveysSurveysInteractor)(nil).Get), ctx, id, options)
 }
 
+// MockSurveysMyInteractor is a mock of SurveysMyInteractor interface.
+type MockSurveysMyInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockSurveysMyInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockSurveysMyInteractorMockRecorder is the mock recorder for MockSurveysMyInteractor.
+type MockSurveysMyInteractorMockRecorder struct {
+	mock *MockSurveysMyInteractor
+}
+
+// NewMockSurveysMyInteractor creates a new mock instance.
+func NewMockSurveysMyInteractor(ctrl *gomock.Controller) *MockSurveysMyInteractor {
+	mock := &MockSurveysMyInteractor{ctrl: ctrl}
+	mock.recorder = &MockSurveysMyInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSurveysMyInteractor) EXPECT() *MockSurveysMyInteractorMockRecorder {
+	return m.recorder
+}
+
+// My mocks base method.
+func (m *MockSurveysMyInteractor) My(ctx context.Context) ([]*entitySurveys.Survey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "My", ctx)
+	ret0, _ := ret[0].([]*entitySurveys.Survey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// My indicates an expected call of My.
+func (mr *MockSurveysMyInteractorMockRecorder) My(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "My", reflect.TypeOf((*MockSurveysMyInteractor)(nil).My), ctx)
+}
+
 // MockSurveysAnswersInteractor is a mock of SurveysAnswersInteractor interface.
 type MockSurveysAnswersInteractor struct {
 	ctrl     *gomock.Controller
@@ -2322,18 +2602,33 @@ func (m *MockSurveysAnswersInteractor) EXPECT() *MockSurveysAnswersInteractorMoc
 }
 
 // Add mocks base method.
-func (m *MockSurveysAnswersInteractor) Add(ctx context.Context, answers []*entitySurveys.RespondentAnswer) ([]uuid.UUID, error) {
+func (m *MockSurveysAnswersInteractor) Add(ctx context.Context, surveyID entitySurveys.SurveyID, answers []*entitySurveys.RespondentAnswer) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, answers)
+	ret := m.ctrl.Call(m, "Add", ctx, surveyID, answers)
 	ret0, _ := ret[0].([]uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Add indicates an expected call of Add.
-func (mr *MockSurveysAnswersInteractorMockRecorder) Add(ctx, answers any) *gomock.Call {
+func (mr *MockSurveysAnswersInteractorMockRecorder) Add(ctx, surveyID, answers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSurveysAnswersInteractor)(nil).Add), ctx, surveyID, answers)
+}
+
+// Completion mocks base method.
+func (m *MockSurveysAnswersInteractor) Completion(ctx context.Context, surveyID entitySurveys.SurveyID) (*entitySurveys.Completion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Completion", ctx, surveyID)
+	ret0, _ := ret[0].(*entitySurveys.Completion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Completion indicates an expected call of Completion.
+func (mr *MockSurveysAnswersInteractorMockRecorder) Completion(ctx, surveyID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSurveysAnswersInteractor)(nil).Add), ctx, answers)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Completion", reflect.TypeOf((*MockSurveysAnswersInteractor)(nil).Completion), ctx, surveyID)
 }
 
 // MockSurveysImagesInteractor is a mock of SurveysImagesInteractor interface.
@@ -2360,6 +2655,21 @@ func (m *MockSurveysImagesInteractor) EXPECT() *MockSurveysImagesInteractorMockR
 	return m.recorder
 }
 
+// Add mocks base method.
+func (m *MockSurveysImagesInteractor) Add(ctx context.Context, image *entitySurveys.Image) (*entitySurveys.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, image)
+	ret0, _ := ret[0].(*entitySurveys.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSurveysImagesInteractorMockRecorder) Add(ctx, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSurveysImagesInteractor)(nil).Add), ctx, image)
+}
+
 // Get mocks base method.
 func (m *MockSurveysImagesInteractor) Get(ctx context.Context, imageName string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -2375,150 +2685,417 @@ func (mr *MockSurveysImagesInteractorMockRecorder) Get(ctx, imageName any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSurveysImagesInteractor)(nil).Get), ctx, imageName)
 }
 
-// MockAuthInteractor is a mock of AuthInteractor interface.
-type MockAuthInteractor struct {
+// MockSurveysAdminInteractor is a mock of SurveysAdminInteractor interface.
+type MockSurveysAdminInteractor struct {
 	ctrl     *gomock.Controller
-	recorder *MockAuthInteractorMockRecorder
+	recorder *MockSurveysAdminInteractorMockRecorder
 	isgomock struct{}
 }
 
-// MockAuthInteractorMockRecorder is the mock recorder for MockAuthInteractor.
-type MockAuthInteractorMockRecorder struct {
-	mock *MockAuthInteractor
+// MockSurveysAdminInteractorMockRecorder is the mock recorder for MockSurveysAdminInteractor.
+type MockSurveysAdminInteractorMockRecorder struct {
+	mock *MockSurveysAdminInteractor
 }
 
-// NewMockAuthInteractor creates a new mock instance.
-func NewMockAuthInteractor(ctrl *gomock.Controller) *MockAuthInteractor {
-	mock := &MockAuthInteractor{ctrl: ctrl}
-	mock.recorder = &MockAuthInteractorMockRecorder{mock}
+// NewMockSurveysAdminInteractor creates a new mock instance.
+func NewMockSurveysAdminInteractor(ctrl *gomock.Controller) *MockSurveysAdminInteractor {
+	mock := &MockSurveysAdminInteractor{ctrl: ctrl}
+	mock.recorder = &MockSurveysAdminInteractorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockAuthInteractor) EXPECT() *MockAuthInteractorMockRecorder {
+func (m *MockSurveysAdminInteractor) EXPECT() *MockSurveysAdminInteractorMockRecorder {
 	return m.recorder
 }
 
-// Auth mocks base method.
-func (m *MockAuthInteractor) Auth(ctx context.Context, code, state, callbackURI string) (*auth0.Auth, error) {
+// Create mocks base method.
+func (m *MockSurveysAdminInteractor) Create(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.SurveyID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Auth", ctx, code, state, callbackURI)
-	ret0, _ := ret[0].(*auth0.Auth)
+	ret := m.ctrl.Call(m, "Create", ctx, survey)
+	ret0, _ := ret[0].(*entitySurveys.SurveyID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Auth indicates an expected call of Auth.
-func (mr *MockAuthInteractorMockRecorder) Auth(ctx, code, state, callbackURI any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockSurveysAdminInteractorMockRecorder) Create(ctx, survey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockAuthInteractor)(nil).Auth), ctx, code, state, callbackURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).Create), ctx, survey)
 }
 
-// ChangePortal mocks base method.
-func (m *MockAuthInteractor) ChangePortal(ctx context.Context, selectedPortalID int) ([]*auth0.Portal, string, error) {
+// Delete mocks base method.
+func (m *MockSurveysAdminInteractor) Delete(ctx context.Context, id entitySurveys.SurveyID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangePortal", ctx, selectedPortalID)
-	ret0, _ := ret[0].([]*auth0.Portal)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// ChangePortal indicates an expected call of ChangePortal.
-func (mr *MockAuthInteractorMockRecorder) ChangePortal(ctx, selectedPortalID any) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockSurveysAdminInteractorMockRecorder) Delete(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePortal", reflect.TypeOf((*MockAuthInteractor)(nil).ChangePortal), ctx, selectedPortalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).Delete), ctx, id)
 }
 
-// GetAuthURL mocks base method.
-func (m *MockAuthInteractor) GetAuthURL(ctx context.Context, callbackURI string) (string, error) {
+// Get mocks base method.
+func (m *MockSurveysAdminInteractor) Get(ctx context.Context, id entitySurveys.SurveyID) (*entitySurveys.Survey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAuthURL", ctx, callbackURI)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entitySurveys.Survey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAuthURL indicates an expected call of GetAuthURL.
-func (mr *MockAuthInteractorMockRecorder) GetAuthURL(ctx, callbackURI any) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockSurveysAdminInteractorMockRecorder) Get(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthURL", reflect.TypeOf((*MockAuthInteractor)(nil).GetAuthURL), ctx, callbackURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).Get), ctx, id)
 }
 
-// GetSession mocks base method.
-func (m *MockAuthInteractor) GetSession(ctx context.Context, accessToken string) (*auth0.Session, error) {
+// GetAll mocks base method.
+func (m *MockSurveysAdminInteractor) GetAll(ctx context.Context, ids entitySurveys.SurveyIDs, respondent *entitySurveys.SurveyRespondent, options entitySurveys.SurveyFilterOptions, pagination entitySurveys.Pagination) (*entitySurveys.SurveysWithPagination, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSession", ctx, accessToken)
-	ret0, _ := ret[0].(*auth0.Session)
+	ret := m.ctrl.Call(m, "GetAll", ctx, ids, respondent, options, pagination)
+	ret0, _ := ret[0].(*entitySurveys.SurveysWithPagination)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetSession indicates an expected call of GetSession.
-func (mr *MockAuthInteractorMockRecorder) GetSession(ctx, accessToken any) *gomock.Call {
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSurveysAdminInteractorMockRecorder) GetAll(ctx, ids, respondent, options, pagination any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAuthInteractor)(nil).GetSession), ctx, accessToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).GetAll), ctx, ids, respondent, options, pagination)
 }
 
-// Logout mocks base method.
-func (m *MockAuthInteractor) Logout(ctx context.Context, accessToken, refreshToken string) error {
+// SetPublished mocks base method.
+func (m *MockSurveysAdminInteractor) SetPublished(ctx context.Context, id entitySurveys.SurveyID, published bool) (*entitySurveys.Survey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SetPublished", ctx, id, published)
+	ret0, _ := ret[0].(*entitySurveys.Survey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Logout indicates an expected call of Logout.
-func (mr *MockAuthInteractorMockRecorder) Logout(ctx, accessToken, refreshToken any) *gomock.Call {
+// SetPublished indicates an expected call of SetPublished.
+func (mr *MockSurveysAdminInteractorMockRecorder) SetPublished(ctx, id, published any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthInteractor)(nil).Logout), ctx, accessToken, refreshToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublished", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).SetPublished), ctx, id, published)
 }
 
-// RefreshTokensPair mocks base method.
-func (m *MockAuthInteractor) RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*auth0.TokensPair, error) {
+// Update mocks base method.
+func (m *MockSurveysAdminInteractor) Update(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.Survey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RefreshTokensPair", ctx, accessToken, refreshToken)
-	ret0, _ := ret[0].(*auth0.TokensPair)
+	ret := m.ctrl.Call(m, "Update", ctx, survey)
+	ret0, _ := ret[0].(*entitySurveys.Survey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RefreshTokensPair indicates an expected call of RefreshTokensPair.
-func (mr *MockAuthInteractorMockRecorder) RefreshTokensPair(ctx, accessToken, refreshToken any) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockSurveysAdminInteractorMockRecorder) Update(ctx, survey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokensPair", reflect.TypeOf((*MockAuthInteractor)(nil).RefreshTokensPair), ctx, accessToken, refreshToken)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSurveysAdminInteractor)(nil).Update), ctx, survey)
 }
 
-// MockEmployeesSearchUseCases is a mock of EmployeesSearchUseCases interface.
-type MockEmployeesSearchUseCases struct {
+// MockSurveysResultsInteractor is a mock of SurveysResultsInteractor interface.
+type MockSurveysResultsInteractor struct {
 	ctrl     *gomock.Controller
-	recorder *MockEmployeesSearchUseCasesMockRecorder
+	recorder *MockSurveysResultsInteractorMockRecorder
 	isgomock struct{}
 }
 
-// MockEmployeesSearchUseCasesMockRecorder is the mock recorder for MockEmployeesSearchUseCases.
-type MockEmployeesSearchUseCasesMockRecorder struct {
-	mock *MockEmployeesSearchUseCases
+// MockSurveysResultsInteractorMockRecorder is the mock recorder for MockSurveysResultsInteractor.
+type MockSurveysResultsInteractorMockRecorder struct {
+	mock *MockSurveysResultsInteractor
 }
 
-// NewMockEmployeesSearchUseCases creates a new mock instance.
-func NewMockEmployeesSearchUseCases(ctrl *gomock.Controller) *MockEmployeesSearchUseCases {
-	mock := &MockEmployeesSearchUseCases{ctrl: ctrl}
-	mock.recorder = &MockEmployeesSearchUseCasesMockRecorder{mock}
+// NewMockSurveysResultsInteractor creates a new mock instance.
+func NewMockSurveysResultsInteractor(ctrl *gomock.Controller) *MockSurveysResultsInteractor {
+	mock := &MockSurveysResultsInteractor{ctrl: ctrl}
+	mock.recorder = &MockSurveysResultsInteractorMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockEmployeesSearchUseCases) EXPECT() *MockEmployeesSearchUseCasesMockRecorder {
+func (m *MockSurveysResultsInteractor) EXPECT() *MockSurveysResultsInteractorMockRecorder {
 	return m.recorder
 }
 
-// Filters mocks base method.
-func (m *MockEmployeesSearchUseCases) Filters(ctx context.Context, request *employees_search0.SearchParams) (*employees_search0.FiltersResponse, error) {
+// Export mocks base method.
+func (m *MockSurveysResultsInteractor) Export(ctx context.Context, filter entitySurveys.AnswersFilter, write func([]*entitySurveys.ResponseRow) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Filters", ctx, request)
-	ret0, _ := ret[0].(*employees_search0.FiltersResponse)
-	ret1, _ := ret[1].(error)
+	ret := m.ctrl.Call(m, "Export", ctx, filter, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockSurveysResultsInteractorMockRecorder) Export(ctx, filter, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockSurveysResultsInteractor)(nil).Export), ctx, filter, write)
+}
+
+// Results mocks base method.
+func (m *MockSurveysResultsInteractor) Results(ctx context.Context, filter entitySurveys.AnswersFilter) (*entitySurveys.Results, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Results", ctx, filter)
+	ret0, _ := ret[0].(*entitySurveys.Results)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Results indicates an expected call of Results.
+func (mr *MockSurveysResultsInteractorMockRecorder) Results(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Results", reflect.TypeOf((*MockSurveysResultsInteractor)(nil).Results), ctx, filter)
+}
+
+// TextAnswers mocks base method.
+func (m *MockSurveysResultsInteractor) TextAnswers(ctx context.Context, filter entitySurveys.AnswersFilter, pagination entitySurveys.AnswersPagination) (*entitySurveys.AnswersWithPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextAnswers", ctx, filter, pagination)
+	ret0, _ := ret[0].(*entitySurveys.AnswersWithPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TextAnswers indicates an expected call of TextAnswers.
+func (mr *MockSurveysResultsInteractorMockRecorder) TextAnswers(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextAnswers", reflect.TypeOf((*MockSurveysResultsInteractor)(nil).TextAnswers), ctx, filter, pagination)
+}
+
+// MockAuthInteractor is a mock of AuthInteractor interface.
+type MockAuthInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthInteractorMockRecorder is the mock recorder for MockAuthInteractor.
+type MockAuthInteractorMockRecorder struct {
+	mock *MockAuthInteractor
+}
+
+// NewMockAuthInteractor creates a new mock instance.
+func NewMockAuthInteractor(ctrl *gomock.Controller) *MockAuthInteractor {
+	mock := &MockAuthInteractor{ctrl: ctrl}
+	mock.recorder = &MockAuthInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthInteractor) EXPECT() *MockAuthInteractorMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *MockAuthInteractor) Auth(ctx context.Context, code, state, callbackURI string, portalID int) (*auth0.Auth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth", ctx, code, state, callbackURI, portalID)
+	ret0, _ := ret[0].(*auth0.Auth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockAuthInteractorMockRecorder) Auth(ctx, code, state, callbackURI, portalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockAuthInteractor)(nil).Auth), ctx, code, state, callbackURI, portalID)
+}
+
+// ChangePortal mocks base method.
+func (m *MockAuthInteractor) ChangePortal(ctx context.Context, selectedPortalID int) ([]*auth0.Portal, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePortal", ctx, selectedPortalID)
+	ret0, _ := ret[0].([]*auth0.Portal)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ChangePortal indicates an expected call of ChangePortal.
+func (mr *MockAuthInteractorMockRecorder) ChangePortal(ctx, selectedPortalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePortal", reflect.TypeOf((*MockAuthInteractor)(nil).ChangePortal), ctx, selectedPortalID)
+}
+
+// CheckAccess mocks base method.
+func (m *MockAuthInteractor) CheckAccess(ctx context.Context, session *auth0.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockAuthInteractorMockRecorder) CheckAccess(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockAuthInteractor)(nil).CheckAccess), ctx, session)
+}
+
+// CheckToken mocks base method.
+func (m *MockAuthInteractor) CheckToken(ctx context.Context, accessToken string) (*auth0.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckToken", ctx, accessToken)
+	ret0, _ := ret[0].(*auth0.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckToken indicates an expected call of CheckToken.
+func (mr *MockAuthInteractorMockRecorder) CheckToken(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckToken", reflect.TypeOf((*MockAuthInteractor)(nil).CheckToken), ctx, accessToken)
+}
+
+// GetAuthURL mocks base method.
+func (m *MockAuthInteractor) GetAuthURL(ctx context.Context, callbackURI string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthURL", ctx, callbackURI)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAuthURL indicates an expected call of GetAuthURL.
+func (mr *MockAuthInteractorMockRecorder) GetAuthURL(ctx, callbackURI any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthURL", reflect.TypeOf((*MockAuthInteractor)(nil).GetAuthURL), ctx, callbackURI)
+}
+
+// GetSession mocks base method.
+func (m *MockAuthInteractor) GetSession(ctx context.Context, accessToken string) (*auth0.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, accessToken)
+	ret0, _ := ret[0].(*auth0.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockAuthInteractorMockRecorder) GetSession(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAuthInteractor)(nil).GetSession), ctx, accessToken)
+}
+
+// Logout mocks base method.
+func (m *MockAuthInteractor) Logout(ctx context.Context, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthInteractorMockRecorder) Logout(ctx, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthInteractor)(nil).Logout), ctx, accessToken, refreshToken)
+}
+
+// RefreshTokensPair mocks base method.
+func (m *MockAuthInteractor) RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*auth0.TokensPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokensPair", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(*auth0.TokensPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokensPair indicates an expected call of RefreshTokensPair.
+func (mr *MockAuthInteractorMockRecorder) RefreshTokensPair(ctx, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokensPair", reflect.TypeOf((*MockAuthInteractor)(nil).RefreshTokensPair), ctx, accessToken, refreshToken)
+}
+
+// TrackActivity mocks base method.
+func (m *MockAuthInteractor) TrackActivity(ctx context.Context, session *auth0.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TrackActivity", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TrackActivity indicates an expected call of TrackActivity.
+func (mr *MockAuthInteractorMockRecorder) TrackActivity(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TrackActivity", reflect.TypeOf((*MockAuthInteractor)(nil).TrackActivity), ctx, session)
+}
+
+// MockAuthorizationInteractor is a mock of AuthorizationInteractor interface.
+type MockAuthorizationInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationInteractorMockRecorder is the mock recorder for MockAuthorizationInteractor.
+type MockAuthorizationInteractorMockRecorder struct {
+	mock *MockAuthorizationInteractor
+}
+
+// NewMockAuthorizationInteractor creates a new mock instance.
+func NewMockAuthorizationInteractor(ctrl *gomock.Controller) *MockAuthorizationInteractor {
+	mock := &MockAuthorizationInteractor{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationInteractor) EXPECT() *MockAuthorizationInteractorMockRecorder {
+	return m.recorder
+}
+
+// CheckPermissions mocks base method.
+func (m *MockAuthorizationInteractor) CheckPermissions(ctx context.Context, permissions ...auth0.Permission) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range permissions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckPermissions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPermissions indicates an expected call of CheckPermissions.
+func (mr *MockAuthorizationInteractorMockRecorder) CheckPermissions(ctx any, permissions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, permissions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermissions", reflect.TypeOf((*MockAuthorizationInteractor)(nil).CheckPermissions), varargs...)
+}
+
+// MockEmployeesSearchUseCases is a mock of EmployeesSearchUseCases interface.
+type MockEmployeesSearchUseCases struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeesSearchUseCasesMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeesSearchUseCasesMockRecorder is the mock recorder for MockEmployeesSearchUseCases.
+type MockEmployeesSearchUseCasesMockRecorder struct {
+	mock *MockEmployeesSearchUseCases
+}
+
+// NewMockEmployeesSearchUseCases creates a new mock instance.
+func NewMockEmployeesSearchUseCases(ctrl *gomock.Controller) *MockEmployeesSearchUseCases {
+	mock := &MockEmployeesSearchUseCases{ctrl: ctrl}
+	mock.recorder = &MockEmployeesSearchUseCasesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeesSearchUseCases) EXPECT() *MockEmployeesSearchUseCasesMockRecorder {
+	return m.recorder
+}
+
+// Filters mocks base method.
+func (m *MockEmployeesSearchUseCases) Filters(ctx context.Context, request *employees_search0.SearchParams) (*employees_search0.FiltersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filters", ctx, request)
+	ret0, _ := ret[0].(*employees_search0.FiltersResponse)
+	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
@@ -2582,6 +3159,74 @@ func (mr *MockUsersInteractorMockRecorder) GetMe(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockUsersInteractor)(nil).GetMe), ctx)
 }
 
+// MockSessionsInteractor is a mock of SessionsInteractor interface.
+type MockSessionsInteractor struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionsInteractorMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionsInteractorMockRecorder is the mock recorder for MockSessionsInteractor.
+type MockSessionsInteractorMockRecorder struct {
+	mock *MockSessionsInteractor
+}
+
+// NewMockSessionsInteractor creates a new mock instance.
+func NewMockSessionsInteractor(ctrl *gomock.Controller) *MockSessionsInteractor {
+	mock := &MockSessionsInteractor{ctrl: ctrl}
+	mock.recorder = &MockSessionsInteractorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionsInteractor) EXPECT() *MockSessionsInteractorMockRecorder {
+	return m.recorder
+}
+
+// ListSessions mocks base method.
+func (m *MockSessionsInteractor) ListSessions(ctx context.Context) ([]*auth0.SessionActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSessions", ctx)
+	ret0, _ := ret[0].([]*auth0.SessionActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSessions indicates an expected call of ListSessions.
+func (mr *MockSessionsInteractorMockRecorder) ListSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSessions", reflect.TypeOf((*MockSessionsInteractor)(nil).ListSessions), ctx)
+}
+
+// RevokeOtherSessions mocks base method.
+func (m *MockSessionsInteractor) RevokeOtherSessions(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeOtherSessions", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeOtherSessions indicates an expected call of RevokeOtherSessions.
+func (mr *MockSessionsInteractorMockRecorder) RevokeOtherSessions(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeOtherSessions", reflect.TypeOf((*MockSessionsInteractor)(nil).RevokeOtherSessions), ctx)
+}
+
+// RevokeSession mocks base method.
+func (m *MockSessionsInteractor) RevokeSession(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockSessionsInteractorMockRecorder) RevokeSession(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockSessionsInteractor)(nil).RevokeSession), ctx, sessionID)
+}
+
 // MockStringPaginationPresenter is a mock of StringPaginationPresenter interface.
 type MockStringPaginationPresenter struct {
 	ctrl     *gomock.Controller
@@ -3436,6 +4081,20 @@ func (mr *MockSurveysPresenterMockRecorder) ToEntity(s any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToEntity", reflect.TypeOf((*MockSurveysPresenter)(nil).ToEntity), s)
 }
 
+// ToMyView mocks base method.
+func (m *MockSurveysPresenter) ToMyView(arg0 []*entitySurveys.Survey) *view0.MySurveys {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToMyView", arg0)
+	ret0, _ := ret[0].(*view0.MySurveys)
+	return ret0
+}
+
+// ToMyView indicates an expected call of ToMyView.
+func (mr *MockSurveysPresenterMockRecorder) ToMyView(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMyView", reflect.TypeOf((*MockSurveysPresenter)(nil).ToMyView), arg0)
+}
+
 // ToNewEntity mocks base method.
 func (m *MockSurveysPresenter) ToNewEntity(s *view0.NewSurvey) *entitySurveys.Survey {
 	m.ctrl.T.Helper()
@@ -3502,10 +4161,24 @@ func (m *MockSurveysAnswersPresenter) EXPECT() *MockSurveysAnswersPresenterMockR
 	return m.recorder
 }
 
-// ToNewEntities mocks base method.
-func (m *MockSurveysAnswersPresenter) ToNewEntities(answers *view0.NewSurveyAnswers) []*entitySurveys.RespondentAnswer {
+// CompletionToView mocks base method.
+func (m *MockSurveysAnswersPresenter) CompletionToView(completion *entitySurveys.Completion) *view0.SurveyCompletion {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToNewEntities", answers)
+	ret := m.ctrl.Call(m, "CompletionToView", completion)
+	ret0, _ := ret[0].(*view0.SurveyCompletion)
+	return ret0
+}
+
+// CompletionToView indicates an expected call of CompletionToView.
+func (mr *MockSurveysAnswersPresenterMockRecorder) CompletionToView(completion any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompletionToView", reflect.TypeOf((*MockSurveysAnswersPresenter)(nil).CompletionToView), completion)
+}
+
+// ToNewEntities mocks base method.
+func (m *MockSurveysAnswersPresenter) ToNewEntities(answers *view0.NewSurveyAnswers) []*entitySurveys.RespondentAnswer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToNewEntities", answers)
 	ret0, _ := ret[0].([]*entitySurveys.RespondentAnswer)
 	return ret0
 }
@@ -3596,6 +4269,101 @@ func (mr *MockSurveysImagesPresenterMockRecorder) ToView(image any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToView", reflect.TypeOf((*MockSurveysImagesPresenter)(nil).ToView), image)
 }
 
+// MockSurveysResultsPresenter is a mock of SurveysResultsPresenter interface.
+type MockSurveysResultsPresenter struct {
+	ctrl     *gomock.Controller
+	recorder *MockSurveysResultsPresenterMockRecorder
+	isgomock struct{}
+}
+
+// MockSurveysResultsPresenterMockRecorder is the mock recorder for MockSurveysResultsPresenter.
+type MockSurveysResultsPresenterMockRecorder struct {
+	mock *MockSurveysResultsPresenter
+}
+
+// NewMockSurveysResultsPresenter creates a new mock instance.
+func NewMockSurveysResultsPresenter(ctrl *gomock.Controller) *MockSurveysResultsPresenter {
+	mock := &MockSurveysResultsPresenter{ctrl: ctrl}
+	mock.recorder = &MockSurveysResultsPresenterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSurveysResultsPresenter) EXPECT() *MockSurveysResultsPresenterMockRecorder {
+	return m.recorder
+}
+
+// FilterToEntity mocks base method.
+func (m *MockSurveysResultsPresenter) FilterToEntity(surveyID entitySurveys.SurveyID, filter *view0.SurveyResultsFilter) (entitySurveys.AnswersFilter, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FilterToEntity", surveyID, filter)
+	ret0, _ := ret[0].(entitySurveys.AnswersFilter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FilterToEntity indicates an expected call of FilterToEntity.
+func (mr *MockSurveysResultsPresenterMockRecorder) FilterToEntity(surveyID, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterToEntity", reflect.TypeOf((*MockSurveysResultsPresenter)(nil).FilterToEntity), surveyID, filter)
+}
+
+// ResponseRowToView mocks base method.
+func (m *MockSurveysResultsPresenter) ResponseRowToView(row *entitySurveys.ResponseRow) []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseRowToView", row)
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ResponseRowToView indicates an expected call of ResponseRowToView.
+func (mr *MockSurveysResultsPresenterMockRecorder) ResponseRowToView(row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseRowToView", reflect.TypeOf((*MockSurveysResultsPresenter)(nil).ResponseRowToView), row)
+}
+
+// ResponseRowsHeader mocks base method.
+func (m *MockSurveysResultsPresenter) ResponseRowsHeader() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResponseRowsHeader")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// ResponseRowsHeader indicates an expected call of ResponseRowsHeader.
+func (mr *MockSurveysResultsPresenterMockRecorder) ResponseRowsHeader() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResponseRowsHeader", reflect.TypeOf((*MockSurveysResultsPresenter)(nil).ResponseRowsHeader))
+}
+
+// TextAnswersToView mocks base method.
+func (m *MockSurveysResultsPresenter) TextAnswersToView(answers *entitySurveys.AnswersWithPagination) *view0.SurveyTextAnswers {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TextAnswersToView", answers)
+	ret0, _ := ret[0].(*view0.SurveyTextAnswers)
+	return ret0
+}
+
+// TextAnswersToView indicates an expected call of TextAnswersToView.
+func (mr *MockSurveysResultsPresenterMockRecorder) TextAnswersToView(answers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TextAnswersToView", reflect.TypeOf((*MockSurveysResultsPresenter)(nil).TextAnswersToView), answers)
+}
+
+// ToView mocks base method.
+func (m *MockSurveysResultsPresenter) ToView(results *entitySurveys.Results) *view0.SurveyResults {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ToView", results)
+	ret0, _ := ret[0].(*view0.SurveyResults)
+	return ret0
+}
+
+// ToView indicates an expected call of ToView.
+func (mr *MockSurveysResultsPresenterMockRecorder) ToView(results any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToView", reflect.TypeOf((*MockSurveysResultsPresenter)(nil).ToView), results)
+}
+
 // MockAuthPresenter is a mock of AuthPresenter interface.
 type MockAuthPresenter struct {
 	ctrl     *gomock.Controller
@@ -3820,6 +4588,20 @@ func (m *MockUsersPresenter) EXPECT() *MockUsersPresenterMockRecorder {
 	return m.recorder
 }
 
+// SessionsToView mocks base method.
+func (m *MockUsersPresenter) SessionsToView(sessions []*auth0.SessionActivity) []*users.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SessionsToView", sessions)
+	ret0, _ := ret[0].([]*users.Session)
+	return ret0
+}
+
+// SessionsToView indicates an expected call of SessionsToView.
+func (mr *MockUsersPresenterMockRecorder) SessionsToView(sessions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SessionsToView", reflect.TypeOf((*MockUsersPresenter)(nil).SessionsToView), sessions)
+}
+
 // ShortUserToView mocks base method.
 func (m *MockUsersPresenter) ShortUserToView(employee *user.ShortUser) *users.ShortUser {
 	m.ctrl.T.Helper()
@@ -4376,6 +5158,34 @@ func (mr *MockNewsCommentsInteractorMockRecorder) Create(ctx, in any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).Create), ctx, in)
 }
 
+// Delete mocks base method.
+func (m *MockNewsCommentsInteractor) Delete(ctx context.Context, newsID, commentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNewsCommentsInteractorMockRecorder) Delete(ctx, newsID, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).Delete), ctx, newsID, commentID)
+}
+
+// DismissReport mocks base method.
+func (m *MockNewsCommentsInteractor) DismissReport(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DismissReport", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DismissReport indicates an expected call of DismissReport.
+func (mr *MockNewsCommentsInteractorMockRecorder) DismissReport(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DismissReport", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).DismissReport), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockNewsCommentsInteractor) List(ctx context.Context, params *news0.FilterComments) ([]*news1.NewsComment, int, error) {
 	m.ctrl.T.Helper()
@@ -4392,6 +5202,64 @@ func (mr *MockNewsCommentsInteractorMockRecorder) List(ctx, params any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).List), ctx, params)
 }
 
+// Report mocks base method.
+func (m *MockNewsCommentsInteractor) Report(ctx context.Context, in news0.NewCommentReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockNewsCommentsInteractorMockRecorder) Report(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).Report), ctx, in)
+}
+
+// Reports mocks base method.
+func (m *MockNewsCommentsInteractor) Reports(ctx context.Context) ([]*news1.CommentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reports", ctx)
+	ret0, _ := ret[0].([]*news1.CommentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reports indicates an expected call of Reports.
+func (mr *MockNewsCommentsInteractorMockRecorder) Reports(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reports", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).Reports), ctx)
+}
+
+// SetHidden mocks base method.
+func (m *MockNewsCommentsInteractor) SetHidden(ctx context.Context, newsID, commentID uuid.UUID, hidden bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHidden", ctx, newsID, commentID, hidden)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHidden indicates an expected call of SetHidden.
+func (mr *MockNewsCommentsInteractorMockRecorder) SetHidden(ctx, newsID, commentID, hidden any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHidden", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).SetHidden), ctx, newsID, commentID, hidden)
+}
+
+// Update mocks base method.
+func (m *MockNewsCommentsInteractor) Update(ctx context.Context, in news0.UpdateComment) (*news1.NewsComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, in)
+	ret0, _ := ret[0].(*news1.NewsComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockNewsCommentsInteractorMockRecorder) Update(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockNewsCommentsInteractor)(nil).Update), ctx, in)
+}
+
 // MockNewsAdminInteractor is a mock of NewsAdminInteractor interface.
 type MockNewsAdminInteractor struct {
 	ctrl     *gomock.Controller
@@ -4417,18 +5285,18 @@ func (m *MockNewsAdminInteractor) EXPECT() *MockNewsAdminInteractorMockRecorder
 }
 
 // ChangeStatus mocks base method.
-func (m *MockNewsAdminInteractor) ChangeStatus(ctx context.Context, id uuid.UUID, status news1.NewsStatus) (*news1.News, error) {
+func (m *MockNewsAdminInteractor) ChangeStatus(ctx context.Context, id uuid.UUID, status news1.NewsStatus, comment string) (*news1.News, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ChangeStatus", ctx, id, status)
+	ret := m.ctrl.Call(m, "ChangeStatus", ctx, id, status, comment)
 	ret0, _ := ret[0].(*news1.News)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ChangeStatus indicates an expected call of ChangeStatus.
-func (mr *MockNewsAdminInteractorMockRecorder) ChangeStatus(ctx, id, status any) *gomock.Call {
+func (mr *MockNewsAdminInteractorMockRecorder) ChangeStatus(ctx, id, status, comment any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockNewsAdminInteractor)(nil).ChangeStatus), ctx, id, status)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStatus", reflect.TypeOf((*MockNewsAdminInteractor)(nil).ChangeStatus), ctx, id, status, comment)
 }
 
 // Create mocks base method.
@@ -4460,6 +5328,35 @@ func (mr *MockNewsAdminInteractorMockRecorder) Delete(ctx, id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNewsAdminInteractor)(nil).Delete), ctx, id)
 }
 
+// DeleteDraft mocks base method.
+func (m *MockNewsAdminInteractor) DeleteDraft(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDraft", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDraft indicates an expected call of DeleteDraft.
+func (mr *MockNewsAdminInteractorMockRecorder) DeleteDraft(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDraft", reflect.TypeOf((*MockNewsAdminInteractor)(nil).DeleteDraft), ctx, id)
+}
+
+// Draft mocks base method.
+func (m *MockNewsAdminInteractor) Draft(ctx context.Context, id uuid.UUID) (*news1.Draft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Draft", ctx, id)
+	ret0, _ := ret[0].(*news1.Draft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Draft indicates an expected call of Draft.
+func (mr *MockNewsAdminInteractorMockRecorder) Draft(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Draft", reflect.TypeOf((*MockNewsAdminInteractor)(nil).Draft), ctx, id)
+}
+
 // Get mocks base method.
 func (m *MockNewsAdminInteractor) Get(ctx context.Context, id uuid.UUID) (*news1.NewsFull, error) {
 	m.ctrl.T.Helper()
@@ -4475,6 +5372,81 @@ func (mr *MockNewsAdminInteractorMockRecorder) Get(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNewsAdminInteractor)(nil).Get), ctx, id)
 }
 
+// History mocks base method.
+func (m *MockNewsAdminInteractor) History(ctx context.Context, id uuid.UUID) ([]*news1.StatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "History", ctx, id)
+	ret0, _ := ret[0].([]*news1.StatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// History indicates an expected call of History.
+func (mr *MockNewsAdminInteractorMockRecorder) History(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "History", reflect.TypeOf((*MockNewsAdminInteractor)(nil).History), ctx, id)
+}
+
+// RestoreRevision mocks base method.
+func (m *MockNewsAdminInteractor) RestoreRevision(ctx context.Context, id, revisionID uuid.UUID, updatedAt *time.Time) (*news1.News, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreRevision", ctx, id, revisionID, updatedAt)
+	ret0, _ := ret[0].(*news1.News)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreRevision indicates an expected call of RestoreRevision.
+func (mr *MockNewsAdminInteractorMockRecorder) RestoreRevision(ctx, id, revisionID, updatedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreRevision", reflect.TypeOf((*MockNewsAdminInteractor)(nil).RestoreRevision), ctx, id, revisionID, updatedAt)
+}
+
+// Revision mocks base method.
+func (m *MockNewsAdminInteractor) Revision(ctx context.Context, id, revisionID uuid.UUID) (*news1.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revision", ctx, id, revisionID)
+	ret0, _ := ret[0].(*news1.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revision indicates an expected call of Revision.
+func (mr *MockNewsAdminInteractorMockRecorder) Revision(ctx, id, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revision", reflect.TypeOf((*MockNewsAdminInteractor)(nil).Revision), ctx, id, revisionID)
+}
+
+// Revisions mocks base method.
+func (m *MockNewsAdminInteractor) Revisions(ctx context.Context, id uuid.UUID) ([]*news1.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revisions", ctx, id)
+	ret0, _ := ret[0].([]*news1.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Revisions indicates an expected call of Revisions.
+func (mr *MockNewsAdminInteractorMockRecorder) Revisions(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revisions", reflect.TypeOf((*MockNewsAdminInteractor)(nil).Revisions), ctx, id)
+}
+
+// SaveDraft mocks base method.
+func (m *MockNewsAdminInteractor) SaveDraft(ctx context.Context, id uuid.UUID, arg2 *news0.UpdateNews) (*news1.Draft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDraft", ctx, id, arg2)
+	ret0, _ := ret[0].(*news1.Draft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveDraft indicates an expected call of SaveDraft.
+func (mr *MockNewsAdminInteractorMockRecorder) SaveDraft(ctx, id, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDraft", reflect.TypeOf((*MockNewsAdminInteractor)(nil).SaveDraft), ctx, id, arg2)
+}
+
 // Search mocks base method.
 func (m *MockNewsAdminInteractor) Search(ctx context.Context, search *news0.SearchNews) (*news0.SearchNewsResult, error) {
 	m.ctrl.T.Helper()
@@ -4520,6 +5492,21 @@ func (mr *MockNewsAdminInteractorMockRecorder) UpdateFlags(ctx, id, updateNews a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFlags", reflect.TypeOf((*MockNewsAdminInteractor)(nil).UpdateFlags), ctx, id, updateNews)
 }
 
+// ViewsStats mocks base method.
+func (m *MockNewsAdminInteractor) ViewsStats(ctx context.Context, id uuid.UUID, from, to time.Time) (*news1.ViewsStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewsStats", ctx, id, from, to)
+	ret0, _ := ret[0].(*news1.ViewsStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ViewsStats indicates an expected call of ViewsStats.
+func (mr *MockNewsAdminInteractorMockRecorder) ViewsStats(ctx, id, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewsStats", reflect.TypeOf((*MockNewsAdminInteractor)(nil).ViewsStats), ctx, id, from, to)
+}
+
 // MockNewsInteractor is a mock of NewsInteractor interface.
 type MockNewsInteractor struct {
 	ctrl     *gomock.Controller
@@ -4544,6 +5531,21 @@ func (m *MockNewsInteractor) EXPECT() *MockNewsInteractorMockRecorder {
 	return m.recorder
 }
 
+// AddReaction mocks base method.
+func (m *MockNewsInteractor) AddReaction(ctx context.Context, newsID uuid.UUID, reaction news1.ReactionType) (*news1.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", ctx, newsID, reaction)
+	ret0, _ := ret[0].(*news1.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockNewsInteractorMockRecorder) AddReaction(ctx, newsID, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockNewsInteractor)(nil).AddReaction), ctx, newsID, reaction)
+}
+
 // Get mocks base method.
 func (m *MockNewsInteractor) Get(ctx context.Context, slug string) (*news1.NewsFull, error) {
 	m.ctrl.T.Helper()
@@ -4559,6 +5561,21 @@ func (mr *MockNewsInteractorMockRecorder) Get(ctx, slug any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNewsInteractor)(nil).Get), ctx, slug)
 }
 
+// RemoveReaction mocks base method.
+func (m *MockNewsInteractor) RemoveReaction(ctx context.Context, newsID uuid.UUID, reaction news1.ReactionType) (*news1.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", ctx, newsID, reaction)
+	ret0, _ := ret[0].(*news1.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockNewsInteractorMockRecorder) RemoveReaction(ctx, newsID, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockNewsInteractor)(nil).RemoveReaction), ctx, newsID, reaction)
+}
+
 // Search mocks base method.
 func (m *MockNewsInteractor) Search(ctx context.Context, search *news0.SearchNews) (*news0.SearchNewsResult, error) {
 	m.ctrl.T.Helper()
@@ -4640,6 +5657,20 @@ func (mr *MockNewsAdminPresenterMockRecorder) CategoryToView(c any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CategoryToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).CategoryToView), c)
 }
 
+// CommentToView mocks base method.
+func (m *MockNewsAdminPresenter) CommentToView(comment *news1.NewsComment) *news.NewsComment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentToView", comment)
+	ret0, _ := ret[0].(*news.NewsComment)
+	return ret0
+}
+
+// CommentToView indicates an expected call of CommentToView.
+func (mr *MockNewsAdminPresenterMockRecorder) CommentToView(comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).CommentToView), comment)
+}
+
 // CommentsToView mocks base method.
 func (m *MockNewsAdminPresenter) CommentsToView(list []*news1.NewsComment) []*news.NewsComment {
 	m.ctrl.T.Helper()
@@ -4654,6 +5685,20 @@ func (mr *MockNewsAdminPresenterMockRecorder) CommentsToView(list any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).CommentsToView), list)
 }
 
+// DraftToView mocks base method.
+func (m *MockNewsAdminPresenter) DraftToView(draft *news1.Draft) *news.NewsDraft {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DraftToView", draft)
+	ret0, _ := ret[0].(*news.NewsDraft)
+	return ret0
+}
+
+// DraftToView indicates an expected call of DraftToView.
+func (mr *MockNewsAdminPresenterMockRecorder) DraftToView(draft any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DraftToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).DraftToView), draft)
+}
+
 // FullNewsToSearchItem mocks base method.
 func (m *MockNewsAdminPresenter) FullNewsToSearchItem(n *news1.NewsFull) *news.SearchNewsResponseItem {
 	m.ctrl.T.Helper()
@@ -4696,6 +5741,20 @@ func (mr *MockNewsAdminPresenterMockRecorder) FullNewsToView(n any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullNewsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).FullNewsToView), n)
 }
 
+// HistoryToView mocks base method.
+func (m *MockNewsAdminPresenter) HistoryToView(history []*news1.StatusTransition) []*news.NewsStatusTransition {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HistoryToView", history)
+	ret0, _ := ret[0].([]*news.NewsStatusTransition)
+	return ret0
+}
+
+// HistoryToView indicates an expected call of HistoryToView.
+func (mr *MockNewsAdminPresenterMockRecorder) HistoryToView(history any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HistoryToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).HistoryToView), history)
+}
+
 // NewCategoryToDTO mocks base method.
 func (m *MockNewsAdminPresenter) NewCategoryToDTO(nc *news.NewCategory) *news0.NewCategory {
 	m.ctrl.T.Helper()
@@ -4808,12 +5867,97 @@ func (mr *MockNewsAdminPresenterMockRecorder) ParticipantsToView(participants an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParticipantsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ParticipantsToView), participants)
 }
 
+// ReactionsToView mocks base method.
+func (m *MockNewsAdminPresenter) ReactionsToView(reactions *news1.Reactions) *news.NewsReactions {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReactionsToView", reactions)
+	ret0, _ := ret[0].(*news.NewsReactions)
+	return ret0
+}
+
+// ReactionsToView indicates an expected call of ReactionsToView.
+func (mr *MockNewsAdminPresenterMockRecorder) ReactionsToView(reactions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReactionsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ReactionsToView), reactions)
+}
+
+// ReportToDTO mocks base method.
+func (m *MockNewsAdminPresenter) ReportToDTO(newsID, commentID uuid.UUID, v *news.ReportNewsComment) news0.NewCommentReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportToDTO", newsID, commentID, v)
+	ret0, _ := ret[0].(news0.NewCommentReport)
+	return ret0
+}
+
+// ReportToDTO indicates an expected call of ReportToDTO.
+func (mr *MockNewsAdminPresenterMockRecorder) ReportToDTO(newsID, commentID, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportToDTO", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ReportToDTO), newsID, commentID, v)
+}
+
+// ReportsToView mocks base method.
+func (m *MockNewsAdminPresenter) ReportsToView(list []*news1.CommentReport) []*news.CommentReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportsToView", list)
+	ret0, _ := ret[0].([]*news.CommentReport)
+	return ret0
+}
+
+// ReportsToView indicates an expected call of ReportsToView.
+func (mr *MockNewsAdminPresenterMockRecorder) ReportsToView(list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ReportsToView), list)
+}
+
+// RevisionToView mocks base method.
+func (m *MockNewsAdminPresenter) RevisionToView(revision *news1.Revision) *news.NewsRevisionPreview {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevisionToView", revision)
+	ret0, _ := ret[0].(*news.NewsRevisionPreview)
+	return ret0
+}
+
+// RevisionToView indicates an expected call of RevisionToView.
+func (mr *MockNewsAdminPresenterMockRecorder) RevisionToView(revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevisionToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).RevisionToView), revision)
+}
+
+// RevisionsToView mocks base method.
+func (m *MockNewsAdminPresenter) RevisionsToView(revisions []*news1.Revision) []*news.NewsRevision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevisionsToView", revisions)
+	ret0, _ := ret[0].([]*news.NewsRevision)
+	return ret0
+}
+
+// RevisionsToView indicates an expected call of RevisionsToView.
+func (mr *MockNewsAdminPresenterMockRecorder) RevisionsToView(revisions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevisionsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).RevisionsToView), revisions)
+}
+
+// ScrollToCursor mocks base method.
+func (m *MockNewsAdminPresenter) ScrollToCursor(scroll *news0.SearchNewsScroll) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScrollToCursor", scroll)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// ScrollToCursor indicates an expected call of ScrollToCursor.
+func (mr *MockNewsAdminPresenterMockRecorder) ScrollToCursor(scroll any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScrollToCursor", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ScrollToCursor), scroll)
+}
+
 // SearchNewsToDTO mocks base method.
-func (m *MockNewsAdminPresenter) SearchNewsToDTO(search *news.SearchNewsRequest) *news0.SearchNews {
+func (m *MockNewsAdminPresenter) SearchNewsToDTO(search *news.SearchNewsRequest) (*news0.SearchNews, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SearchNewsToDTO", search)
 	ret0, _ := ret[0].(*news0.SearchNews)
-	return ret0
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // SearchNewsToDTO indicates an expected call of SearchNewsToDTO.
@@ -4864,6 +6008,20 @@ func (mr *MockNewsAdminPresenterMockRecorder) UpdateCategoryToDTO(c any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCategoryToDTO", reflect.TypeOf((*MockNewsAdminPresenter)(nil).UpdateCategoryToDTO), c)
 }
 
+// UpdateCommentToDTO mocks base method.
+func (m *MockNewsAdminPresenter) UpdateCommentToDTO(newsID, commentID uuid.UUID, v *news.UpdateNewsComment) news0.UpdateComment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentToDTO", newsID, commentID, v)
+	ret0, _ := ret[0].(news0.UpdateComment)
+	return ret0
+}
+
+// UpdateCommentToDTO indicates an expected call of UpdateCommentToDTO.
+func (mr *MockNewsAdminPresenterMockRecorder) UpdateCommentToDTO(newsID, commentID, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentToDTO", reflect.TypeOf((*MockNewsAdminPresenter)(nil).UpdateCommentToDTO), newsID, commentID, v)
+}
+
 // UpdateFlagsToDTO mocks base method.
 func (m *MockNewsAdminPresenter) UpdateFlagsToDTO(n *news.UpdateNewsFlags) *news0.UpdateFlags {
 	m.ctrl.T.Helper()
@@ -4892,6 +6050,20 @@ func (mr *MockNewsAdminPresenterMockRecorder) UpdateNewsToDTO(updateNews any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNewsToDTO", reflect.TypeOf((*MockNewsAdminPresenter)(nil).UpdateNewsToDTO), updateNews)
 }
 
+// ViewsStatsToView mocks base method.
+func (m *MockNewsAdminPresenter) ViewsStatsToView(stats *news1.ViewsStats) *news.NewsViewsStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ViewsStatsToView", stats)
+	ret0, _ := ret[0].(*news.NewsViewsStats)
+	return ret0
+}
+
+// ViewsStatsToView indicates an expected call of ViewsStatsToView.
+func (mr *MockNewsAdminPresenterMockRecorder) ViewsStatsToView(stats any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ViewsStatsToView", reflect.TypeOf((*MockNewsAdminPresenter)(nil).ViewsStatsToView), stats)
+}
+
 // MockNewsCategoryPresenter is a mock of NewsCategoryPresenter interface.
 type MockNewsCategoryPresenter struct {
 	ctrl     *gomock.Controller
@@ -4996,6 +6168,20 @@ func (m *MockNewsCommentsPresenter) EXPECT() *MockNewsCommentsPresenterMockRecor
 	return m.recorder
 }
 
+// CommentToView mocks base method.
+func (m *MockNewsCommentsPresenter) CommentToView(comment *news1.NewsComment) *news.NewsComment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommentToView", comment)
+	ret0, _ := ret[0].(*news.NewsComment)
+	return ret0
+}
+
+// CommentToView indicates an expected call of CommentToView.
+func (mr *MockNewsCommentsPresenterMockRecorder) CommentToView(comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommentToView", reflect.TypeOf((*MockNewsCommentsPresenter)(nil).CommentToView), comment)
+}
+
 // CommentsToView mocks base method.
 func (m *MockNewsCommentsPresenter) CommentsToView(list []*news1.NewsComment) []*news.NewsComment {
 	m.ctrl.T.Helper()
@@ -5024,6 +6210,48 @@ func (mr *MockNewsCommentsPresenterMockRecorder) NewCommentToDTO(newsID, v any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewCommentToDTO", reflect.TypeOf((*MockNewsCommentsPresenter)(nil).NewCommentToDTO), newsID, v)
 }
 
+// ReportToDTO mocks base method.
+func (m *MockNewsCommentsPresenter) ReportToDTO(newsID, commentID uuid.UUID, v *news.ReportNewsComment) news0.NewCommentReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportToDTO", newsID, commentID, v)
+	ret0, _ := ret[0].(news0.NewCommentReport)
+	return ret0
+}
+
+// ReportToDTO indicates an expected call of ReportToDTO.
+func (mr *MockNewsCommentsPresenterMockRecorder) ReportToDTO(newsID, commentID, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportToDTO", reflect.TypeOf((*MockNewsCommentsPresenter)(nil).ReportToDTO), newsID, commentID, v)
+}
+
+// ReportsToView mocks base method.
+func (m *MockNewsCommentsPresenter) ReportsToView(list []*news1.CommentReport) []*news.CommentReport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportsToView", list)
+	ret0, _ := ret[0].([]*news.CommentReport)
+	return ret0
+}
+
+// ReportsToView indicates an expected call of ReportsToView.
+func (mr *MockNewsCommentsPresenterMockRecorder) ReportsToView(list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportsToView", reflect.TypeOf((*MockNewsCommentsPresenter)(nil).ReportsToView), list)
+}
+
+// UpdateCommentToDTO mocks base method.
+func (m *MockNewsCommentsPresenter) UpdateCommentToDTO(newsID, commentID uuid.UUID, v *news.UpdateNewsComment) news0.UpdateComment {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCommentToDTO", newsID, commentID, v)
+	ret0, _ := ret[0].(news0.UpdateComment)
+	return ret0
+}
+
+// UpdateCommentToDTO indicates an expected call of UpdateCommentToDTO.
+func (mr *MockNewsCommentsPresenterMockRecorder) UpdateCommentToDTO(newsID, commentID, v any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCommentToDTO", reflect.TypeOf((*MockNewsCommentsPresenter)(nil).UpdateCommentToDTO), newsID, commentID, v)
+}
+
 // MockNewsAdminHandlers is a mock of NewsAdminHandlers interface.
 type MockNewsAdminHandlers struct {
 	ctrl     *gomock.Controller
@@ -5048,6 +6276,18 @@ func (m *MockNewsAdminHandlers) EXPECT() *MockNewsAdminHandlersMockRecorder {
 	return m.recorder
 }
 
+// commentReports mocks base method.
+func (m *MockNewsAdminHandlers) commentReports(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "commentReports", c)
+}
+
+// commentReports indicates an expected call of commentReports.
+func (mr *MockNewsAdminHandlersMockRecorder) commentReports(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "commentReports", reflect.TypeOf((*MockNewsAdminHandlers)(nil).commentReports), c)
+}
+
 // createCategory mocks base method.
 func (m *MockNewsAdminHandlers) createCategory(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5084,6 +6324,18 @@ func (mr *MockNewsAdminHandlersMockRecorder) deleteCategory(c any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "deleteCategory", reflect.TypeOf((*MockNewsAdminHandlers)(nil).deleteCategory), c)
 }
 
+// deleteDraft mocks base method.
+func (m *MockNewsAdminHandlers) deleteDraft(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "deleteDraft", c)
+}
+
+// deleteDraft indicates an expected call of deleteDraft.
+func (mr *MockNewsAdminHandlersMockRecorder) deleteDraft(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "deleteDraft", reflect.TypeOf((*MockNewsAdminHandlers)(nil).deleteDraft), c)
+}
+
 // deleteNews mocks base method.
 func (m *MockNewsAdminHandlers) deleteNews(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5096,6 +6348,18 @@ func (mr *MockNewsAdminHandlersMockRecorder) deleteNews(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "deleteNews", reflect.TypeOf((*MockNewsAdminHandlers)(nil).deleteNews), c)
 }
 
+// dismissCommentReport mocks base method.
+func (m *MockNewsAdminHandlers) dismissCommentReport(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "dismissCommentReport", c)
+}
+
+// dismissCommentReport indicates an expected call of dismissCommentReport.
+func (mr *MockNewsAdminHandlersMockRecorder) dismissCommentReport(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "dismissCommentReport", reflect.TypeOf((*MockNewsAdminHandlers)(nil).dismissCommentReport), c)
+}
+
 // getCategory mocks base method.
 func (m *MockNewsAdminHandlers) getCategory(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5108,6 +6372,18 @@ func (mr *MockNewsAdminHandlersMockRecorder) getCategory(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getCategory", reflect.TypeOf((*MockNewsAdminHandlers)(nil).getCategory), c)
 }
 
+// getDraft mocks base method.
+func (m *MockNewsAdminHandlers) getDraft(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "getDraft", c)
+}
+
+// getDraft indicates an expected call of getDraft.
+func (mr *MockNewsAdminHandlersMockRecorder) getDraft(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getDraft", reflect.TypeOf((*MockNewsAdminHandlers)(nil).getDraft), c)
+}
+
 // getNews mocks base method.
 func (m *MockNewsAdminHandlers) getNews(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5120,6 +6396,90 @@ func (mr *MockNewsAdminHandlersMockRecorder) getNews(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "getNews", reflect.TypeOf((*MockNewsAdminHandlers)(nil).getNews), c)
 }
 
+// hideComment mocks base method.
+func (m *MockNewsAdminHandlers) hideComment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "hideComment", c)
+}
+
+// hideComment indicates an expected call of hideComment.
+func (mr *MockNewsAdminHandlersMockRecorder) hideComment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "hideComment", reflect.TypeOf((*MockNewsAdminHandlers)(nil).hideComment), c)
+}
+
+// history mocks base method.
+func (m *MockNewsAdminHandlers) history(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "history", c)
+}
+
+// history indicates an expected call of history.
+func (mr *MockNewsAdminHandlersMockRecorder) history(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "history", reflect.TypeOf((*MockNewsAdminHandlers)(nil).history), c)
+}
+
+// restoreComment mocks base method.
+func (m *MockNewsAdminHandlers) restoreComment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "restoreComment", c)
+}
+
+// restoreComment indicates an expected call of restoreComment.
+func (mr *MockNewsAdminHandlersMockRecorder) restoreComment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "restoreComment", reflect.TypeOf((*MockNewsAdminHandlers)(nil).restoreComment), c)
+}
+
+// restoreRevision mocks base method.
+func (m *MockNewsAdminHandlers) restoreRevision(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "restoreRevision", c)
+}
+
+// restoreRevision indicates an expected call of restoreRevision.
+func (mr *MockNewsAdminHandlersMockRecorder) restoreRevision(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "restoreRevision", reflect.TypeOf((*MockNewsAdminHandlers)(nil).restoreRevision), c)
+}
+
+// revision mocks base method.
+func (m *MockNewsAdminHandlers) revision(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "revision", c)
+}
+
+// revision indicates an expected call of revision.
+func (mr *MockNewsAdminHandlersMockRecorder) revision(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "revision", reflect.TypeOf((*MockNewsAdminHandlers)(nil).revision), c)
+}
+
+// revisions mocks base method.
+func (m *MockNewsAdminHandlers) revisions(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "revisions", c)
+}
+
+// revisions indicates an expected call of revisions.
+func (mr *MockNewsAdminHandlersMockRecorder) revisions(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "revisions", reflect.TypeOf((*MockNewsAdminHandlers)(nil).revisions), c)
+}
+
+// saveDraft mocks base method.
+func (m *MockNewsAdminHandlers) saveDraft(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "saveDraft", c)
+}
+
+// saveDraft indicates an expected call of saveDraft.
+func (mr *MockNewsAdminHandlersMockRecorder) saveDraft(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "saveDraft", reflect.TypeOf((*MockNewsAdminHandlers)(nil).saveDraft), c)
+}
+
 // searchCategory mocks base method.
 func (m *MockNewsAdminHandlers) searchCategory(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5192,6 +6552,18 @@ func (mr *MockNewsAdminHandlersMockRecorder) updateNews(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "updateNews", reflect.TypeOf((*MockNewsAdminHandlers)(nil).updateNews), c)
 }
 
+// viewsStats mocks base method.
+func (m *MockNewsAdminHandlers) viewsStats(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "viewsStats", c)
+}
+
+// viewsStats indicates an expected call of viewsStats.
+func (mr *MockNewsAdminHandlersMockRecorder) viewsStats(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "viewsStats", reflect.TypeOf((*MockNewsAdminHandlers)(nil).viewsStats), c)
+}
+
 // MockNewsHandlers is a mock of NewsHandlers interface.
 type MockNewsHandlers struct {
 	ctrl     *gomock.Controller
@@ -5216,6 +6588,18 @@ func (m *MockNewsHandlers) EXPECT() *MockNewsHandlersMockRecorder {
 	return m.recorder
 }
 
+// addReaction mocks base method.
+func (m *MockNewsHandlers) addReaction(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "addReaction", c)
+}
+
+// addReaction indicates an expected call of addReaction.
+func (mr *MockNewsHandlersMockRecorder) addReaction(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "addReaction", reflect.TypeOf((*MockNewsHandlers)(nil).addReaction), c)
+}
+
 // createComment mocks base method.
 func (m *MockNewsHandlers) createComment(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5228,6 +6612,18 @@ func (mr *MockNewsHandlersMockRecorder) createComment(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "createComment", reflect.TypeOf((*MockNewsHandlers)(nil).createComment), c)
 }
 
+// deleteComment mocks base method.
+func (m *MockNewsHandlers) deleteComment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "deleteComment", c)
+}
+
+// deleteComment indicates an expected call of deleteComment.
+func (mr *MockNewsHandlersMockRecorder) deleteComment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "deleteComment", reflect.TypeOf((*MockNewsHandlers)(nil).deleteComment), c)
+}
+
 // getNews mocks base method.
 func (m *MockNewsHandlers) getNews(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5252,6 +6648,30 @@ func (mr *MockNewsHandlersMockRecorder) listComments(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "listComments", reflect.TypeOf((*MockNewsHandlers)(nil).listComments), c)
 }
 
+// removeReaction mocks base method.
+func (m *MockNewsHandlers) removeReaction(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "removeReaction", c)
+}
+
+// removeReaction indicates an expected call of removeReaction.
+func (mr *MockNewsHandlersMockRecorder) removeReaction(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "removeReaction", reflect.TypeOf((*MockNewsHandlers)(nil).removeReaction), c)
+}
+
+// reportComment mocks base method.
+func (m *MockNewsHandlers) reportComment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "reportComment", c)
+}
+
+// reportComment indicates an expected call of reportComment.
+func (mr *MockNewsHandlersMockRecorder) reportComment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "reportComment", reflect.TypeOf((*MockNewsHandlers)(nil).reportComment), c)
+}
+
 // searchNews mocks base method.
 func (m *MockNewsHandlers) searchNews(c *gin.Context) {
 	m.ctrl.T.Helper()
@@ -5264,6 +6684,18 @@ func (mr *MockNewsHandlersMockRecorder) searchNews(c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "searchNews", reflect.TypeOf((*MockNewsHandlers)(nil).searchNews), c)
 }
 
+// updateComment mocks base method.
+func (m *MockNewsHandlers) updateComment(c *gin.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "updateComment", c)
+}
+
+// updateComment indicates an expected call of updateComment.
+func (mr *MockNewsHandlersMockRecorder) updateComment(c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "updateComment", reflect.TypeOf((*MockNewsHandlers)(nil).updateComment), c)
+}
+
 // MockBannersHandlers is a mock of BannersHandlers interface.
 type MockBannersHandlers struct {
 	ctrl     *gomock.Controller
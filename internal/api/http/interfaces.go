@@ -2,6 +2,7 @@ package http
 
 import (
 	"context"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -176,11 +177,13 @@ type SurveysHandlers interface {
 // SurveysSurveyHandlers ручки по опросам
 type SurveysSurveyHandlers interface {
 	getSurvey(c *gin.Context)
+	mySurveys(c *gin.Context)
 }
 
 // SurveysAnswerHandlers ручки по ответам на опрос
 type SurveysAnswerHandlers interface {
 	addAnswers(c *gin.Context)
+	getCompletion(c *gin.Context)
 }
 
 // SurveysImageHandlers ручки по изображениям опроса
@@ -188,6 +191,21 @@ type SurveysImageHandlers interface {
 	getImage(c *gin.Context)
 }
 
+// SurveysAdminHandlers ручки управления опросами
+type SurveysAdminHandlers interface {
+	create(c *gin.Context)
+	get(c *gin.Context)
+	search(c *gin.Context)
+	update(c *gin.Context)
+	publish(c *gin.Context)
+	unpublish(c *gin.Context)
+	delete(c *gin.Context)
+	uploadImage(c *gin.Context)
+	results(c *gin.Context)
+	textAnswers(c *gin.Context)
+	exportResults(c *gin.Context)
+}
+
 /**
 Модуль поиска сотрудников
 */
@@ -205,6 +223,9 @@ type EmployeesSearchHandlers interface {
 type UsersHandlers interface {
 	getMe(c *gin.Context)
 	changePortal(c *gin.Context)
+	getSessions(c *gin.Context)
+	revokeSession(c *gin.Context)
+	revokeOtherSessions(c *gin.Context)
 }
 
 /**
@@ -296,14 +317,52 @@ type SurveysSurveysInteractor interface {
 	) (*entitySurvey.Survey, error)
 }
 
+// SurveysMyInteractor use-кейсы опросов текущего пользователя
+type SurveysMyInteractor interface {
+	My(ctx context.Context) ([]*entitySurvey.Survey, error)
+}
+
 // SurveysAnswersInteractor use-кейсы методов ответов на опрос
 type SurveysAnswersInteractor interface {
-	Add(ctx context.Context, answers []*entitySurvey.RespondentAnswer) ([]uuid.UUID, error)
+	Add(ctx context.Context, surveyID entitySurvey.SurveyID, answers []*entitySurvey.RespondentAnswer) ([]uuid.UUID, error)
+	Completion(ctx context.Context, surveyID entitySurvey.SurveyID) (*entitySurvey.Completion, error)
 }
 
 // SurveysImagesInteractor use-кейсы методов изобржений для опроса
 type SurveysImagesInteractor interface {
 	Get(ctx context.Context, imageName string) ([]byte, error)
+	Add(ctx context.Context, image *entitySurvey.Image) (*entitySurvey.Image, error)
+}
+
+// SurveysAdminInteractor use-кейсы управления опросами
+type SurveysAdminInteractor interface {
+	Get(ctx context.Context, id entitySurvey.SurveyID) (*entitySurvey.Survey, error)
+	GetAll(
+		ctx context.Context,
+		ids entitySurvey.SurveyIDs,
+		respondent *entitySurvey.SurveyRespondent,
+		options entitySurvey.SurveyFilterOptions,
+		pagination entitySurvey.Pagination,
+	) (*entitySurvey.SurveysWithPagination, error)
+	Create(ctx context.Context, survey *entitySurvey.Survey) (*entitySurvey.SurveyID, error)
+	Update(ctx context.Context, survey *entitySurvey.Survey) (*entitySurvey.Survey, error)
+	SetPublished(ctx context.Context, id entitySurvey.SurveyID, published bool) (*entitySurvey.Survey, error)
+	Delete(ctx context.Context, id entitySurvey.SurveyID) error
+}
+
+// SurveysResultsInteractor use-кейсы результатов опросов
+type SurveysResultsInteractor interface {
+	Results(ctx context.Context, filter entitySurvey.AnswersFilter) (*entitySurvey.Results, error)
+	TextAnswers(
+		ctx context.Context,
+		filter entitySurvey.AnswersFilter,
+		pagination entitySurvey.AnswersPagination,
+	) (*entitySurvey.AnswersWithPagination, error)
+	Export(
+		ctx context.Context,
+		filter entitySurvey.AnswersFilter,
+		write func(rows []*entitySurvey.ResponseRow) error,
+	) error
 }
 
 type AuthInteractor interface {
@@ -313,14 +372,26 @@ type AuthInteractor interface {
 	// Auth авторизация web пользователя
 	//  метод возвращает информацию о пользователе в СУДИР
 	//  и oauth2 токены
-	Auth(ctx context.Context, code, state, callbackURI string) (*entityAuth.Auth, error)
+	Auth(ctx context.Context, code, state, callbackURI string, portalID int) (*entityAuth.Auth, error)
 	GetSession(ctx context.Context, accessToken string) (*entityAuth.Session, error)
+	// CheckAccess проверяет доступ пользователя сессии к активному порталу по списку доступа
+	CheckAccess(ctx context.Context, session *entityAuth.Session) error
+	// CheckToken проверяет токен доступа локально и существование сессии без получения полной сессии
+	CheckToken(ctx context.Context, accessToken string) (*entityAuth.Session, error)
+	// TrackActivity фиксирует активность сессии. Для отозванной или простаивающей сессии возвращает ошибку
+	TrackActivity(ctx context.Context, session *entityAuth.Session) error
 	Logout(ctx context.Context, accessToken, refreshToken string) error
 	// ChangePortal метод для смены активного портала. На вход принимает идентификатор выбранного портала 1С и сессию. Возвращает порталы и сессию портала 1С
 	ChangePortal(ctx context.Context, selectedPortalID int) ([]*entityAuth.Portal, string, error)
 	RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*entityAuth.TokensPair, error)
 }
 
+// AuthorizationInteractor use-кейсы проверки прав пользователя
+type AuthorizationInteractor interface {
+	// CheckPermissions проверяет наличие прав у пользователя сессии на активном портале
+	CheckPermissions(ctx context.Context, permissions ...entityAuth.Permission) error
+}
+
 /**
 Модуль поиска сотрудников
 */
@@ -338,6 +409,16 @@ type UsersInteractor interface {
 	GetMe(ctx context.Context) (*entityUser.UserInfo, error)
 }
 
+// SessionsInteractor use-кейсы управления сессиями пользователя
+type SessionsInteractor interface {
+	// ListSessions возвращает действующие сессии пользователя
+	ListSessions(ctx context.Context) ([]*entityAuth.SessionActivity, error)
+	// RevokeSession отзывает сессию пользователя по идентификатору
+	RevokeSession(ctx context.Context, sessionID string) error
+	// RevokeOtherSessions отзывает все сессии пользователя, кроме текущей
+	RevokeOtherSessions(ctx context.Context) (int, error)
+}
+
 /**
 Презентеры
 */
@@ -420,6 +501,7 @@ type SurveysPresenter interface {
 	ToEntity(s *viewSurveys.Survey) *entitySurvey.Survey
 	ToView(s *entitySurvey.Survey) *viewSurveys.Survey
 	ToShortView(s *entitySurvey.Survey) *viewSurveys.SurveyInfo
+	ToMyView(surveys []*entitySurvey.Survey) *viewSurveys.MySurveys
 	IDsToEntities(ids []uuid.UUID) entitySurvey.SurveyIDs
 	RespondentToEntity(respondent *viewSurveys.GetAllSurveysRespondent) *entitySurvey.SurveyRespondent
 	OptionsToEntity(options *viewSurveys.SurveysOptions) entitySurvey.SurveyFilterOptions
@@ -433,6 +515,7 @@ type SurveysAnswersPresenter interface {
 	ToNewEntities(answers *viewSurveys.NewSurveyAnswers) []*entitySurvey.RespondentAnswer
 	ToViews(answers []*entitySurvey.RespondentAnswer) []*viewSurveys.SurveyAnswer
 	ToShortViews(ids []uuid.UUID) []*viewSurveys.SurveyAnswerInfo
+	CompletionToView(completion *entitySurvey.Completion) *viewSurveys.SurveyCompletion
 }
 
 // SurveysImagesPresenter презентер методов изображений для опроса
@@ -441,6 +524,15 @@ type SurveysImagesPresenter interface {
 	ToView(image *entitySurvey.Image) *viewSurveys.SurveyImageObject
 }
 
+// SurveysResultsPresenter презентер результатов опросов
+type SurveysResultsPresenter interface {
+	FilterToEntity(surveyID entitySurvey.SurveyID, filter *viewSurveys.SurveyResultsFilter) (entitySurvey.AnswersFilter, error)
+	ToView(results *entitySurvey.Results) *viewSurveys.SurveyResults
+	TextAnswersToView(answers *entitySurvey.AnswersWithPagination) *viewSurveys.SurveyTextAnswers
+	ResponseRowsHeader() []string
+	ResponseRowToView(row *entitySurvey.ResponseRow) []string
+}
+
 type AuthPresenter interface {
 	AuthToView(authInfo *entityAuth.Auth) *viewAuth.AuthResponse
 }
@@ -469,6 +561,7 @@ type EmployeesUseCases interface {
 
 type UsersPresenter interface {
 	ShortUserToView(employee *entityUser.ShortUser) *viewUsers.ShortUser
+	SessionsToView(sessions []*entityAuth.SessionActivity) []*viewUsers.Session
 }
 
 type FilesHandlers interface {
@@ -543,20 +636,36 @@ type NewsCategoryInteractor interface {
 type NewsCommentsInteractor interface {
 	Create(ctx context.Context, in dtoNews.NewComment) (uuid.UUID, int, error)
 	List(ctx context.Context, params *dtoNews.FilterComments) ([]*entityNews.NewsComment, int, error)
+	Update(ctx context.Context, in dtoNews.UpdateComment) (*entityNews.NewsComment, error)
+	Delete(ctx context.Context, newsID, commentID uuid.UUID) error
+	Report(ctx context.Context, in dtoNews.NewCommentReport) error
+	SetHidden(ctx context.Context, newsID, commentID uuid.UUID, hidden bool) error
+	Reports(ctx context.Context) ([]*entityNews.CommentReport, error)
+	DismissReport(ctx context.Context, id uuid.UUID) error
 }
 type NewsAdminInteractor interface {
 	Create(ctx context.Context, news *dtoNews.NewNews) (uuid.UUID, error)
 	Update(ctx context.Context, id uuid.UUID, updateNews *dtoNews.UpdateNews) (*entityNews.News, error)
-	ChangeStatus(ctx context.Context, id uuid.UUID, status entityNews.NewsStatus) (*entityNews.News, error)
+	ChangeStatus(ctx context.Context, id uuid.UUID, status entityNews.NewsStatus, comment string) (*entityNews.News, error)
+	History(ctx context.Context, id uuid.UUID) ([]*entityNews.StatusTransition, error)
+	ViewsStats(ctx context.Context, id uuid.UUID, from, to time.Time) (*entityNews.ViewsStats, error)
 	Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error)
 	Search(ctx context.Context, search *dtoNews.SearchNews) (*dtoNews.SearchNewsResult, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateFlags(ctx context.Context, id uuid.UUID, updateNews *dtoNews.UpdateFlags) (*entityNews.News, error)
+	SaveDraft(ctx context.Context, id uuid.UUID, news *dtoNews.UpdateNews) (*entityNews.Draft, error)
+	Draft(ctx context.Context, id uuid.UUID) (*entityNews.Draft, error)
+	DeleteDraft(ctx context.Context, id uuid.UUID) error
+	Revisions(ctx context.Context, id uuid.UUID) ([]*entityNews.Revision, error)
+	Revision(ctx context.Context, id, revisionID uuid.UUID) (*entityNews.Revision, error)
+	RestoreRevision(ctx context.Context, id, revisionID uuid.UUID, updatedAt *time.Time) (*entityNews.News, error)
 }
 
 type NewsInteractor interface {
 	Get(ctx context.Context, slug string) (*entityNews.NewsFull, error)
 	Search(ctx context.Context, search *dtoNews.SearchNews) (*dtoNews.SearchNewsResult, error)
+	AddReaction(ctx context.Context, newsID uuid.UUID, reaction entityNews.ReactionType) (*entityNews.Reactions, error)
+	RemoveReaction(ctx context.Context, newsID uuid.UUID, reaction entityNews.ReactionType) (*entityNews.Reactions, error)
 }
 
 type NewsAdminPresenter interface {
@@ -564,19 +673,26 @@ type NewsAdminPresenter interface {
 	NewsCommentsPresenter
 	NewNewsToDTO(news *viewNews.NewNews) *dtoNews.NewNews
 	UpdateNewsToDTO(updateNews *viewNews.UpdateNews) *dtoNews.UpdateNews
-	SearchNewsToDTO(search *viewNews.SearchNewsRequest) *dtoNews.SearchNews
+	SearchNewsToDTO(search *viewNews.SearchNewsRequest) (*dtoNews.SearchNews, error)
+	ScrollToCursor(scroll *dtoNews.SearchNewsScroll) string
 	StatusToEntity(status viewNews.NewsStatus) entityNews.NewsStatus
 	FullNewsToView(n *entityNews.NewsFull) *viewNews.News
+	ReactionsToView(reactions *entityNews.Reactions) *viewNews.NewsReactions
+	ViewsStatsToView(stats *entityNews.ViewsStats) *viewNews.NewsViewsStats
 	NewsCategoryToView(category *entityNews.Category) *viewNews.NewsCategory
 	NewsOrganizationToView(organization *entityNews.NewsOrganization) *viewNews.NewsOrganization
 	NewsProductToView(product *entityNews.NewsProduct) *viewNews.NewsProduct
 	AuthorToView(author entityNews.Author) viewNews.Author
 	StatusToView(status entityNews.NewsStatus) viewNews.NewsStatus
+	HistoryToView(history []*entityNews.StatusTransition) []*viewNews.NewsStatusTransition
 	ParticipantsToView(participants []*entityNews.Participant) []*viewNews.NewsParticipants
 	ParticipantToView(participant *entityNews.Participant) *viewNews.NewsParticipants
 	FullNewsToSearchItems(n []*entityNews.NewsFull) []*viewNews.SearchNewsResponseItem
 	FullNewsToSearchItem(n *entityNews.NewsFull) *viewNews.SearchNewsResponseItem
 	UpdateFlagsToDTO(n *viewNews.UpdateNewsFlags) *dtoNews.UpdateFlags
+	RevisionsToView(revisions []*entityNews.Revision) []*viewNews.NewsRevision
+	RevisionToView(revision *entityNews.Revision) *viewNews.NewsRevisionPreview
+	DraftToView(draft *entityNews.Draft) *viewNews.NewsDraft
 }
 
 type NewsCategoryPresenter interface {
@@ -589,6 +705,10 @@ type NewsCategoryPresenter interface {
 type NewsCommentsPresenter interface {
 	NewCommentToDTO(newsID uuid.UUID, v *viewNews.NewNewsComment) dtoNews.NewComment
 	CommentsToView(list []*entityNews.NewsComment) []*viewNews.NewsComment
+	CommentToView(comment *entityNews.NewsComment) *viewNews.NewsComment
+	UpdateCommentToDTO(newsID, commentID uuid.UUID, v *viewNews.UpdateNewsComment) dtoNews.UpdateComment
+	ReportToDTO(newsID, commentID uuid.UUID, v *viewNews.ReportNewsComment) dtoNews.NewCommentReport
+	ReportsToView(list []*entityNews.CommentReport) []*viewNews.CommentReport
 }
 
 type NewsAdminHandlers interface {
@@ -604,6 +724,18 @@ type NewsAdminHandlers interface {
 	deleteNews(c *gin.Context)
 	setStatusNews(c *gin.Context)
 	setFlagsNews(c *gin.Context)
+	history(c *gin.Context)
+	viewsStats(c *gin.Context)
+	hideComment(c *gin.Context)
+	restoreComment(c *gin.Context)
+	commentReports(c *gin.Context)
+	dismissCommentReport(c *gin.Context)
+	getDraft(c *gin.Context)
+	saveDraft(c *gin.Context)
+	deleteDraft(c *gin.Context)
+	revisions(c *gin.Context)
+	revision(c *gin.Context)
+	restoreRevision(c *gin.Context)
 }
 
 type NewsHandlers interface {
@@ -611,6 +743,11 @@ type NewsHandlers interface {
 	getNews(c *gin.Context)
 	createComment(c *gin.Context)
 	listComments(c *gin.Context)
+	addReaction(c *gin.Context)
+	removeReaction(c *gin.Context)
+	updateComment(c *gin.Context)
+	deleteComment(c *gin.Context)
+	reportComment(c *gin.Context)
 }
 
 /*
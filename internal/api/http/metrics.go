@@ -1,13 +1,21 @@
 package http
 
 import (
+	"time"
+
 	"github.com/gin-gonic/gin"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+const (
+	authCheckModeSession  = "session"
+	authCheckModeOnlyAuth = "only_auth"
+)
+
 var (
-	httpDurationMetric *prometheus.HistogramVec
+	httpDurationMetric      *prometheus.HistogramVec
+	authCheckDurationMetric *prometheus.HistogramVec
 )
 
 func InitHTTPMetrics() {
@@ -18,4 +26,20 @@ func InitHTTPMetrics() {
 		Name: "http_handler_handling_seconds",
 		Help: "Histogram of response latency (seconds) of the HTTP until it is finished by the application",
 	}, []string{"method", "path"})
+	authCheckDurationMetric = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "http_auth_check_seconds",
+		Help: "Histogram of access token check latency (seconds) by mode: full session fetch or only auth check",
+	}, []string{"mode", "result"})
+}
+
+// observeAuthCheck фиксирует время проверки токена доступа
+func observeAuthCheck(mode string, start time.Time, err error) {
+	if authCheckDurationMetric == nil {
+		return
+	}
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	authCheckDurationMetric.WithLabelValues(mode, result).Observe(time.Since(start).Seconds())
 }
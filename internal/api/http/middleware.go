@@ -18,6 +18,8 @@ import (
 	authView "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
 	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+	authUseCase "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/auth"
 )
 
 const onlyAuthOptKey = "onlyAuthCheck"
@@ -43,7 +45,10 @@ func NewAuthSessionMiddleware(ai AuthInteractor, tu timeUtils.TimeUtils, opts Mi
 	return func(c *gin.Context) {
 		startTime := tu.New()
 		defer func() {
-			httpDurationMetric.WithLabelValues(c.Request.Method, c.Request.URL.EscapedPath()).Observe(time.Since(*startTime).Seconds())
+			// В тестовом режиме метрики не регистрируются
+			if httpDurationMetric != nil {
+				httpDurationMetric.WithLabelValues(c.Request.Method, c.Request.URL.EscapedPath()).Observe(time.Since(*startTime).Seconds())
+			}
 		}()
 
 		tokenHeader := c.GetHeader(JWTTokenHeader)
@@ -63,46 +68,30 @@ func NewAuthSessionMiddleware(ai AuthInteractor, tu timeUtils.TimeUtils, opts Mi
 
 		accessToken := strings.TrimPrefix(tokenHeader, "Bearer ")
 
-		// TODO опция ингорируется, поскольку пока отсутствует ручка для проверки существования сессии, всегда выполняется получение сессии
-		_ = onlyAuth
-		/*
-			if onlyAuth {
-				// Проверяем существование сессии (токен валидный и не протух, сессия по id из payload найдена)
-				if !uc.SessionExists(c, token) {
-					c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrMessageUnauthorized))
-					return
-				}
-				c.Next()
-				return
+		var (
+			session *entityAuth.Session
+			err     error
+		)
+		checkStart := time.Now()
+		checkMode := authCheckModeSession
+		if onlyAuth {
+			// Проверяем токен локально и существование сессии по идентификатору из токена, полная сессия не получается
+			checkMode = authCheckModeOnlyAuth
+			session, err = ai.CheckToken(c, accessToken)
+		} else {
+			// Получаем сессию по токену (токен валидный и не протух, сессия по id найдена и не протухла)
+			session, err = ai.GetSession(c, accessToken)
+			if err == nil && session != nil {
+				err = ai.CheckAccess(c, session)
 			}
-		*/
-
-		// Получаем сессию по токену (токен валидный и не протух, сессия по id найдена и не протухла)
-		session, err := ai.GetSession(c, accessToken)
+		}
+		if err == nil && session != nil {
+			// Отозванная или простаивающая сессия считается истекшей на обоих путях
+			err = ai.TrackActivity(c, session)
+		}
+		observeAuthCheck(checkMode, checkStart, err)
 		if err != nil {
-			switch {
-			case errors.As(err, new(diterrors.ValidationError)):
-				// Невалидный JWT токен или идентификатор сессии
-				c.Header(StatusCodeHeader, "WASM_03")
-				c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrMessageInvalidRequest))
-			case errors.Is(err, diterrors.ErrFailedPrecondition):
-				// JWT токен истёк
-				c.Header(StatusCodeHeader, "WASM_04")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthenticated))
-			case errors.Is(err, diterrors.ErrNotFound):
-				// Сессия не найдена
-				c.Header(StatusCodeHeader, "WASM_05")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthorized))
-			case errors.Is(err, diterrors.ErrUnauthenticated):
-				// Сессия истекла
-				c.Header(StatusCodeHeader, "WASM_06")
-				c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated))
-			default:
-				// Внутренняя ошибка сервиса
-				c.Header(StatusCodeHeader, "WASM_07")
-				c.AbortWithStatusJSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
-
-			}
+			abortWithSessionError(c, err)
 			return
 		}
 
@@ -112,21 +101,65 @@ func NewAuthSessionMiddleware(ai AuthInteractor, tu timeUtils.TimeUtils, opts Mi
 			return
 		}
 
-		if SharedFields.AccessList != nil {
-			if !SharedFields.AccessList.Have(session.GetUser().GetEmail()) {
-				c.Header(StatusCodeHeader, "WASM_09")
-				c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrMessageUserAccessDenied))
-				return
-			}
-		}
-
 		c.Request = c.Request.WithContext(entity.WithSession(c.Request.Context(), session))
 
-		/*
-			TODO: подумать об изменении активности сессии
-			session.LastActive = startTime
-			c.Request = c.Request.WithContext(entity.WithSession(c.Request.Context(), sessionRepository.Update(c, session)))
-		*/
+		c.Next()
+	}
+}
+
+// abortWithSessionError прерывает запрос с кодом ошибки проверки сессии
+func abortWithSessionError(c *gin.Context, err error) {
+	switch {
+	case errors.As(err, new(diterrors.ValidationError)):
+		// Невалидный JWT токен или идентификатор сессии
+		c.Header(StatusCodeHeader, "WASM_03")
+		c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrMessageInvalidRequest))
+	case errors.Is(err, diterrors.ErrFailedPrecondition):
+		// JWT токен истёк
+		c.Header(StatusCodeHeader, "WASM_04")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthenticated))
+	case errors.Is(err, diterrors.ErrNotFound):
+		// Сессия не найдена
+		c.Header(StatusCodeHeader, "WASM_05")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthorized))
+	case errors.Is(err, diterrors.ErrUnauthenticated):
+		// Сессия истекла
+		c.Header(StatusCodeHeader, "WASM_06")
+		c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated))
+	case errors.Is(err, authUseCase.ErrUserAccessDenied):
+		// Доступ запрещен списком доступа
+		c.Header(StatusCodeHeader, "WASM_09")
+		c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrMessageUserAccessDenied))
+	default:
+		// Внутренняя ошибка сервиса
+		c.Header(StatusCodeHeader, "WASM_07")
+		c.AbortWithStatusJSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+	}
+}
+
+// NewPermissionMiddleware проверяет права пользователя сессии на выполнение запроса.
+//
+//	Должен использоваться после NewAuthSessionMiddleware.
+func NewPermissionMiddleware(ai AuthorizationInteractor, permissions ...entityAuth.Permission) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		err := ai.CheckPermissions(c.Request.Context(), permissions...)
+		if err != nil {
+			switch {
+			case errors.Is(err, usecase.ErrGetSessionFromContext):
+				// Сессия не найдена в контексте запроса
+				c.Header(StatusCodeHeader, "WASM_08")
+				c.AbortWithStatusJSON(http.StatusUnauthorized, view.NewErrorResponse(view.ErrMessageUnauthorized))
+			case errors.Is(err, authUseCase.ErrPermissionDenied):
+				// Недостаточно прав на активном портале
+				c.Header(StatusCodeHeader, "WASM_10")
+				c.AbortWithStatusJSON(http.StatusForbidden, view.NewErrorResponse(view.ErrPermissionDenied))
+			default:
+				// Внутренняя ошибка сервиса
+				c.Header(StatusCodeHeader, "WASM_07")
+				c.AbortWithStatusJSON(http.StatusInternalServerError, view.NewErrorResponse(view.ErrMessageInternalError))
+			}
+			return
+		}
 
 		c.Next()
 	}
@@ -1,5 +1,227 @@
 package http
 
-/*
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
 
- */
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/gintest.git"
+	timeUtils "git.mos.ru/buch-cloud/moscow-team-2.0/build/time-utils.git"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
+	authView "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+	authUseCase "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/auth"
+)
+
+func Test_NewPermissionMiddleware(t *testing.T) {
+	type fields struct {
+		interactor *MockAuthorizationInteractor
+	}
+
+	permissions := []entityAuth.Permission{entityAuth.PermissionNewsWrite}
+
+	tests := []struct {
+		name     string
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name: "no session",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckPermissions(gomock.Any(), entityAuth.PermissionNewsWrite).
+					Return(usecase.ErrGetSessionFromContext)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/news",
+					},
+					Response: gintest.NewResponse(
+						http.StatusUnauthorized,
+						map[string][]string{
+							"Content-Type":   {"application/json; charset=utf-8"},
+							StatusCodeHeader: {"WASM_08"},
+						},
+						nil,
+						nil,
+					).JsonBody(view.NewErrorResponse(view.ErrMessageUnauthorized)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "permission denied",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckPermissions(gomock.Any(), entityAuth.PermissionNewsWrite).
+					Return(authUseCase.ErrPermissionDenied)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/news",
+					},
+					Response: gintest.NewResponse(
+						http.StatusForbidden,
+						map[string][]string{
+							"Content-Type":   {"application/json; charset=utf-8"},
+							StatusCodeHeader: {"WASM_10"},
+						},
+						nil,
+						nil,
+					).JsonBody(view.NewErrorResponse(view.ErrPermissionDenied)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "internal err",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckPermissions(gomock.Any(), entityAuth.PermissionNewsWrite).
+					Return(assert.AnError)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/news",
+					},
+					Response: gintest.NewResponse(
+						http.StatusInternalServerError,
+						map[string][]string{
+							"Content-Type":   {"application/json; charset=utf-8"},
+							StatusCodeHeader: {"WASM_07"},
+						},
+						nil,
+						nil,
+					).JsonBody(view.NewErrorResponse(view.ErrMessageInternalError)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "correct",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckPermissions(gomock.Any(), entityAuth.PermissionNewsWrite).
+					Return(nil)
+				return &gintest.HandlerTestCase{
+					Request: &gintest.Request{
+						Method: http.MethodPost,
+						Path:   "/news",
+					},
+					Response:    gintest.NewResponse(http.StatusOK, nil, nil, nil),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				interactor: NewMockAuthorizationInteractor(ctrl),
+			}
+
+			middleware := NewPermissionMiddleware(f.interactor, permissions...)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(middleware, f))
+		})
+	}
+}
+
+func Test_NewAuthSessionMiddleware(t *testing.T) {
+	type fields struct {
+		interactor *MockAuthInteractor
+	}
+
+	accessToken := "testAccessToken"
+	testSession := &entityAuth.Session{User: &entityAuth.User{Email: "user@mos.ru"}}
+	revokedErr := fmt.Errorf("%w: %w", authUseCase.ErrSessionRevoked, diterrors.ErrUnauthenticated)
+	authRequest := &gintest.Request{
+		Method:  http.MethodGet,
+		Path:    "/proxy",
+		Headers: map[string][]string{JWTTokenHeader: {"Bearer " + accessToken}},
+	}
+
+	tests := []struct {
+		name     string
+		onlyAuth bool
+		testCase func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase
+	}{
+		{
+			name:     "only auth revoked session",
+			onlyAuth: true,
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckToken(gomock.Any(), accessToken).Return(testSession, nil)
+				f.interactor.EXPECT().TrackActivity(gomock.Any(), testSession).Return(revokedErr)
+				return &gintest.HandlerTestCase{
+					Request: authRequest,
+					Response: gintest.NewResponse(
+						http.StatusUnauthorized,
+						map[string][]string{
+							"Content-Type":   {"application/json; charset=utf-8"},
+							StatusCodeHeader: {"WASM_06"},
+						},
+						nil,
+						nil,
+					).JsonBody(view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name:     "only auth correct",
+			onlyAuth: true,
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().CheckToken(gomock.Any(), accessToken).Return(testSession, nil)
+				f.interactor.EXPECT().TrackActivity(gomock.Any(), testSession).Return(nil)
+				return &gintest.HandlerTestCase{
+					Request:     authRequest,
+					Response:    gintest.NewResponse(http.StatusOK, nil, nil, nil),
+					HandlerFunc: handler,
+				}
+			},
+		},
+		{
+			name: "session revoked session",
+			testCase: func(handler gin.HandlerFunc, f fields) *gintest.HandlerTestCase {
+				f.interactor.EXPECT().GetSession(gomock.Any(), accessToken).Return(testSession, nil)
+				f.interactor.EXPECT().CheckAccess(gomock.Any(), testSession).Return(nil)
+				f.interactor.EXPECT().TrackActivity(gomock.Any(), testSession).Return(revokedErr)
+				return &gintest.HandlerTestCase{
+					Request: authRequest,
+					Response: gintest.NewResponse(
+						http.StatusUnauthorized,
+						map[string][]string{
+							"Content-Type":   {"application/json; charset=utf-8"},
+							StatusCodeHeader: {"WASM_06"},
+						},
+						nil,
+						nil,
+					).JsonBody(view.NewErrorResponse(authView.ErrMessageFrontUnauthenticated)),
+					HandlerFunc: handler,
+				}
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				interactor: NewMockAuthInteractor(ctrl),
+			}
+			tu := timeUtils.NewMockTimeUtils(ctrl)
+			now := time.Now()
+			tu.EXPECT().New().Return(&now)
+
+			opts := MiddlewareOptions{}
+			if tt.onlyAuth {
+				opts.Add(&MiddlewareOption{Name: onlyAuthOptKey, Value: true})
+			}
+			middleware := NewAuthSessionMiddleware(f.interactor, tu, opts)
+			ginTest := gintest.NewGinTest()
+			ginTest.TestHandler(t, tt.testCase(middleware, f))
+		})
+	}
+}
@@ -0,0 +1,804 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/openapi"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view"
+	viewAnalytics "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/analytics"
+	viewAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/auth"
+	viewBanner "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/banner"
+	viewBanners "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/banners"
+	viewEmployees "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/employees"
+	viewEmployeesSearch "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/employees-search"
+	viewEvents "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/events"
+	viewNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/news"
+	viewPortalsV2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/portalsv2"
+	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
+	viewUsers "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/users"
+)
+
+// OpenAPIPath путь спецификации OpenAPI, доступна во всех окружениях кроме production
+const OpenAPIPath = "/openapi.json"
+
+// Теги роутов в спецификации OpenAPI
+const (
+	tagPortals         = "Порталы"
+	tagSurveys         = "Опросы"
+	tagAuth            = "Аутентификация"
+	tagProxy           = "Прокси"
+	tagEmployeesSearch = "Поиск сотрудников"
+	tagUsers           = "Пользователи"
+	tagEmployees       = "Сотрудники"
+	tagFiles           = "Файлы"
+	tagAnalytics       = "Аналитика"
+	tagNews            = "Новости"
+	tagBanners         = "Баннеры"
+	tagAdminNews       = "Администрирование новостей"
+	tagAdminBanners    = "Администрирование баннеров"
+	tagAdminSurveys    = "Администрирование опросов"
+	tagService         = "Служебные"
+)
+
+// openAPIHandler отдает спецификацию OpenAPI зарегистрированных роутов
+func openAPIHandler() gin.HandlerFunc {
+	doc := openapi.NewBuilder(view.Response{}).Build(openapi.Info{
+		Title:   "web-api",
+		Version: "1.0",
+	}, apiRoutes())
+
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, doc)
+	}
+}
+
+// apiRoutes описание роутов registerRoutes и registerAdminRoutes для спецификации OpenAPI.
+//
+//	При добавлении или изменении роута его описание нужно обновить здесь,
+//	соответствие проверяется тестом
+//
+//nolint:funlen
+func apiRoutes() []*openapi.Route {
+	portalsRoutes := func(prefix string) []*openapi.Route {
+		return []*openapi.Route{
+			{
+				Method:   http.MethodGet,
+				Path:     prefix + "/oivs",
+				Summary:  "Получение отфильтрованного списка порталов",
+				Tags:     []string{tagPortals},
+				Body:     viewPortalsV2.PortalsFilterRequest{},
+				Response: []*viewPortalsV2.Portal{},
+			},
+			{
+				Method:   http.MethodGet,
+				Path:     prefix + "/complexes",
+				Summary:  "Получение списка комплексов",
+				Tags:     []string{tagPortals},
+				Response: []*viewPortalsV2.Complex{},
+			},
+		}
+	}
+
+	surveysRoutes := func(answersPath, imagesPath, surveysPath string) []*openapi.Route {
+		return []*openapi.Route{
+			{
+				Method:   http.MethodPost,
+				Path:     answersPath,
+				Summary:  "Создание ответов на опрос",
+				Tags:     []string{tagSurveys},
+				Body:     viewSurveys.NewSurveyAnswers{},
+				Response: []*viewSurveys.SurveyAnswerInfo{},
+				Status:   http.StatusCreated,
+				Secured:  true,
+			},
+			{
+				Method:      http.MethodGet,
+				Path:        imagesPath,
+				Summary:     "Получение изображения по идентификатору",
+				Tags:        []string{tagSurveys},
+				ContentType: openapi.ContentTypeBinary,
+				Secured:     true,
+			},
+			{
+				Method:   http.MethodGet,
+				Path:     surveysPath,
+				Summary:  "Получение опроса по идентификатору",
+				Tags:     []string{tagSurveys},
+				Response: &viewSurveys.SurveyInfo{},
+				Secured:  true,
+			},
+		}
+	}
+
+	usersRoutes := func(prefix string) []*openapi.Route {
+		return []*openapi.Route{
+			{
+				Method:   http.MethodGet,
+				Path:     prefix + "/me",
+				Summary:  "Получение текущего пользователя",
+				Tags:     []string{tagUsers},
+				Response: &viewUsers.ShortUser{},
+				Wrapped:  true,
+				Secured:  true,
+			},
+			{
+				Method:   http.MethodGet,
+				Path:     prefix + "/changeportal/:id",
+				Summary:  "Смена активного портала",
+				Tags:     []string{tagUsers},
+				Params:   []*openapi.Parameter{openapi.Path("id", "integer", "идентификатор портала")},
+				Response: &viewAuth.AuthResponse{},
+				Wrapped:  true,
+				Secured:  true,
+			},
+		}
+	}
+
+	employeesSearchRoutes := func(searchPath, filtersPath string) []*openapi.Route {
+		return []*openapi.Route{
+			{
+				Method:   http.MethodPost,
+				Path:     searchPath,
+				Summary:  "Поиск сотрудников",
+				Tags:     []string{tagEmployeesSearch},
+				Body:     viewEmployeesSearch.SearchRequest{},
+				Response: &viewEmployeesSearch.SearchResponse{},
+				Wrapped:  true,
+				Secured:  true,
+			},
+			{
+				Method:   http.MethodPost,
+				Path:     filtersPath,
+				Summary:  "Получение фильтров поиска сотрудников",
+				Tags:     []string{tagEmployeesSearch},
+				Body:     viewEmployeesSearch.FiltersRequest{},
+				Response: &viewEmployeesSearch.FiltersResponse{},
+				Wrapped:  true,
+				Secured:  true,
+			},
+		}
+	}
+
+	filesRoute := func(path string) *openapi.Route {
+		return &openapi.Route{
+			Method:      http.MethodGet,
+			Path:        path,
+			Summary:     "Получение публичного файла по идентификатору",
+			Tags:        []string{tagFiles},
+			Params:      []*openapi.Parameter{openapi.Path("file_id", "string", "идентификатор файла")},
+			ContentType: openapi.ContentTypeBinary,
+			Secured:     true,
+		}
+	}
+
+	var routes []*openapi.Route
+	routes = append(routes, portalsRoutes("")...)
+	routes = append(routes, portalsRoutes("/portals/v1")...)
+	routes = append(routes, surveysRoutes("/survey/answers/", "/survey/images/:id", "/survey/:id")...)
+	routes = append(routes, surveysRoutes("/surveys/v1/answers/", "/surveys/v1/images/:id", "/surveys/v1/surveys/:id")...)
+	routes = append(routes, &openapi.Route{
+		Method:   http.MethodGet,
+		Path:     "/surveys/v1/surveys/:id/completion",
+		Summary:  "Статус прохождения опроса текущим пользователем",
+		Tags:     []string{tagSurveys},
+		Response: &viewSurveys.SurveyCompletion{},
+		Secured:  true,
+	})
+	routes = append(routes, &openapi.Route{
+		Method:   http.MethodGet,
+		Path:     "/surveys/v1/my",
+		Summary:  "Опросы, которые текущий пользователь может пройти",
+		Tags:     []string{tagSurveys},
+		Response: &viewSurveys.MySurveys{},
+		Secured:  true,
+	})
+	routes = append(routes,
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/auth/v1/redirect",
+			Summary:  "Создание сессии для перехода на портал",
+			Tags:     []string{tagAuth},
+			Body:     viewAuth.CreateSessionSessionRequest{},
+			Response: &viewAuth.CreateSessionSessionResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:  http.MethodGet,
+			Path:    "/auth/v1/auth",
+			Summary: "Аутентификация через СУДИР",
+			Tags:    []string{tagAuth},
+			Params: []*openapi.Parameter{
+				openapi.Query(paramCode, "string", false, "код аутентификации, возвращается СУДИР"),
+				openapi.Query(paramState, "string", false, "идентификатор сессии аутентификации, без него выполняется перенаправление в СУДИР"),
+				openapi.Query(paramCallbackURI, "string", false, "страница портала для перенаправления после аутентификации"),
+				openapi.Query(paramPortalID, "integer", false, "идентификатор портала для входа"),
+			},
+			Response: &viewAuth.AuthResponse{},
+			Wrapped:  true,
+		},
+		&openapi.Route{
+			Method:  http.MethodGet,
+			Path:    "/auth/v1/logout",
+			Summary: "Выход из системы",
+			Tags:    []string{tagAuth},
+			Secured: true,
+		},
+		&openapi.Route{
+			Method:  http.MethodGet,
+			Path:    "/auth/v1/refresh",
+			Summary: "Обновление токена доступа по токену обновления из cookie",
+			Tags:    []string{tagAuth},
+			Params:  []*openapi.Parameter{openapi.Header(JWTTokenHeader, true, "истекший токен доступа")},
+		},
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/proxy/v1/banners/home-slider",
+			Summary:  "Получение баннеров главной страницы портала",
+			Tags:     []string{tagProxy},
+			Response: &viewBanner.BannersList{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:  http.MethodGet,
+			Path:    "/proxy/v1/events/list",
+			Summary: "Получение событий календаря портала",
+			Tags:    []string{tagProxy},
+			Params: []*openapi.Parameter{
+				openapi.Query("year", "integer", true, "год"),
+				openapi.Query("month", "integer", true, "месяц"),
+			},
+			Response: &viewEvents.CalendarEventsList{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/proxy/v1/events/links",
+			Summary:  "Получение ссылок на события календаря портала",
+			Tags:     []string{tagProxy},
+			Body:     viewEvents.CalendarEventsLinksRequest{},
+			Response: []*viewEvents.CalendarEventLink{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+	)
+	routes = append(routes, employeesSearchRoutes("/search/v1/employees/search", "/search/v1/employees/filters")...)
+	routes = append(routes, usersRoutes("/users")...)
+	routes = append(routes, usersRoutes("/users/v1")...)
+	routes = append(routes,
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/users/v1/profile",
+			Summary:  "Получение профиля текущего пользователя",
+			Tags:     []string{tagUsers},
+			Response: &viewEmployees.Employee{},
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/users/v1/sessions",
+			Summary:  "Получение активных сессий пользователя",
+			Tags:     []string{tagUsers},
+			Response: []*viewUsers.Session{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodDelete,
+			Path:     "/users/v1/sessions",
+			Summary:  "Завершение всех сессий пользователя, кроме текущей",
+			Tags:     []string{tagUsers},
+			Response: &viewUsers.RevokeSessionsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodDelete,
+			Path:     "/users/v1/sessions/:id",
+			Summary:  "Завершение сессии пользователя",
+			Tags:     []string{tagUsers},
+			Params:   []*openapi.Parameter{openapi.Path("id", "string", "идентификатор сессии")},
+			Response: &viewUsers.RevokeSessionsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/employees/v1/employees/:id",
+			Summary:  "Получение сотрудника",
+			Tags:     []string{tagEmployees},
+			Params:   []*openapi.Parameter{openapi.Query("portalId", "integer", false, "идентификатор портала, если id - внешний идентификатор сотрудника")},
+			Response: &viewEmployees.Employee{},
+			Secured:  true,
+		},
+	)
+	routes = append(routes, employeesSearchRoutes("/employees/v1/search", "/employees/v1/search/filters")...)
+	routes = append(routes, filesRoute("/files/:file_id"), filesRoute("/files/v1/files/:file_id"))
+	routes = append(routes,
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/analytics/v1/metrics",
+			Summary:  "Отправка метрик аналитики",
+			Tags:     []string{tagAnalytics},
+			Params:   []*openapi.Parameter{openapi.Header(headerXCFCUserAgent, true, "клиент, отправивший метрики")},
+			Body:     map[string]any{},
+			Response: viewAnalytics.AddMetricsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/news/v1/news/search",
+			Summary:  "Поиск новостей",
+			Tags:     []string{tagNews},
+			Body:     viewNews.SearchNewsRequest{},
+			Response: &viewNews.SearchNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/news/v1/news/:id",
+			Summary:  "Получение новости",
+			Tags:     []string{tagNews},
+			Params:   []*openapi.Parameter{openapi.Path("id", "string", "slug новости")},
+			Response: &viewNews.News{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/news/v1/news/:id/comments",
+			Summary:  "Создание комментария к новости",
+			Tags:     []string{tagNews},
+			Body:     viewNews.NewNewsComment{},
+			Response: &viewNews.CreateCommentResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:  http.MethodGet,
+			Path:    "/news/v1/news/:id/comments",
+			Summary: "Получение комментариев к новости",
+			Tags:    []string{tagNews},
+			Params: []*openapi.Parameter{
+				openapi.Query("sortBy", "string", true, "поле сортировки, поддерживается только date"),
+				openapi.Query("orderType", "string", true, "направление сортировки ASC или DESC"),
+				openapi.Query("lastCommentId", "string", false, "идентификатор последнего полученного комментария"),
+				openapi.Query("parentId", "string", false, "идентификатор комментария, ответы на который нужно получить"),
+				openapi.Query("limit", "integer", true, "количество комментариев"),
+			},
+			Response: []*viewNews.NewsComment{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodPost,
+			Path:     "/news/v1/news/:id/reactions",
+			Summary:  "Добавление реакции на новость",
+			Tags:     []string{tagNews},
+			Body:     viewNews.NewsReactionRequest{},
+			Response: &viewNews.NewsReactions{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodDelete,
+			Path:     "/news/v1/news/:id/reactions",
+			Summary:  "Снятие реакции с новости",
+			Tags:     []string{tagNews},
+			Body:     viewNews.NewsReactionRequest{},
+			Response: &viewNews.NewsReactions{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:   http.MethodPatch,
+			Path:     "/news/v1/news/:id/comments/:commentId",
+			Summary:  "Изменение комментария автором",
+			Tags:     []string{tagNews},
+			Body:     viewNews.UpdateNewsComment{},
+			Response: &viewNews.NewsComment{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		&openapi.Route{
+			Method:  http.MethodDelete,
+			Path:    "/news/v1/news/:id/comments/:commentId",
+			Summary: "Удаление комментария автором",
+			Tags:    []string{tagNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		&openapi.Route{
+			Method:  http.MethodPost,
+			Path:    "/news/v1/news/:id/comments/:commentId/reports",
+			Summary: "Жалоба на комментарий",
+			Tags:    []string{tagNews},
+			Body:    viewNews.ReportNewsComment{},
+			Wrapped: true,
+			Secured: true,
+		},
+		&openapi.Route{
+			Method:   http.MethodGet,
+			Path:     "/main/v1/banners",
+			Summary:  "Получение баннеров главной страницы",
+			Tags:     []string{tagBanners},
+			Response: &viewBanners.BannersList{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+	)
+	routes = append(routes, adminRoutes()...)
+
+	return append(routes, &openapi.Route{
+		Method:  http.MethodGet,
+		Path:    OpenAPIPath,
+		Summary: "Спецификация OpenAPI",
+		Tags:    []string{tagService},
+	})
+}
+
+// adminRoutes описание роутов registerAdminRoutes
+//
+//nolint:funlen
+func adminRoutes() []*openapi.Route {
+	return []*openapi.Route{
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/category",
+			Summary:  "Создание категории новостей",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.NewCategory{},
+			Response: &viewNews.CategoryResult{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/admin/news/v1/category/:id",
+			Summary:  "Изменение категории новостей",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.UpdateCategory{},
+			Response: &viewNews.CategoryResult{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/admin/news/v1/category/:id",
+			Summary: "Удаление категории новостей",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/category/search",
+			Summary:  "Поиск категорий новостей",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.SearchCategoryRequest{},
+			Response: &viewNews.SearchCategoryResult{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/category/:id",
+			Summary:  "Получение категории новостей",
+			Tags:     []string{tagAdminNews},
+			Response: &viewNews.CategoryInfo{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/news",
+			Summary:  "Создание новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.NewNews{},
+			Response: &viewNews.CreateNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/news/:id",
+			Summary:  "Получение новости",
+			Tags:     []string{tagAdminNews},
+			Response: &viewNews.News{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/admin/news/v1/news/:id",
+			Summary:  "Изменение новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.UpdateNews{},
+			Response: &viewNews.UpdateNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/admin/news/v1/news/:id",
+			Summary: "Удаление новости",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/news/:id/status",
+			Summary:  "Изменение статуса новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.SetNewsStatusRequest{},
+			Response: &viewNews.UpdateNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/news/:id/history",
+			Summary:  "История переходов новости между статусами",
+			Tags:     []string{tagAdminNews},
+			Response: []*viewNews.NewsStatusTransition{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/admin/news/v1/news/:id/views",
+			Summary: "Статистика просмотров новости по дням",
+			Tags:    []string{tagAdminNews},
+			Params: []*openapi.Parameter{
+				openapi.Query("from", "string", false, "первый день периода в формате 2006-01-02, по умолчанию за 29 дней до to"),
+				openapi.Query("to", "string", false, "последний день периода в формате 2006-01-02, по умолчанию сегодня"),
+			},
+			Response: &viewNews.NewsViewsStats{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPatch,
+			Path:     "/admin/news/v1/news/:id/flags",
+			Summary:  "Изменение флагов новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.UpdateNewsFlags{},
+			Response: &viewNews.UpdateNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/news/:id/draft",
+			Summary:  "Черновик правок новости текущего редактора",
+			Tags:     []string{tagAdminNews},
+			Response: &viewNews.NewsDraft{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/admin/news/v1/news/:id/draft",
+			Summary:  "Автосохранение черновика правок новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.UpdateNews{},
+			Response: &viewNews.NewsDraft{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/admin/news/v1/news/:id/draft",
+			Summary: "Удаление черновика правок новости",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/news/:id/revisions",
+			Summary:  "Версии новости, новые первыми",
+			Tags:     []string{tagAdminNews},
+			Response: []*viewNews.NewsRevision{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/news/:id/revisions/:revisionId",
+			Summary:  "Предпросмотр версии новости",
+			Tags:     []string{tagAdminNews},
+			Response: &viewNews.NewsRevisionPreview{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/news/:id/revisions/:revisionId/restore",
+			Summary:  "Восстановление версии новости",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.RestoreRevisionRequest{},
+			Response: &viewNews.UpdateNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/admin/news/v1/news/:id/comments/:commentId/hide",
+			Summary: "Скрытие комментария",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/admin/news/v1/news/:id/comments/:commentId/restore",
+			Summary: "Восстановление скрытого комментария",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/news/v1/comments/reports",
+			Summary:  "Очередь жалоб на комментарии активного портала",
+			Tags:     []string{tagAdminNews},
+			Response: []*viewNews.CommentReport{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/admin/news/v1/comments/reports/:reportId",
+			Summary: "Отклонение жалобы на комментарий",
+			Tags:    []string{tagAdminNews},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/news/v1/news/search",
+			Summary:  "Поиск новостей",
+			Tags:     []string{tagAdminNews},
+			Body:     viewNews.SearchNewsRequest{},
+			Response: &viewNews.SearchNewsResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/surveys/v1/surveys",
+			Summary:  "Создание опроса",
+			Tags:     []string{tagAdminSurveys},
+			Body:     viewSurveys.NewSurvey{},
+			Response: &viewSurveys.IDResponse{},
+			Status:   http.StatusCreated,
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/surveys/v1/surveys/search",
+			Summary:  "Список опросов с пагинацией по last_id и last_date",
+			Tags:     []string{tagAdminSurveys},
+			Body:     viewSurveys.GetAllSurveys{},
+			Response: &viewSurveys.SurveysWithPagination{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/admin/surveys/v1/surveys/:id",
+			Summary:  "Получение опроса для редактирования",
+			Tags:     []string{tagAdminSurveys},
+			Response: &viewSurveys.Survey{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/admin/surveys/v1/surveys/:id",
+			Summary:  "Изменение опроса, вопросов и вариантов ответа",
+			Tags:     []string{tagAdminSurveys},
+			Body:     viewSurveys.Survey{},
+			Response: &viewSurveys.Survey{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/admin/surveys/v1/surveys/:id",
+			Summary: "Удаление опроса",
+			Tags:    []string{tagAdminSurveys},
+			Wrapped: true,
+			Secured: true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/surveys/v1/surveys/:id/publish",
+			Summary:  "Публикация опроса",
+			Tags:     []string{tagAdminSurveys},
+			Response: &viewSurveys.Survey{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/surveys/v1/surveys/:id/unpublish",
+			Summary:  "Снятие опроса с публикации",
+			Tags:     []string{tagAdminSurveys},
+			Response: &viewSurveys.Survey{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/admin/surveys/v1/surveys/:id/results",
+			Summary: "Статистика ответов на опрос по вопросам",
+			Tags:    []string{tagAdminSurveys},
+			Params: []*openapi.Parameter{
+				openapi.Query("portal_id", "integer", false, "портал респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+				openapi.Query("organization_id", "string", false, "организация респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+			},
+			Response: &viewSurveys.SurveyResults{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/admin/surveys/v1/surveys/:id/results/questions/:question_id/texts",
+			Summary: "Ответы с произвольным текстом на вопрос опроса постранично",
+			Tags:    []string{tagAdminSurveys},
+			Params: []*openapi.Parameter{
+				openapi.Query("limit", "integer", true, "размер страницы"),
+				openapi.Query("last_id", "string", false, "last_id из предыдущей страницы"),
+				openapi.Query("portal_id", "integer", false, "портал респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+				openapi.Query("organization_id", "string", false, "организация респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+			},
+			Response: &viewSurveys.SurveyTextAnswers{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/admin/surveys/v1/surveys/:id/results/export",
+			Summary: "Выгрузка ответов на опрос в CSV или XLSX",
+			Tags:    []string{tagAdminSurveys},
+			Params: []*openapi.Parameter{
+				openapi.Query("format", "string", false, "формат выгрузки: csv (по умолчанию) или xlsx"),
+				openapi.Query("portal_id", "integer", false, "портал респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+				openapi.Query("organization_id", "string", false, "организация респондентов, можно указать несколько раз; не допускается для анонимного опроса"),
+			},
+			ContentType: openapi.ContentTypeBinary,
+			Secured:     true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/admin/surveys/v1/images",
+			Summary:  "Загрузка изображения варианта ответа",
+			Tags:     []string{tagAdminSurveys},
+			Body:     viewSurveys.NewSurveyImageObject{},
+			Response: &viewSurveys.SurveyImageObject{},
+			Status:   http.StatusCreated,
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/admin/banners/v1/banners",
+			Summary:  "Изменение баннеров главной страницы",
+			Tags:     []string{tagAdminBanners},
+			Body:     viewBanners.SetBanners{},
+			Response: &viewBanners.SetBannersResponse{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+	}
+}
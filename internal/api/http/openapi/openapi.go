@@ -0,0 +1,257 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Version версия спецификации OpenAPI
+	Version = "3.0.3"
+
+	// ContentTypeJSON тип содержимого запросов и ответов по умолчанию
+	ContentTypeJSON = "application/json"
+	// ContentTypeBinary тип содержимого файлов
+	ContentTypeBinary = "application/octet-stream"
+
+	bearerAuth = "bearerAuth"
+)
+
+// Document спецификация OpenAPI
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+// PathItem операции пути, ключ - метод в нижнем регистре
+type PathItem map[string]*Operation
+
+type Operation struct {
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+// Route описание роута http-сервера
+type Route struct {
+	// Method http-метод
+	Method string
+	// Path путь в формате gin, например /news/v1/news/:id
+	Path    string
+	Summary string
+	Tags    []string
+	// Params параметры запроса. Параметры пути, не описанные явно, добавляются автоматически
+	Params []*Parameter
+	// Body значение типа тела запроса, nil если тела нет
+	Body any
+	// Response значение типа данных ответа, nil если данных нет
+	Response any
+	// Status код успешного ответа, по умолчанию 200
+	Status int
+	// Wrapped данные ответа передаются в конверте
+	Wrapped bool
+	// ContentType тип содержимого успешного ответа, по умолчанию application/json
+	ContentType string
+	// Secured роут требует токен доступа
+	Secured bool
+}
+
+// Query параметр строки запроса
+func Query(name, typ string, required bool, description string) *Parameter {
+	return &Parameter{Name: name, In: "query", Required: required, Description: description, Schema: &Schema{Type: typ}}
+}
+
+// Header параметр заголовка запроса
+func Header(name string, required bool, description string) *Parameter {
+	return &Parameter{Name: name, In: "header", Required: required, Description: description, Schema: &Schema{Type: typeString}}
+}
+
+// Path параметр пути
+func Path(name, typ, description string) *Parameter {
+	return &Parameter{Name: name, In: "path", Required: true, Description: description, Schema: &Schema{Type: typ}}
+}
+
+type builder struct {
+	schemas  *schemas
+	envelope any
+}
+
+// NewBuilder построитель спецификации.
+//
+//	envelope - значение типа конверта ответа, поле data которого заменяется данными роута.
+//	Конверт также описывает ответ с ошибкой
+func NewBuilder(envelope any) *builder {
+	return &builder{
+		schemas:  newSchemas(),
+		envelope: envelope,
+	}
+}
+
+// Build спецификация по описаниям роутов
+func (b *builder) Build(info Info, routes []*Route) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   make(map[string]PathItem, len(routes)),
+	}
+
+	for _, route := range routes {
+		path := Key(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = b.operation(route)
+	}
+
+	doc.Components = Components{
+		Schemas: b.schemas.components,
+		SecuritySchemes: map[string]*SecurityScheme{
+			bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+		},
+	}
+	return doc
+}
+
+func (b *builder) operation(route *Route) *Operation {
+	op := &Operation{
+		Summary:    route.Summary,
+		Tags:       route.Tags,
+		Parameters: pathParams(route),
+		Responses:  map[string]*Response{},
+	}
+	op.Parameters = append(op.Parameters, route.Params...)
+	for _, param := range op.Parameters {
+		if param.Schema == nil {
+			param.Schema = &Schema{Type: typeString}
+		}
+	}
+
+	if route.Body != nil {
+		op.RequestBody = &RequestBody{
+			Required: true,
+			Content:  map[string]*MediaType{ContentTypeJSON: {Schema: b.schemas.of(route.Body)}},
+		}
+	}
+	if route.Secured {
+		op.Security = []map[string][]string{{bearerAuth: {}}}
+	}
+
+	status := route.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	op.Responses[strconv.Itoa(status)] = b.response(route, status)
+	if b.envelope != nil {
+		op.Responses["default"] = &Response{
+			Description: "Ошибка",
+			Content:     map[string]*MediaType{ContentTypeJSON: {Schema: b.schemas.of(b.envelope)}},
+		}
+	}
+	return op
+}
+
+func (b *builder) response(route *Route, status int) *Response {
+	resp := &Response{Description: http.StatusText(status)}
+
+	var schema *Schema
+	switch {
+	case route.ContentType == ContentTypeBinary:
+		schema = &Schema{Type: typeString, Format: "binary"}
+	case route.Wrapped && b.envelope != nil:
+		schema = &Schema{AllOf: []*Schema{b.schemas.of(b.envelope)}}
+		if route.Response != nil {
+			schema.AllOf = append(schema.AllOf, &Schema{
+				Type:       typeObject,
+				Properties: map[string]*Schema{"data": b.schemas.of(route.Response)},
+			})
+		}
+	case route.Response != nil:
+		schema = b.schemas.of(route.Response)
+	default:
+		return resp
+	}
+
+	contentType := route.ContentType
+	if contentType == "" {
+		contentType = ContentTypeJSON
+	}
+	resp.Content = map[string]*MediaType{contentType: {Schema: schema}}
+	return resp
+}
+
+// pathParams параметры пути роута, не описанные в Params
+func pathParams(route *Route) []*Parameter {
+	var params []*Parameter
+	for _, segment := range strings.Split(route.Path, "/") {
+		if len(segment) < 2 || (segment[0] != ':' && segment[0] != '*') {
+			continue
+		}
+		name := segment[1:]
+		described := false
+		for _, param := range route.Params {
+			if param.In == "path" && param.Name == name {
+				described = true
+				break
+			}
+		}
+		if !described {
+			params = append(params, Path(name, typeString, ""))
+		}
+	}
+	return params
+}
+
+// Key путь OpenAPI для пути gin: параметры :id и *id заменяются на {id}
+func Key(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if len(segment) > 1 && (segment[0] == ':' || segment[0] == '*') {
+			segments[i] = "{" + segment[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
@@ -0,0 +1,142 @@
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type testEnvelope struct {
+	Error *testError `json:"error"`
+	Data  any        `json:"data"`
+}
+
+type testError struct {
+	Message string `json:"message"`
+}
+
+type testBase struct {
+	ID uuid.UUID `json:"id"`
+}
+
+type testItem struct {
+	testBase
+	Title    string            `json:"title" binding:"required"`
+	Count    *int              `json:"count,omitempty"`
+	Created  time.Time         `json:"created"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Children []*testItem       `json:"children"`
+	Content  json.RawMessage   `json:"content"`
+	Internal string            `json:"-"`
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "static", path: "/users/v1/me", want: "/users/v1/me"},
+		{name: "param", path: "/news/v1/news/:id/comments", want: "/news/v1/news/{id}/comments"},
+		{name: "wildcard", path: "/files/*path", want: "/files/{path}"},
+		{name: "trailing slash", path: "/survey/answers/", want: "/survey/answers/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Key(tt.path))
+		})
+	}
+}
+
+func Test_schemas_of(t *testing.T) {
+	s := newSchemas()
+
+	assert.Equal(t, &Schema{Type: typeArray, Items: &Schema{Ref: componentsPrefix + "openapi.testItem"}}, s.of([]*testItem{}))
+	assert.Equal(t, &Schema{
+		Type: typeObject,
+		Properties: map[string]*Schema{
+			"id":       {Type: typeString, Format: "uuid"},
+			"title":    {Type: typeString},
+			"count":    {Type: typeInteger},
+			"created":  {Type: typeString, Format: "date-time"},
+			"tags":     {Type: typeArray, Items: &Schema{Type: typeString}},
+			"labels":   {Type: typeObject, AdditionalProperties: &Schema{Type: typeString}},
+			"children": {Type: typeArray, Items: &Schema{Ref: componentsPrefix + "openapi.testItem"}},
+			"content":  {},
+		},
+		Required: []string{"title"},
+	}, s.components["openapi.testItem"])
+}
+
+func Test_builder_Build(t *testing.T) {
+	routes := []*Route{
+		{
+			Method:   http.MethodGet,
+			Path:     "/items/:id",
+			Params:   []*Parameter{Query("limit", typeInteger, false, "")},
+			Response: &testItem{},
+			Wrapped:  true,
+			Secured:  true,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/items/",
+			Body:     testItem{},
+			Response: []*testItem{},
+			Status:   http.StatusCreated,
+		},
+		{
+			Method:      http.MethodGet,
+			Path:        "/items/:id/image",
+			ContentType: ContentTypeBinary,
+		},
+		{
+			Method: http.MethodDelete,
+			Path:   "/items/:id",
+		},
+	}
+
+	doc := NewBuilder(testEnvelope{}).Build(Info{Title: "test", Version: "1"}, routes)
+
+	assert.Equal(t, Version, doc.OpenAPI)
+	assert.Len(t, doc.Paths, 3)
+	assert.ElementsMatch(t, []string{"get", "delete"}, keys(doc.Paths["/items/{id}"]))
+
+	get := doc.Paths["/items/{id}"]["get"]
+	assert.Equal(t, []*Parameter{
+		Path("id", typeString, ""),
+		Query("limit", typeInteger, false, ""),
+	}, get.Parameters)
+	assert.Equal(t, []map[string][]string{{bearerAuth: {}}}, get.Security)
+	assert.Equal(t, &Schema{AllOf: []*Schema{
+		{Ref: componentsPrefix + "openapi.testEnvelope"},
+		{Type: typeObject, Properties: map[string]*Schema{"data": {Ref: componentsPrefix + "openapi.testItem"}}},
+	}}, get.Responses["200"].Content[ContentTypeJSON].Schema)
+	assert.Equal(t, &Schema{Ref: componentsPrefix + "openapi.testEnvelope"}, get.Responses["default"].Content[ContentTypeJSON].Schema)
+
+	post := doc.Paths["/items/"]["post"]
+	assert.Equal(t, &Schema{Ref: componentsPrefix + "openapi.testItem"}, post.RequestBody.Content[ContentTypeJSON].Schema)
+	assert.Equal(t, &Schema{Type: typeArray, Items: &Schema{Ref: componentsPrefix + "openapi.testItem"}},
+		post.Responses["201"].Content[ContentTypeJSON].Schema)
+	assert.Nil(t, post.Security)
+
+	image := doc.Paths["/items/{id}/image"]["get"]
+	assert.Equal(t, &Schema{Type: typeString, Format: "binary"}, image.Responses["200"].Content[ContentTypeBinary].Schema)
+
+	assert.Nil(t, doc.Paths["/items/{id}"]["delete"].Responses["200"].Content)
+
+	assert.ElementsMatch(t, []string{"openapi.testEnvelope", "openapi.testError", "openapi.testItem"}, keys(doc.Components.Schemas))
+}
+
+func keys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	return result
+}
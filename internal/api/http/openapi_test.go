@@ -0,0 +1,85 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/openapi"
+)
+
+func newTestRouter(environment Environment) *router {
+	gin.SetMode(gin.TestMode)
+	r := &router{
+		engine:      gin.New(),
+		environment: environment,
+	}
+	r.initHandlers().
+		registerRoutes()
+	return r
+}
+
+// Test_apiRoutes каждый зарегистрированный роут описан в спецификации и спецификация не содержит лишних роутов
+func Test_apiRoutes(t *testing.T) {
+	registered := map[string]struct{}{}
+	for _, route := range newTestRouter(EnvironmentTest).engine.Routes() {
+		registered[route.Method+" "+route.Path] = struct{}{}
+	}
+
+	described := map[string]struct{}{}
+	for _, route := range apiRoutes() {
+		key := route.Method + " " + route.Path
+		_, duplicate := described[key]
+		assert.False(t, duplicate, "route %s is described twice", key)
+		described[key] = struct{}{}
+	}
+
+	for key := range registered {
+		assert.Contains(t, described, key, "route %s is not described in the OpenAPI specification", key)
+	}
+	for key := range described {
+		assert.Contains(t, registered, key, "route %s from the OpenAPI specification is not registered", key)
+	}
+}
+
+func Test_openAPIHandler(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment Environment
+		wantStatus  int
+	}{
+		{
+			name:        "develop",
+			environment: EnvironmentDevelop,
+			wantStatus:  http.StatusOK,
+		},
+		{
+			name:        "prod",
+			environment: EnvironmentProd,
+			wantStatus:  http.StatusMethodNotAllowed,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newTestRouter(tt.environment)
+
+			w := httptest.NewRecorder()
+			r.engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, OpenAPIPath, nil))
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+
+			var doc openapi.Document
+			assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &doc))
+			assert.Equal(t, openapi.Version, doc.OpenAPI)
+			assert.Contains(t, doc.Paths, "/news/v1/news/{id}/comments")
+			assert.Contains(t, doc.Paths["/admin/news/v1/news/{id}"], "put")
+			assert.Contains(t, doc.Components.Schemas, "auth.AuthResponse")
+		})
+	}
+}
@@ -22,6 +22,7 @@ func (cp *commentsPresenter) NewCommentToDTO(newsID uuid.UUID, v *viewNews.NewNe
 	}
 	if v != nil {
 		comment.Text = strings.TrimSpace(v.Text)
+		comment.ParentID = v.ParentID
 	}
 	return comment
 }
@@ -50,40 +51,97 @@ func (cp *commentsPresenter) CommentToView(comment *entityNews.NewsComment) *vie
 		return nil
 	}
 
+	view := &viewNews.NewsComment{
+		ID:           comment.ID,
+		CreateAt:     comment.GetCreatedAtPtr(),
+		Message:      comment.Message,
+		IsUserMade:   false,
+		Author:       *cp.authorToView(&comment.Author),
+		ParentID:     comment.GetParentIDPtr(),
+		RepliesCount: comment.Replies,
+		HasReplies:   comment.Replies > 0,
+		Mentions:     make([]*viewNews.CommentAuthor, 0, len(comment.Mentions)),
+	}
+	for _, mention := range comment.Mentions {
+		if mention != nil {
+			view.Mentions = append(view.Mentions, cp.authorToView(mention))
+		}
+	}
+
+	if !comment.GetDeletedAt().IsZero() {
+		view.IsDeleted = true
+		// TODO: Уточнить у СА требование к тексту комментария
+		view.Message = ""
+	}
+	if !comment.GetHiddenAt().IsZero() {
+		view.IsHidden = true
+		view.Message = ""
+	}
+
+	return view
+}
+
+func (cp *commentsPresenter) authorToView(author *entityNews.Author) *viewNews.CommentAuthor {
 	fio := make([]string, 0, 3)
 	fio = append(fio,
-		comment.Author.LastName,
-		comment.Author.FirstName,
+		author.LastName,
+		author.FirstName,
 	)
-	if comment.Author.GetMiddleNamePtr() != nil {
-		fio = append(fio, comment.Author.GetMiddleName())
+	if author.GetMiddleNamePtr() != nil {
+		fio = append(fio, author.GetMiddleName())
 	}
 	name := strings.Join(fio, " ")
 
 	var imgID string
-	if comment.Author.GetImageIDPtr() != nil && comment.Author.GetImageID() != uuid.Nil {
-		imgID = comment.Author.GetImageID().String()
+	if author.GetImageIDPtr() != nil && author.GetImageID() != uuid.Nil {
+		imgID = author.GetImageID().String()
 	}
 
-	view := &viewNews.NewsComment{
-		ID:         comment.ID,
-		CreateAt:   comment.GetCreatedAtPtr(),
-		Message:    comment.Message,
-		IsUserMade: false,
-		Author: viewNews.CommentAuthor{
-			ID:      comment.Author.GetID(),
-			Name:    name,
-			ImageID: imgID,
-			// TODO: Уточнить у СА требование к этому флагу
-			IsActive: true,
-		},
+	return &viewNews.CommentAuthor{
+		ID:      author.GetID(),
+		Name:    name,
+		ImageID: imgID,
+		// TODO: Уточнить у СА требование к этому флагу
+		IsActive: true,
+	}
+}
+
+func (cp *commentsPresenter) UpdateCommentToDTO(newsID, commentID uuid.UUID, v *viewNews.UpdateNewsComment) dtoNews.UpdateComment {
+	comment := dtoNews.UpdateComment{
+		NewsID:    newsID,
+		CommentID: commentID,
+	}
+	if v != nil {
+		comment.Text = strings.TrimSpace(v.Text)
 	}
+	return comment
+}
 
-	if !comment.GetDeletedAt().IsZero() {
-		view.IsDeleted = true
-		// TODO: Уточнить у СА требование к тексту комментария
-		view.Message = ""
+func (cp *commentsPresenter) ReportToDTO(newsID, commentID uuid.UUID, v *viewNews.ReportNewsComment) dtoNews.NewCommentReport {
+	report := dtoNews.NewCommentReport{
+		NewsID:    newsID,
+		CommentID: commentID,
 	}
+	if v != nil {
+		report.Reason = strings.TrimSpace(v.Reason)
+	}
+	return report
+}
 
-	return view
+func (cp *commentsPresenter) ReportsToView(list []*entityNews.CommentReport) []*viewNews.CommentReport {
+	reports := make([]*viewNews.CommentReport, 0, len(list))
+	for _, report := range list {
+		if report == nil {
+			continue
+		}
+		reports = append(reports, &viewNews.CommentReport{
+			ID:         report.ID,
+			CommentID:  report.CommentID,
+			NewsID:     report.NewsID,
+			ReporterID: report.ReporterID,
+			Reason:     report.Reason,
+			CreatedAt:  report.CreatedAt,
+		})
+	}
+	return reports
 }
@@ -1,9 +1,12 @@
 package news
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"strings"
 	"time"
 
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"github.com/google/uuid"
 
 	viewNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/news"
@@ -12,6 +15,9 @@ import (
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
 )
 
+// newsSnippetLength длина фрагмента текста новости в выдаче поиска
+const newsSnippetLength = 200
+
 type newsAdminPresenter struct {
 	categoryPresenter
 	commentsPresenter
@@ -138,10 +144,11 @@ func (p *newsAdminPresenter) UpdateNewsToDTO(updateNews *viewNews.UpdateNews) *d
 		CanReacts:       &updateNews.Properties.LikesEnabled,
 		CanCommented:    &updateNews.Properties.CommentsEnabled,
 		PublicationAt:   updateNews.PublishDate,
+		UpdatedAt:       updateNews.UpdatedAt,
 	}
 }
 
-func (p *newsAdminPresenter) SearchNewsToDTO(search *viewNews.SearchNewsRequest) *dtoNews.SearchNews {
+func (p *newsAdminPresenter) SearchNewsToDTO(search *viewNews.SearchNewsRequest) (*dtoNews.SearchNews, error) {
 
 	orderBy := dtoNews.SearchNewsOrderByTitle
 	switch search.OrderBy {
@@ -156,6 +163,20 @@ func (p *newsAdminPresenter) SearchNewsToDTO(search *viewNews.SearchNewsRequest)
 		orderDirection = dto.OrderDirectionAsc
 	}
 
+	limit := search.Limit
+	if limit == 0 {
+		limit = dtoNews.SearchNewsDefaultLimit
+	}
+
+	var scroll *dtoNews.SearchNewsScroll
+	if search.Cursor != "" {
+		var err error
+		if scroll, err = p.CursorToScroll(search.Cursor); err != nil {
+			return nil, err
+		}
+		scroll.Limit = limit
+	}
+
 	return &dtoNews.SearchNews{
 		Query: search.Query,
 		Filter: &dtoNews.SearchNewsFilter{
@@ -169,13 +190,51 @@ func (p *newsAdminPresenter) SearchNewsToDTO(search *viewNews.SearchNewsRequest)
 		},
 		Pagination: dtoNews.SearchNewsPagination{
 			Page:  search.Page,
-			Limit: search.Limit,
+			Limit: limit,
 		},
+		Scroll: scroll,
 		Order: dtoNews.SearchNewsOrder{
 			By:        orderBy,
 			Direction: orderDirection,
 		},
+	}, nil
+}
+
+// searchCursor содержимое курсора поиска новостей
+type searchCursor struct {
+	LastID    uuid.UUID  `json:"id"`
+	CreatedAt *time.Time `json:"createdAt,omitempty"`
+}
+
+// ScrollToCursor непрозрачный для клиента курсор следующей страницы. Пустая строка, если страниц больше нет
+func (p *newsAdminPresenter) ScrollToCursor(scroll *dtoNews.SearchNewsScroll) string {
+	if scroll == nil || scroll.GetLastID() == uuid.Nil {
+		return ""
 	}
+	data, err := json.Marshal(searchCursor{
+		LastID:    scroll.GetLastID(),
+		CreatedAt: scroll.GetCreatedAtPtr(),
+	})
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// CursorToScroll позиция поиска из курсора, полученного от ScrollToCursor
+func (p *newsAdminPresenter) CursorToScroll(cursor string) (*dtoNews.SearchNewsScroll, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, diterrors.NewValidationError(dtoNews.ErrSearchCursor)
+	}
+	var c searchCursor
+	if err = json.Unmarshal(data, &c); err != nil || c.LastID == uuid.Nil {
+		return nil, diterrors.NewValidationError(dtoNews.ErrSearchCursor)
+	}
+	return &dtoNews.SearchNewsScroll{
+		LastID:    &c.LastID,
+		CreatedAt: c.CreatedAt,
+	}, nil
 }
 
 func (p *newsAdminPresenter) StatusToEntity(status viewNews.NewsStatus) entityNews.NewsStatus {
@@ -219,15 +278,64 @@ func (p *newsAdminPresenter) FullNewsToView(n *entityNews.NewsFull) *viewNews.Ne
 		ProviderProduct:      p.NewsProductToView(n.GetProductPtr()),
 		CreateDate:           n.GetCreatedAtPtr(),
 		UpdatedAt:            n.GetUpdatedAtPtr(),
+		Reactions:            p.ReactionsToView(n.GetReactionsPtr()),
 	}
 
 	if !n.GetPublicationAt().IsZero() {
 		news.PublishDate = n.GetPublicationAtPtr()
 	}
 
+	if n.CanDisplayViews {
+		views := n.Views
+		news.Views = &views
+	}
+
+	if body, err := entityNews.ParseBody(n.Body); err == nil {
+		news.ReadingTime = body.ReadingTime()
+	}
+
 	return news
 }
 
+func (p *newsAdminPresenter) ViewsStatsToView(stats *entityNews.ViewsStats) *viewNews.NewsViewsStats {
+	if stats == nil {
+		return nil
+	}
+
+	result := &viewNews.NewsViewsStats{
+		Total: stats.Total,
+		Days:  make([]*viewNews.NewsDayViews, 0, len(stats.Days)),
+	}
+	for _, day := range stats.Days {
+		result.Days = append(result.Days, &viewNews.NewsDayViews{
+			Date:  day.Date.Format(viewNews.ViewsDateLayout),
+			Views: day.Views,
+		})
+	}
+	return result
+}
+
+func (p *newsAdminPresenter) ReactionsToView(reactions *entityNews.Reactions) *viewNews.NewsReactions {
+	if reactions == nil {
+		return nil
+	}
+
+	result := &viewNews.NewsReactions{
+		Counts: make(map[string]int, len(reactions.Counts)),
+		Mine:   make([]string, 0, len(reactions.Mine)),
+	}
+	for reaction, count := range reactions.Counts {
+		result.Counts[string(reaction)] = count
+	}
+	for _, reaction := range reactions.Mine {
+		result.Mine = append(result.Mine, string(reaction))
+		if reaction == entityNews.ReactionLike {
+			result.IsUserMade = true
+		}
+	}
+	return result
+}
+
 func (p *newsAdminPresenter) NewsCategoryToView(category *entityNews.Category) *viewNews.NewsCategory {
 	if category == nil {
 		return nil
@@ -315,6 +423,106 @@ func (p *newsAdminPresenter) StatusToView(status entityNews.NewsStatus) viewNews
 	}
 }
 
+func (p *newsAdminPresenter) HistoryToView(history []*entityNews.StatusTransition) []*viewNews.NewsStatusTransition {
+	result := make([]*viewNews.NewsStatusTransition, 0, len(history))
+	for _, transition := range history {
+		if transition == nil {
+			continue
+		}
+		result = append(result, &viewNews.NewsStatusTransition{
+			From:      p.StatusToView(transition.From),
+			To:        p.StatusToView(transition.To),
+			Author:    p.AuthorToView(transition.Actor),
+			Comment:   transition.Comment,
+			CreatedAt: transition.CreatedAt,
+		})
+	}
+	return result
+}
+
+func (p *newsAdminPresenter) RevisionsToView(revisions []*entityNews.Revision) []*viewNews.NewsRevision {
+	result := make([]*viewNews.NewsRevision, 0, len(revisions))
+	for _, revision := range revisions {
+		if revision == nil {
+			continue
+		}
+		result = append(result, p.revisionToView(revision))
+	}
+	return result
+}
+
+func (p *newsAdminPresenter) RevisionToView(revision *entityNews.Revision) *viewNews.NewsRevisionPreview {
+	if revision == nil {
+		return nil
+	}
+
+	return &viewNews.NewsRevisionPreview{
+		NewsRevision: *p.revisionToView(revision),
+		News:         p.SnapshotToView(revision.Snapshot),
+	}
+}
+
+func (p *newsAdminPresenter) revisionToView(revision *entityNews.Revision) *viewNews.NewsRevision {
+	changedFields := revision.ChangedFields
+	if changedFields == nil {
+		changedFields = []string{}
+	}
+
+	return &viewNews.NewsRevision{
+		ID:            revision.ID,
+		Author:        p.AuthorToView(revision.Author),
+		CreatedAt:     revision.CreatedAt,
+		ChangedFields: changedFields,
+		RestoredFrom:  revision.RestoredFrom,
+	}
+}
+
+func (p *newsAdminPresenter) DraftToView(draft *entityNews.Draft) *viewNews.NewsDraft {
+	if draft == nil {
+		return nil
+	}
+
+	return &viewNews.NewsDraft{
+		News:          p.SnapshotToView(draft.Snapshot),
+		BaseUpdatedAt: draft.BaseUpdatedAt,
+		SavedAt:       draft.SavedAt,
+	}
+}
+
+func (p *newsAdminPresenter) SnapshotToView(snapshot *entityNews.NewsSnapshot) *viewNews.NewsSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+
+	news := &viewNews.NewsSnapshot{
+		Slug:                   snapshot.Slug,
+		Title:                  snapshot.Title,
+		ImageID:                snapshot.ImageID,
+		ProviderOrganizationID: snapshot.OrganizationID,
+		ProviderProductID:      snapshot.ProductID,
+		Properties: viewNews.NewsProperties{
+			ViewsEnabled:    snapshot.CanDisplayViews,
+			LikesEnabled:    snapshot.CanReacts,
+			CommentsEnabled: snapshot.CanCommented,
+			OnMainPage:      snapshot.OnMain,
+			MainPagePinned:  snapshot.Pinned,
+		},
+		Content:         snapshot.Body,
+		ParticipantsIDs: snapshot.Participants,
+		PublishDate:     snapshot.PublicationAt,
+	}
+	if snapshot.CategoryID != uuid.Nil {
+		news.CategoryID = &snapshot.CategoryID
+	}
+	if news.ParticipantsIDs == nil {
+		news.ParticipantsIDs = []uuid.UUID{}
+	}
+	if body, err := entityNews.ParseBody(snapshot.Body); err == nil {
+		news.ReadingTime = body.ReadingTime()
+	}
+	return news
+}
+
 func (p *newsAdminPresenter) ParticipantsToView(participants []*entityNews.Participant) []*viewNews.NewsParticipants {
 	if len(participants) == 0 {
 		return []*viewNews.NewsParticipants{}
@@ -380,5 +588,10 @@ func (p *newsAdminPresenter) FullNewsToSearchItem(n *entityNews.NewsFull) *viewN
 		news.PublishedAt = n.GetPublicationAtPtr()
 	}
 
+	if body, err := entityNews.ParseBody(n.Body); err == nil {
+		news.Snippet = body.Snippet(newsSnippetLength)
+		news.ReadingTime = body.ReadingTime()
+	}
+
 	return news
 }
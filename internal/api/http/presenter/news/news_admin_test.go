@@ -0,0 +1,204 @@
+package news
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	viewNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func TestNewsAdminPresenter_SearchNewsToDTO(t *testing.T) {
+	p := NewNewsAdminPresenter()
+	lastID := uuid.New()
+	createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	cursor := p.ScrollToCursor(&dtoNews.SearchNewsScroll{LastID: &lastID, CreatedAt: &createdAt})
+
+	tests := []struct {
+		name    string
+		search  *viewNews.SearchNewsRequest
+		want    *dtoNews.SearchNews
+		wantErr error
+	}{
+		{
+			name: "default limit",
+			search: &viewNews.SearchNewsRequest{
+				OrderBy:   "createDate",
+				OrderType: "ASC",
+				Page:      2,
+				Query:     "query",
+				Filters: viewNews.SearchNewsRequestFilters{
+					Status:          viewNews.NewsStatusPublished,
+					CategoriesNames: []string{"category"},
+					OnMainPage:      true,
+				},
+			},
+			want: &dtoNews.SearchNews{
+				Query: "query",
+				Filter: &dtoNews.SearchNewsFilter{
+					Status:          entityNews.NewsStatusPublished,
+					CategoriesNames: []string{"category"},
+					OnMainPage:      true,
+				},
+				Pagination: dtoNews.SearchNewsPagination{Page: 2, Limit: dtoNews.SearchNewsDefaultLimit},
+				Order:      dtoNews.SearchNewsOrder{By: dtoNews.SearchNewsOrderByCreatedAt, Direction: dto.OrderDirectionAsc},
+			},
+		},
+		{
+			name: "cursor",
+			search: &viewNews.SearchNewsRequest{
+				Limit:  10,
+				Cursor: cursor,
+			},
+			want: &dtoNews.SearchNews{
+				Filter:     &dtoNews.SearchNewsFilter{},
+				Pagination: dtoNews.SearchNewsPagination{Limit: 10},
+				Scroll:     &dtoNews.SearchNewsScroll{LastID: &lastID, CreatedAt: &createdAt, Limit: 10},
+				Order:      dtoNews.SearchNewsOrder{By: dtoNews.SearchNewsOrderByTitle, Direction: dto.OrderDirectionDesc},
+			},
+		},
+		{
+			name:    "broken cursor",
+			search:  &viewNews.SearchNewsRequest{Cursor: "not a cursor"},
+			wantErr: dtoNews.ErrSearchCursor,
+		},
+		{
+			name:    "cursor without id",
+			search:  &viewNews.SearchNewsRequest{Cursor: "e30"},
+			wantErr: dtoNews.ErrSearchCursor,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.SearchNewsToDTO(tt.search)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewsAdminPresenter_ScrollToCursor(t *testing.T) {
+	p := NewNewsAdminPresenter()
+	lastID := uuid.New()
+
+	assert.Empty(t, p.ScrollToCursor(nil))
+	assert.Empty(t, p.ScrollToCursor(&dtoNews.SearchNewsScroll{}))
+
+	got, err := p.CursorToScroll(p.ScrollToCursor(&dtoNews.SearchNewsScroll{LastID: &lastID, Limit: 10}))
+	assert.NoError(t, err)
+	assert.Equal(t, &dtoNews.SearchNewsScroll{LastID: &lastID}, got)
+}
+
+func TestNewsAdminPresenter_ReactionsToView(t *testing.T) {
+	p := NewNewsAdminPresenter()
+
+	tests := []struct {
+		name      string
+		reactions *entityNews.Reactions
+		want      *viewNews.NewsReactions
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "liked",
+			reactions: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 3},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+			want: &viewNews.NewsReactions{
+				Counts:     map[string]int{"like": 3},
+				Mine:       []string{"like"},
+				IsUserMade: true,
+			},
+		},
+		{
+			name: "not liked",
+			reactions: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 1},
+			},
+			want: &viewNews.NewsReactions{
+				Counts: map[string]int{"like": 1},
+				Mine:   []string{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, p.ReactionsToView(tt.reactions))
+		})
+	}
+}
+
+func TestNewsAdminPresenter_FullNewsToView_views(t *testing.T) {
+	p := NewNewsAdminPresenter()
+
+	assert.Nil(t, p.FullNewsToView(&entityNews.NewsFull{Views: 10}).Views)
+	assert.Equal(t, 10, p.FullNewsToView(&entityNews.NewsFull{Views: 10, CanDisplayViews: true}).GetViews())
+}
+
+func TestNewsAdminPresenter_ViewsStatsToView(t *testing.T) {
+	p := NewNewsAdminPresenter()
+	day := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Nil(t, p.ViewsStatsToView(nil))
+	assert.Equal(t, &viewNews.NewsViewsStats{
+		Total: 5,
+		Days: []*viewNews.NewsDayViews{
+			{Date: "2025-01-01", Views: 2},
+			{Date: "2025-01-02", Views: 0},
+		},
+	}, p.ViewsStatsToView(&entityNews.ViewsStats{
+		Total: 5,
+		Days: []*entityNews.DailyViews{
+			{Date: day, Views: 2},
+			{Date: day.Add(24 * time.Hour)},
+		},
+	}))
+}
+
+func TestNewsAdminPresenter_CommentToView_moderation(t *testing.T) {
+	p := NewNewsAdminPresenter()
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		comment     *entityNews.NewsComment
+		wantMessage string
+		wantDeleted bool
+		wantHidden  bool
+	}{
+		{
+			name:        "visible",
+			comment:     &entityNews.NewsComment{Message: "text"},
+			wantMessage: "text",
+		},
+		{
+			name:        "deleted",
+			comment:     &entityNews.NewsComment{Message: "text", DeletedAt: &now},
+			wantDeleted: true,
+		},
+		{
+			name:       "hidden",
+			comment:    &entityNews.NewsComment{Message: "text", HiddenAt: &now},
+			wantHidden: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := p.CommentToView(tt.comment)
+			assert.Equal(t, tt.wantMessage, got.Message)
+			assert.Equal(t, tt.wantDeleted, got.IsDeleted)
+			assert.Equal(t, tt.wantHidden, got.IsHidden)
+		})
+	}
+}
@@ -21,9 +21,9 @@ func (sap surveyAnswersPresenter) ToNewEntities(answers *viewSurveys.NewSurveyAn
 			ChosenVariant: entitySurvey.AnswerID(answer.ChosenVariant),
 			Content:       answer.Content,
 		}
-		if answers.RespondentId != nil {
-			id := entitySurvey.RespondentID(*answers.RespondentId)
-			newAnswer.RespondentId = &id
+		if answer.QuestionId != nil {
+			id := entitySurvey.QuestionID(*answer.QuestionId)
+			newAnswer.QuestionID = &id
 		}
 
 		answersResult = append(answersResult, newAnswer)
@@ -68,3 +68,15 @@ func (sap surveyAnswersPresenter) ToShortViews(ids []uuid.UUID) []*viewSurveys.S
 
 	return answersResult
 }
+
+func (sap surveyAnswersPresenter) CompletionToView(completion *entitySurvey.Completion) *viewSurveys.SurveyCompletion {
+	if completion == nil {
+		return nil
+	}
+
+	return &viewSurveys.SurveyCompletion{
+		SurveyID:    uuid.UUID(completion.SurveyID),
+		Completed:   completion.Completed,
+		CompletedAt: completion.CompletedAt,
+	}
+}
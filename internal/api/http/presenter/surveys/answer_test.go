@@ -2,6 +2,7 @@ package surveys
 
 import (
 	"testing"
+	"time"
 
 	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
 	entitySurvey "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
@@ -19,7 +20,6 @@ func TestSurveyAnswersPresenter_ToNewEntities(t *testing.T) {
 	}
 
 	testUUID := uuid.New()
-	rID := entitySurvey.RespondentID(testUUID)
 
 	tests := []struct {
 		name string
@@ -30,7 +30,6 @@ func TestSurveyAnswersPresenter_ToNewEntities(t *testing.T) {
 			name: "correct",
 			args: args{
 				answers: &viewSurveys.NewSurveyAnswers{
-					RespondentId: &testUUID,
 					Answers: []*viewSurveys.NewSurveyAnswer{
 						{ChosenVariant: testUUID},
 					},
@@ -39,7 +38,25 @@ func TestSurveyAnswersPresenter_ToNewEntities(t *testing.T) {
 			want: func(a args, f fields) []*entitySurvey.RespondentAnswer {
 				return []*entitySurvey.RespondentAnswer{{
 					ChosenVariant: entitySurvey.AnswerID(testUUID),
-					RespondentId:  &rID,
+				}}
+			},
+		},
+		{
+			name: "with question",
+			args: args{
+				answers: &viewSurveys.NewSurveyAnswers{
+					SurveyId: testUUID,
+					Answers: []*viewSurveys.NewSurveyAnswer{
+						{QuestionId: &testUUID, ChosenVariant: testUUID, Content: "42"},
+					},
+				},
+			},
+			want: func(a args, f fields) []*entitySurvey.RespondentAnswer {
+				qID := entitySurvey.QuestionID(testUUID)
+				return []*entitySurvey.RespondentAnswer{{
+					QuestionID:    &qID,
+					ChosenVariant: entitySurvey.AnswerID(testUUID),
+					Content:       "42",
 				}}
 			},
 		},
@@ -210,3 +227,34 @@ func TestSurveyAnswersPresenter_ToShortViews(t *testing.T) {
 		})
 	}
 }
+
+func TestSurveyAnswersPresenter_CompletionToView(t *testing.T) {
+	testUUID := uuid.New()
+	completedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		completion *entitySurvey.Completion
+		want       *viewSurveys.SurveyCompletion
+	}{
+		{
+			name:       "completed",
+			completion: &entitySurvey.Completion{SurveyID: entitySurvey.SurveyID(testUUID), Completed: true, CompletedAt: &completedAt},
+			want:       &viewSurveys.SurveyCompletion{SurveyID: testUUID, Completed: true, CompletedAt: &completedAt},
+		},
+		{
+			name:       "not completed",
+			completion: &entitySurvey.Completion{SurveyID: entitySurvey.SurveyID(testUUID)},
+			want:       &viewSurveys.SurveyCompletion{SurveyID: testUUID},
+		},
+		{
+			name: "nil",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewAnswersPresenter().CompletionToView(tt.completion)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
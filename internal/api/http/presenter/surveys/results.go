@@ -0,0 +1,151 @@
+package surveys
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/google/uuid"
+
+	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
+	entitySurvey "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+)
+
+// responseRowsHeader заголовок выгрузки ответов на опрос
+var responseRowsHeader = []string{
+	"ID ответа",
+	"ID респондента",
+	"ID портала",
+	"ID организации",
+	"ID вопроса",
+	"Вопрос",
+	"ID варианта ответа",
+	"Вариант ответа",
+	"Текст ответа",
+}
+
+type surveyResultsPresenter struct {
+	answersPresenter *surveyAnswersPresenter
+}
+
+func NewResultsPresenter() *surveyResultsPresenter {
+	return &surveyResultsPresenter{
+		answersPresenter: NewAnswersPresenter(),
+	}
+}
+
+// FilterToEntity фильтр ответов на опрос по порталам и организациям респондентов
+func (srp surveyResultsPresenter) FilterToEntity(
+	surveyID entitySurvey.SurveyID,
+	filter *viewSurveys.SurveyResultsFilter,
+) (entitySurvey.AnswersFilter, error) {
+	result := entitySurvey.AnswersFilter{SurveyID: surveyID}
+	if filter == nil {
+		return result, nil
+	}
+
+	for _, param := range filter.PortalIDs {
+		portalID, err := strconv.Atoi(param)
+		if err != nil {
+			return entitySurvey.AnswersFilter{}, fmt.Errorf("can't parse portal_id: %w", err)
+		}
+		result.PortalIDs = append(result.PortalIDs, portalID)
+	}
+	for _, param := range filter.OrganizationIDs {
+		organizationID, err := uuid.Parse(param)
+		if err != nil {
+			return entitySurvey.AnswersFilter{}, fmt.Errorf("can't parse organization_id: %w", err)
+		}
+		result.OrganizationIDs = append(result.OrganizationIDs, organizationID)
+	}
+
+	return result, nil
+}
+
+func (srp surveyResultsPresenter) ToView(results *entitySurvey.Results) *viewSurveys.SurveyResults {
+	if results == nil {
+		return nil
+	}
+
+	questions := make([]*viewSurveys.SurveyQuestionResults, 0, len(results.Questions))
+	for _, question := range results.Questions {
+		questionView := &viewSurveys.SurveyQuestionResults{
+			QuestionID:       uuid.UUID(question.QuestionID),
+			Text:             question.Text,
+			Type:             string(question.Type),
+			AnswersCount:     question.AnswersCount,
+			TextAnswersCount: question.ContentCount,
+		}
+		for _, variant := range question.Variants {
+			questionView.Variants = append(questionView.Variants, &viewSurveys.SurveyVariantResults{
+				VariantID: uuid.UUID(variant.VariantID),
+				Text:      variant.Text,
+				Count:     variant.Count,
+				Percent:   variant.Percent,
+			})
+		}
+		if numbers := question.Numbers; numbers != nil {
+			questionView.Numbers = &viewSurveys.SurveyNumberStats{
+				Count:  numbers.Count,
+				Min:    numbers.Min,
+				Max:    numbers.Max,
+				Mean:   numbers.Mean,
+				Median: numbers.Median,
+			}
+		}
+
+		questions = append(questions, questionView)
+	}
+
+	return &viewSurveys.SurveyResults{
+		SurveyID:     uuid.UUID(results.SurveyID),
+		AnswersCount: results.AnswersCount,
+		Questions:    questions,
+	}
+}
+
+func (srp surveyResultsPresenter) TextAnswersToView(answers *entitySurvey.AnswersWithPagination) *viewSurveys.SurveyTextAnswers {
+	if answers == nil {
+		return nil
+	}
+
+	return &viewSurveys.SurveyTextAnswers{
+		Answers: srp.answersPresenter.ToViews(answers.Answers),
+		LastID:  answers.LastID,
+	}
+}
+
+func (srp surveyResultsPresenter) ResponseRowsHeader() []string {
+	return responseRowsHeader
+}
+
+func (srp surveyResultsPresenter) ResponseRowToView(row *entitySurvey.ResponseRow) []string {
+	result := make([]string, 0, len(responseRowsHeader))
+	if row.AnswerID != nil {
+		result = append(result, row.AnswerID.String())
+	} else {
+		result = append(result, "")
+	}
+	if row.RespondentID != nil {
+		result = append(result, row.RespondentID.String())
+	} else {
+		result = append(result, "")
+	}
+	if row.PortalID != 0 {
+		result = append(result, strconv.Itoa(row.PortalID))
+	} else {
+		result = append(result, "")
+	}
+	if row.OrganizationID != nil {
+		result = append(result, row.OrganizationID.String())
+	} else {
+		result = append(result, "")
+	}
+
+	return append(result,
+		row.QuestionID.String(),
+		row.QuestionText,
+		row.VariantID.String(),
+		row.VariantText,
+		row.Content,
+	)
+}
@@ -0,0 +1,185 @@
+package surveys
+
+import (
+	"testing"
+
+	viewSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/surveys"
+	entitySurvey "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSurveyResultsPresenter_FilterToEntity(t *testing.T) {
+	surveyID := entitySurvey.SurveyID(uuid.New())
+	organizationID := uuid.New()
+
+	tests := []struct {
+		name    string
+		filter  *viewSurveys.SurveyResultsFilter
+		want    entitySurvey.AnswersFilter
+		wantErr bool
+	}{
+		{
+			name: "nil",
+			want: entitySurvey.AnswersFilter{SurveyID: surveyID},
+		},
+		{
+			name: "portals and organizations",
+			filter: &viewSurveys.SurveyResultsFilter{
+				PortalIDs:       []string{"1", "7"},
+				OrganizationIDs: []string{organizationID.String()},
+			},
+			want: entitySurvey.AnswersFilter{
+				SurveyID:        surveyID,
+				PortalIDs:       []int{1, 7},
+				OrganizationIDs: []uuid.UUID{organizationID},
+			},
+		},
+		{
+			name:    "bad portal",
+			filter:  &viewSurveys.SurveyResultsFilter{PortalIDs: []string{"abc"}},
+			wantErr: true,
+		},
+		{
+			name:    "bad organization",
+			filter:  &viewSurveys.SurveyResultsFilter{OrganizationIDs: []string{"abc"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewResultsPresenter().FilterToEntity(surveyID, tt.filter)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSurveyResultsPresenter_ToView(t *testing.T) {
+	testUUID := uuid.New()
+
+	tests := []struct {
+		name    string
+		results *entitySurvey.Results
+		want    *viewSurveys.SurveyResults
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "correct",
+			results: &entitySurvey.Results{
+				SurveyID:     entitySurvey.SurveyID(testUUID),
+				AnswersCount: 3,
+				Questions: []*entitySurvey.QuestionResults{
+					{
+						QuestionID:   entitySurvey.QuestionID(testUUID),
+						Text:         "Нравится?",
+						Type:         entitySurvey.QuestionTypeRadio,
+						AnswersCount: 2,
+						ContentCount: 1,
+						Variants:     []*entitySurvey.VariantResults{{VariantID: entitySurvey.AnswerID(testUUID), Text: "Да", Count: 2, Percent: 100}},
+					},
+					{
+						QuestionID:   entitySurvey.QuestionID(testUUID),
+						Type:         entitySurvey.QuestionTypeNumber,
+						AnswersCount: 1,
+						Numbers:      &entitySurvey.NumberStats{Count: 1, Min: 5, Max: 5, Mean: 5, Median: 5},
+					},
+				},
+			},
+			want: &viewSurveys.SurveyResults{
+				SurveyID:     testUUID,
+				AnswersCount: 3,
+				Questions: []*viewSurveys.SurveyQuestionResults{
+					{
+						QuestionID:       testUUID,
+						Text:             "Нравится?",
+						Type:             "radio",
+						AnswersCount:     2,
+						TextAnswersCount: 1,
+						Variants:         []*viewSurveys.SurveyVariantResults{{VariantID: testUUID, Text: "Да", Count: 2, Percent: 100}},
+					},
+					{
+						QuestionID:   testUUID,
+						Type:         "inputNumber",
+						AnswersCount: 1,
+						Numbers:      &viewSurveys.SurveyNumberStats{Count: 1, Min: 5, Max: 5, Mean: 5, Median: 5},
+					},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, NewResultsPresenter().ToView(tt.results))
+		})
+	}
+}
+
+func TestSurveyResultsPresenter_TextAnswersToView(t *testing.T) {
+	testUUID := uuid.New()
+	qID := entitySurvey.QuestionID(testUUID)
+
+	got := NewResultsPresenter().TextAnswersToView(&entitySurvey.AnswersWithPagination{
+		AnswersPagination: entitySurvey.AnswersPagination{Limit: 10, LastID: &testUUID},
+		Answers: []*entitySurvey.RespondentAnswer{
+			{ID: &testUUID, QuestionID: &qID, ChosenVariant: entitySurvey.AnswerID(testUUID), Content: "текст"},
+		},
+	})
+
+	assert.Equal(t, &viewSurveys.SurveyTextAnswers{
+		Answers: []*viewSurveys.SurveyAnswer{
+			{ID: testUUID, QuestionId: testUUID, ChosenVariant: testUUID, Content: "текст"},
+		},
+		LastID: &testUUID,
+	}, got)
+}
+
+func TestSurveyResultsPresenter_ResponseRowToView(t *testing.T) {
+	testUUID := uuid.New()
+	rID := entitySurvey.RespondentID(testUUID)
+
+	tests := []struct {
+		name string
+		row  *entitySurvey.ResponseRow
+		want []string
+	}{
+		{
+			name: "full",
+			row: &entitySurvey.ResponseRow{
+				AnswerID:       &testUUID,
+				RespondentID:   &rID,
+				PortalID:       7,
+				OrganizationID: &testUUID,
+				QuestionID:     entitySurvey.QuestionID(testUUID),
+				QuestionText:   "Нравится?",
+				VariantID:      entitySurvey.AnswerID(testUUID),
+				VariantText:    "Да",
+				Content:        "текст",
+			},
+			want: []string{testUUID.String(), testUUID.String(), "7", testUUID.String(), testUUID.String(), "Нравится?", testUUID.String(), "Да", "текст"},
+		},
+		{
+			name: "anonymous",
+			row: &entitySurvey.ResponseRow{
+				QuestionID: entitySurvey.QuestionID(testUUID),
+				VariantID:  entitySurvey.AnswerID(testUUID),
+			},
+			want: []string{"", "", "", "", testUUID.String(), "", testUUID.String(), "", ""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewResultsPresenter()
+			got := p.ResponseRowToView(tt.row)
+			assert.Equal(t, tt.want, got)
+			assert.Len(t, got, len(p.ResponseRowsHeader()))
+		})
+	}
+}
@@ -50,13 +50,26 @@ func (sp surveysPresenter) ToNewEntity(survey *viewSurveys.NewSurvey) *entitySur
 		Questions: sp.questionsToNewEntity(survey.Questions),
 	}
 
-	if len(survey.Respondents) != 0 && entitySurvey.RespondentType(survey.RespondentType) == entitySurvey.RespondentTypeUser {
-		newSurvey.Respondent.Ids = sp.respondentsToIDs(survey.Respondents)
+	if entitySurvey.RespondentType(survey.RespondentType) == entitySurvey.RespondentTypeUser {
+		sp.targetingToEntity(newSurvey.Respondent, survey.Respondents, survey.SurveyTargeting)
 	}
 
 	return newSurvey
 }
 
+func (sp surveysPresenter) targetingToEntity(
+	respondent *entitySurvey.SurveyRespondent,
+	respondents []*viewSurveys.SurveyRespondent,
+	targeting viewSurveys.SurveyTargeting,
+) {
+	if len(respondents) != 0 {
+		respondent.Ids = sp.respondentsToIDs(respondents)
+	}
+	respondent.PortalIDs = targeting.RespondentPortalIDs
+	respondent.OrganizationIDs = targeting.RespondentOrganizationIDs
+	respondent.SubdivisionIDs = targeting.RespondentSubdivisionIDs
+}
+
 func (sp surveysPresenter) respondentsToIDs(respondents []*viewSurveys.SurveyRespondent) entitySurvey.RespondentIDs {
 	respondentsIDs := make(entitySurvey.RespondentIDs, 0, len(respondents))
 	for _, respondent := range respondents {
@@ -176,8 +189,8 @@ func (sp surveysPresenter) ToEntity(survey *viewSurveys.Survey) *entitySurvey.Su
 		Questions: sp.questionsToEntity(survey.Questions),
 	}
 
-	if len(survey.Respondents) != 0 && entitySurvey.RespondentType(survey.RespondentType) == entitySurvey.RespondentTypeUser {
-		newSurvey.Respondent.Ids = sp.respondentsToIDs(survey.Respondents)
+	if entitySurvey.RespondentType(survey.RespondentType) == entitySurvey.RespondentTypeUser {
+		sp.targetingToEntity(newSurvey.Respondent, survey.Respondents, survey.SurveyTargeting)
 	}
 	if survey.UpdatedAt != nil {
 		newSurvey.UpdatedAt = survey.UpdatedAt
@@ -282,7 +295,12 @@ func (sp surveysPresenter) ToView(survey *entitySurvey.Survey) *viewSurveys.Surv
 		RespondentType:    int(survey.Respondent.Type),
 		CreatedAt:         *survey.GetCreatedAt(),
 		Respondents:       sp.respondentsToView(survey.GetRespondent().Ids),
-		Questions:         sp.questionsToView(survey.Questions),
+		SurveyTargeting: viewSurveys.SurveyTargeting{
+			RespondentPortalIDs:       survey.Respondent.PortalIDs,
+			RespondentOrganizationIDs: survey.Respondent.OrganizationIDs,
+			RespondentSubdivisionIDs:  survey.Respondent.SubdivisionIDs,
+		},
+		Questions: sp.questionsToView(survey.Questions),
 	}
 	if survey.GetUpdatedAt() != nil {
 		viewSurvey.UpdatedAt = survey.GetUpdatedAt()
@@ -392,6 +410,24 @@ func (sp surveysPresenter) ToShortView(survey *entitySurvey.Survey) *viewSurveys
 	return viewSurvey
 }
 
+func (sp surveysPresenter) ToMyView(surveys []*entitySurvey.Survey) *viewSurveys.MySurveys {
+	result := &viewSurveys.MySurveys{
+		Count:   len(surveys),
+		Surveys: make([]*viewSurveys.MySurvey, 0, len(surveys)),
+	}
+	for _, survey := range surveys {
+		result.Surveys = append(result.Surveys, &viewSurveys.MySurvey{
+			ID:                uuid.UUID(*survey.GetID()),
+			Title:             survey.Title,
+			Description:       survey.Description,
+			ActivePeriodStart: survey.ActivePeriodStart,
+			ActivePeriodEnd:   survey.ActivePeriodEnd,
+		})
+	}
+
+	return result
+}
+
 func (sp surveysPresenter) questionsToShortView(questions []*entitySurvey.Question) []*viewSurveys.SurveyQuestionInfo {
 	viewQuestions := make([]*viewSurveys.SurveyQuestionInfo, 0, len(questions))
 	for _, question := range questions {
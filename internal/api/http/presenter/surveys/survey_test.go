@@ -34,9 +34,10 @@ func TestSurveysPresenter_ToNewEntity(t *testing.T) {
 		{
 			name: "correct 1",
 			args: args{survey: &viewSurveys.NewSurvey{
-				Title:          "test",
-				RespondentType: 2,
-				Respondents:    []*viewSurveys.SurveyRespondent{{ID: testUUID}},
+				Title:           "test",
+				RespondentType:  2,
+				Respondents:     []*viewSurveys.SurveyRespondent{{ID: testUUID}},
+				SurveyTargeting: viewSurveys.SurveyTargeting{RespondentPortalIDs: []int{testINT}},
 				Questions: []*viewSurveys.NewSurveyQuestion{
 					{
 						Text: "test",
@@ -95,8 +96,9 @@ func TestSurveysPresenter_ToNewEntity(t *testing.T) {
 						},
 					},
 					Respondent: &entitySurvey.SurveyRespondent{
-						Type: entitySurvey.RespondentTypeUser,
-						Ids:  entitySurvey.RespondentIDs{entitySurvey.RespondentID(testUUID)},
+						Type:      entitySurvey.RespondentTypeUser,
+						Ids:       entitySurvey.RespondentIDs{entitySurvey.RespondentID(testUUID)},
+						PortalIDs: []int{testINT},
 					},
 				}
 			},
@@ -366,19 +368,21 @@ func TestSurveysPresenter_ToView(t *testing.T) {
 					},
 				},
 				Respondent: &entitySurvey.SurveyRespondent{
-					Type: entitySurvey.RespondentTypeUser,
-					Ids:  entitySurvey.RespondentIDs{entitySurvey.RespondentID(testUUID)},
+					Type:            entitySurvey.RespondentTypeUser,
+					Ids:             entitySurvey.RespondentIDs{entitySurvey.RespondentID(testUUID)},
+					OrganizationIDs: []uuid.UUID{testUUID},
 				},
 			}},
 			want: func(a args, f fields) *viewSurveys.Survey {
 				return &viewSurveys.Survey{
-					ID:             testUUID,
-					Title:          "test",
-					RespondentType: 2,
-					Respondents:    []*viewSurveys.SurveyRespondent{{ID: testUUID}},
-					CreatedAt:      testTime,
-					UpdatedAt:      &testTime,
-					DeletedAt:      &testTime,
+					ID:              testUUID,
+					Title:           "test",
+					RespondentType:  2,
+					Respondents:     []*viewSurveys.SurveyRespondent{{ID: testUUID}},
+					SurveyTargeting: viewSurveys.SurveyTargeting{RespondentOrganizationIDs: []uuid.UUID{testUUID}},
+					CreatedAt:       testTime,
+					UpdatedAt:       &testTime,
+					DeletedAt:       &testTime,
 					Questions: []*viewSurveys.SurveyQuestion{
 						{
 							ID:        testUUID,
@@ -2,6 +2,7 @@ package users
 
 import (
 	viewUsers "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/users"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	entityUser "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/user"
 )
 
@@ -31,3 +32,24 @@ func (up *usersPresenter) ShortUserToView(shortUser *entityUser.ShortUser) *view
 
 	return u
 }
+
+func (up *usersPresenter) SessionsToView(sessions []*entityAuth.SessionActivity) []*viewUsers.Session {
+	views := make([]*viewUsers.Session, 0, len(sessions))
+	for _, session := range sessions {
+		if session == nil {
+			continue
+		}
+		v := &viewUsers.Session{
+			ID:           session.SessionID,
+			UserAgent:    session.UserAgent,
+			CreatedAt:    session.CreatedTime,
+			LastActiveAt: session.LastActiveTime,
+			Current:      session.Current,
+		}
+		if session.UserIP != nil {
+			v.IP = session.UserIP.String()
+		}
+		views = append(views, v)
+	}
+	return views
+}
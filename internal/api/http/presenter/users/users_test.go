@@ -1,13 +1,16 @@
 package users
 
 import (
+	"net"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	viewUsers "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/api/http/view/users"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	entityUser "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/user"
 )
 
@@ -68,3 +71,60 @@ func Test_employeePresenter_PersonToShortView(t *testing.T) {
 		})
 	}
 }
+
+func Test_usersPresenter_SessionsToView(t *testing.T) {
+	testString := "testString"
+	testID := uuid.New()
+	testTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		sessions []*entityAuth.SessionActivity
+		want     []*viewUsers.Session
+	}{
+		{
+			name:     "nil",
+			sessions: nil,
+			want:     []*viewUsers.Session{},
+		},
+		{
+			name: "correct",
+			sessions: []*entityAuth.SessionActivity{
+				{
+					SessionID:      testID.String(),
+					CloudID:        testString,
+					UserIP:         net.ParseIP("127.0.0.1"),
+					UserAgent:      testString,
+					CreatedTime:    testTime,
+					LastActiveTime: testTime.Add(time.Hour),
+					Current:        true,
+				},
+				nil,
+				{
+					SessionID: testString,
+				},
+			},
+			want: []*viewUsers.Session{
+				{
+					ID:           testID.String(),
+					UserAgent:    testString,
+					IP:           "127.0.0.1",
+					CreatedAt:    testTime,
+					LastActiveAt: testTime.Add(time.Hour),
+					Current:      true,
+				},
+				{
+					ID: testString,
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			up := NewUsersPresenter()
+			got := up.SessionsToView(tt.sessions)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	timeUtils "git.mos.ru/buch-cloud/moscow-team-2.0/build/time-utils.git"
@@ -25,6 +26,7 @@ import (
 
 type routerHandlers struct {
 	surveysHandlers           SurveysHandlers
+	surveysAdminHandlers      SurveysAdminHandlers
 	authHandlers              AuthHandlers
 	proxyHandlers             ProxyHandlers
 	employeesSearchesHandlers EmployeesSearchHandlers
@@ -45,7 +47,6 @@ type handlersFields struct {
 	AuthCallbackUrl *url.URL
 	TemplatesPath   string
 	ExternalHost    string
-	AccessList      auth.AccessList
 }
 
 // SharedFields Общие поля для передачи их в пакет http.
@@ -67,8 +68,12 @@ type router struct {
 	surveysInteractor        SurveysSurveysInteractor
 	surveysAnswersInteractor SurveysAnswersInteractor
 	surveysImagesInteractor  SurveysImagesInteractor
+	surveysAdminInteractor   SurveysAdminInteractor
+	surveysResultsInteractor SurveysResultsInteractor
+	surveysMyInteractor      SurveysMyInteractor
 
-	authInteractor AuthInteractor
+	authInteractor          AuthInteractor
+	authorizationInteractor AuthorizationInteractor
 
 	proxyInteractor           ProxyInteractor
 	redirectSessionInteractor RedirectSessionInteractor
@@ -77,7 +82,8 @@ type router struct {
 
 	employeesInteractor EmployeesUseCases
 
-	usersInteractor UsersInteractor
+	usersInteractor    UsersInteractor
+	sessionsInteractor SessionsInteractor
 
 	filesInteractor FilesInteractor
 
@@ -101,8 +107,12 @@ func NewRouter(
 	surveysInteractor SurveysSurveysInteractor,
 	surveysAnswersInteractor SurveysAnswersInteractor,
 	surveysImagesInteractor SurveysImagesInteractor,
+	surveysAdminInteractor SurveysAdminInteractor,
+	surveysResultsInteractor SurveysResultsInteractor,
+	surveysMyInteractor SurveysMyInteractor,
 
 	authInteractor AuthInteractor,
+	authorizationInteractor AuthorizationInteractor,
 
 	proxyInteractor ProxyInteractor,
 	redirectSessionInteractor RedirectSessionInteractor,
@@ -110,6 +120,7 @@ func NewRouter(
 	employeesSearchInteractor EmployeesSearchUseCases,
 
 	usersInteractor UsersInteractor,
+	sessionsInteractor SessionsInteractor,
 	filesInteractor FilesInteractor,
 
 	employeesInteractor EmployeesUseCases,
@@ -137,15 +148,20 @@ func NewRouter(
 		surveysInteractor:        surveysInteractor,
 		surveysAnswersInteractor: surveysAnswersInteractor,
 		surveysImagesInteractor:  surveysImagesInteractor,
+		surveysAdminInteractor:   surveysAdminInteractor,
+		surveysResultsInteractor: surveysResultsInteractor,
+		surveysMyInteractor:      surveysMyInteractor,
 
-		authInteractor: authInteractor,
+		authInteractor:          authInteractor,
+		authorizationInteractor: authorizationInteractor,
 
 		proxyInteractor:           proxyInteractor,
 		redirectSessionInteractor: redirectSessionInteractor,
 
 		employeesSearchInteractor: employeesSearchInteractor,
 
-		usersInteractor: usersInteractor,
+		usersInteractor:    usersInteractor,
+		sessionsInteractor: sessionsInteractor,
 
 		filesInteractor: filesInteractor,
 
@@ -209,6 +225,7 @@ func (r *router) initHandlers() *router {
 	surveysPresenter := presenterSurveys.NewSurveysPresenter(r.logger)
 	surveysAnswersPresenter := presenterSurveys.NewAnswersPresenter()
 	surveysImagesPresenter := presenterSurveys.NewSurveyImagesPresenter()
+	surveysResultsPresenter := presenterSurveys.NewResultsPresenter()
 
 	sh := NewSurveysHandlers(
 		r.surveysInteractor,
@@ -217,6 +234,16 @@ func (r *router) initHandlers() *router {
 		surveysAnswersPresenter,
 		r.surveysImagesInteractor,
 		surveysImagesPresenter,
+		r.surveysMyInteractor,
+		r.logger,
+	)
+	sah := NewSurveysAdminHandlers(
+		r.surveysAdminInteractor,
+		surveysPresenter,
+		r.surveysImagesInteractor,
+		surveysImagesPresenter,
+		r.surveysResultsInteractor,
+		surveysResultsPresenter,
 		r.logger,
 	)
 
@@ -233,7 +260,7 @@ func (r *router) initHandlers() *router {
 
 	// Users presenters
 	usersPresenter := presenterUsers.NewUsersPresenter()
-	uh := NewUsersHandlers(r.authInteractor, r.usersInteractor, authPresenter, usersPresenter)
+	uh := NewUsersHandlers(r.authInteractor, r.usersInteractor, r.sessionsInteractor, authPresenter, usersPresenter)
 
 	rhs := NewRedirectSessionHandlers(r.redirectSessionInteractor)
 
@@ -252,7 +279,7 @@ func (r *router) initHandlers() *router {
 	// news presenters
 	newsAdminPresenter := presenterNews.NewNewsAdminPresenter()
 	newsCommentPresenter := presenterNews.NewCommentsPresenter()
-	nah := NewNewsAdminHandlers(r.newsCategoryInteractor, r.newsAdminInteractor, newsAdminPresenter, r.logger)
+	nah := NewNewsAdminHandlers(r.newsCategoryInteractor, r.commentsInteractor, newsCommentPresenter, r.newsAdminInteractor, newsAdminPresenter, r.logger)
 	nh := NewNewsHandlers(r.newsCategoryInteractor, r.commentsInteractor, newsCommentPresenter, r.newsInteractor, newsAdminPresenter, r.logger)
 
 	bannersPresenter := presenterBanners.NewBannersPresenter()
@@ -260,6 +287,7 @@ func (r *router) initHandlers() *router {
 
 	r.handlers = &routerHandlers{
 		surveysHandlers:           sh,
+		surveysAdminHandlers:      sah,
 		authHandlers:              ah,
 		proxyHandlers:             pxh,
 		employeesSearchesHandlers: esh,
@@ -304,13 +332,15 @@ func (r *router) registerRoutes() {
 		NewHeadersMiddleware(r.middlewareOptions),
 	)
 
-	// TODO: реализовать логику при взаимодействии с session service
+	// sessionMiddleware получает сессию и кладет ее в контекст запроса
+	sessionMiddleware := NewAuthSessionMiddleware(r.authInteractor, r.tu, r.middlewareOptions)
+	// authOnlyMiddleware проверяет токен локально, а пользователя и активный портал берет из сохраненной активности сессии
 	onlyAuthOpts := r.middlewareOptions
-	onlyAuthOpts.opts = append(onlyAuthOpts.opts, &MiddlewareOption{
+	onlyAuthOpts.opts = append(slices.Clone(onlyAuthOpts.opts), &MiddlewareOption{
 		Name:  onlyAuthOptKey,
 		Value: true,
 	})
-	sessionMiddleware := NewAuthSessionMiddleware(r.authInteractor, r.tu, onlyAuthOpts)
+	authOnlyMiddleware := NewAuthSessionMiddleware(r.authInteractor, r.tu, onlyAuthOpts)
 
 	api := r.engine.Group("/", NewRequestIDMiddleware(r.middlewareOptions))
 	{
@@ -335,6 +365,9 @@ func (r *router) registerRoutes() {
 		  после реализации фронтом задачи https://oblako.mos.ru/jira/browse/TECH-511
 		*/
 		surveysGroupDeprecated := api.Group("/survey")
+		// Устаревшие роуты тоже требуют сессию: без нее ответ нельзя привязать к респонденту
+		// и отклонить повторную отправку. Клиенты без сессии получают 401 вместо прежнего анонимного доступа
+		surveysGroupDeprecated.Use(sessionMiddleware)
 		{
 			surveysAnswersGroup := surveysGroupDeprecated.Group("/answers")
 			{
@@ -348,6 +381,7 @@ func (r *router) registerRoutes() {
 		}
 
 		surveysModuleGroup := api.Group("/surveys")
+		surveysModuleGroup.Use(sessionMiddleware)
 		{
 			surveysV1Group := surveysModuleGroup.Group("/v1")
 			{
@@ -359,9 +393,11 @@ func (r *router) registerRoutes() {
 				{
 					surveysImagesGroup.GET("/:id", r.handlers.surveysHandlers.getImage)
 				}
+				surveysV1Group.GET("/my", r.handlers.surveysHandlers.mySurveys)
 				surveysGroup := surveysV1Group.Group("/surveys")
 				{
 					surveysGroup.GET("/:id", r.handlers.surveysHandlers.getSurvey)
+					surveysGroup.GET("/:id/completion", r.handlers.surveysHandlers.getCompletion)
 				}
 			}
 		}
@@ -370,7 +406,7 @@ func (r *router) registerRoutes() {
 		{
 			authV1Group := authModuleGroup.Group("/v1")
 			{
-				authV1Group.POST("/redirect", sessionMiddleware, r.handlers.redirectSessionHandlers.createSession)
+				authV1Group.POST("/redirect", authOnlyMiddleware, r.handlers.redirectSessionHandlers.createSession)
 				authV1Group.GET("/auth", r.handlers.authHandlers.auth)
 				authV1Group.GET("/logout", sessionMiddleware, r.handlers.authHandlers.logout)
 				authV1Group.GET("/refresh", r.handlers.authHandlers.refresh)
@@ -378,7 +414,7 @@ func (r *router) registerRoutes() {
 		}
 
 		proxyGroup := api.Group("/proxy")
-		proxyGroup.Use(sessionMiddleware)
+		proxyGroup.Use(authOnlyMiddleware)
 		{
 			proxyV1Group := proxyGroup.Group("/v1")
 			{
@@ -417,20 +453,24 @@ func (r *router) registerRoutes() {
 				usersV1Group.GET("/profile", r.handlers.employeesHandlers.getProfile)
 				usersV1Group.GET("/me", r.handlers.usersHandlers.getMe)
 				usersV1Group.GET("/changeportal/:id", r.handlers.usersHandlers.changePortal)
+				usersV1Group.GET("/sessions", r.handlers.usersHandlers.getSessions)
+				usersV1Group.DELETE("/sessions", r.handlers.usersHandlers.revokeOtherSessions)
+				usersV1Group.DELETE("/sessions/:id", r.handlers.usersHandlers.revokeSession)
 			}
 		}
 
 		employeesModuleGroup := api.Group("/employees")
-		employeesModuleGroup.Use(sessionMiddleware)
 		{
 			employeesV1Group := employeesModuleGroup.Group("/v1")
 			{
 				employeesGroup := employeesV1Group.Group("/employees")
+				employeesGroup.Use(authOnlyMiddleware)
 				{
 					employeesGroup.GET("/:id", r.handlers.employeesHandlers.getEmployee)
 				}
 				// Группа роутов для поиска сотрудников
 				employeesSearchGroup := employeesV1Group.Group("/search")
+				employeesSearchGroup.Use(sessionMiddleware)
 				{
 					employeesSearchGroup.POST("", r.handlers.employeesSearchesHandlers.search)
 					employeesSearchGroup.POST("/filters", r.handlers.employeesSearchesHandlers.filters)
@@ -481,6 +521,11 @@ func (r *router) registerRoutes() {
 					newsGroup.GET("/:id", r.handlers.newsHandlers.getNews)
 					newsGroup.POST("/:id/comments", r.handlers.newsHandlers.createComment)
 					newsGroup.GET("/:id/comments", r.handlers.newsHandlers.listComments)
+					newsGroup.POST("/:id/reactions", r.handlers.newsHandlers.addReaction)
+					newsGroup.DELETE("/:id/reactions", r.handlers.newsHandlers.removeReaction)
+					newsGroup.PATCH("/:id/comments/:commentId", r.handlers.newsHandlers.updateComment)
+					newsGroup.DELETE("/:id/comments/:commentId", r.handlers.newsHandlers.deleteComment)
+					newsGroup.POST("/:id/comments/:commentId/reports", r.handlers.newsHandlers.reportComment)
 				}
 			}
 		}
@@ -498,6 +543,11 @@ func (r *router) registerRoutes() {
 
 	// Административные роуты /admin/*
 	r.registerAdminRoutes(api.Group("/admin"))
+
+	// Спецификация OpenAPI не публикуется в production
+	if r.environment != EnvironmentProd {
+		api.GET(OpenAPIPath, openAPIHandler())
+	}
 }
 
 // Административные роуты
@@ -505,31 +555,72 @@ func (r *router) registerAdminRoutes(adminGroup *gin.RouterGroup) {
 	authMiddleware := NewAuthSessionMiddleware(r.authInteractor, r.tu, r.middlewareOptions)
 	adminGroup.Use(authMiddleware)
 
+	// requirePermissions проверка прав пользователя на активном портале
+	requirePermissions := func(permissions ...auth.Permission) gin.HandlerFunc {
+		return NewPermissionMiddleware(r.authorizationInteractor, permissions...)
+	}
+
 	newsModuleGroup := adminGroup.Group("/news")
 	{
 		newsV1Group := newsModuleGroup.Group("/v1")
 		{
 			categoryGroup := newsV1Group.Group("/category")
 			{
-				categoryGroup.POST("", r.handlers.newsAdminHandlers.createCategory)
-				categoryGroup.PUT("/:id", r.handlers.newsAdminHandlers.updateCategory)
-				categoryGroup.DELETE("/:id", r.handlers.newsAdminHandlers.deleteCategory)
-				categoryGroup.POST("/search", r.handlers.newsAdminHandlers.searchCategory)
-				categoryGroup.GET("/:id", r.handlers.newsAdminHandlers.getCategory)
+				categoryGroup.POST("", requirePermissions(auth.PermissionNewsCategoryWrite), r.handlers.newsAdminHandlers.createCategory)
+				categoryGroup.PUT("/:id", requirePermissions(auth.PermissionNewsCategoryWrite), r.handlers.newsAdminHandlers.updateCategory)
+				categoryGroup.DELETE("/:id", requirePermissions(auth.PermissionNewsCategoryDelete), r.handlers.newsAdminHandlers.deleteCategory)
+				categoryGroup.POST("/search", requirePermissions(auth.PermissionNewsCategoryRead), r.handlers.newsAdminHandlers.searchCategory)
+				categoryGroup.GET("/:id", requirePermissions(auth.PermissionNewsCategoryRead), r.handlers.newsAdminHandlers.getCategory)
 			}
 			newsGroup := newsV1Group.Group("/news")
 			{
-				newsGroup.POST("", r.handlers.newsAdminHandlers.createNews)
-				newsGroup.GET("/:id", r.handlers.newsAdminHandlers.getNews)
-				newsGroup.PUT("/:id", r.handlers.newsAdminHandlers.updateNews)
-				newsGroup.DELETE("/:id", r.handlers.newsAdminHandlers.deleteNews)
-				newsGroup.POST("/:id/status", r.handlers.newsAdminHandlers.setStatusNews)
-				newsGroup.PATCH("/:id/flags", r.handlers.newsAdminHandlers.setFlagsNews)
+				newsGroup.POST("", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.createNews)
+				newsGroup.GET("/:id", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.getNews)
+				newsGroup.PUT("/:id", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.updateNews)
+				newsGroup.DELETE("/:id", requirePermissions(auth.PermissionNewsDelete), r.handlers.newsAdminHandlers.deleteNews)
+				newsGroup.POST("/:id/status", requirePermissions(auth.PermissionNewsPublish), r.handlers.newsAdminHandlers.setStatusNews)
+				newsGroup.GET("/:id/history", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.history)
+				newsGroup.GET("/:id/views", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.viewsStats)
+				newsGroup.PATCH("/:id/flags", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.setFlagsNews)
+				newsGroup.GET("/:id/draft", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.getDraft)
+				newsGroup.PUT("/:id/draft", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.saveDraft)
+				newsGroup.DELETE("/:id/draft", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.deleteDraft)
+				newsGroup.GET("/:id/revisions", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.revisions)
+				newsGroup.GET("/:id/revisions/:revisionId", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.revision)
+				newsGroup.POST("/:id/revisions/:revisionId/restore", requirePermissions(auth.PermissionNewsWrite), r.handlers.newsAdminHandlers.restoreRevision)
+				newsGroup.POST("/:id/comments/:commentId/hide", requirePermissions(auth.PermissionNewsCommentsModerate), r.handlers.newsAdminHandlers.hideComment)
+				newsGroup.POST("/:id/comments/:commentId/restore", requirePermissions(auth.PermissionNewsCommentsModerate), r.handlers.newsAdminHandlers.restoreComment)
 				searchGroup := newsGroup.Group("/search")
 				{
-					searchGroup.POST("", r.handlers.newsAdminHandlers.searchNews)
+					searchGroup.POST("", requirePermissions(auth.PermissionNewsRead), r.handlers.newsAdminHandlers.searchNews)
 				}
 			}
+			commentsGroup := newsV1Group.Group("/comments")
+			{
+				commentsGroup.GET("/reports", requirePermissions(auth.PermissionNewsCommentsModerate), r.handlers.newsAdminHandlers.commentReports)
+				commentsGroup.DELETE("/reports/:reportId", requirePermissions(auth.PermissionNewsCommentsModerate), r.handlers.newsAdminHandlers.dismissCommentReport)
+			}
+		}
+	}
+
+	surveysModuleGroup := adminGroup.Group("/surveys")
+	{
+		surveysV1Group := surveysModuleGroup.Group("/v1")
+		{
+			surveysGroup := surveysV1Group.Group("/surveys")
+			{
+				surveysGroup.POST("", requirePermissions(auth.PermissionSurveysWrite), r.handlers.surveysAdminHandlers.create)
+				surveysGroup.POST("/search", requirePermissions(auth.PermissionSurveysRead), r.handlers.surveysAdminHandlers.search)
+				surveysGroup.GET("/:id", requirePermissions(auth.PermissionSurveysRead), r.handlers.surveysAdminHandlers.get)
+				surveysGroup.PUT("/:id", requirePermissions(auth.PermissionSurveysWrite), r.handlers.surveysAdminHandlers.update)
+				surveysGroup.DELETE("/:id", requirePermissions(auth.PermissionSurveysDelete), r.handlers.surveysAdminHandlers.delete)
+				surveysGroup.POST("/:id/publish", requirePermissions(auth.PermissionSurveysPublish), r.handlers.surveysAdminHandlers.publish)
+				surveysGroup.POST("/:id/unpublish", requirePermissions(auth.PermissionSurveysPublish), r.handlers.surveysAdminHandlers.unpublish)
+				surveysGroup.GET("/:id/results", requirePermissions(auth.PermissionSurveysRead), r.handlers.surveysAdminHandlers.results)
+				surveysGroup.GET("/:id/results/export", requirePermissions(auth.PermissionSurveysRead), r.handlers.surveysAdminHandlers.exportResults)
+				surveysGroup.GET("/:id/results/questions/:question_id/texts", requirePermissions(auth.PermissionSurveysRead), r.handlers.surveysAdminHandlers.textAnswers)
+			}
+			surveysV1Group.POST("/images", requirePermissions(auth.PermissionSurveysWrite), r.handlers.surveysAdminHandlers.uploadImage)
 		}
 	}
 
@@ -539,7 +630,7 @@ func (r *router) registerAdminRoutes(adminGroup *gin.RouterGroup) {
 		{
 			bannersGroup := bannersV1Group.Group("/banners")
 			{
-				bannersGroup.PUT("", r.handlers.bannersHandlers.set)
+				bannersGroup.PUT("", requirePermissions(auth.PermissionBannersWrite), r.handlers.bannersHandlers.set)
 			}
 		}
 	}
@@ -57,6 +57,11 @@ func (s *RouterSuite) SetupTest() {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
 		s.middlewareOptions...,
 	)
 }
@@ -17,6 +17,9 @@ import (
 // RequestTimeOut Тайм-аут запросов
 const RequestTimeOut = 30 * time.Second
 
+// ExportTimeOut Тайм-аут выгрузок, которые отдаются потоком по мере чтения из хранилища
+const ExportTimeOut = 10 * time.Minute
+
 type Environment uint8
 
 const (
@@ -85,11 +88,16 @@ func (s *server) InitRouter(
 	surveysInteractor SurveysSurveysInteractor,
 	surveysAnswersInteractor SurveysAnswersInteractor,
 	surveysImagesInteractor SurveysImagesInteractor,
+	surveysAdminInteractor SurveysAdminInteractor,
+	surveysResultsInteractor SurveysResultsInteractor,
+	surveysMyInteractor SurveysMyInteractor,
 	authInteractor AuthInteractor,
+	authorizationInteractor AuthorizationInteractor,
 	proxyInteractor ProxyInteractor,
 	redirectSessionInteractor RedirectSessionInteractor,
 	employeesSearchInteractor EmployeesSearchUseCases,
 	usersInteractor UsersInteractor,
+	sessionsInteractor SessionsInteractor,
 	filesInteractor FilesInteractor,
 	employeesInteractor EmployeesUseCases,
 	analyticsInteractor AnalyticsInteractor,
@@ -114,11 +122,16 @@ func (s *server) InitRouter(
 		surveysInteractor,
 		surveysAnswersInteractor,
 		surveysImagesInteractor,
+		surveysAdminInteractor,
+		surveysResultsInteractor,
+		surveysMyInteractor,
 		authInteractor,
+		authorizationInteractor,
 		proxyInteractor,
 		redirectSessionInteractor,
 		employeesSearchInteractor,
 		usersInteractor,
+		sessionsInteractor,
 		filesInteractor,
 		employeesInteractor,
 		analyticsInteractor,
@@ -4,14 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/tree-alive.git"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/valyala/fasthttp"
 	"github.com/valyala/fasthttp/fasthttpadaptor"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 )
 
 //go:generate mockgen -source=server.go -destination=./service_mock.go -package=service
@@ -22,6 +25,11 @@ var (
 	ContextKeyServiceAddr entity.ContextKey = "service-addr"
 )
 
+const (
+	errTreeNotReady diterrors.StringError = "application is not ready"
+	errBreakerOpen  diterrors.StringError = "circuit breaker is open"
+)
+
 type HTTPSrv interface {
 	Shutdown() error
 	ShutdownWithContext(ctx context.Context) error
@@ -36,18 +44,61 @@ type AppInfo struct {
 	Release   string `json:"release"`
 }
 
+// AccessListInfoProvider источник сведений о загруженном списке доступа
+type AccessListInfoProvider interface {
+	Info() *auth.AccessListInfo
+}
+
+// DependenciesStatusProvider источник состояния зависимых сервисов
+type DependenciesStatusProvider interface {
+	Dependencies() []*entity.DependencyStatus
+}
+
+// ReadinessChecker проверки готовности зависимостей
+type ReadinessChecker interface {
+	Check(ctx context.Context) (bool, []*entity.CheckResult)
+}
+
+// Состояния сервиса в ответе /status
+const (
+	statusOK       = "ok"
+	statusDegraded = "degraded"
+)
+
+// status ответ эндпоинта /status
+type status struct {
+	Status       string                     `json:"status"`
+	Dependencies []*entity.DependencyStatus `json:"dependencies"`
+}
+
+// info ответ эндпоинта /info
+type info struct {
+	*AppInfo
+	AccessList *auth.AccessListInfo `json:"accessList,omitempty"`
+}
+
 type server struct {
 	branch tree.Branch
 	server HTTPSrv
 }
 
-func NewServer(treeBranch tree.Branch, appInfo *AppInfo) *server {
+// NewServer служебный HTTP-сервер: healthz, readyz, status, metrics, info.
+//
+//	Сервис не готов, пока недоступна обязательная зависимость или разомкнут выключатель
+//	хотя бы одного зависимого сервиса. /readyz?verbose возвращает результат каждой проверки
+func NewServer(
+	treeBranch tree.Branch,
+	appInfo *AppInfo,
+	accessList AccessListInfoProvider,
+	dependencies DependenciesStatusProvider,
+	readiness ReadinessChecker,
+) *server {
 	return &server{
 		branch: treeBranch,
 		server: &fasthttp.Server{
 			Handler: fasthttp.TimeoutWithCodeHandler(
 				func(ctx *fasthttp.RequestCtx) {
-					switch string(ctx.RequestURI()) {
+					switch string(ctx.Path()) {
 					case "/healthz":
 						if treeBranch.Tree().IsAlive() {
 							ctx.SetStatusCode(fasthttp.StatusOK)
@@ -56,17 +107,50 @@ func NewServer(treeBranch tree.Branch, appInfo *AppInfo) *server {
 							ctx.SetStatusCode(fasthttp.StatusBadGateway)
 						}
 					case "/readyz":
-						if treeBranch.Tree().IsReady() {
+						ready, lines := readyzChecks(ctx, treeBranch, dependencies, readiness)
+						if ready {
 							ctx.SetStatusCode(fasthttp.StatusOK)
-							ctx.SetBody([]byte("OK"))
 						} else {
 							ctx.SetStatusCode(fasthttp.StatusBadGateway)
 						}
+						switch {
+						case ctx.QueryArgs().Has("verbose"):
+							result := "readyz check passed"
+							if !ready {
+								result = "readyz check failed"
+							}
+							ctx.SetBody([]byte(strings.Join(append(lines, result), "\n")))
+						case ready:
+							ctx.SetBody([]byte("OK"))
+						}
+					case "/status":
+						resp := status{Status: statusOK, Dependencies: []*entity.DependencyStatus{}}
+						if dependencies != nil {
+							resp.Dependencies = dependencies.Dependencies()
+						}
+						for _, dependency := range resp.Dependencies {
+							if dependency.State != entity.DependencyClosed {
+								resp.Status = statusDegraded
+								break
+							}
+						}
+						body, err := json.Marshal(resp)
+						if err != nil {
+							ctx.SetStatusCode(fasthttp.StatusInternalServerError)
+							return
+						}
+						ctx.SetContentType("application/json")
+						ctx.SetStatusCode(fasthttp.StatusOK)
+						ctx.SetBody(body)
 					case "/metrics":
 						metricsHandler := fasthttpadaptor.NewFastHTTPHandler(promhttp.Handler())
 						metricsHandler(ctx)
 					case "/info":
-						body, err := json.Marshal(appInfo)
+						resp := info{AppInfo: appInfo}
+						if accessList != nil {
+							resp.AccessList = accessList.Info()
+						}
+						body, err := json.Marshal(resp)
 						if err != nil {
 							ctx.SetStatusCode(fasthttp.StatusInternalServerError)
 							return
@@ -85,6 +169,53 @@ func NewServer(treeBranch tree.Branch, appInfo *AppInfo) *server {
 	}
 }
 
+// readyzChecks проверяет готовность сервиса.
+//
+//	Возвращает признак готовности и построчный результат проверок: [+] проверка пройдена, [-] не пройдена
+func readyzChecks(
+	ctx context.Context,
+	treeBranch tree.Branch,
+	dependencies DependenciesStatusProvider,
+	readiness ReadinessChecker,
+) (bool, []string) {
+	ready := treeBranch.Tree().IsReady()
+	lines := []string{checkLine("tree", true, nil)}
+	if !ready {
+		lines[0] = checkLine("tree", true, errTreeNotReady)
+	}
+
+	if readiness != nil {
+		checksReady, results := readiness.Check(ctx)
+		ready = ready && checksReady
+		for _, result := range results {
+			lines = append(lines, checkLine(result.Name, result.Required, result.Err))
+		}
+	}
+
+	if dependencies != nil {
+		for _, dependency := range dependencies.Dependencies() {
+			var err error
+			if dependency.State == entity.DependencyOpen {
+				// Разомкнутый выключатель необязательного сервиса не снимает готовность
+				ready = ready && !dependency.Required
+				err = errBreakerOpen
+			}
+			lines = append(lines, checkLine("breaker "+dependency.Name, dependency.Required, err))
+		}
+	}
+	return ready, lines
+}
+
+func checkLine(name string, required bool, err error) string {
+	if !required {
+		name += " (optional)"
+	}
+	if err != nil {
+		return fmt.Sprintf("[-]%s failed: %s", name, err)
+	}
+	return fmt.Sprintf("[+]%s ok", name)
+}
+
 func (s *server) Run(ctx context.Context) error {
 	addr, ok := ctx.Value(&ContextKeyServiceAddr).(entity.ContextStringValue)
 	if !ok {
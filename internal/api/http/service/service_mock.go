@@ -13,6 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
+	entity "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	auth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -81,3 +83,118 @@ func (mr *MockHTTPSrvMockRecorder) ShutdownWithContext(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShutdownWithContext", reflect.TypeOf((*MockHTTPSrv)(nil).ShutdownWithContext), ctx)
 }
+
+// MockAccessListInfoProvider is a mock of AccessListInfoProvider interface.
+type MockAccessListInfoProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessListInfoProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessListInfoProviderMockRecorder is the mock recorder for MockAccessListInfoProvider.
+type MockAccessListInfoProviderMockRecorder struct {
+	mock *MockAccessListInfoProvider
+}
+
+// NewMockAccessListInfoProvider creates a new mock instance.
+func NewMockAccessListInfoProvider(ctrl *gomock.Controller) *MockAccessListInfoProvider {
+	mock := &MockAccessListInfoProvider{ctrl: ctrl}
+	mock.recorder = &MockAccessListInfoProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessListInfoProvider) EXPECT() *MockAccessListInfoProviderMockRecorder {
+	return m.recorder
+}
+
+// Info mocks base method.
+func (m *MockAccessListInfoProvider) Info() *auth.AccessListInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Info")
+	ret0, _ := ret[0].(*auth.AccessListInfo)
+	return ret0
+}
+
+// Info indicates an expected call of Info.
+func (mr *MockAccessListInfoProviderMockRecorder) Info() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockAccessListInfoProvider)(nil).Info))
+}
+
+// MockDependenciesStatusProvider is a mock of DependenciesStatusProvider interface.
+type MockDependenciesStatusProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependenciesStatusProviderMockRecorder
+	isgomock struct{}
+}
+
+// MockDependenciesStatusProviderMockRecorder is the mock recorder for MockDependenciesStatusProvider.
+type MockDependenciesStatusProviderMockRecorder struct {
+	mock *MockDependenciesStatusProvider
+}
+
+// NewMockDependenciesStatusProvider creates a new mock instance.
+func NewMockDependenciesStatusProvider(ctrl *gomock.Controller) *MockDependenciesStatusProvider {
+	mock := &MockDependenciesStatusProvider{ctrl: ctrl}
+	mock.recorder = &MockDependenciesStatusProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependenciesStatusProvider) EXPECT() *MockDependenciesStatusProviderMockRecorder {
+	return m.recorder
+}
+
+// Dependencies mocks base method.
+func (m *MockDependenciesStatusProvider) Dependencies() []*entity.DependencyStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dependencies")
+	ret0, _ := ret[0].([]*entity.DependencyStatus)
+	return ret0
+}
+
+// Dependencies indicates an expected call of Dependencies.
+func (mr *MockDependenciesStatusProviderMockRecorder) Dependencies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dependencies", reflect.TypeOf((*MockDependenciesStatusProvider)(nil).Dependencies))
+}
+
+// MockReadinessChecker is a mock of ReadinessChecker interface.
+type MockReadinessChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadinessCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockReadinessCheckerMockRecorder is the mock recorder for MockReadinessChecker.
+type MockReadinessCheckerMockRecorder struct {
+	mock *MockReadinessChecker
+}
+
+// NewMockReadinessChecker creates a new mock instance.
+func NewMockReadinessChecker(ctrl *gomock.Controller) *MockReadinessChecker {
+	mock := &MockReadinessChecker{ctrl: ctrl}
+	mock.recorder = &MockReadinessCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadinessChecker) EXPECT() *MockReadinessCheckerMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockReadinessChecker) Check(ctx context.Context) (bool, []*entity.CheckResult) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]*entity.CheckResult)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockReadinessCheckerMockRecorder) Check(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockReadinessChecker)(nil).Check), ctx)
+}
@@ -1,6 +1,7 @@
 package http
 
 const (
-	ID_PARAM_KEY   = "id"
-	SLUG_PARAM_KEY = "id"
+	ID_PARAM_KEY          = "id"
+	SLUG_PARAM_KEY        = "id"
+	QUESTION_ID_PARAM_KEY = "question_id"
 )
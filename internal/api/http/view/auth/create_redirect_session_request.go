@@ -0,0 +1,6 @@
+package auth
+
+type CreateSessionSessionRequest struct {
+	PortalURL string `json:"portalUrl" binding:"required"`
+	TargetURL string `json:"targetUrl" binding:"required"`
+}
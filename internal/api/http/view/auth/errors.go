@@ -33,4 +33,6 @@ const (
 	ErrMessageFrontUnauthenticated   diterrors.StringError = "Необходима аутентификация"
 	ErrMessageFrontUserAccessDenied  diterrors.StringError = "Доступ к порталу ограничен"
 	ErrMessageFrontUnavailablePortal diterrors.StringError = "Выбранный портал недоступен пользователю"
+
+	ErrMessageFrontRevokeCurrentSession diterrors.StringError = "Текущую сессию нельзя отозвать, используйте выход"
 )
@@ -43,3 +43,13 @@ const (
 	BannerTypeSlider  BannerType = "slider"
 	BannerTypeBottom  BannerType = "bottom"
 )
+
+type BannersList struct {
+	Promo  *Banner   `json:"promo,omitempty"`
+	Slider []*Banner `json:"slider"`
+	Bottom *Banner   `json:"bottom,omitempty"`
+}
+
+type SetBannersResponse struct {
+	Banners []*BannerInfo `json:"banners"`
+}
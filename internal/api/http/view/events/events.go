@@ -16,3 +16,7 @@ type CalendarEventLink struct {
 	ID          string `json:"id"`
 	RedirectURL string `json:"redirectUrl"`
 }
+
+type CalendarEventsLinksRequest struct {
+	EventIDs []string `json:"ids"`
+}
@@ -3,6 +3,7 @@
 package news
 
 import (
+	"github.com/google/uuid"
 	"time"
 )
 
@@ -19,3 +20,17 @@ func (n *NewsComment) GetCreateAt() time.Time {
 	}
 	return *n.CreateAt
 }
+
+func (n *NewsComment) GetParentIDPtr() *uuid.UUID {
+	if n == nil {
+		return nil
+	}
+	return n.ParentID
+}
+
+func (n *NewsComment) GetParentID() uuid.UUID {
+	if n == nil || n.ParentID == nil {
+		return uuid.Nil
+	}
+	return *n.ParentID
+}
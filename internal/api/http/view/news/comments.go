@@ -8,9 +8,12 @@ import (
 
 //go:generate ditgen -source=comments.go -zero=true
 
-// Тело запроса: { "text": "..." }
+// Тело запроса: { "text": "...", "parentId": "..." }
+//
+//	Упоминание сотрудника в тексте: @<идентификатор сотрудника>
 type NewNewsComment struct {
-	Text string `json:"text"`
+	Text     string     `json:"text"`
+	ParentID *uuid.UUID `json:"parentId,omitempty"`
 }
 
 type NewsComment struct {
@@ -19,7 +22,13 @@ type NewsComment struct {
 	Message    string        `json:"text"`
 	IsUserMade bool          `json:"isUserMade"`
 	IsDeleted  bool          `json:"isDeleted"`
+	IsHidden   bool          `json:"isHidden"`
 	Author     CommentAuthor `json:"author"`
+	// ParentID комментарий, на который дан ответ
+	ParentID     *uuid.UUID       `json:"parentId,omitempty"`
+	RepliesCount int              `json:"repliesCount"`
+	HasReplies   bool             `json:"hasReplies"`
+	Mentions     []*CommentAuthor `json:"mentions"`
 }
 
 type CommentAuthor struct {
@@ -28,3 +37,33 @@ type CommentAuthor struct {
 	ImageID  string    `json:"imageId"`
 	IsActive bool      `json:"isActive"`
 }
+
+// CreateCommentResponse ответ на создание комментария
+type CreateCommentResponse struct {
+	Comments CommentsCounter `json:"comments"`
+}
+
+type CommentsCounter struct {
+	Count      int  `json:"count"`
+	IsUserMade bool `json:"isUserMade"`
+}
+
+// Тело запроса: { "text": "..." }
+type UpdateNewsComment struct {
+	Text string `json:"text"`
+}
+
+// Тело запроса: { "reason": "..." }
+type ReportNewsComment struct {
+	Reason string `json:"reason"`
+}
+
+// CommentReport жалоба на комментарий в очереди модерации
+type CommentReport struct {
+	ID         uuid.UUID `json:"id"`
+	CommentID  uuid.UUID `json:"commentId"`
+	NewsID     uuid.UUID `json:"newsId"`
+	ReporterID uuid.UUID `json:"reporterId"`
+	Reason     string    `json:"reason"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
@@ -12,4 +12,26 @@ const (
 	ErrPublishTimeBeforeNow  diterrors.StringError = "Дата публикации не может быть в прошлом"
 	ErrStatus                diterrors.StringError = "Некорректный статус новости"
 	ErrNewsNotFound          diterrors.StringError = "Новость не найдена"
+	ErrStatusTransition      diterrors.StringError = "Переход новости в этот статус недоступен"
+	ErrPublicationAtRequired diterrors.StringError = "Для отправки на публикацию необходимо указать дату публикации"
+	ErrSelfApproval          diterrors.StringError = "Новость должен опубликовать другой редактор"
+	ErrSearchPageTooDeep     diterrors.StringError = "Слишком далекая страница, используйте курсор"
+	ErrSearchCursor          diterrors.StringError = "Некорректный курсор"
+	ErrReactionType          diterrors.StringError = "Неизвестный тип реакции"
+	ErrReactionsDisabled     diterrors.StringError = "Реакции на новость отключены"
+	ErrViewsPeriod           diterrors.StringError = "Некорректный период статистики просмотров"
+	ErrCommentsDisabled      diterrors.StringError = "Комментарии к новости отключены"
+	ErrCommentNotFound       diterrors.StringError = "Комментарий не найден"
+	ErrCommentNotAuthor      diterrors.StringError = "Изменить комментарий может только автор"
+	ErrCommentEditExpired    diterrors.StringError = "Время на изменение комментария истекло"
+	ErrCommentRemoved        diterrors.StringError = "Комментарий удален или скрыт"
+	ErrForeignPortal         diterrors.StringError = "Новость не опубликована на активном портале"
+	ErrReportNotFound        diterrors.StringError = "Жалоба не найдена"
+	ErrContent               diterrors.StringError = "Некорректное содержимое новости"
+	ErrNewsConflict          diterrors.StringError = "Новость изменена другим редактором"
+	ErrNewsVersionRequired   diterrors.StringError = "Не передано время изменения новости, обновите страницу"
+	ErrRevisionNotFound      diterrors.StringError = "Версия новости не найдена"
+	ErrDraftNotFound         diterrors.StringError = "Черновик не найден"
+	ErrNewsLocked            diterrors.StringError = "Новость сейчас изменяет другой редактор, повторите попытку"
+	ErrSubmitterUnknown      diterrors.StringError = "Неизвестно, кто отправил новость на публикацию, отправьте ее на публикацию повторно"
 )
@@ -127,6 +127,20 @@ func (n *News) GetPublishDatePtr() *time.Time {
 	return n.PublishDate
 }
  
+func (n *News) GetReactionsPtr() *NewsReactions {
+    if n == nil {
+        return nil
+    }
+	return n.Reactions
+}
+ 
+func (n *News) GetViewsPtr() *int {
+    if n == nil {
+        return nil
+    }
+	return n.Views
+}
+ 
 func (s *SearchNewsResponseItem) GetImageIDPtr() *uuid.UUID {
     if s == nil {
         return nil
@@ -326,6 +340,20 @@ func (n *News) GetPublishDate() time.Time {
 	return *n.PublishDate
 }
  
+func (n *News) GetReactions() (_Reactions NewsReactions) {
+    if n == nil || n.Reactions == nil  {
+        return 
+    }
+	return *n.Reactions
+}
+ 
+func (n *News) GetViews() int {
+    if n == nil || n.Views == nil  {
+        return 0
+    }
+	return *n.Views
+}
+ 
 func (s *SearchNewsResponseItem) GetImageID() uuid.UUID {
     if s == nil || s.ImageID == nil  {
         return uuid.Nil
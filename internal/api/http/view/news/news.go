@@ -53,6 +53,11 @@ type News struct {
 	UpdatedAt            *time.Time          `json:"updatedAt"`
 	CreateDate           *time.Time          `json:"createDate"`
 	PublishDate          *time.Time          `json:"publishDate"`
+	Reactions            *NewsReactions      `json:"reactions,omitempty"`
+	// Views количество просмотров, только если их показ включен
+	Views *int `json:"views,omitempty"`
+	// ReadingTime оценка времени чтения в минутах
+	ReadingTime int `json:"readingTime"`
 }
 
 type NewsProperties struct {
@@ -89,6 +94,7 @@ type SearchNewsRequest struct {
 	OrderType string                   `json:"orderType"`
 	Page      int                      `json:"page"`
 	Limit     int                      `json:"limit"`
+	Cursor    string                   `json:"cursor"`
 	Query     string                   `json:"query"`
 	Filters   SearchNewsRequestFilters `json:"filters"`
 }
@@ -104,8 +110,9 @@ type SearchNewsRequestFilters struct {
 }
 
 type SearchNewsResponse struct {
-	Total int                       `json:"total"`
-	Data  []*SearchNewsResponseItem `json:"data"`
+	Total      int                       `json:"total"`
+	NextCursor string                    `json:"nextCursor"`
+	Data       []*SearchNewsResponseItem `json:"data"`
 }
 
 type SearchNewsResponseItem struct {
@@ -121,6 +128,10 @@ type SearchNewsResponseItem struct {
 	Status               NewsStatus              `json:"status"`
 	CreateAt             *time.Time              `json:"createDate"`
 	PublishedAt          *time.Time              `json:"publishDate"`
+	// Snippet начало текста новости без разметки
+	Snippet string `json:"snippet"`
+	// ReadingTime оценка времени чтения в минутах
+	ReadingTime int `json:"readingTime"`
 }
 
 type NewsCategory struct {
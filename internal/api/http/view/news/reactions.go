@@ -0,0 +1,14 @@
+package news
+
+type NewsReactionRequest struct {
+	Type string `json:"type"`
+}
+
+type NewsReactions struct {
+	// Counts количество реакций по типам
+	Counts map[string]int `json:"counts"`
+	// Mine реакции текущего пользователя
+	Mine []string `json:"mine"`
+	// IsUserMade пользователь поставил лайк
+	IsUserMade bool `json:"isUserMade"`
+}
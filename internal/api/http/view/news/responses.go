@@ -0,0 +1,105 @@
+package news
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ViewsDateLayout формат дня в статистике просмотров
+const ViewsDateLayout = time.DateOnly
+
+// CreateNewsResponse ответ на создание новости
+type CreateNewsResponse struct {
+	ID uuid.UUID `json:"id"`
+}
+
+// UpdateNewsResponse ответ на изменение новости, ее статуса или флагов
+type UpdateNewsResponse struct {
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// SetNewsStatusRequest запрос на изменение статуса новости
+type SetNewsStatusRequest struct {
+	Status NewsStatus `json:"status"`
+	// Comment комментарий к переходу, сохраняется в истории
+	Comment string `json:"comment"`
+}
+
+// NewsStatusTransition запись истории переходов новости между статусами
+type NewsStatusTransition struct {
+	From      NewsStatus `json:"from"`
+	To        NewsStatus `json:"to"`
+	Author    Author     `json:"author"`
+	Comment   string     `json:"comment"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// NewsViewsStats статистика просмотров новости
+type NewsViewsStats struct {
+	// Total количество просмотров за все время
+	Total int             `json:"total"`
+	Days  []*NewsDayViews `json:"days"`
+}
+
+// NewsDayViews количество просмотров новости за день
+type NewsDayViews struct {
+	// Date день в формате ViewsDateLayout
+	Date  string `json:"date"`
+	Views int    `json:"views"`
+}
+
+// CategoryInfo категория новостей в административном разделе
+// TODO: когда устаканится контракт переделать на нормальную структуру
+type CategoryInfo struct {
+	ID        uuid.UUID  `json:"id"`
+	Title     string     `json:"title"`
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
+
+// NewsRevision версия новости в истории изменений
+type NewsRevision struct {
+	ID        uuid.UUID `json:"id"`
+	Author    Author    `json:"author"`
+	CreatedAt time.Time `json:"createdAt"`
+	// ChangedFields поля, измененные относительно предыдущей версии
+	ChangedFields []string `json:"changedFields"`
+	// RestoredFrom версия, из которой восстановлена новость
+	RestoredFrom *uuid.UUID `json:"restoredFrom"`
+}
+
+// NewsRevisionPreview версия новости с содержимым для предпросмотра
+type NewsRevisionPreview struct {
+	NewsRevision
+	News *NewsSnapshot `json:"news"`
+}
+
+// NewsSnapshot редактируемые поля новости в версии или черновике
+type NewsSnapshot struct {
+	Slug                   string          `json:"slug"`
+	Title                  string          `json:"title"`
+	ImageID                *uuid.UUID      `json:"titleImageId"`
+	CategoryID             *uuid.UUID      `json:"categoryId"`
+	ProviderOrganizationID *uuid.UUID      `json:"providerOrganizationId"`
+	ProviderProductID      *uuid.UUID      `json:"providerProductId"`
+	Properties             NewsProperties  `json:"flags"`
+	Content                json.RawMessage `json:"content"`
+	ParticipantsIDs        []uuid.UUID     `json:"participantsIds"`
+	PublishDate            *time.Time      `json:"publishDate"`
+	ReadingTime            int             `json:"readingTime"`
+}
+
+// NewsDraft автосохраненный черновик правок редактора
+type NewsDraft struct {
+	News *NewsSnapshot `json:"news"`
+	// BaseUpdatedAt время изменения новости, от которой редактор начал правки
+	BaseUpdatedAt *time.Time `json:"baseUpdatedAt"`
+	SavedAt       time.Time  `json:"savedAt"`
+}
+
+// RestoreRevisionRequest запрос на восстановление версии новости
+type RestoreRevisionRequest struct {
+	// UpdatedAt время изменения новости, которую видел редактор
+	UpdatedAt *time.Time `json:"updatedAt"`
+}
@@ -1,6 +1,10 @@
 package view
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type SurveyAnswer struct {
 	ID            uuid.UUID  `json:"id"`
@@ -11,15 +15,24 @@ type SurveyAnswer struct {
 }
 
 type NewSurveyAnswer struct {
-	ChosenVariant uuid.UUID `json:"chosen_variant_id"`
-	Content       string    `json:"content,omitempty"`
+	QuestionId    *uuid.UUID `json:"question_id,omitempty"`
+	ChosenVariant uuid.UUID  `json:"chosen_variant_id"`
+	Content       string     `json:"content,omitempty"`
 }
 
+// NewSurveyAnswers ответы на опрос. Респондент определяется по сессии
 type NewSurveyAnswers struct {
-	RespondentId *uuid.UUID         `json:"respondent_id,omitempty"`
-	Answers      []*NewSurveyAnswer `json:"answers"`
+	SurveyId uuid.UUID          `json:"survey_id"`
+	Answers  []*NewSurveyAnswer `json:"answers"`
 }
 
 type SurveyAnswerInfo struct {
 	ID uuid.UUID `json:"id"`
 }
+
+// SurveyCompletion статус прохождения опроса текущим пользователем
+type SurveyCompletion struct {
+	SurveyID    uuid.UUID  `json:"survey_id"`
+	Completed   bool       `json:"completed"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
@@ -0,0 +1,7 @@
+package view
+
+import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+const (
+	ErrSurveyCompleted diterrors.StringError = "Опрос уже пройден"
+)
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"github.com/google/uuid"
+)
+
+// SurveyResultsFilter фильтр ответов по респондентам из параметров запроса portal_id и organization_id.
+// Каждый параметр можно указать несколько раз
+type SurveyResultsFilter struct {
+	PortalIDs       []string
+	OrganizationIDs []string
+}
+
+// SurveyResults статистика ответов на опрос
+type SurveyResults struct {
+	SurveyID     uuid.UUID                `json:"survey_id"`
+	AnswersCount int                      `json:"answers_count"`
+	Questions    []*SurveyQuestionResults `json:"questions"`
+}
+
+// SurveyQuestionResults статистика ответов на вопрос.
+// Ответы с произвольным текстом выдаются постранично отдельным запросом
+type SurveyQuestionResults struct {
+	QuestionID       uuid.UUID               `json:"question_id"`
+	Text             string                  `json:"text"`
+	Type             string                  `json:"type" enums:"invalid,text,inputNumber,checkbox,radio,checkboxImg,radioImg"`
+	AnswersCount     int                     `json:"answers_count"`
+	TextAnswersCount int                     `json:"text_answers_count"`
+	Variants         []*SurveyVariantResults `json:"variants,omitempty"`
+	Numbers          *SurveyNumberStats      `json:"numbers,omitempty"`
+}
+
+// SurveyVariantResults статистика выбора варианта ответа, percent - доля от ответов на вопрос
+type SurveyVariantResults struct {
+	VariantID uuid.UUID `json:"variant_id"`
+	Text      string    `json:"text"`
+	Count     int       `json:"count"`
+	Percent   float64   `json:"percent"`
+}
+
+// SurveyNumberStats статистика ответов на вопрос inputNumber
+type SurveyNumberStats struct {
+	Count  int     `json:"count"`
+	Min    int     `json:"min"`
+	Max    int     `json:"max"`
+	Mean   float64 `json:"mean"`
+	Median float64 `json:"median"`
+}
+
+// SurveyTextAnswers страница ответов с произвольным текстом.
+// Следующая страница запрашивается с параметром last_id
+type SurveyTextAnswers struct {
+	Answers []*SurveyAnswer `json:"answers"`
+	LastID  *uuid.UUID      `json:"last_id,omitempty"`
+}
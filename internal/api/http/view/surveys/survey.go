@@ -23,7 +23,16 @@ type Survey struct {
 	UpdatedAt      *time.Time          `json:"updated_at,omitempty"`
 	DeletedAt      *time.Time          `json:"deleted_at,omitempty"`
 	Respondents    []*SurveyRespondent `json:"respondents,omitempty"`
-	Questions      []*SurveyQuestion   `json:"questions,omitempty"`
+	SurveyTargeting
+	Questions []*SurveyQuestion `json:"questions,omitempty"`
+}
+
+// SurveyTargeting круг респондентов опроса помимо явно указанных пользователей.
+// Учитывается только для авторизованных респондентов
+type SurveyTargeting struct {
+	RespondentPortalIDs       []int       `json:"respondent_portal_ids,omitempty"`
+	RespondentOrganizationIDs []uuid.UUID `json:"respondent_organization_ids,omitempty"`
+	RespondentSubdivisionIDs  []uuid.UUID `json:"respondent_subdivision_ids,omitempty"`
 }
 
 type SurveyRespondent struct {
@@ -78,9 +87,10 @@ type NewSurvey struct {
 	// * 0 - Неизвестный респондент.
 	// * 1 - Анонимный респондент.
 	// * 2 - Авторизованный респондент.
-	RespondentType int                  `json:"respondent_type" enums:"0,1,2"`
-	Respondents    []*SurveyRespondent  `json:"respondents,omitempty"`
-	Questions      []*NewSurveyQuestion `json:"questions,omitempty"`
+	RespondentType int                 `json:"respondent_type" enums:"0,1,2"`
+	Respondents    []*SurveyRespondent `json:"respondents,omitempty"`
+	SurveyTargeting
+	Questions []*NewSurveyQuestion `json:"questions,omitempty"`
 }
 
 type NewSurveyQuestion struct {
@@ -142,6 +152,21 @@ type SurveyInfo struct {
 	Questions         []*SurveyQuestionInfo `json:"questions,omitempty"`
 }
 
+// MySurveys опросы, которые текущий пользователь может пройти
+type MySurveys struct {
+	// Количество опросов, для отображения на главной странице
+	Count   int         `json:"count"`
+	Surveys []*MySurvey `json:"surveys"`
+}
+
+type MySurvey struct {
+	ID                uuid.UUID `json:"id"`
+	Title             string    `json:"title"`
+	Description       string    `json:"description"`
+	ActivePeriodStart time.Time `json:"active_period_start"`
+	ActivePeriodEnd   time.Time `json:"active_period_end"`
+}
+
 type SurveyQuestionInfo struct {
 	ID   uuid.UUID `json:"id"`
 	Text string    `json:"text"`
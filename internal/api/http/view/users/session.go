@@ -0,0 +1,18 @@
+package users
+
+import "time"
+
+// Session сессия пользователя
+type Session struct {
+	ID           string    `json:"id"`
+	UserAgent    string    `json:"userAgent"`
+	IP           string    `json:"ip"`
+	CreatedAt    time.Time `json:"createdAt"`
+	LastActiveAt time.Time `json:"lastActiveAt"`
+	Current      bool      `json:"current"`
+}
+
+// RevokeSessionsResponse результат отзыва сессий
+type RevokeSessionsResponse struct {
+	Revoked int `json:"revoked"`
+}
@@ -33,13 +33,11 @@ import (
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	timeUtils "git.mos.ru/buch-cloud/moscow-team-2.0/build/time-utils.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/tree-alive.git"
-	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/prometheus/client_golang/prometheus"
 	redisPkg "github.com/redis/go-redis/v9"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 
 	complexesfacadev1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/portalsfacade/complexes/v1"
 	portalsfacadev1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/portalsfacade/portals/v1"
@@ -54,11 +52,13 @@ import (
 	mapperEmployees "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/employees"
 	mapperEmployeesSearch "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/employees-search"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/files"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/interceptors"
 	mapperNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/news"
 	mapperPortals "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/portals"
 	mapperPortalsV2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/portalsv2"
 	mapperProxy "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/proxy"
 	mapperSurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/surveys"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/transport"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	repositoryAnalytics "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/analytics"
@@ -71,8 +71,10 @@ import (
 	repositoriesPortal "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/portal"
 	repositoryPortalsv2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/portalsv2"
 	repositoryProxy "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/proxy"
+	repositoriesSession "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/session"
 	repositoriesSurvey "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories/survey"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/analytics"
+	usecaseHealth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/health"
 	usecaseAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/auth"
 	usecaseBanners "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/banners"
 	usecaseEmployees "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/employees"
@@ -114,6 +116,10 @@ type app struct {
 	serviceHTTPServer Server
 	httpServer        Server
 	redisClient       redisPkg.UniversalClient
+	dependencies      Dependencies
+	health            Health
+	newsScheduler     Scheduler
+	newsViewsCounter  Scheduler
 	tree              tree.Tree
 	stop              context.CancelFunc
 	logger            ditzap.Logger
@@ -153,21 +159,51 @@ func (a *app) Run(ctx context.Context) {
 	a.tree = tree.NewTree()
 	a.tree.Alive()
 
+	a.health = usecaseHealth.NewHealthUseCase(
+		strings.Split(a.config.Readiness.Required, ","),
+		a.config.Readiness.CheckTimeout,
+		a.logger,
+	)
+
+	var (
+		accessChecker        usecaseAuth.AccessChecker
+		accessListInteractor service.AccessListInfoProvider
+	)
+	if strings.TrimSpace(a.config.AccessListFile) != "" {
+		accessListUseCase := usecaseAuth.NewAccessListUseCase(
+			repositoriesAuth.NewAccessListRepository(a.config.AccessListFile),
+			a.logger,
+		)
+		if alErr := accessListUseCase.Reload(appCtx); alErr != nil {
+			a.logger.Error("can't load access list", zap.Error(alErr))
+			return
+		}
+		if a.config.AccessListReloadInterval > 0 {
+			go accessListUseCase.Watch(appCtx, a.config.AccessListReloadInterval)
+		}
+		accessChecker = accessListUseCase
+		accessListInteractor = accessListUseCase
+		a.health.Register("access-list", accessListUseCase.Check)
+	}
+
+	interceptors.InitMetrics()
+	a.dependencies = interceptors.NewDependencies(a.health.IsRequired)
+
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
 	serviceHttpBranch := a.tree.GrowBranch("service-http-server")
-	go a.startServiceServer(appCtx, serviceHttpBranch, wg)
+	go a.startServiceServer(appCtx, serviceHttpBranch, wg, accessListInteractor)
 
 	tu := timeUtils.NewTimeUtils()
 
-	var accessList auth.AccessList
-	if strings.TrimSpace(a.config.AccessListFile) != "" {
-		var alErr error
-		accessList, alErr = a.readAccessList()
-		if alErr != nil {
-			return
-		}
-		httpApi.SharedFields.AccessList = accessList
+	adminPolicy, apErr := a.readAdminPolicy()
+	if apErr != nil {
+		return
+	}
+
+	tokenVerifier, tvErr := a.tokenVerifier()
+	if tvErr != nil {
+		return
 	}
 
 	// Подключение к зависимым gRPC-сервисам
@@ -260,7 +296,10 @@ func (a *app) Run(ctx context.Context) {
 		a.logger.Error("can't parse WEB_AUTH_URL parameter", zap.String("url", a.config.WebAuthURL), zap.Error(err))
 		return
 	}
-	authRepository := repositoriesAuth.NewAuthRepository(authAPIClient, authMapper, *callbackURL, a.config.AppName, a.config.TTL.AccessToken, a.config.TTL.RefreshToken, tu, a.logger)
+	var authRepository usecaseAuth.Repository = repositoriesAuth.NewAuthRepository(authAPIClient, authMapper, *callbackURL, a.config.AppName, a.config.TTL.AccessToken, a.config.TTL.RefreshToken, tu, a.logger)
+	if a.config.SessionCache.Enabled {
+		authRepository = repositoriesSession.NewSessionRepository(authRepository, a.sessionCache(appCtx), a.config.SessionCache.TTL, a.logger)
+	}
 	redirectSessionRepository := repositoriesAuth.NewRedirectSessionRepository(redirectSessionAPIClient, redirectSessionMapper, a.logger)
 
 	proxyRepository := repositoryProxy.NewProxyRepository(bannerAPIClient, eventsAPIClient, proxyMapper, a.logger)
@@ -278,15 +317,68 @@ func (a *app) Run(ctx context.Context) {
 	bannersRepository := repositoryBanners.NewBannersRepository(bannersAPIClient, bannersMapper, a.logger)
 	commentsRepository := repositoryNews.NewCommentsRepository(commentsAPIClient, commentsMapper, newsMapper)
 
+	// Хранилища состояния
+	redisClient, keyPrefix := a.redis(appCtx), a.config.Redis.KeyPrefix
+	activityRepository := repositoriesSession.NewRedisActivityRepository(redisClient, keyPrefix)
+	lastPortalRepository := repositoriesSession.NewRedisLastPortalRepository(redisClient, keyPrefix, a.config.TTL.LastPortal)
+	surveysCompletionsRepository := repositoriesSurvey.NewRedisCompletionsRepository(redisClient, keyPrefix)
+	newsHistoryRepository := repositoryNews.NewRedisHistoryRepository(redisClient, keyPrefix)
+	newsRevisionsRepository := repositoryNews.NewRedisRevisionsRepository(redisClient, keyPrefix, a.config.NewsRevisions.Limit)
+	newsDraftsRepository := repositoryNews.NewRedisDraftsRepository(redisClient, keyPrefix, a.config.NewsRevisions.DraftTTL)
+	newsReactionsRepository := repositoryNews.NewRedisReactionsRepository(redisClient, keyPrefix)
+	newsViewsRepository := repositoryNews.NewRedisViewsRepository(redisClient, keyPrefix)
+	newsCommentReportsRepository := repositoryNews.NewRedisCommentReportsRepository(redisClient, keyPrefix)
+	newsCommentThreadsRepository := repositoryNews.NewRedisCommentThreadsRepository(redisClient, keyPrefix)
+	newsCommentModerationRepository := repositoryNews.NewRedisCommentModerationRepository(redisClient, keyPrefix)
+	newsLocker := repositoryNews.NewRedisNewsLocker(redisClient, keyPrefix)
+	// Аренда нужна, чтобы одну новость не публиковали несколько реплик одновременно
+	newsSchedulerLease := repositoryNews.NewRedisSchedulerLease(redisClient, keyPrefix)
+
 	// Интеракторы
 	portalsV2Interactor := usecasePortalsv2.NewPortalsUseCase(portalsFacadePortalRepository)
 	complexesV2Interactor := usecasePortalsv2.NewComplexesUseCase(portalsFacadeComplexesRepository)
 
+	authorizationInteractor := usecaseAuth.NewAuthorizationUseCase(adminPolicy, a.logger)
+
 	surveysInteractor := usecaseSurveys.NewSurveysUseCase(surveysRepository)
-	surveysAnswersInteractor := usecaseSurveys.NewAnswersUseCase(surveysAnswersRepository)
+	surveysAnswersInteractor := usecaseSurveys.NewAnswersUseCase(
+		surveysAnswersRepository,
+		surveysRepository,
+		surveysCompletionsRepository,
+		employeesRepository,
+		a.config.Surveys.RespondentSalt,
+		a.logger,
+	)
 	surveysImagesInteractor := usecaseSurveys.NewImagesUseCase(surveysImagesRepository)
+	surveysAdminInteractor := usecaseSurveys.NewSurveysAdminUseCase(surveysRepository, authorizationInteractor)
+	surveysResultsInteractor := usecaseSurveys.NewSurveysResultsUseCase(
+		surveysRepository,
+		surveysAnswersRepository,
+		authorizationInteractor,
+	)
+	surveysMyInteractor := usecaseSurveys.NewMySurveysUseCase(
+		surveysRepository,
+		surveysCompletionsRepository,
+		employeesRepository,
+		a.config.Surveys.RespondentSalt,
+		a.logger,
+	)
 
-	authInteractor := usecaseAuth.NewAuthUseCase(authRepository, a.logger, accessList)
+	activityInteractor := usecaseAuth.NewActivityUseCase(
+		activityRepository,
+		a.config.SessionActivity.IdleTimeout,
+		a.config.SessionActivity.SyncInterval,
+		a.logger,
+	)
+	authInteractor := usecaseAuth.NewAuthUseCase(
+		authRepository,
+		a.logger,
+		accessChecker,
+		tokenVerifier,
+		activityInteractor,
+		portalsPortalRepository,
+		lastPortalRepository,
+	)
 	redirectSessionInteractor := usecaseAuth.NewRedirectSessionInteractor(redirectSessionRepository, a.config.WebAuthRedirectURI)
 
 	proxyInteractor := usecaseProxy.NewProxyInteractor(proxyRepository)
@@ -300,14 +392,82 @@ func (a *app) Run(ctx context.Context) {
 
 	analyticsInteractor := analytics.NewAnalyticsInteractor(analyticsRepository, a.logger)
 
+	newsViewsCounter := usecaseNews.NewViewsCounter(
+		newsViewsRepository,
+		a.config.NewsViews.DedupWindow,
+		a.config.NewsViews.FlushInterval,
+		a.config.NewsViews.BatchSize,
+		a.logger,
+	)
+	a.newsViewsCounter = newsViewsCounter
+	go newsViewsCounter.Run(appCtx)
+
+	newsVisibility := usecaseNews.NewVisibilityEvaluator(
+		newsCategoryRepository,
+		employeesRepository,
+		portalsFacadeComplexesRepository,
+		a.logger,
+	)
 	newsCategoryInteractor := usecaseNews.NewCategoryInteractor(newsCategoryRepository, employeesRepository, a.logger)
-	newsAdminInteractor := usecaseNews.NewNewsAdminInteractor(newsRepository, employeesRepository, a.logger)
+	newsAdminInteractor := usecaseNews.NewNewsAdminInteractor(
+		newsRepository,
+		employeesRepository,
+		newsHistoryRepository,
+		newsViewsRepository,
+		newsCategoryRepository,
+		newsRevisionsRepository,
+		newsDraftsRepository,
+		newsLocker,
+		authorizationInteractor,
+		a.config.NewsWorkflow.TwoPersonReview,
+		a.logger,
+	)
 
-	newsInteractor := usecaseNews.NewNewsInteractor(newsRepository, employeesRepository, a.logger)
-	newsCommentsInteractor := usecaseNews.NewCommentInteractor(commentsRepository, employeesRepository, a.logger)
+	// Публикация новостей по расписанию
+	usecaseNews.InitMetrics()
+	newsScheduler := usecaseNews.NewPublishScheduler(
+		newsRepository,
+		newsHistoryRepository,
+		newsSchedulerLease,
+		newsLocker,
+		a.config.NewsScheduler.Interval,
+		a.config.NewsScheduler.LeaseTTL,
+		a.config.NewsScheduler.RetryBackoff,
+		a.config.NewsWorkflow.TwoPersonReview,
+		a.logger,
+	)
+	a.newsScheduler = newsScheduler
+	go newsScheduler.Run(appCtx)
+
+	newsInteractor := usecaseNews.NewNewsInteractor(
+		newsRepository,
+		employeesRepository,
+		newsReactionsRepository,
+		newsViewsCounter,
+		newsVisibility,
+		a.logger,
+	)
+	newsCommentsInteractor := usecaseNews.NewCommentInteractor(
+		commentsRepository,
+		newsRepository,
+		employeesRepository,
+		newsCommentReportsRepository,
+		newsCommentThreadsRepository,
+		newsCommentModerationRepository,
+		newsVisibility,
+		a.config.NewsComments.EditWindow,
+		a.logger,
+	)
 
 	bannersInteractor := usecaseBanners.NewBannersInteractor(bannersRepository, a.logger)
 
+	// Все проверки зависимостей зарегистрированы, неизвестные имена в READINESS_REQUIRED - ошибка конфигурации
+	if vrErr := a.health.ValidateRequired(); vrErr != nil {
+		a.logger.Error("invalid readiness configuration", zap.Error(vrErr))
+		return
+	}
+	go a.health.WatchRequired(appCtx, a.config.Readiness.GracePeriod, a.stop)
+
 	// Старт HTTP-сервера
 	wg.Add(1)
 	httpBranch := a.tree.GrowBranch("http-server")
@@ -320,11 +480,16 @@ func (a *app) Run(ctx context.Context) {
 		surveysInteractor,
 		surveysAnswersInteractor,
 		surveysImagesInteractor,
+		surveysAdminInteractor,
+		surveysResultsInteractor,
+		surveysMyInteractor,
 		authInteractor,
+		authorizationInteractor,
 		proxyInteractor,
 		redirectSessionInteractor,
 		employeesSearchInteractor,
 		usersInteractor,
+		activityInteractor,
 		filesInteractor,
 		employeesInteractor,
 		analyticsInteractor,
@@ -357,10 +522,21 @@ func (a *app) GracefulShutdown(c context.Context) (err error) {
 			err = errors.Join(err, fmt.Errorf("can't shutdown service http-server: %w", serviceErr))
 		}
 	}
+	// Планировщик освобождает аренду, а счетчик сохраняет накопленные просмотры в Redis,
+	// поэтому они останавливаются до закрытия клиента
+	if a.newsScheduler != nil {
+		if schedulerErr := a.newsScheduler.Stop(ctx); schedulerErr != nil {
+			err = errors.Join(err, fmt.Errorf("can't stop news scheduler: %w", schedulerErr))
+		}
+	}
+	if a.newsViewsCounter != nil {
+		if viewsErr := a.newsViewsCounter.Stop(ctx); viewsErr != nil {
+			err = errors.Join(err, fmt.Errorf("can't stop news views counter: %w", viewsErr))
+		}
+	}
 	if a.redisClient != nil {
-		status := a.redisClient.Shutdown(ctx)
-		if status.Err() != nil {
-			err = errors.Join(err, fmt.Errorf("can't shutdown service redis: %w", status.Err()))
+		if redisErr := a.redisClient.Close(); redisErr != nil {
+			err = errors.Join(err, fmt.Errorf("can't close redis client: %w", redisErr))
 		}
 	}
 	return
@@ -395,83 +571,79 @@ func (a *app) connectToProviders(
 		dialErr error
 	)
 	grpc_prometheus.EnableClientHandlingTimeHistogram()
-	dialOpts := []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(grpc_prometheus.UnaryClientInterceptor)),
-		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(grpc_prometheus.StreamClientInterceptor)),
-	}
+	transport.InitMetrics()
 
 	// Инициализация подключения к сервису portal
-	portalsConn, dialErr = a.dial(ctx, endpoints.PortalsEndpoint, false, dialOpts...)
+	portalsConn, dialErr = a.dial(ctx, "portals", endpoints.PortalsEndpoint, endpoints.PortalsClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to posrtals service: %w", dialErr)
 		return
 	}
 
 	// Инициализация подключения к сервису portal v2
-	portalsV2Conn, dialErr = a.dial(ctx, endpoints.PortalsV2Endpoint, false, dialOpts...)
+	portalsV2Conn, dialErr = a.dial(ctx, "portals-v2", endpoints.PortalsV2Endpoint, endpoints.PortalsV2Client)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to posrtals v2 service: %w", dialErr)
 		return
 	}
 
 	// Инициализация подключения к сервису survey
-	surveysConn, dialErr = a.dial(ctx, endpoints.SurveysEndpoint, false, dialOpts...)
+	surveysConn, dialErr = a.dial(ctx, "surveys", endpoints.SurveysEndpoint, endpoints.SurveysClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to surveys service: %w", dialErr)
 		return
 	}
 
 	// Инициализация подключения к сервису auth-facade
-	authConn, dialErr = a.dial(ctx, endpoints.AuthFacadeEndpoint, false, dialOpts...)
+	authConn, dialErr = a.dial(ctx, "auth-facade", endpoints.AuthFacadeEndpoint, endpoints.AuthFacadeClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to auth-facade service: %w", dialErr)
 		return
 	}
 
-	proxyConn, dialErr = a.dial(ctx, endpoints.ProxyFacadeEndpoint, false, dialOpts...)
+	proxyConn, dialErr = a.dial(ctx, "proxy-facade", endpoints.ProxyFacadeEndpoint, endpoints.ProxyFacadeClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to banners service: %w", dialErr)
 		return
 	}
 
-	employeesSearchConn, dialErr = a.dial(ctx, endpoints.EmployeesSearchEndpoint, false, dialOpts...)
+	employeesSearchConn, dialErr = a.dial(ctx, "employees-search", endpoints.EmployeesSearchEndpoint, endpoints.EmployeesSearchClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to employees-search service: %w", dialErr)
 		return
 	}
 
-	filesConn, dialErr = a.dial(ctx, endpoints.FilesEndpoint, false, dialOpts...)
+	filesConn, dialErr = a.dial(ctx, "files", endpoints.FilesEndpoint, endpoints.FilesClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to files service: %w", dialErr)
 		return
 	}
 
-	employeesConn, dialErr = a.dial(ctx, endpoints.EmployeesEndpoint, false, dialOpts...)
+	employeesConn, dialErr = a.dial(ctx, "employees", endpoints.EmployeesEndpoint, endpoints.EmployeesClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to employees service: %w", dialErr)
 		return
 	}
 
-	analyticsConn, dialErr = a.dial(ctx, endpoints.AnalyticsEndpoint, false, dialOpts...)
+	analyticsConn, dialErr = a.dial(ctx, "analytics", endpoints.AnalyticsEndpoint, endpoints.AnalyticsClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to analytics service: %w", dialErr)
 		return
 	}
 
-	portalsFacadeConn, dialErr = a.dial(ctx, endpoints.PortalsFacadeEndpoint, false, dialOpts...)
+	portalsFacadeConn, dialErr = a.dial(ctx, "portals-facade", endpoints.PortalsFacadeEndpoint, endpoints.PortalsFacadeClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to portals-facade service: %w", dialErr)
 		return
 	}
 
-	newsConn, dialErr = a.dial(ctx, endpoints.NewsFacadeEndpoint, false, dialOpts...)
+	newsConn, dialErr = a.dial(ctx, "news-facade", endpoints.NewsFacadeEndpoint, endpoints.NewsFacadeClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to news service: %w", dialErr)
 		return
 	}
 
-	bannersConn, dialErr = a.dial(ctx, endpoints.BannersEndpoint, false, dialOpts...)
+	bannersConn, dialErr = a.dial(ctx, "banners", endpoints.BannersEndpoint, endpoints.BannersClient)
 	if dialErr != nil {
 		err = fmt.Errorf("can't connect to banners service: %w", dialErr)
 		return
@@ -490,7 +662,12 @@ func (a *app) initMetrics() {
 	}
 }
 
-func (a *app) startServiceServer(c context.Context, serviceHttpBranch tree.Branch, wg *sync.WaitGroup) {
+func (a *app) startServiceServer(
+	c context.Context,
+	serviceHttpBranch tree.Branch,
+	wg *sync.WaitGroup,
+	accessList service.AccessListInfoProvider,
+) {
 	ctx, serverCancel := context.WithCancel(c)
 	defer func() {
 		if e := recover(); e != nil {
@@ -506,7 +683,7 @@ func (a *app) startServiceServer(c context.Context, serviceHttpBranch tree.Branc
 		BuildTime: ApplicationInfo.BuildTime,
 		Commit:    ApplicationInfo.Commit,
 		Release:   ApplicationInfo.Release,
-	})
+	}, accessList, a.dependencies, a.health)
 	err := a.serviceHTTPServer.Run(
 		context.WithValue(ctx, &service.ContextKeyServiceAddr, entity.MakeContextStringValue(a.config.ServiceHTTPHost)), //nolint:staticcheck
 	)
@@ -526,11 +703,16 @@ func (a *app) startHTTPServer(
 	surveysInteractor httpApi.SurveysSurveysInteractor,
 	surveysAnswersInteractor httpApi.SurveysAnswersInteractor,
 	surveysImagesInteractor httpApi.SurveysImagesInteractor,
+	surveysAdminInteractor httpApi.SurveysAdminInteractor,
+	surveysResultsInteractor httpApi.SurveysResultsInteractor,
+	surveysMyInteractor httpApi.SurveysMyInteractor,
 	authInteractor httpApi.AuthInteractor,
+	authorizationInteractor httpApi.AuthorizationInteractor,
 	proxyInteractor httpApi.ProxyInteractor,
 	redirectSessionInteractor httpApi.RedirectSessionInteractor,
 	employeesSearchesInteractor httpApi.EmployeesSearchUseCases,
 	usersInteractor httpApi.UsersInteractor,
+	sessionsInteractor httpApi.SessionsInteractor,
 	filesInteractor httpApi.FilesInteractor,
 	employeesInteractor httpApi.EmployeesUseCases,
 	analyticsInteractor httpApi.AnalyticsInteractor,
@@ -581,11 +763,16 @@ func (a *app) startHTTPServer(
 			surveysInteractor,
 			surveysAnswersInteractor,
 			surveysImagesInteractor,
+			surveysAdminInteractor,
+			surveysResultsInteractor,
+			surveysMyInteractor,
 			authInteractor,
+			authorizationInteractor,
 			proxyInteractor,
 			redirectSessionInteractor,
 			employeesSearchesInteractor,
 			usersInteractor,
+			sessionsInteractor,
 			filesInteractor,
 			employeesInteractor,
 			analyticsInteractor,
@@ -607,24 +794,89 @@ func (a *app) startHTTPServer(
 	}
 }
 
-func (a *app) readAccessList() (auth.AccessList, error) {
-	accessList := make(auth.AccessList, 0)
-	file, err := os.Open(a.config.AccessListFile)
+// redis клиент Redis, создается при первом обращении
+func (a *app) redis(ctx context.Context) redisPkg.UniversalClient {
+	if a.redisClient != nil {
+		return a.redisClient
+	}
+
+	a.redisClient = redisPkg.NewUniversalClient(&redisPkg.UniversalOptions{
+		Addrs:    strings.Split(a.config.Redis.Addrs, ","),
+		Username: a.config.Redis.Username,
+		Password: a.config.Redis.Password,
+		DB:       a.config.Redis.DB,
+	})
+	pingCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	if err := a.redisClient.Ping(pingCtx).Err(); err != nil {
+		a.logger.Warn("redis is unavailable", zap.Error(err))
+	}
+	a.health.Register("redis", func(ctx context.Context) error {
+		return a.redisClient.Ping(ctx).Err()
+	})
+	return a.redisClient
+}
+
+// sessionCache кэш сессий в Redis с резервным кэшем в памяти процесса на время недоступности Redis
+func (a *app) sessionCache(ctx context.Context) repositoriesSession.Cache {
+	repositoriesSession.InitMetrics()
+
+	return repositoriesSession.NewFallbackCache(
+		repositoriesSession.NewRedisCache(a.redis(ctx), a.config.Redis.KeyPrefix, a.config.SessionCache.TTL),
+		repositoriesSession.NewLRUCache(a.config.SessionCache.Size),
+		a.logger,
+	)
+}
+
+// tokenVerifier локальная проверка токенов доступа. Если ключи не заданы, токен проверяется только сервисом авторизации
+func (a *app) tokenVerifier() (usecaseAuth.TokenVerifier, error) {
+	var (
+		keys *usecaseAuth.TokenKeys
+		err  error
+	)
+	switch {
+	case a.config.JWT.JWKSFile != "":
+		var data []byte
+		if data, err = os.ReadFile(a.config.JWT.JWKSFile); err == nil {
+			keys, err = usecaseAuth.ParseJWKS(data)
+		}
+	case a.config.JWT.PublicKeyFile != "":
+		var data []byte
+		if data, err = os.ReadFile(a.config.JWT.PublicKeyFile); err == nil {
+			keys, err = usecaseAuth.ParsePEMKey(data)
+		}
+	case a.config.JWT.Secret != "":
+		keys = usecaseAuth.NewHMACKey(a.config.JWT.Secret)
+	}
+	if err != nil {
+		a.logger.Error("can't read access token keys", zap.Error(err))
+		return nil, fmt.Errorf("can't read access token keys")
+	}
+	if keys.Empty() {
+		a.logger.Warn("access token keys are not set, tokens are verified by auth service only and auth-only routes load the full session")
+		return nil, nil
+	}
+	return usecaseAuth.NewTokenVerifier(keys, a.config.JWT.Issuer, a.config.JWT.Leeway), nil
+}
+
+func (a *app) readAdminPolicy() (*auth.Policy, error) {
+	policy := &auth.Policy{}
+	file, err := os.Open(a.config.AdminPolicyFile)
 	if err != nil {
-		a.logger.Error("can't open access list file", zap.Error(err))
-		return nil, fmt.Errorf("can't open access list file")
+		a.logger.Error("can't open admin policy file", zap.Error(err))
+		return nil, fmt.Errorf("can't open admin policy file")
 	}
 	defer file.Close()
 
 	data, err := io.ReadAll(file)
 	if err != nil {
-		a.logger.Error("can't read access list file", zap.Error(err))
-		return nil, fmt.Errorf("can't read access list file")
+		a.logger.Error("can't read admin policy file", zap.Error(err))
+		return nil, fmt.Errorf("can't read admin policy file")
 	}
-	err = json.Unmarshal(data, &accessList)
-	if err != nil && !errors.Is(err, io.EOF) {
-		a.logger.Error("can't unmarshal access list file", zap.Error(err))
-		return nil, fmt.Errorf("can't unmarshal access list file")
+	err = json.Unmarshal(data, policy)
+	if err != nil {
+		a.logger.Error("can't unmarshal admin policy file", zap.Error(err))
+		return nil, fmt.Errorf("can't unmarshal admin policy file")
 	}
-	return accessList, nil
+	return policy, nil
 }
@@ -12,8 +12,13 @@ package app
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	interceptors "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/interceptors"
+	entity "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	health "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/health"
 	gomock "go.uber.org/mock/gomock"
+	grpc "google.golang.org/grpc"
 )
 
 // MockServer is a mock of Server interface.
@@ -105,3 +110,196 @@ func (mr *MockSyncMockRecorder) Sync(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockSync)(nil).Sync), ctx)
 }
+
+// MockDependencies is a mock of Dependencies interface.
+type MockDependencies struct {
+	ctrl     *gomock.Controller
+	recorder *MockDependenciesMockRecorder
+	isgomock struct{}
+}
+
+// MockDependenciesMockRecorder is the mock recorder for MockDependencies.
+type MockDependenciesMockRecorder struct {
+	mock *MockDependencies
+}
+
+// NewMockDependencies creates a new mock instance.
+func NewMockDependencies(ctrl *gomock.Controller) *MockDependencies {
+	mock := &MockDependencies{ctrl: ctrl}
+	mock.recorder = &MockDependenciesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDependencies) EXPECT() *MockDependenciesMockRecorder {
+	return m.recorder
+}
+
+// Dependencies mocks base method.
+func (m *MockDependencies) Dependencies() []*entity.DependencyStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dependencies")
+	ret0, _ := ret[0].([]*entity.DependencyStatus)
+	return ret0
+}
+
+// Dependencies indicates an expected call of Dependencies.
+func (mr *MockDependenciesMockRecorder) Dependencies() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dependencies", reflect.TypeOf((*MockDependencies)(nil).Dependencies))
+}
+
+// DialOptions mocks base method.
+func (m *MockDependencies) DialOptions(name, target string, cfg interceptors.Config) []grpc.DialOption {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DialOptions", name, target, cfg)
+	ret0, _ := ret[0].([]grpc.DialOption)
+	return ret0
+}
+
+// DialOptions indicates an expected call of DialOptions.
+func (mr *MockDependenciesMockRecorder) DialOptions(name, target, cfg any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DialOptions", reflect.TypeOf((*MockDependencies)(nil).DialOptions), name, target, cfg)
+}
+
+// MockHealth is a mock of Health interface.
+type MockHealth struct {
+	ctrl     *gomock.Controller
+	recorder *MockHealthMockRecorder
+	isgomock struct{}
+}
+
+// MockHealthMockRecorder is the mock recorder for MockHealth.
+type MockHealthMockRecorder struct {
+	mock *MockHealth
+}
+
+// NewMockHealth creates a new mock instance.
+func NewMockHealth(ctrl *gomock.Controller) *MockHealth {
+	mock := &MockHealth{ctrl: ctrl}
+	mock.recorder = &MockHealthMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHealth) EXPECT() *MockHealthMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockHealth) Check(ctx context.Context) (bool, []*entity.CheckResult) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", ctx)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].([]*entity.CheckResult)
+	return ret0, ret1
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockHealthMockRecorder) Check(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockHealth)(nil).Check), ctx)
+}
+
+// IsRequired mocks base method.
+func (m *MockHealth) IsRequired(name string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRequired", name)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsRequired indicates an expected call of IsRequired.
+func (mr *MockHealthMockRecorder) IsRequired(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRequired", reflect.TypeOf((*MockHealth)(nil).IsRequired), name)
+}
+
+// Register mocks base method.
+func (m *MockHealth) Register(name string, fn health.CheckFunc) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", name, fn)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockHealthMockRecorder) Register(name, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockHealth)(nil).Register), name, fn)
+}
+
+// ValidateRequired mocks base method.
+func (m *MockHealth) ValidateRequired() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateRequired")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateRequired indicates an expected call of ValidateRequired.
+func (mr *MockHealthMockRecorder) ValidateRequired() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateRequired", reflect.TypeOf((*MockHealth)(nil).ValidateRequired))
+}
+
+// WatchRequired mocks base method.
+func (m *MockHealth) WatchRequired(ctx context.Context, gracePeriod time.Duration, stop func()) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "WatchRequired", ctx, gracePeriod, stop)
+}
+
+// WatchRequired indicates an expected call of WatchRequired.
+func (mr *MockHealthMockRecorder) WatchRequired(ctx, gracePeriod, stop any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchRequired", reflect.TypeOf((*MockHealth)(nil).WatchRequired), ctx, gracePeriod, stop)
+}
+
+// MockScheduler is a mock of Scheduler interface.
+type MockScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerMockRecorder
+	isgomock struct{}
+}
+
+// MockSchedulerMockRecorder is the mock recorder for MockScheduler.
+type MockSchedulerMockRecorder struct {
+	mock *MockScheduler
+}
+
+// NewMockScheduler creates a new mock instance.
+func NewMockScheduler(ctrl *gomock.Controller) *MockScheduler {
+	mock := &MockScheduler{ctrl: ctrl}
+	mock.recorder = &MockSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduler) EXPECT() *MockSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Run mocks base method.
+func (m *MockScheduler) Run(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Run", ctx)
+}
+
+// Run indicates an expected call of Run.
+func (mr *MockSchedulerMockRecorder) Run(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Run", reflect.TypeOf((*MockScheduler)(nil).Run), ctx)
+}
+
+// Stop mocks base method.
+func (m *MockScheduler) Stop(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockSchedulerMockRecorder) Stop(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockScheduler)(nil).Stop), ctx)
+}
@@ -19,6 +19,7 @@ func Test_app_GracefulShutdown(t *testing.T) {
 		config     *config.Config
 		sqlmock    sqlmock.Sqlmock
 		httpServer *http.MockServer
+		scheduler  *MockScheduler
 		logger     *ditzap.MockLogger
 	}
 	type args struct {
@@ -39,10 +40,24 @@ func Test_app_GracefulShutdown(t *testing.T) {
 			want: func(a args, f fields) error {
 				ctx := context.WithoutCancel(a.ctx)
 				f.httpServer.EXPECT().Shutdown(ctx).Return(testErr)
+				f.scheduler.EXPECT().Stop(ctx).Return(nil)
 				f.sqlmock.ExpectClose()
 				return fmt.Errorf("can't shutdown http-server: %w", testErr)
 			},
 		},
+		{
+			name: "news scheduler stop error",
+			args: args{
+				ctx: ctx,
+			},
+			want: func(a args, f fields) error {
+				ctx := context.WithoutCancel(a.ctx)
+				f.httpServer.EXPECT().Shutdown(ctx).Return(nil)
+				f.scheduler.EXPECT().Stop(ctx).Return(testErr)
+				f.sqlmock.ExpectClose()
+				return fmt.Errorf("can't stop news scheduler: %w", testErr)
+			},
+		},
 		{
 			name: "correct",
 			args: args{
@@ -51,6 +66,7 @@ func Test_app_GracefulShutdown(t *testing.T) {
 			want: func(a args, f fields) error {
 				ctx := context.WithoutCancel(a.ctx)
 				f.httpServer.EXPECT().Shutdown(ctx).Return(nil)
+				f.scheduler.EXPECT().Stop(ctx).Return(nil)
 				f.sqlmock.ExpectClose().WillReturnError(nil)
 				return nil
 			},
@@ -66,11 +82,13 @@ func Test_app_GracefulShutdown(t *testing.T) {
 			f := fields{
 				sqlmock:    mock,
 				httpServer: http.NewMockServer(ctrl),
+				scheduler:  NewMockScheduler(ctrl),
 				logger:     ditzap.NewMockLogger(ctrl),
 			}
 			wantErr := tt.want(tt.args, f)
 			a := NewApp(f.config, nil, f.logger)
 			a.httpServer = f.httpServer
+			a.newsScheduler = f.scheduler
 
 			err = a.GracefulShutdown(tt.args.ctx)
 			if wantErr != nil {
@@ -8,14 +8,23 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/cmd/web-api/config"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/interceptors"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/transport"
 )
 
-func (a *app) dial(_ context.Context, target string, required bool, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+// dial подключение к сервису name. Состояние подключения учитывается в проверке готовности приложения
+func (a *app) dial(ctx context.Context, name, target string, cfg config.GRPCClient) (*grpc.ClientConn, error) {
 	var (
 		cc  *grpc.ClientConn
 		err error
 	)
 
+	opts, err := a.dialOptions(ctx, name, target, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("can't create client [%s]: %w", target, err)
+	}
 	cc, err = grpc.NewClient(target, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("can't create client [%s]: %w", target, err)
@@ -33,11 +42,37 @@ func (a *app) dial(_ context.Context, target string, required bool, opts ...grpc
 		}()
 		if cc.GetState() != connectivity.Ready && cc.GetState() != connectivity.Idle {
 			a.logger.Error("can't connect to " + target)
-			if required {
-				a.stop()
-			}
 		}
 	})
+	a.health.Register(name, transport.ConnectivityCheck(cc))
 
 	return cc, nil
 }
+
+// dialOptions опции подключения к сервису: учетные данные TLS и перехватчики с настройками клиента сервиса.
+//
+//	Сертификаты перечитываются при изменении файлов до завершения ctx
+func (a *app) dialOptions(ctx context.Context, name, target string, cfg config.GRPCClient) ([]grpc.DialOption, error) {
+	creds, err := transport.NewCredentials(name, transport.Config{
+		Insecure:   cfg.Insecure,
+		CAFile:     cfg.CAFile,
+		CertFile:   cfg.CertFile,
+		KeyFile:    cfg.KeyFile,
+		ServerName: cfg.ServerName,
+	}, a.logger)
+	if err != nil {
+		return nil, err
+	}
+	go creds.Watch(ctx, a.config.Endpoints.CertReloadInterval)
+
+	return append(
+		[]grpc.DialOption{grpc.WithTransportCredentials(creds.TransportCredentials())},
+		a.dependencies.DialOptions(name, target, interceptors.Config{
+			Timeout:            cfg.Timeout,
+			MaxRetries:         cfg.MaxRetries,
+			RetryBackoff:       cfg.RetryBackoff,
+			BreakerFailures:    cfg.BreakerFailures,
+			BreakerOpenTimeout: cfg.BreakerOpenTimeout,
+		})...,
+	), nil
+}
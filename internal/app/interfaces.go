@@ -2,6 +2,13 @@ package app
 
 import (
 	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/client/grpc/interceptors"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	usecaseHealth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase/health"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./app_mock.go -package=app
@@ -14,3 +21,26 @@ type Server interface {
 type Sync interface {
 	Sync(ctx context.Context) error
 }
+
+// Dependencies перехватчики клиентов зависимых сервисов и состояние их выключателей
+type Dependencies interface {
+	DialOptions(name, target string, cfg interceptors.Config) []grpc.DialOption
+	Dependencies() []*entity.DependencyStatus
+}
+
+// Health проверки готовности зависимостей
+type Health interface {
+	Register(name string, fn usecaseHealth.CheckFunc)
+	// IsRequired зависимость обязательна для готовности приложения
+	IsRequired(name string) bool
+	// ValidateRequired проверяет, что для всех обязательных зависимостей зарегистрированы проверки
+	ValidateRequired() error
+	Check(ctx context.Context) (bool, []*entity.CheckResult)
+	WatchRequired(ctx context.Context, gracePeriod time.Duration, stop func())
+}
+
+// Scheduler фоновая задача, запускаемая в Run и останавливаемая в GracefulShutdown
+type Scheduler interface {
+	Run(ctx context.Context)
+	Stop(ctx context.Context) error
+}
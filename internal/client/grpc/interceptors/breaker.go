@@ -0,0 +1,136 @@
+package interceptors
+
+import (
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+type breaker struct {
+	name        string
+	target      string
+	threshold   int
+	openTimeout time.Duration
+	now         func() time.Time
+
+	mu       sync.Mutex
+	state    string
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewBreaker автоматический выключатель (circuit breaker) сервиса.
+//
+//	После threshold ошибок подряд выключатель размыкается и запросы к сервису завершаются сразу.
+//	Через openTimeout пропускается один пробный запрос: при успехе выключатель замыкается,
+//	при ошибке снова размыкается. Нулевой threshold отключает выключатель
+func NewBreaker(name, target string, threshold int, openTimeout time.Duration) *breaker {
+	b := &breaker{
+		name:        name,
+		target:      target,
+		threshold:   threshold,
+		openTimeout: openTimeout,
+		now:         time.Now,
+		state:       entity.DependencyClosed,
+	}
+	observeBreakerState(name, entity.DependencyClosed)
+	return b
+}
+
+// Allow проверяет, можно ли выполнить запрос к сервису
+func (b *breaker) Allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case entity.DependencyOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return false
+		}
+		b.setState(entity.DependencyHalfOpen)
+		b.probing = true
+		return true
+	case entity.DependencyHalfOpen:
+		// Пока пробный запрос не завершился, остальные запросы не пропускаются
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// Done фиксирует результат запроса, разрешенного Allow
+func (b *breaker) Done(code codes.Code) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if code == codes.Canceled {
+		// Запрос отменен вызывающим кодом, о состоянии сервиса это ничего не говорит
+		return
+	}
+	if !isBreakerFailure(code) {
+		b.failures = 0
+		b.setState(entity.DependencyClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == entity.DependencyHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		b.setState(entity.DependencyOpen)
+	}
+}
+
+// Status состояние выключателя для /readyz и /status
+func (b *breaker) Status() *entity.DependencyStatus {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	status := &entity.DependencyStatus{
+		Name:     b.name,
+		Target:   b.target,
+		State:    b.state,
+		Failures: b.failures,
+	}
+	if b.state != entity.DependencyClosed {
+		openedAt := b.openedAt
+		status.OpenedAt = &openedAt
+	}
+	return status
+}
+
+// setState меняет состояние выключателя. Вызывается под блокировкой
+func (b *breaker) setState(state string) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	observeBreakerState(b.name, state)
+}
+
+// isBreakerFailure ошибки, говорящие о недоступности или перегрузке сервиса.
+// Ошибки бизнес-логики (NotFound, InvalidArgument и т.п.) выключатель не размыкают
+func isBreakerFailure(code codes.Code) bool {
+	switch code {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,67 @@
+package interceptors
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+func Test_breaker(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	b := NewBreaker("portals", "portals:9000", 2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	// Ошибки бизнес-логики и отмена запроса выключатель не размыкают
+	assert.True(t, b.Allow())
+	b.Done(codes.Unavailable)
+	assert.True(t, b.Allow())
+	b.Done(codes.Canceled)
+	assert.True(t, b.Allow())
+	b.Done(codes.NotFound)
+	assert.Equal(t, 0, b.Status().Failures)
+
+	// После threshold ошибок подряд выключатель размыкается
+	b.Done(codes.Unavailable)
+	b.Done(codes.DeadlineExceeded)
+	assert.False(t, b.Allow())
+	status := b.Status()
+	assert.Equal(t, entity.DependencyOpen, status.State)
+	assert.Equal(t, 2, status.Failures)
+	assert.Equal(t, &now, status.OpenedAt)
+
+	// Через openTimeout пропускается только один пробный запрос
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow())
+	assert.False(t, b.Allow())
+	assert.Equal(t, entity.DependencyHalfOpen, b.Status().State)
+
+	// Ошибка пробного запроса снова размыкает выключатель
+	b.Done(codes.Unavailable)
+	assert.False(t, b.Allow())
+	assert.Equal(t, entity.DependencyOpen, b.Status().State)
+
+	// Успешный пробный запрос замыкает выключатель
+	now = now.Add(time.Minute)
+	assert.True(t, b.Allow())
+	b.Done(codes.OK)
+	assert.True(t, b.Allow())
+	assert.Equal(t, &entity.DependencyStatus{
+		Name:   "portals",
+		Target: "portals:9000",
+		State:  entity.DependencyClosed,
+	}, b.Status())
+}
+
+func Test_breaker_disabled(t *testing.T) {
+	b := NewBreaker("portals", "portals:9000", 0, time.Minute)
+	for i := 0; i < 10; i++ {
+		assert.True(t, b.Allow())
+		b.Done(codes.Unavailable)
+	}
+	assert.Equal(t, entity.DependencyClosed, b.Status().State)
+}
@@ -0,0 +1,69 @@
+package interceptors
+
+import (
+	"sync"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+type dependencies struct {
+	isRequired func(name string) bool
+
+	mu       sync.RWMutex
+	breakers []*breaker
+}
+
+// NewDependencies перехватчики клиентов зависимых сервисов.
+//
+//	Хранит выключатели всех сервисов, чтобы отдавать их состояние в /readyz и /status.
+//	isRequired определяет, обязателен ли сервис для готовности приложения
+func NewDependencies(isRequired func(name string) bool) *dependencies {
+	return &dependencies{
+		isRequired: isRequired,
+	}
+}
+
+// DialOptions опции подключения к сервису с цепочкой перехватчиков:
+// метаданные, срок выполнения, выключатель, повторы читающих методов и метрики Prometheus.
+//
+//	Выключатель стоит перед повторами, поэтому запрос, не выполненный после всех повторов, считается одной ошибкой
+func (d *dependencies) DialOptions(name, target string, cfg Config) []grpc.DialOption {
+	b := NewBreaker(name, target, cfg.BreakerFailures, cfg.BreakerOpenTimeout)
+
+	d.mu.Lock()
+	d.breakers = append(d.breakers, b)
+	d.mu.Unlock()
+
+	return []grpc.DialOption{
+		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(
+			MetadataUnaryInterceptor(),
+			DeadlineUnaryInterceptor(cfg.Timeout),
+			BreakerUnaryInterceptor(b),
+			RetryUnaryInterceptor(cfg.MaxRetries, cfg.RetryBackoff),
+			grpc_prometheus.UnaryClientInterceptor,
+		)),
+		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(
+			MetadataStreamInterceptor(),
+			BreakerStreamInterceptor(b),
+			grpc_prometheus.StreamClientInterceptor,
+		)),
+	}
+}
+
+// Dependencies состояние выключателей зависимых сервисов
+func (d *dependencies) Dependencies() []*entity.DependencyStatus {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+
+	statuses := make([]*entity.DependencyStatus, 0, len(d.breakers))
+	for _, b := range d.breakers {
+		status := b.Status()
+		status.Required = d.isRequired != nil && d.isRequired(status.Name)
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
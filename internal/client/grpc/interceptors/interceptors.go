@@ -0,0 +1,172 @@
+package interceptors
+
+import (
+	"context"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+// Ключи метаданных, передаваемых в зависимые сервисы
+const (
+	MetadataRequestID = "x-request-id"
+	MetadataSessionID = "x-session-id"
+)
+
+// maxRetryBackoff максимальная пауза между повторами запроса
+const maxRetryBackoff = 2 * time.Second
+
+// idempotentMethodPrefixes префиксы читающих методов, которые можно безопасно повторять
+var idempotentMethodPrefixes = []string{"Get", "Filter", "Search"}
+
+// Config настройки клиента сервиса
+type Config struct {
+	// Timeout срок выполнения запроса, если вызывающий код не задал более ранний
+	Timeout time.Duration
+	// MaxRetries количество повторов читающих методов
+	MaxRetries int
+	// RetryBackoff начальная пауза между повторами, удваивается с каждым повтором
+	RetryBackoff time.Duration
+	// BreakerFailures количество ошибок подряд для размыкания выключателя, 0 отключает выключатель
+	BreakerFailures int
+	// BreakerOpenTimeout время, через которое разомкнутый выключатель пропускает пробный запрос
+	BreakerOpenTimeout time.Duration
+}
+
+// MetadataUnaryInterceptor передает в сервис идентификатор запроса и сессии.
+//
+//	Если идентификатор запроса не был задан HTTP-обработчиком, генерируется новый
+func MetadataUnaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		return invoker(withMetadata(ctx), method, req, reply, cc, opts...)
+	}
+}
+
+// MetadataStreamInterceptor передает в сервис идентификатор запроса и сессии для потоковых методов
+func MetadataStreamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		return streamer(withMetadata(ctx), desc, cc, method, opts...)
+	}
+}
+
+// DeadlineUnaryInterceptor ограничивает время выполнения запроса, если вызывающий код не задал более ранний срок
+func DeadlineUnaryInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if timeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) <= timeout {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+		ctx, cancel := context.WithTimeout(ctx, timeout)
+		defer cancel()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// BreakerUnaryInterceptor завершает запрос с codes.Unavailable, пока выключатель сервиса разомкнут
+func BreakerUnaryInterceptor(b *breaker) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.Allow() {
+			return status.Errorf(codes.Unavailable, "circuit breaker is open for %s", b.name)
+		}
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.Done(status.Code(err))
+		return err
+	}
+}
+
+// BreakerStreamInterceptor завершает открытие потока с codes.Unavailable, пока выключатель сервиса разомкнут
+func BreakerStreamInterceptor(b *breaker) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		if !b.Allow() {
+			return nil, status.Errorf(codes.Unavailable, "circuit breaker is open for %s", b.name)
+		}
+		stream, err := streamer(ctx, desc, cc, method, opts...)
+		b.Done(status.Code(err))
+		return stream, err
+	}
+}
+
+// RetryUnaryInterceptor повторяет читающие методы (Get, Filter, Search) при недоступности сервиса.
+//
+//	Пауза между повторами растет экспоненциально от backoff со случайным разбросом.
+//	Повторы выполняются в пределах срока исходного запроса
+func RetryUnaryInterceptor(maxRetries int, backoff time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if maxRetries <= 0 || !isIdempotent(method) {
+			return err
+		}
+		for attempt := 0; attempt < maxRetries && isRetryable(err); attempt++ {
+			timer := time.NewTimer(retryDelay(backoff, attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return err
+			case <-timer.C:
+			}
+			observeRetry(method)
+			err = invoker(ctx, method, req, reply, cc, opts...)
+		}
+		return err
+	}
+}
+
+// withMetadata добавляет в исходящие метаданные идентификаторы запроса и сессии, если их там еще нет
+func withMetadata(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	kv := make([]string, 0, 4)
+	if len(md.Get(MetadataRequestID)) == 0 {
+		kv = append(kv, MetadataRequestID, uuid.NewString())
+	}
+	if len(md.Get(MetadataSessionID)) == 0 {
+		if session, err := entity.SessionFromContext(ctx); err == nil && session.GetID() != nil {
+			kv = append(kv, MetadataSessionID, session.GetID().String())
+		}
+	}
+	if len(kv) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, kv...)
+}
+
+// isIdempotent проверяет, что метод только читает данные. method имеет вид /package.Service/Method
+func isIdempotent(method string) bool {
+	name := method[strings.LastIndex(method, "/")+1:]
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func isRetryable(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.Aborted:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryDelay пауза перед повтором attempt: случайное значение в пределах backoff*2^attempt
+func retryDelay(backoff time.Duration, attempt int) time.Duration {
+	if backoff <= 0 {
+		return 0
+	}
+	delay := backoff << attempt
+	if delay <= 0 || delay > maxRetryBackoff {
+		delay = maxRetryBackoff
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
@@ -0,0 +1,172 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func TestRetryUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		errs      []error
+		wantCalls int
+		wantCode  codes.Code
+	}{
+		{
+			name:      "read method retried until success",
+			method:    "/portals.v1.PortalsAPI/GetPortal",
+			errs:      []error{status.Error(codes.Unavailable, "unavailable"), nil},
+			wantCalls: 2,
+			wantCode:  codes.OK,
+		},
+		{
+			name:   "read method retried max times",
+			method: "/portals.v1.PortalsAPI/FilterPortals",
+			errs: []error{
+				status.Error(codes.Unavailable, "unavailable"),
+				status.Error(codes.Aborted, "aborted"),
+				status.Error(codes.Unavailable, "unavailable"),
+			},
+			wantCalls: 3,
+			wantCode:  codes.Unavailable,
+		},
+		{
+			name:      "not retryable error",
+			method:    "/employees.v1.SearchAPI/Search",
+			errs:      []error{status.Error(codes.NotFound, "not found")},
+			wantCalls: 1,
+			wantCode:  codes.NotFound,
+		},
+		{
+			name:      "write method is not retried",
+			method:    "/surveys.v1.AnswersAPI/CreateAnswer",
+			errs:      []error{status.Error(codes.Unavailable, "unavailable")},
+			wantCalls: 1,
+			wantCode:  codes.Unavailable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			invoker := func(_ context.Context, method string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				assert.Equal(t, tt.method, method)
+				err := tt.errs[calls]
+				calls++
+				return err
+			}
+
+			err := RetryUnaryInterceptor(2, 0)(context.TODO(), tt.method, nil, nil, nil, invoker)
+			assert.Equal(t, tt.wantCode, status.Code(err))
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestDeadlineUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		ctx         func() (context.Context, context.CancelFunc)
+		wantTimeout time.Duration
+	}{
+		{
+			name: "deadline applied",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.TODO())
+			},
+			wantTimeout: time.Second,
+		},
+		{
+			name: "earlier deadline kept",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.TODO(), 100*time.Millisecond)
+			},
+			wantTimeout: 100 * time.Millisecond,
+		},
+		{
+			name: "later deadline shortened",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.TODO(), time.Hour)
+			},
+			wantTimeout: time.Second,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				deadline, ok := ctx.Deadline()
+				assert.True(t, ok)
+				assert.WithinDuration(t, time.Now().Add(tt.wantTimeout), deadline, 50*time.Millisecond)
+				return nil
+			}
+			assert.NoError(t, DeadlineUnaryInterceptor(time.Second)(ctx, "/svc/Get", nil, nil, nil, invoker))
+		})
+	}
+}
+
+func TestBreakerUnaryInterceptor(t *testing.T) {
+	b := NewBreaker("portals", "portals:9000", 1, time.Minute)
+	interceptor := BreakerUnaryInterceptor(b)
+
+	calls := 0
+	invoker := func(_ context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+
+	err := interceptor(context.TODO(), "/svc/Get", nil, nil, nil, invoker)
+	assert.EqualError(t, err, "rpc error: code = Unavailable desc = unavailable")
+	err = interceptor(context.TODO(), "/svc/Get", nil, nil, nil, invoker)
+	assert.EqualError(t, err, "rpc error: code = Unavailable desc = circuit breaker is open for portals")
+	assert.Equal(t, 1, calls)
+}
+
+func TestMetadataUnaryInterceptor(t *testing.T) {
+	sessionID := entityAuth.SessionID(uuid.MustParse("8d2f8b7c-5b9a-4c3e-9f0a-3f1e2d4c5b6a"))
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		wantRequestID string
+		wantSessionID []string
+	}{
+		{
+			name:          "request id generated",
+			ctx:           context.TODO(),
+			wantSessionID: nil,
+		},
+		{
+			name:          "request id and session passed",
+			ctx:           metadata.AppendToOutgoingContext(entity.WithSession(context.TODO(), &entityAuth.Session{ID: &sessionID}), MetadataRequestID, "requestID"),
+			wantRequestID: "requestID",
+			wantSessionID: []string{"8d2f8b7c-5b9a-4c3e-9f0a-3f1e2d4c5b6a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+				md, _ := metadata.FromOutgoingContext(ctx)
+				requestIDs := md.Get(MetadataRequestID)
+				if assert.Len(t, requestIDs, 1) && tt.wantRequestID != "" {
+					assert.Equal(t, tt.wantRequestID, requestIDs[0])
+				}
+				assert.Equal(t, tt.wantSessionID, md.Get(MetadataSessionID))
+				return nil
+			}
+			assert.NoError(t, MetadataUnaryInterceptor()(tt.ctx, "/svc/Get", nil, nil, nil, invoker))
+		})
+	}
+}
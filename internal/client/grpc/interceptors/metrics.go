@@ -0,0 +1,46 @@
+package interceptors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+var (
+	breakerStateMetric *prometheus.GaugeVec
+	retriesMetric      *prometheus.CounterVec
+)
+
+// InitMetrics регистрирует метрики клиентов зависимых сервисов
+func InitMetrics() {
+	breakerStateMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_breaker_state",
+		Help: "Circuit breaker state of the gRPC dependency: 0 - closed, 1 - half-open, 2 - open",
+	}, []string{"service"})
+	retriesMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "grpc_client_retries_total",
+		Help: "Number of retried gRPC client calls by method",
+	}, []string{"method"})
+}
+
+func observeBreakerState(service, state string) {
+	if breakerStateMetric == nil {
+		return
+	}
+	var value float64
+	switch state {
+	case entity.DependencyHalfOpen:
+		value = 1
+	case entity.DependencyOpen:
+		value = 2
+	}
+	breakerStateMetric.WithLabelValues(service).Set(value)
+}
+
+func observeRetry(method string) {
+	if retriesMetric == nil {
+		return
+	}
+	retriesMetric.WithLabelValues(method).Inc()
+}
@@ -38,8 +38,10 @@ func (cm *commentsMapper) CommentToEntity(comment *commentsv1.Comment) *entityNe
 	}
 
 	id := cm.sharedMapper.StringToUUIDPtr(comment.GetId(), false)
+	newsID := cm.sharedMapper.StringToUUIDPtr(comment.GetNewsId(), false)
 	return &entityNews.NewsComment{
 		ID:      *id,
+		NewsID:  *newsID,
 		Message: comment.GetText(),
 		Author: entityNews.Author{
 			ID:         cm.sharedMapper.StringToUUIDPtr(comment.GetAuthor().GetId(), true),
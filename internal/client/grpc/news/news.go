@@ -2,6 +2,7 @@ package news
 
 import (
 	newsv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/newsfacade/news/v1"
+	sharedv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/newsfacade/shared/v1"
 	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
 	"github.com/google/uuid"
 
@@ -93,8 +94,15 @@ func (m *newsMapper) OnceNewsFullToEntity(newsPb *newsv1.News) *entityNews.NewsF
 			Name:      newsPb.GetCategory().GetName(),
 			UpdatedAt: nil,
 			Visibility: entityNews.CategoryVisibility{
-				ComplexIDs: m.sharedMapper.Int32SliceToInt(newsPb.GetCategory().GetVisibility().GetComplexIds()),
-				OIVs:       m.sharedMapper.Int32SliceToInt(newsPb.GetCategory().GetVisibility().GetPortalIds()),
+				Condition:        newsPb.GetCategory().GetVisibility().GetCondition(),
+				ComplexIDs:       m.sharedMapper.Int32SliceToInt(newsPb.GetCategory().GetVisibility().GetComplexIds()),
+				OIVs:             m.sharedMapper.Int32SliceToInt(newsPb.GetCategory().GetVisibility().GetPortalIds()),
+				OrgIDs:           m.sharedMapper.StringSliceToUUID(newsPb.GetCategory().GetVisibility().GetOrgIds(), true),
+				ProductIDs:       m.sharedMapper.StringSliceToUUID(newsPb.GetCategory().GetVisibility().GetProductIds(), true),
+				SubdivisionNames: newsPb.GetCategory().GetVisibility().GetSubdivisionNames(),
+				PositionNames:    newsPb.GetCategory().GetVisibility().GetPositionNames(),
+				EmployeeIDs:      m.sharedMapper.StringSliceToUUID(newsPb.GetCategory().GetVisibility().GetEmployeeIds(), true),
+				RoleNames:        newsPb.GetCategory().GetVisibility().GetRoleNames(),
 			},
 		},
 		Organization: organization,
@@ -277,6 +285,43 @@ func (m *newsMapper) VisibilityToPb(vis *entityNews.NewsVisibility) *newsv1.Visi
 		ComplexIds: m.sharedMapper.IntSliceToInt32(vis.ComplexIDs),
 	}
 }
+
+// SearchToPb фильтры поиска новостей. Пагинацию задает репозиторий.
+//
+//	Запрос сервиса новостей не принимает сортировку, новости возвращаются в порядке сервиса,
+//	поэтому search.Order не передается
+func (m *newsMapper) SearchToPb(search *dtoNews.SearchNews) *newsv1.FilterRequest {
+	if search == nil {
+		return &newsv1.FilterRequest{}
+	}
+
+	filter := search.GetFilter()
+	organizationsIds := make([]string, 0, len(filter.ProviderOrganizationsIds))
+	for _, id := range filter.ProviderOrganizationsIds {
+		if id == nil || *id == uuid.Nil {
+			continue
+		}
+		organizationsIds = append(organizationsIds, id.String())
+	}
+
+	return &newsv1.FilterRequest{
+		Filters: &newsv1.FilterRequest_Title{Title: search.GetQuery()},
+		Options: &newsv1.FilterRequest_Options{
+			WithComments:     false,
+			OnlyMain:         filter.OnMainPage,
+			OnlyPinned:       filter.IsPinnedOnMainPage,
+			Status:           m.StatusToPb(filter.GetStatus()),
+			CategoriesNames:  filter.CategoriesNames,
+			AuthorsNames:     filter.AuthorsNames,
+			ProductsNames:    filter.ProviderProductsNames,
+			OrganizationsIds: organizationsIds,
+		},
+		Visitor: &sharedv1.Visitor{
+			PortalId: int32(search.GetVisitorPtr().GetPortalID()),
+		},
+	}
+}
+
 func (m *newsMapper) ParticipantsToUUID(participants []*newsv1.Participant) []*uuid.UUID {
 	if participants == nil {
 		return nil
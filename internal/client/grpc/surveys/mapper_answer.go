@@ -48,6 +48,10 @@ func (am answerMapper) answerToEntity(answer *answerv1.Answer) (*surveys.Respond
 		QuestionID:    &qID,
 		ChosenVariant: surveys.AnswerID(variantID),
 		Content:       answer.Content,
+		PortalID:      int(answer.GetPortalId()),
+	}
+	if organizationID, err := uuid.Parse(answer.GetOrganizationId()); err == nil && organizationID != uuid.Nil {
+		newAnswer.OrganizationID = &organizationID
 	}
 
 	if answer.GetRespondent() != nil {
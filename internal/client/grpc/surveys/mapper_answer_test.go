@@ -63,6 +63,25 @@ func TestAnswerMapper_AnswerToEntity(t *testing.T) {
 				}, nil
 			},
 		},
+		{
+			name: "correct with portal and organization",
+			args: args{answer: &answerv1.Answer{
+				Id:             testID.String(),
+				ChosenVariant:  testID.String(),
+				QuestionId:     testID.String(),
+				PortalId:       7,
+				OrganizationId: testID.String(),
+			}},
+			want: func(a args) (*surveys.RespondentAnswer, error) {
+				return &surveys.RespondentAnswer{
+					ID:             &testID,
+					ChosenVariant:  aID,
+					QuestionID:     &qID,
+					PortalID:       7,
+					OrganizationID: &testID,
+				}, nil
+			},
+		},
 		{
 			name: "correct respondent anonymous with empty content ",
 			args: args{answer: &answerv1.Answer{
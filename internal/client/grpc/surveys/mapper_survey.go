@@ -128,7 +128,10 @@ func (sm surveyMapper) RespondentToPb(respondent *surveys.SurveyRespondent) *res
 	case surveys.RespondentTypeUser:
 		result.Respondent = &respondentv1.Respondent_User_{
 			User: &respondentv1.Respondent_User{
-				Ids: respondent.Ids.ToStringSlice(),
+				Ids:             respondent.Ids.ToStringSlice(),
+				PortalIds:       sm.portalIDsToPb(respondent.PortalIDs),
+				OrganizationIds: sm.uuidsToPb(respondent.OrganizationIDs),
+				SubdivisionIds:  sm.uuidsToPb(respondent.SubdivisionIDs),
 			},
 		}
 	}
@@ -136,6 +139,51 @@ func (sm surveyMapper) RespondentToPb(respondent *surveys.SurveyRespondent) *res
 	return result
 }
 
+// AvailableFilterToPb фильтр опросов, доступных респонденту. Профиль передается как респондент-пользователь,
+// которому достаточно подойти под одно из условий адресности опроса
+func (sm surveyMapper) AvailableFilterToPb(filter *surveys.AvailableFilter) *surveyv1.GetAllRequest_Available {
+	if filter == nil {
+		return nil
+	}
+	result := &surveyv1.GetAllRequest_Available{
+		ActiveTime: sm.timeUtils.TimeToTimestamp(&filter.ActiveAt),
+	}
+	if profile := filter.Respondent; profile != nil {
+		result.Respondent = &respondentv1.Respondent_User{
+			Ids:             []string{profile.UserID.String()},
+			PortalIds:       sm.portalIDsToPb(profile.PortalIDs),
+			OrganizationIds: sm.uuidsToPb(profile.OrganizationIDs),
+			SubdivisionIds:  sm.uuidsToPb(profile.SubdivisionIDs),
+		}
+	}
+
+	return result
+}
+
+func (sm surveyMapper) portalIDsToPb(portalIDs []int) []int64 {
+	if len(portalIDs) == 0 {
+		return nil
+	}
+	result := make([]int64, 0, len(portalIDs))
+	for _, id := range portalIDs {
+		result = append(result, int64(id))
+	}
+
+	return result
+}
+
+func (sm surveyMapper) uuidsToPb(ids []uuid.UUID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	result := make([]string, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, id.String())
+	}
+
+	return result
+}
+
 func (sm surveyMapper) RespondentToEntity(respondent *respondentv1.Respondent) (*surveys.SurveyRespondent, error) {
 	if respondent.GetRespondent() == nil {
 		return nil, nil
@@ -153,6 +201,31 @@ func (sm surveyMapper) RespondentToEntity(respondent *respondentv1.Respondent) (
 			return nil, err
 		}
 		result.Ids = rIds
+		for _, id := range respondent.GetUser().GetPortalIds() {
+			result.PortalIDs = append(result.PortalIDs, int(id))
+		}
+		if result.OrganizationIDs, err = sm.uuidsToEntity(respondent.GetUser().GetOrganizationIds()); err != nil {
+			return nil, fmt.Errorf("can't parse organization id: %w", err)
+		}
+		if result.SubdivisionIDs, err = sm.uuidsToEntity(respondent.GetUser().GetSubdivisionIds()); err != nil {
+			return nil, fmt.Errorf("can't parse subdivision id: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (sm surveyMapper) uuidsToEntity(ids []string) ([]uuid.UUID, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	result := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		parsed, err := uuid.Parse(id)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, parsed)
 	}
 
 	return result, nil
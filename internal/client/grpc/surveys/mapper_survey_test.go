@@ -260,6 +260,27 @@ func TestSurveyMapper_RespondentToEntity(t *testing.T) {
 					nil
 			},
 		},
+		{
+			name: "correct with targeting",
+			args: args{
+				respondent: &respondentv1.Respondent{
+					Respondent: &respondentv1.Respondent_User_{User: &respondentv1.Respondent_User{
+						PortalIds:       []int64{7},
+						OrganizationIds: []string{testID},
+						SubdivisionIds:  []string{testID},
+					}},
+				},
+			},
+			want: func(a args, f fields) (*surveys.SurveyRespondent, error) {
+				return &surveys.SurveyRespondent{
+					Type:            surveys.RespondentTypeUser,
+					Ids:             surveys.RespondentIDs{},
+					PortalIDs:       []int{7},
+					OrganizationIDs: []uuid.UUID{testUUID},
+					SubdivisionIDs:  []uuid.UUID{testUUID},
+				}, nil
+			},
+		},
 		{
 			name: "uuid parse error",
 			args: args{
@@ -350,6 +371,71 @@ func TestSurveyMapper_OptionsToPb(t *testing.T) {
 	}
 }
 
+func TestSurveyMapper_AvailableFilterToPb(t *testing.T) {
+	activeAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	activeAtPb := timestamppb.New(activeAt)
+	userID := uuid.New()
+	organizationID := uuid.New()
+	subdivisionID := uuid.New()
+
+	tests := []struct {
+		name    string
+		filter  *surveys.AvailableFilter
+		prepare func(tu *timeUtils.MockTimeUtils)
+		want    *surveyv1.GetAllRequest_Available
+	}{
+		{
+			name:   "nil filter",
+			filter: nil,
+			want:   nil,
+		},
+		{
+			name: "profile is passed as user respondent",
+			filter: &surveys.AvailableFilter{
+				ActiveAt: activeAt,
+				Respondent: &surveys.RespondentProfile{
+					UserID:          userID,
+					PortalIDs:       []int{7},
+					OrganizationIDs: []uuid.UUID{organizationID},
+					SubdivisionIDs:  []uuid.UUID{subdivisionID},
+				},
+			},
+			prepare: func(tu *timeUtils.MockTimeUtils) {
+				tu.EXPECT().TimeToTimestamp(&activeAt).Return(activeAtPb)
+			},
+			want: &surveyv1.GetAllRequest_Available{
+				ActiveTime: activeAtPb,
+				Respondent: &respondentv1.Respondent_User{
+					Ids:             []string{userID.String()},
+					PortalIds:       []int64{7},
+					OrganizationIds: []string{organizationID.String()},
+					SubdivisionIds:  []string{subdivisionID.String()},
+				},
+			},
+		},
+		{
+			name:   "without profile",
+			filter: &surveys.AvailableFilter{ActiveAt: activeAt},
+			prepare: func(tu *timeUtils.MockTimeUtils) {
+				tu.EXPECT().TimeToTimestamp(gomock.Any()).Return(activeAtPb)
+			},
+			want: &surveyv1.GetAllRequest_Available{ActiveTime: activeAtPb},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tu := timeUtils.NewMockTimeUtils(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(tu)
+			}
+			got := NewSurveyMapper(tu).AvailableFilterToPb(tt.filter)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestSurveyMapper_SurveyToPb(t *testing.T) {
 	type fields struct {
 		timeUtils *timeUtils.MockTimeUtils
@@ -0,0 +1,27 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// ConnectivityCheck проверка готовности подключения к сервису.
+//
+//	Подключение в состоянии Idle считается готовым: соединение устанавливается при первом запросе.
+//	Проверка инициирует его, чтобы недоступность сервиса обнаружилась до запросов пользователей
+func ConnectivityCheck(cc *grpc.ClientConn) func(ctx context.Context) error {
+	return func(_ context.Context) error {
+		switch state := cc.GetState(); state {
+		case connectivity.Ready:
+			return nil
+		case connectivity.Idle:
+			cc.Connect()
+			return nil
+		default:
+			return fmt.Errorf("connection state is %s", state)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package transport
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// Виды сертификатов в метрике срока действия
+const (
+	certificateCA     = "ca"
+	certificateClient = "client"
+)
+
+var certificateExpiryMetric *prometheus.GaugeVec
+
+// InitMetrics регистрирует метрики сертификатов подключений к зависимым сервисам
+func InitMetrics() {
+	certificateExpiryMetric = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "grpc_client_certificate_expiry_timestamp_seconds",
+		Help: "Expiration time of the gRPC client certificate or the earliest expiring CA certificate, unix seconds",
+	}, []string{"service", "type"})
+}
+
+func observeExpiry(service, certificateType string, expiry time.Time) {
+	if certificateExpiryMetric == nil {
+		return
+	}
+	certificateExpiryMetric.WithLabelValues(service, certificateType).Set(float64(expiry.Unix()))
+}
@@ -0,0 +1,266 @@
+package transport
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Ошибки настроек TLS
+var (
+	ErrNoCACertificates = errors.New("no CA certificates found")
+	ErrClientKeyPair    = errors.New("client certificate and key must be set together")
+)
+
+// Config настройки защищенного подключения к сервису
+type Config struct {
+	// Insecure подключение без TLS
+	Insecure bool
+	// CAFile сертификаты удостоверяющих центров для проверки сервера. Если не задан, используются системные
+	CAFile string
+	// CertFile сертификат клиента для mTLS
+	CertFile string
+	// KeyFile закрытый ключ сертификата клиента
+	KeyFile string
+	// ServerName имя сервера для проверки сертификата, если отличается от адреса подключения
+	ServerName string
+}
+
+// keyMaterial загруженные сертификаты
+type keyMaterial struct {
+	roots *x509.CertPool
+	cert  *tls.Certificate
+}
+
+// reloadingCredentials учетные данные TLS, которые при каждом новом подключении
+// берут текущие сертификаты из tlsCredentials
+type reloadingCredentials struct {
+	credentials.TransportCredentials
+	source *tlsCredentials
+}
+
+func (r *reloadingCredentials) ClientHandshake(ctx context.Context, authority string, conn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	return credentials.NewTLS(r.source.tlsConfig()).ClientHandshake(ctx, authority, conn)
+}
+
+func (r *reloadingCredentials) Clone() credentials.TransportCredentials {
+	return r.source.TransportCredentials()
+}
+
+type tlsCredentials struct {
+	name   string
+	cfg    Config
+	logger ditzap.Logger
+
+	material atomic.Pointer[keyMaterial]
+
+	mu       sync.Mutex
+	modTimes map[string]time.Time
+}
+
+// NewCredentials учетные данные подключения к сервису name.
+//
+//	Сертификаты читаются из файлов при создании и перечитываются Watch при их изменении,
+//	поэтому новые подключения используют актуальные сертификаты без перезапуска приложения
+func NewCredentials(name string, cfg Config, logger ditzap.Logger) (*tlsCredentials, error) {
+	if (cfg.CertFile == "") != (cfg.KeyFile == "") {
+		return nil, fmt.Errorf("%s: %w", name, ErrClientKeyPair)
+	}
+
+	c := &tlsCredentials{
+		name:     name,
+		cfg:      cfg,
+		logger:   logger,
+		modTimes: map[string]time.Time{},
+	}
+	if cfg.Insecure {
+		return c, nil
+	}
+	if err := c.Reload(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// TransportCredentials учетные данные транспорта для grpc.WithTransportCredentials
+func (c *tlsCredentials) TransportCredentials() credentials.TransportCredentials {
+	if c.cfg.Insecure {
+		return insecure.NewCredentials()
+	}
+	return &reloadingCredentials{
+		TransportCredentials: credentials.NewTLS(c.tlsConfig()),
+		source:               c,
+	}
+}
+
+// tlsConfig настройки TLS с актуальными сертификатами
+func (c *tlsCredentials) tlsConfig() *tls.Config {
+	material := c.material.Load()
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: c.cfg.ServerName,
+		RootCAs:    material.roots,
+	}
+	if material.cert != nil {
+		cfg.Certificates = []tls.Certificate{*material.cert}
+	}
+	return cfg
+}
+
+// Reload перечитывает сертификаты.
+//
+//	При ошибке продолжают действовать ранее загруженные сертификаты
+func (c *tlsCredentials) Reload() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	modTimes, err := c.fileModTimes()
+	if err != nil {
+		return err
+	}
+	return c.load(modTimes)
+}
+
+// Watch проверяет изменение файлов сертификатов с заданным интервалом и перечитывает их.
+//
+//	Завершается при отмене контекста
+func (c *tlsCredentials) Watch(ctx context.Context, interval time.Duration) {
+	if c.cfg.Insecure || interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.reloadIfModified(); err != nil {
+				c.logger.Error("can't reload certificates",
+					zap.String("service", c.name),
+					zap.Error(err),
+				)
+			}
+		}
+	}
+}
+
+func (c *tlsCredentials) reloadIfModified() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	modTimes, err := c.fileModTimes()
+	if err != nil {
+		return err
+	}
+	modified := false
+	for file, modTime := range modTimes {
+		if !modTime.Equal(c.modTimes[file]) {
+			modified = true
+			break
+		}
+	}
+	if !modified {
+		return nil
+	}
+	return c.load(modTimes)
+}
+
+func (c *tlsCredentials) load(modTimes map[string]time.Time) error {
+	material := &keyMaterial{}
+
+	if c.cfg.CAFile != "" {
+		data, err := os.ReadFile(c.cfg.CAFile)
+		if err != nil {
+			return fmt.Errorf("%s: can't read CA file: %w", c.name, err)
+		}
+		certs, err := parseCertificates(data)
+		if err != nil {
+			return fmt.Errorf("%s: can't parse CA file: %w", c.name, err)
+		}
+		material.roots = x509.NewCertPool()
+		for _, cert := range certs {
+			material.roots.AddCert(cert)
+		}
+		observeExpiry(c.name, certificateCA, earliestExpiry(certs))
+	}
+
+	if c.cfg.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(c.cfg.CertFile, c.cfg.KeyFile)
+		if err != nil {
+			return fmt.Errorf("%s: can't load client certificate: %w", c.name, err)
+		}
+		material.cert = &cert
+		observeExpiry(c.name, certificateClient, cert.Leaf.NotAfter)
+	}
+
+	c.material.Store(material)
+	c.modTimes = modTimes
+
+	c.logger.Info("certificates loaded", zap.String("service", c.name))
+	return nil
+}
+
+// fileModTimes время изменения файлов сертификатов
+func (c *tlsCredentials) fileModTimes() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time, 3)
+	for _, file := range []string{c.cfg.CAFile, c.cfg.CertFile, c.cfg.KeyFile} {
+		if file == "" {
+			continue
+		}
+		stat, err := os.Stat(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", c.name, err)
+		}
+		modTimes[file] = stat.ModTime()
+	}
+	return modTimes, nil
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, ErrNoCACertificates
+	}
+	return certs, nil
+}
+
+func earliestExpiry(certs []*x509.Certificate) time.Time {
+	var expiry time.Time
+	for _, cert := range certs {
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+	return expiry
+}
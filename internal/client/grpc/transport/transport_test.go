@@ -0,0 +1,326 @@
+package transport
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testServerName = "files.test"
+
+// testCA удостоверяющий центр для выпуска тестовых сертификатов
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T, name string) *testCA {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue выпускает сертификат, возвращает сертификат и ключ в PEM
+func (ca *testCA) issue(t *testing.T, name string, notAfter time.Time, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     notAfter,
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, &key.PublicKey, ca.key)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})
+}
+
+// startTestServer запускает gRPC-сервер, требующий сертификат клиента, выпущенный ca
+func startTestServer(t *testing.T, ca *testCA) string {
+	t.Helper()
+
+	certPEM, keyPEM := ca.issue(t, testServerName, time.Now().Add(time.Hour), x509.ExtKeyUsageServerAuth)
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	assert.NoError(t, err)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca.cert)
+
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(&tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+	})))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+	assert.NoError(t, os.WriteFile(path, data, 0o600))
+	assert.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func checkHealth(t *testing.T, target string, creds credentials.TransportCredentials) error {
+	t.Helper()
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
+	defer cancel()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+//nolint:funlen
+func Test_tlsCredentials(t *testing.T) {
+	InitMetrics()
+
+	ca := newTestCA(t, "test CA")
+	otherCA := newTestCA(t, "other CA")
+	target := startTestServer(t, ca)
+	clientExpiry := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	otherCAFile := filepath.Join(dir, "other-ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	modTime := time.Now().Add(-time.Minute)
+	writeFile(t, caFile, ca.pem, modTime)
+	writeFile(t, otherCAFile, otherCA.pem, modTime)
+	certPEM, keyPEM := ca.issue(t, "web-api", clientExpiry, x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, certPEM, modTime)
+	writeFile(t, keyFile, keyPEM, modTime)
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr bool
+	}{
+		{
+			name: "mtls",
+			cfg: Config{
+				CAFile:     caFile,
+				CertFile:   certFile,
+				KeyFile:    keyFile,
+				ServerName: testServerName,
+			},
+		},
+		{
+			name: "client certificate is not set",
+			cfg: Config{
+				CAFile:     caFile,
+				ServerName: testServerName,
+			},
+			wantErr: true,
+		},
+		{
+			name: "server name mismatch",
+			cfg: Config{
+				CAFile:     caFile,
+				CertFile:   certFile,
+				KeyFile:    keyFile,
+				ServerName: "employees.test",
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown server CA",
+			cfg: Config{
+				CAFile:     otherCAFile,
+				CertFile:   certFile,
+				KeyFile:    keyFile,
+				ServerName: testServerName,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Info("certificates loaded", gomock.Any())
+
+			c, err := NewCredentials("files", tt.cfg, logger)
+			assert.NoError(t, err)
+
+			err = checkHealth(t, target, c.TransportCredentials())
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	assert.Equal(t, float64(clientExpiry.Unix()),
+		testutil.ToFloat64(certificateExpiryMetric.WithLabelValues("files", certificateClient)))
+	assert.Equal(t, float64(ca.cert.NotAfter.Unix()),
+		testutil.ToFloat64(certificateExpiryMetric.WithLabelValues("files", certificateCA)))
+}
+
+func Test_tlsCredentials_reload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := ditzap.NewMockLogger(ctrl)
+	logger.EXPECT().Info("certificates loaded", gomock.Any()).Times(2)
+
+	ca := newTestCA(t, "test CA")
+	otherCA := newTestCA(t, "other CA")
+	target := startTestServer(t, ca)
+
+	dir := t.TempDir()
+	caFile := filepath.Join(dir, "ca.pem")
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	modTime := time.Now().Add(-time.Minute)
+	writeFile(t, caFile, ca.pem, modTime)
+	// Сертификат клиента выпущен удостоверяющим центром, которому сервер не доверяет
+	certPEM, keyPEM := otherCA.issue(t, "web-api", time.Now().Add(time.Hour), x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, certPEM, modTime)
+	writeFile(t, keyFile, keyPEM, modTime)
+
+	c, err := NewCredentials("files", Config{
+		CAFile:     caFile,
+		CertFile:   certFile,
+		KeyFile:    keyFile,
+		ServerName: testServerName,
+	}, logger)
+	assert.NoError(t, err)
+	creds := c.TransportCredentials()
+	assert.Error(t, checkHealth(t, target, creds))
+
+	// Файлы не изменились, сертификаты не перечитываются
+	assert.NoError(t, c.reloadIfModified())
+
+	// После замены сертификата новые подключения используют его без пересоздания учетных данных
+	certPEM, keyPEM = ca.issue(t, "web-api", time.Now().Add(time.Hour), x509.ExtKeyUsageClientAuth)
+	writeFile(t, certFile, certPEM, modTime.Add(time.Second))
+	writeFile(t, keyFile, keyPEM, modTime.Add(time.Second))
+	assert.NoError(t, c.reloadIfModified())
+	assert.NoError(t, checkHealth(t, target, creds))
+
+	// Ошибка чтения новых файлов не сбрасывает загруженные сертификаты
+	writeFile(t, keyFile, []byte("broken"), modTime.Add(2*time.Second))
+	assert.Error(t, c.reloadIfModified())
+	assert.NoError(t, checkHealth(t, target, creds))
+}
+
+func TestNewCredentials(t *testing.T) {
+	dir := t.TempDir()
+	emptyFile := filepath.Join(dir, "empty.pem")
+	writeFile(t, emptyFile, []byte("no certificates"), time.Now())
+
+	tests := []struct {
+		name    string
+		cfg     Config
+		wantErr error
+	}{
+		{
+			name: "insecure",
+			cfg:  Config{Insecure: true, CAFile: filepath.Join(dir, "missing.pem")},
+		},
+		{
+			name:    "certificate without key",
+			cfg:     Config{CertFile: emptyFile},
+			wantErr: ErrClientKeyPair,
+		},
+		{
+			name:    "no CA certificates",
+			cfg:     Config{CAFile: emptyFile},
+			wantErr: ErrNoCACertificates,
+		},
+		{
+			name:    "missing file",
+			cfg:     Config{CAFile: filepath.Join(dir, "missing.pem")},
+			wantErr: os.ErrNotExist,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewCredentials("files", tt.cfg, ditzap.NewMockLogger(gomock.NewController(t)))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestConnectivityCheck(t *testing.T) {
+	// Порт освобождается сразу, подключение к нему невозможно
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	target := listener.Addr().String()
+	assert.NoError(t, listener.Close())
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	check := ConnectivityCheck(conn)
+	assert.NoError(t, check(context.TODO()))
+	assert.Eventually(t, func() bool {
+		return check(context.TODO()) != nil
+	}, 5*time.Second, 10*time.Millisecond)
+}
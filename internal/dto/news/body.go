@@ -0,0 +1,131 @@
+package news
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+// validateBody проверяет тело новости на соответствие схеме блоков.
+//
+//	Возвращает все найденные ошибки с путем до поля, например content.blocks[3].fileId
+func validateBody(raw []byte) error {
+	body, err := entityNews.ParseBody(raw)
+	if err != nil {
+		return diterrors.NewValidationError(ErrNewsBody, diterrors.ErrValidationFields{
+			Field:   "content",
+			Message: fmt.Sprintf("content must be an object with blocks: %s", err),
+		})
+	}
+
+	if len(body.Blocks) > entityNews.BodyMaxBlocks {
+		return diterrors.NewValidationError(ErrNewsBody, diterrors.ErrValidationFields{
+			Field:   "content.blocks",
+			Message: fmt.Sprintf("no more than %d blocks allowed", entityNews.BodyMaxBlocks),
+		})
+	}
+
+	var fields []diterrors.ErrValidationFields
+	for i, block := range body.Blocks {
+		fields = append(fields, validateBodyBlock(fmt.Sprintf("content.blocks[%d]", i), block)...)
+	}
+	if len(fields) > 0 {
+		return diterrors.NewValidationError(ErrNewsBody, fields...)
+	}
+
+	return nil
+}
+
+func validateBodyBlock(path string, block *entityNews.BodyBlock) []diterrors.ErrValidationFields {
+	if block == nil {
+		return []diterrors.ErrValidationFields{{Field: path, Message: "block is empty"}}
+	}
+
+	var fields []diterrors.ErrValidationFields
+	required := func(field, value string) {
+		if entityNews.HTMLToText(value) == "" {
+			fields = append(fields, diterrors.ErrValidationFields{Field: path + "." + field, Message: field + " is required"})
+		}
+	}
+
+	switch block.Type {
+	case entityNews.BodyBlockParagraph:
+		required("text", block.Text)
+	case entityNews.BodyBlockHeading:
+		required("text", block.Text)
+		if block.Level < entityNews.BodyHeadingMinLevel || block.Level > entityNews.BodyHeadingMaxLevel {
+			fields = append(fields, diterrors.ErrValidationFields{
+				Field: path + ".level",
+				Message: fmt.Sprintf("level must be between %d and %d",
+					entityNews.BodyHeadingMinLevel, entityNews.BodyHeadingMaxLevel),
+			})
+		}
+	case entityNews.BodyBlockImage:
+		if block.FileID == nil || *block.FileID == uuid.Nil {
+			fields = append(fields, diterrors.ErrValidationFields{Field: path + ".fileId", Message: "fileId is required"})
+		}
+	case entityNews.BodyBlockEmbed:
+		if !entityNews.IsEmbedURLAllowed(block.URL) {
+			fields = append(fields, diterrors.ErrValidationFields{
+				Field:   path + ".url",
+				Message: fmt.Sprintf("embedding <%s> is not allowed", block.URL),
+			})
+		}
+	case entityNews.BodyBlockList:
+		if len(block.Items) == 0 || len(block.Items) > entityNews.BodyMaxListItems {
+			fields = append(fields, diterrors.ErrValidationFields{
+				Field:   path + ".items",
+				Message: fmt.Sprintf("list must have from 1 to %d items", entityNews.BodyMaxListItems),
+			})
+		}
+		for i, item := range block.Items {
+			required(fmt.Sprintf("items[%d]", i), item)
+		}
+	default:
+		fields = append(fields, diterrors.ErrValidationFields{
+			Field:   path + ".type",
+			Message: fmt.Sprintf("unknown block type <%s>", block.Type),
+		})
+	}
+
+	return fields
+}
+
+// sanitizeBody очищает разметку в теле новости и собирает его заново.
+//
+//	Поля, не входящие в схему блоков, отбрасываются
+func sanitizeBody(raw []byte) ([]byte, error) {
+	body, err := entityNews.ParseBody(raw)
+	if err != nil {
+		return nil, err
+	}
+	body.Sanitize()
+	return json.Marshal(body)
+}
+
+// SanitizeBody очищает разметку в теле новой новости. Вызывается после Validate
+func (nn *NewNews) SanitizeBody() error {
+	body, err := sanitizeBody(nn.Body)
+	if err != nil {
+		return err
+	}
+	nn.Body = body
+	return nil
+}
+
+// SanitizeBody очищает разметку в теле новости, если оно изменяется. Вызывается после Validate
+func (un *UpdateNews) SanitizeBody() error {
+	if len(un.Body) == 0 {
+		return nil
+	}
+	body, err := sanitizeBody(un.Body)
+	if err != nil {
+		return err
+	}
+	un.Body = body
+	return nil
+}
@@ -0,0 +1,77 @@
+package news
+
+import (
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateBody(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantErr    bool
+		wantFields []diterrors.ErrValidationFields
+	}{
+		{
+			name: "empty body",
+			body: "null",
+		},
+		{
+			name: "valid blocks",
+			body: `{"blocks":[
+				{"type":"heading","level":2,"text":"Заголовок"},
+				{"type":"paragraph","text":"<b>Текст</b>"},
+				{"type":"image","fileId":"5f0c1b2e-6d7a-4c1e-9a3b-2f4d5e6a7b8c"},
+				{"type":"embed","url":"https://rutube.ru/video/1"},
+				{"type":"list","ordered":true,"items":["один"]}
+			]}`,
+		},
+		{
+			name:    "not an object",
+			body:    `"<script>alert(1)</script>"`,
+			wantErr: true,
+		},
+		{
+			name: "invalid blocks",
+			body: `{"blocks":[
+				{"type":"paragraph","text":"<script>x</script>"},
+				{"type":"heading","level":1,"text":"Заголовок"},
+				{"type":"image"},
+				{"type":"embed","url":"https://evil.example/"},
+				{"type":"list","items":["один",""]},
+				{"type":"html"}
+			]}`,
+			wantErr: true,
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "content.blocks[0].text", Message: "text is required"},
+				{Field: "content.blocks[1].level", Message: "level must be between 2 and 4"},
+				{Field: "content.blocks[2].fileId", Message: "fileId is required"},
+				{Field: "content.blocks[3].url", Message: "embedding <https://evil.example/> is not allowed"},
+				{Field: "content.blocks[4].items[1]", Message: "items[1] is required"},
+				{Field: "content.blocks[5].type", Message: "unknown block type <html>"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateBody([]byte(tt.body))
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrNewsBody)
+			if tt.wantFields != nil {
+				assert.Equal(t, diterrors.NewValidationError(ErrNewsBody, tt.wantFields...), err)
+			}
+		})
+	}
+}
+
+func TestNewNews_SanitizeBody(t *testing.T) {
+	news := &NewNews{Body: []byte(`{"blocks":[{"type":"paragraph","text":"<p onclick=\"x()\">Текст <i>курсив</i></p>","extra":1}]}`)}
+
+	assert.NoError(t, news.SanitizeBody())
+	assert.JSONEq(t, `{"blocks":[{"type":"paragraph","text":"Текст <i>курсив</i>"}]}`, string(news.Body))
+}
@@ -14,6 +14,13 @@ func (n *NewComment) GetAuthorID() *uuid.UUID {
 	return n.AuthorID
 }
 
+func (n *NewComment) GetParentID() *uuid.UUID {
+	if n == nil {
+		return nil
+	}
+	return n.ParentID
+}
+
 func (f *FilterComments) GetAfterID() *uuid.UUID {
 	if f == nil {
 		return nil
@@ -27,3 +34,10 @@ func (f *FilterComments) GetVisitor() (_Visitor *entityNews.Visitor) {
 	}
 	return f.Visitor
 }
+
+func (f *FilterComments) GetParentID() *uuid.UUID {
+	if f == nil {
+		return nil
+	}
+	return f.ParentID
+}
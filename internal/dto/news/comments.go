@@ -3,6 +3,7 @@ package news
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
@@ -15,12 +16,18 @@ const (
 	ErrNewsIDRequired      diterrors.StringError = "news id required"
 	ErrCommentTextRequired diterrors.StringError = "comment text required"
 	ErrAuthorRequired      diterrors.StringError = "author required"
+	ErrCommentIDRequired   diterrors.StringError = "comment id required"
+	ErrReportReason        diterrors.StringError = "incorrect report reason"
 )
 
+// CommentReportReasonMaxLength максимальная длина причины жалобы на комментарий
+const CommentReportReasonMaxLength = 1000
+
 type NewComment struct {
 	NewsID   uuid.UUID  // ID новости (из path {id})
 	Text     string     // Текст комментария (из body)
 	AuthorID *uuid.UUID // Автор из контекста аутентификации
+	ParentID *uuid.UUID // Комментарий, на который дан ответ (из body)
 }
 
 func (nc *NewComment) Validate() error {
@@ -66,4 +73,62 @@ type FilterComments struct {
 	AfterID   *uuid.UUID
 	Limit     int
 	Visitor   *entityNews.Visitor
+	// ParentID если задан, выдаются ответы на этот комментарий, иначе комментарии верхнего уровня
+	ParentID *uuid.UUID
+}
+
+type UpdateComment struct {
+	NewsID    uuid.UUID
+	CommentID uuid.UUID
+	Text      string
+}
+
+func (uc *UpdateComment) Validate() error {
+	if uc == nil {
+		return diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	if uc.NewsID == uuid.Nil {
+		return diterrors.NewValidationError(ErrNewsIDRequired)
+	}
+	if uc.CommentID == uuid.Nil {
+		return diterrors.NewValidationError(ErrCommentIDRequired)
+	}
+
+	if strings.TrimSpace(uc.Text) == "" {
+		return diterrors.NewValidationError(ErrCommentTextRequired, diterrors.ErrValidationFields{
+			Field:   "text",
+			Message: "text is empty",
+		})
+	}
+
+	return nil
+}
+
+type NewCommentReport struct {
+	NewsID    uuid.UUID
+	CommentID uuid.UUID
+	Reason    string
+}
+
+func (nr *NewCommentReport) Validate() error {
+	if nr == nil {
+		return diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	if nr.NewsID == uuid.Nil {
+		return diterrors.NewValidationError(ErrNewsIDRequired)
+	}
+	if nr.CommentID == uuid.Nil {
+		return diterrors.NewValidationError(ErrCommentIDRequired)
+	}
+
+	if reason := strings.TrimSpace(nr.Reason); reason == "" || utf8.RuneCountInString(reason) > CommentReportReasonMaxLength {
+		return diterrors.NewValidationError(ErrReportReason, diterrors.ErrValidationFields{
+			Field:   "reason",
+			Message: fmt.Sprintf("reason must be from 1 to %d characters", CommentReportReasonMaxLength),
+		})
+	}
+
+	return nil
 }
@@ -184,6 +184,13 @@ func (s *SearchNews) GetFilterPtr() *SearchNewsFilter {
 	return s.Filter
 }
 
+func (s *SearchNews) GetScrollPtr() *SearchNewsScroll {
+	if s == nil {
+		return nil
+	}
+	return s.Scroll
+}
+
 func (s *SearchNews) GetVisitorPtr() *entityNews.Visitor {
 	if s == nil {
 		return nil
@@ -205,6 +212,13 @@ func (s *SearchNewsScroll) GetCreatedAtPtr() *time.Time {
 	return s.CreatedAt
 }
 
+func (s *SearchNewsResult) GetNextPtr() *SearchNewsScroll {
+	if s == nil {
+		return nil
+	}
+	return s.Next
+}
+
 func (n *NewNews) GetTitle() string {
 	if n == nil {
 		return ""
@@ -520,6 +534,13 @@ func (s *SearchNews) GetPagination() SearchNewsPagination {
 	return s.Pagination
 }
 
+func (s *SearchNews) GetScroll() (_Scroll SearchNewsScroll) {
+	if s == nil || s.Scroll == nil {
+		return
+	}
+	return *s.Scroll
+}
+
 func (s *SearchNews) GetOrder() SearchNewsOrder {
 	if s == nil {
 		return SearchNewsOrder{}
@@ -645,3 +666,10 @@ func (s *SearchNewsResult) GetTotal() int {
 	}
 	return s.Total
 }
+
+func (s *SearchNewsResult) GetNext() (_Next SearchNewsScroll) {
+	if s == nil || s.Next == nil {
+		return
+	}
+	return *s.Next
+}
@@ -19,6 +19,20 @@ const (
 	ErrCategoryRequired     diterrors.StringError = "category required"
 	ErrNewsStatus           diterrors.StringError = "incorrect news status"
 	ErrPublishTimeBeforeNow diterrors.StringError = "publish time must be in the future"
+	ErrSearchLimit          diterrors.StringError = "incorrect news search limit"
+	ErrSearchPage           diterrors.StringError = "incorrect news search page"
+	ErrSearchPageTooDeep    diterrors.StringError = "news search page is too deep, use cursor"
+	ErrSearchCursor         diterrors.StringError = "incorrect news search cursor"
+	ErrNewsBody             diterrors.StringError = "incorrect news content"
+)
+
+const (
+	// SearchNewsDefaultLimit количество новостей на странице, если оно не задано
+	SearchNewsDefaultLimit = 20
+	// SearchNewsMaxLimit максимальное количество новостей на странице
+	SearchNewsMaxLimit = 100
+	// SearchNewsMaxDepth сколько новостей можно пролистать по номеру страницы, дальше листать только курсором
+	SearchNewsMaxDepth = 1000
 )
 
 type NewNews struct {
@@ -92,6 +106,10 @@ func (nn *NewNews) Validate() error {
 		})
 	}
 
+	if err := validateBody(nn.Body); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -176,6 +194,12 @@ func (un *UpdateNews) Validate() error {
 		})
 	}
 
+	if len(un.Body) > 0 {
+		if err := validateBody(un.Body); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -183,8 +207,55 @@ type SearchNews struct {
 	Query      string
 	Filter     *SearchNewsFilter
 	Pagination SearchNewsPagination
-	Order      SearchNewsOrder
-	Visitor    *entityNews.Visitor
+	// Scroll позиция, с которой продолжается поиск. Если задана, номер страницы не учитывается
+	Scroll  *SearchNewsScroll
+	Order   SearchNewsOrder
+	Visitor *entityNews.Visitor
+}
+
+func (sn *SearchNews) Validate() error {
+	if sn == nil {
+		return diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	if limit := sn.Pagination.Limit; limit <= 0 || limit > SearchNewsMaxLimit {
+		return diterrors.NewValidationError(ErrSearchLimit, diterrors.ErrValidationFields{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", SearchNewsMaxLimit),
+		})
+	}
+
+	if sn.Scroll != nil {
+		if sn.Scroll.GetLastID() == uuid.Nil {
+			return diterrors.NewValidationError(ErrSearchCursor)
+		}
+		return nil
+	}
+
+	if sn.Pagination.Page < 0 {
+		return diterrors.NewValidationError(ErrSearchPage, diterrors.ErrValidationFields{
+			Field:   "page",
+			Message: fmt.Sprintf("incorrect page <%d>", sn.Pagination.Page),
+		})
+	}
+	if sn.Offset()+sn.Pagination.Limit > SearchNewsMaxDepth {
+		return diterrors.NewValidationError(ErrSearchPageTooDeep, diterrors.ErrValidationFields{
+			Field:   "page",
+			Message: fmt.Sprintf("only first %d news are available by page number", SearchNewsMaxDepth),
+		})
+	}
+
+	return nil
+}
+
+// Offset сколько новостей пропустить от начала выдачи. При поиске по курсору всегда 0.
+//
+//	Страницы нумеруются с 1, нулевая страница считается первой
+func (sn *SearchNews) Offset() int {
+	if sn == nil || sn.Scroll != nil || sn.Pagination.Page <= 1 {
+		return 0
+	}
+	return (sn.Pagination.Page - 1) * sn.Pagination.Limit
 }
 
 type SearchNewsFilter struct {
@@ -230,4 +301,6 @@ func (sno SearchNewsOrder) GetValidDirection() dto.OrderDirection {
 type SearchNewsResult struct {
 	News  []*entityNews.NewsFull
 	Total int
+	// Next позиция следующей страницы, nil если новостей больше нет
+	Next *SearchNewsScroll
 }
@@ -0,0 +1,175 @@
+package news
+
+import (
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+// Snapshot снимок создаваемой новости
+func (nn *NewNews) Snapshot() *entityNews.NewsSnapshot {
+	if nn == nil {
+		return nil
+	}
+
+	snapshot := &entityNews.NewsSnapshot{
+		Title:           nn.Title,
+		Slug:            nn.Slug,
+		ImageID:         nn.ImageID,
+		CategoryID:      nn.CategoryID,
+		OrganizationID:  nn.OrganizationID,
+		ProductID:       nn.ProductID,
+		Body:            nn.Body,
+		OnMain:          nn.OnMain,
+		Pinned:          nn.Pinned,
+		CanDisplayViews: nn.CanDisplayViews,
+		CanReacts:       nn.CanReacts,
+		CanCommented:    nn.CanCommented,
+		PublicationAt:   nn.PublicationAt,
+	}
+	for _, id := range nn.Participants {
+		if id != nil && *id != uuid.Nil {
+			snapshot.Participants = append(snapshot.Participants, *id)
+		}
+	}
+	return snapshot
+}
+
+// ValidateDraft проверка автосохраняемого черновика. Черновик может быть заполнен не полностью,
+// поэтому проверяется только то, что содержимое разбирается
+func (un *UpdateNews) ValidateDraft() error {
+	if un == nil {
+		return diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	if len(un.Body) > 0 {
+		if _, err := entityNews.ParseBody(un.Body); err != nil {
+			return diterrors.NewValidationError(ErrNewsBody, diterrors.ErrValidationFields{
+				Field:   "content",
+				Message: err.Error(),
+			})
+		}
+	}
+
+	return nil
+}
+
+// Apply снимок новости после применения изменений к base.
+//
+//	Незаданные поля не меняются, uuid.Nil очищает ссылку. Дата публикации, как и при обновлении, очищается,
+//	если она не передана
+func (un *UpdateNews) Apply(base *entityNews.NewsSnapshot) *entityNews.NewsSnapshot {
+	snapshot := &entityNews.NewsSnapshot{}
+	if base != nil {
+		*snapshot = *base
+	}
+	if un == nil {
+		return snapshot
+	}
+
+	if un.Title != nil {
+		snapshot.Title = *un.Title
+	}
+	if un.Slug != nil {
+		snapshot.Slug = *un.Slug
+	}
+	if un.ImageID != nil {
+		snapshot.ImageID = optionalUUID(*un.ImageID)
+	}
+	if un.CategoryID != nil && *un.CategoryID != uuid.Nil {
+		snapshot.CategoryID = *un.CategoryID
+	}
+	if un.OrganizationID != nil {
+		snapshot.OrganizationID = optionalUUID(*un.OrganizationID)
+	}
+	if un.ProductID != nil {
+		snapshot.ProductID = optionalUUID(*un.ProductID)
+	}
+	if un.Participants != nil {
+		snapshot.Participants = make([]uuid.UUID, 0, len(un.Participants))
+		for _, id := range un.Participants {
+			if id != nil && *id != uuid.Nil {
+				snapshot.Participants = append(snapshot.Participants, *id)
+			}
+		}
+	}
+	if len(un.Body) > 0 {
+		snapshot.Body = un.Body
+	}
+	if un.OnMain != nil {
+		snapshot.OnMain = *un.OnMain
+	}
+	if un.Pinned != nil {
+		snapshot.Pinned = *un.Pinned
+	}
+	if un.CanDisplayViews != nil {
+		snapshot.CanDisplayViews = *un.CanDisplayViews
+	}
+	if un.CanReacts != nil {
+		snapshot.CanReacts = *un.CanReacts
+	}
+	if un.CanCommented != nil {
+		snapshot.CanCommented = *un.CanCommented
+	}
+	snapshot.PublicationAt = nil
+	if un.PublicationAt != nil && !un.PublicationAt.IsZero() {
+		snapshot.PublicationAt = un.PublicationAt
+	}
+
+	return snapshot
+}
+
+// UpdateFromSnapshot обновление, возвращающее новость к снимку.
+//
+//	Прошедшая дата публикации не восстанавливается, так как ее нельзя установить при обновлении
+func UpdateFromSnapshot(snapshot *entityNews.NewsSnapshot, now time.Time) *UpdateNews {
+	if snapshot == nil {
+		return nil
+	}
+
+	participants := make([]*uuid.UUID, 0, len(snapshot.Participants))
+	for _, id := range snapshot.Participants {
+		participants = append(participants, &id)
+	}
+
+	update := &UpdateNews{
+		Title:           &snapshot.Title,
+		Slug:            &snapshot.Slug,
+		ImageID:         clearableUUID(snapshot.ImageID),
+		OrganizationID:  clearableUUID(snapshot.OrganizationID),
+		ProductID:       clearableUUID(snapshot.ProductID),
+		Participants:    participants,
+		Status:          entityNews.NewsStatusInvalid,
+		Body:            snapshot.Body,
+		OnMain:          &snapshot.OnMain,
+		Pinned:          &snapshot.Pinned,
+		CanDisplayViews: &snapshot.CanDisplayViews,
+		CanReacts:       &snapshot.CanReacts,
+		CanCommented:    &snapshot.CanCommented,
+	}
+	if snapshot.CategoryID != uuid.Nil {
+		update.CategoryID = &snapshot.CategoryID
+	}
+	if snapshot.PublicationAt != nil && snapshot.PublicationAt.After(now) {
+		update.PublicationAt = snapshot.PublicationAt
+	}
+	return update
+}
+
+func optionalUUID(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+	return &id
+}
+
+// clearableUUID ссылка для обновления: отсутствующая ссылка очищается
+func clearableUUID(id *uuid.UUID) *uuid.UUID {
+	if id == nil {
+		return &uuid.Nil
+	}
+	return id
+}
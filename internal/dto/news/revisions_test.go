@@ -0,0 +1,100 @@
+package news
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_UpdateNews_Apply(t *testing.T) {
+	imageID := uuid.New()
+	categoryID := uuid.New()
+	participantID := uuid.New()
+	publicationAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	base := &entityNews.NewsSnapshot{
+		Title:         "title",
+		Slug:          "slug",
+		ImageID:       &imageID,
+		CategoryID:    categoryID,
+		Participants:  []uuid.UUID{participantID},
+		Body:          []byte(`{"blocks":[]}`),
+		OnMain:        true,
+		PublicationAt: &publicationAt,
+	}
+	title := "new title"
+	onMain := false
+
+	tests := []struct {
+		name   string
+		update *UpdateNews
+		want   *entityNews.NewsSnapshot
+	}{
+		{
+			name:   "not set fields are kept",
+			update: &UpdateNews{Title: &title, OnMain: &onMain, PublicationAt: &publicationAt},
+			want: &entityNews.NewsSnapshot{
+				Title:         title,
+				Slug:          "slug",
+				ImageID:       &imageID,
+				CategoryID:    categoryID,
+				Participants:  []uuid.UUID{participantID},
+				Body:          []byte(`{"blocks":[]}`),
+				PublicationAt: &publicationAt,
+			},
+		},
+		{
+			name: "references are cleared",
+			update: &UpdateNews{
+				ImageID:       &uuid.Nil,
+				CategoryID:    &uuid.Nil,
+				Participants:  []*uuid.UUID{nil, &uuid.Nil},
+				PublicationAt: &time.Time{},
+			},
+			want: &entityNews.NewsSnapshot{
+				Title:        "title",
+				Slug:         "slug",
+				CategoryID:   categoryID,
+				Participants: []uuid.UUID{},
+				Body:         []byte(`{"blocks":[]}`),
+				OnMain:       true,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.update.Apply(base))
+		})
+	}
+}
+
+func Test_UpdateFromSnapshot(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	categoryID := uuid.New()
+	participantID := uuid.New()
+	future := now.Add(time.Hour)
+	past := now.Add(-time.Hour)
+
+	snapshot := &entityNews.NewsSnapshot{
+		Title:         "title",
+		Slug:          "slug",
+		CategoryID:    categoryID,
+		Participants:  []uuid.UUID{participantID},
+		Body:          []byte(`{"blocks":[]}`),
+		PublicationAt: &future,
+	}
+	update := UpdateFromSnapshot(snapshot, now)
+	assert.Equal(t, "title", *update.Title)
+	assert.Equal(t, &uuid.Nil, update.ImageID)
+	assert.Equal(t, &categoryID, update.CategoryID)
+	assert.Equal(t, []*uuid.UUID{&participantID}, update.Participants)
+	assert.Equal(t, &future, update.PublicationAt)
+	assert.Equal(t, snapshot, update.Apply(&entityNews.NewsSnapshot{}))
+
+	snapshot.PublicationAt = &past
+	assert.Nil(t, UpdateFromSnapshot(snapshot, now).PublicationAt)
+	assert.Nil(t, UpdateFromSnapshot(nil, now))
+}
@@ -0,0 +1,176 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// AccessRule правило доступа пользователя к приложению
+type AccessRule struct {
+	// Email почта пользователя или маска домена вида *@mos.ru
+	Email string `json:"email"`
+	// PortalIDs порталы, на которые разрешен доступ.
+	//  Пустой список означает доступ ко всем порталам
+	PortalIDs []int `json:"portal_ids,omitempty"`
+	// DenyPortalIDs порталы, на которые доступ запрещен
+	DenyPortalIDs []int `json:"deny_portal_ids,omitempty"`
+	// ExpiresAt время окончания действия правила
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+}
+
+// allowsPortal проверяет доступ к порталу.
+//
+//	Нулевой идентификатор портала означает, что портал еще не выбран
+func (r *AccessRule) allowsPortal(portalID int) bool {
+	if portalID == 0 {
+		return true
+	}
+	for _, id := range r.DenyPortalIDs {
+		if id == portalID {
+			return false
+		}
+	}
+	if len(r.PortalIDs) == 0 {
+		return true
+	}
+	for _, id := range r.PortalIDs {
+		if id == portalID {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *AccessRule) isExpired(now time.Time) bool {
+	return r.ExpiresAt != nil && !now.Before(*r.ExpiresAt)
+}
+
+// AccessListFile формат файла списка доступа
+type AccessListFile struct {
+	// Version версия списка
+	Version string `json:"version"`
+	// Allow правила доступа
+	Allow []*AccessRule `json:"allow"`
+	// Deny почты и маски доменов, которым доступ запрещен
+	Deny []string `json:"deny"`
+}
+
+// UnmarshalJSON поддерживает как структурированный формат, так и плоский список почт
+func (f *AccessListFile) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var emails []string
+		if err := json.Unmarshal(data, &emails); err != nil {
+			return err
+		}
+		f.Allow = make([]*AccessRule, 0, len(emails))
+		for _, email := range emails {
+			f.Allow = append(f.Allow, &AccessRule{Email: email})
+		}
+		return nil
+	}
+
+	type accessListFile AccessListFile
+	return json.Unmarshal(data, (*accessListFile)(f))
+}
+
+// AccessListInfo сведения о загруженном списке доступа
+type AccessListInfo struct {
+	Version  string    `json:"version"`
+	Entries  int       `json:"entries"`
+	LoadedAt time.Time `json:"loadedAt"`
+}
+
+// AccessList список доступа пользователей к приложению
+type AccessList struct {
+	info AccessListInfo
+
+	emails  map[string]*AccessRule
+	domains map[string]*AccessRule
+	deny    map[string]struct{}
+}
+
+// NewAccessList собирает список доступа из содержимого файла
+func NewAccessList(file *AccessListFile, loadedAt time.Time) *AccessList {
+	al := &AccessList{
+		info: AccessListInfo{
+			LoadedAt: loadedAt,
+		},
+		emails:  make(map[string]*AccessRule),
+		domains: make(map[string]*AccessRule),
+		deny:    make(map[string]struct{}),
+	}
+	if file == nil {
+		return al
+	}
+	al.info.Version = file.Version
+
+	for _, rule := range file.Allow {
+		if rule == nil {
+			continue
+		}
+		key := normalizeAccessKey(rule.Email)
+		if key == "" {
+			continue
+		}
+		if domain, ok := strings.CutPrefix(key, "*@"); ok {
+			al.domains[domain] = rule
+		} else {
+			al.emails[key] = rule
+		}
+	}
+	for _, email := range file.Deny {
+		if key := normalizeAccessKey(email); key != "" {
+			al.deny[key] = struct{}{}
+		}
+	}
+	al.info.Entries = len(al.emails) + len(al.domains) + len(al.deny)
+
+	return al
+}
+
+// Info сведения о списке доступа
+func (al *AccessList) Info() *AccessListInfo {
+	if al == nil {
+		return nil
+	}
+	info := al.info
+	return &info
+}
+
+// Allowed проверяет доступ пользователя к порталу.
+//
+//	Запрет имеет приоритет над разрешением, правило для почты — над правилом для домена.
+//	Нулевой идентификатор портала означает, что портал еще не выбран
+func (al *AccessList) Allowed(email string, portalID int, now time.Time) bool {
+	if al == nil {
+		return true
+	}
+	email = normalizeAccessKey(email)
+	if email == "" {
+		return false
+	}
+	_, domain, _ := strings.Cut(email, "@")
+
+	if _, ok := al.deny[email]; ok {
+		return false
+	}
+	if _, ok := al.deny["*@"+domain]; ok {
+		return false
+	}
+
+	rule, ok := al.emails[email]
+	if !ok {
+		rule, ok = al.domains[domain]
+	}
+	if !ok || rule.isExpired(now) {
+		return false
+	}
+	return rule.allowsPortal(portalID)
+}
+
+func normalizeAccessKey(s string) string {
+	return strings.ToLower(strings.TrimSpace(s))
+}
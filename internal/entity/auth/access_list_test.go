@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AccessList_Allowed(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	accessList := NewAccessList(&AccessListFile{
+		Version: "v1",
+		Allow: []*AccessRule{
+			{Email: "User@Example.com"},
+			{Email: "*@mos.ru", DenyPortalIDs: []int{3}},
+			{Email: "portal@example.com", PortalIDs: []int{1, 2}},
+			{Email: "expired@example.com", ExpiresAt: &past},
+			{Email: "temporary@example.com", ExpiresAt: &future},
+			{Email: "blocked@mos.ru"},
+		},
+		Deny: []string{"blocked@mos.ru", "*@spam.ru"},
+	}, now)
+
+	tests := []struct {
+		name       string
+		accessList *AccessList
+		email      string
+		portalID   int
+		want       bool
+	}{
+		{
+			name:       "nil access list",
+			accessList: nil,
+			email:      "any@example.com",
+			want:       true,
+		},
+		{
+			name:       "empty email",
+			accessList: accessList,
+			email:      "",
+			want:       false,
+		},
+		{
+			name:       "exact email case insensitive",
+			accessList: accessList,
+			email:      "user@EXAMPLE.com",
+			want:       true,
+		},
+		{
+			name:       "unknown email",
+			accessList: accessList,
+			email:      "unknown@example.com",
+			want:       false,
+		},
+		{
+			name:       "domain wildcard",
+			accessList: accessList,
+			email:      "someone@mos.ru",
+			portalID:   1,
+			want:       true,
+		},
+		{
+			name:       "domain wildcard denied portal",
+			accessList: accessList,
+			email:      "someone@mos.ru",
+			portalID:   3,
+			want:       false,
+		},
+		{
+			name:       "allowed portal",
+			accessList: accessList,
+			email:      "portal@example.com",
+			portalID:   2,
+			want:       true,
+		},
+		{
+			name:       "not allowed portal",
+			accessList: accessList,
+			email:      "portal@example.com",
+			portalID:   5,
+			want:       false,
+		},
+		{
+			name:       "portal not selected",
+			accessList: accessList,
+			email:      "portal@example.com",
+			portalID:   0,
+			want:       true,
+		},
+		{
+			name:       "expired rule",
+			accessList: accessList,
+			email:      "expired@example.com",
+			want:       false,
+		},
+		{
+			name:       "not expired rule",
+			accessList: accessList,
+			email:      "temporary@example.com",
+			want:       true,
+		},
+		{
+			name:       "deny email has priority",
+			accessList: accessList,
+			email:      "blocked@mos.ru",
+			want:       false,
+		},
+		{
+			name:       "deny domain",
+			accessList: accessList,
+			email:      "user@spam.ru",
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.accessList.Allowed(tt.email, tt.portalID, now))
+		})
+	}
+
+	assert.Equal(t, &AccessListInfo{Version: "v1", Entries: 8, LoadedAt: now}, accessList.Info())
+}
+
+func Test_AccessListFile_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    AccessListFile
+		wantErr bool
+	}{
+		{
+			name: "flat list",
+			data: `["a@example.com", "b@example.com"]`,
+			want: AccessListFile{
+				Allow: []*AccessRule{{Email: "a@example.com"}, {Email: "b@example.com"}},
+			},
+		},
+		{
+			name: "structured",
+			data: `{"version": "v2", "allow": [{"email": "*@mos.ru", "portal_ids": [1]}], "deny": ["x@mos.ru"]}`,
+			want: AccessListFile{
+				Version: "v2",
+				Allow:   []*AccessRule{{Email: "*@mos.ru", PortalIDs: []int{1}}},
+				Deny:    []string{"x@mos.ru"},
+			},
+		},
+		{
+			name:    "invalid",
+			data:    `[1, 2]`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got AccessListFile
+			err := json.Unmarshal([]byte(tt.data), &got)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SessionActivity активность сессии пользователя
+type SessionActivity struct {
+	// SessionID идентификатор сессии
+	SessionID string
+	// CloudID идентификатор пользователя СУДИР
+	CloudID string
+	// Email пользователя
+	Email string
+	// SNILS СНИЛС пользователя
+	SNILS string
+	// ActivePortal активный портал сессии
+	ActivePortal *ActivePortal
+	// UserIP IP адрес пользователя
+	UserIP net.IP
+	// UserAgent устройства пользователя
+	UserAgent string
+	// CreatedTime время создания сессии
+	CreatedTime time.Time
+	// LastActiveTime время последней активности сессии
+	LastActiveTime time.Time
+	// ExpiredTime время, после которого сессия не может быть использована
+	ExpiredTime time.Time
+	// Revoked сессия отозвана пользователем, хранится отдельно от активности
+	Revoked bool `json:"-"`
+	// Current сессия, от имени которой выполняется запрос
+	Current bool `json:"-"`
+}
+
+// NewSessionActivity активность сессии по данным сессии
+func NewSessionActivity(session *Session, now time.Time) *SessionActivity {
+	activity := &SessionActivity{
+		SessionID:      session.GetID().String(),
+		CloudID:        session.GetUser().GetCloudID(),
+		Email:          session.GetUser().GetEmail(),
+		SNILS:          session.GetUser().GetSNILS(),
+		ActivePortal:   session.GetActivePortal(),
+		UserIP:         session.UserIP,
+		UserAgent:      session.GetDevice().GetUserAgent(),
+		CreatedTime:    session.CreatedTime,
+		LastActiveTime: now,
+		ExpiredTime:    session.RefreshExpiredTime,
+	}
+	if session.LastActiveTime != nil {
+		activity.LastActiveTime = *session.LastActiveTime
+	}
+	if session.AccessExpiredTime.After(activity.ExpiredTime) {
+		activity.ExpiredTime = session.AccessExpiredTime
+	}
+	return activity
+}
+
+// Session сессия, восстановленная по активности.
+//
+//	Содержит только данные, сохраняемые в активности: пользователя без сведений о сотруднике,
+//	устройство, IP адрес и активный портал. Этого достаточно роутам, которым не нужна полная сессия
+func (a *SessionActivity) Session() *Session {
+	session := &Session{
+		User: &User{
+			CloudID: a.CloudID,
+			Email:   a.Email,
+			SNILS:   a.SNILS,
+		},
+		UserIP:             a.UserIP,
+		Device:             &Device{UserAgent: a.UserAgent},
+		ActivePortal:       a.ActivePortal,
+		RefreshExpiredTime: a.ExpiredTime,
+		CreatedTime:        a.CreatedTime,
+		IsActive:           true,
+	}
+	if id, err := uuid.Parse(a.SessionID); err == nil {
+		sessionID := SessionID(id)
+		session.ID = &sessionID
+	}
+	if !a.LastActiveTime.IsZero() {
+		lastActiveTime := a.LastActiveTime
+		session.LastActiveTime = &lastActiveTime
+	}
+	return session
+}
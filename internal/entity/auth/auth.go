@@ -3,7 +3,6 @@ package auth
 import (
 	"errors"
 	"fmt"
-	"slices"
 	"time"
 
 	"go.uber.org/zap/zapcore"
@@ -136,9 +135,3 @@ type AuthPortalParams struct {
 	Device        *Device
 	User          User1C
 }
-
-type AccessList []string
-
-func (al AccessList) Have(s string) bool {
-	return slices.Contains(al, s)
-}
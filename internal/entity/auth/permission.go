@@ -0,0 +1,106 @@
+package auth
+
+import (
+	"slices"
+	"strings"
+)
+
+// Permission право на выполнение действия в административном разделе
+type Permission string
+
+const (
+	// PermissionAll все права
+	PermissionAll Permission = "*"
+
+	// PermissionNewsRead просмотр новостей
+	PermissionNewsRead Permission = "news.read"
+	// PermissionNewsWrite создание и редактирование новостей
+	PermissionNewsWrite Permission = "news.write"
+	// PermissionNewsPublish смена статуса публикации новостей
+	PermissionNewsPublish Permission = "news.publish"
+	// PermissionNewsDelete удаление новостей
+	PermissionNewsDelete Permission = "news.delete"
+	// PermissionNewsCommentsModerate модерация комментариев к новостям
+	PermissionNewsCommentsModerate Permission = "news.comments.moderate"
+
+	// PermissionNewsCategoryRead просмотр категорий новостей
+	PermissionNewsCategoryRead Permission = "news.category.read"
+	// PermissionNewsCategoryWrite создание и редактирование категорий новостей
+	PermissionNewsCategoryWrite Permission = "news.category.write"
+	// PermissionNewsCategoryDelete удаление категорий новостей
+	PermissionNewsCategoryDelete Permission = "news.category.delete"
+
+	// PermissionBannersWrite изменение баннеров
+	PermissionBannersWrite Permission = "banners.write"
+
+	// PermissionSurveysRead просмотр опросов
+	PermissionSurveysRead Permission = "surveys.read"
+	// PermissionSurveysWrite создание и редактирование опросов
+	PermissionSurveysWrite Permission = "surveys.write"
+	// PermissionSurveysPublish публикация опросов и снятие с публикации
+	PermissionSurveysPublish Permission = "surveys.publish"
+	// PermissionSurveysDelete удаление опросов
+	PermissionSurveysDelete Permission = "surveys.delete"
+)
+
+// Grants набор прав пользователя на портале
+type Grants map[Permission]struct{}
+
+// Has проверяет наличие всех переданных прав
+func (g Grants) Has(permissions ...Permission) bool {
+	if _, ok := g[PermissionAll]; ok {
+		return true
+	}
+	for _, permission := range permissions {
+		if _, ok := g[permission]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// RoleBinding назначение ролей пользователю
+type RoleBinding struct {
+	// Email пользователя
+	Email string `json:"email"`
+	// Roles назначенные роли
+	Roles []string `json:"roles"`
+	// PortalIDs порталы, на которых действует назначение.
+	//  Пустой список означает действие назначения на всех порталах
+	PortalIDs []int `json:"portal_ids"`
+}
+
+// AppliesTo проверяет, что назначение относится к пользователю на портале
+func (rb *RoleBinding) AppliesTo(email string, portalID int) bool {
+	if rb == nil || !strings.EqualFold(rb.Email, email) {
+		return false
+	}
+	return len(rb.PortalIDs) == 0 || slices.Contains(rb.PortalIDs, portalID)
+}
+
+// Policy политика доступа к административному разделу
+type Policy struct {
+	// Roles роли и их права
+	Roles map[string][]Permission `json:"roles"`
+	// Bindings назначения ролей пользователям
+	Bindings []*RoleBinding `json:"bindings"`
+}
+
+// Grants возвращает права пользователя на портале
+func (p *Policy) Grants(email string, portalID int) Grants {
+	grants := make(Grants)
+	if p == nil || email == "" {
+		return grants
+	}
+	for _, binding := range p.Bindings {
+		if !binding.AppliesTo(email, portalID) {
+			continue
+		}
+		for _, role := range binding.Roles {
+			for _, permission := range p.Roles[role] {
+				grants[permission] = struct{}{}
+			}
+		}
+	}
+	return grants
+}
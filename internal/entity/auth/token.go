@@ -0,0 +1,15 @@
+package auth
+
+import "time"
+
+// TokenClaims данные, полученные из проверенного токена доступа
+type TokenClaims struct {
+	// SessionID идентификатор сессии
+	SessionID string
+	// Subject субъект, которому выдан токен
+	Subject string
+	// Issuer сервис, выпустивший токен
+	Issuer string
+	// ExpiresAt время истечения срока действия токена
+	ExpiresAt time.Time
+}
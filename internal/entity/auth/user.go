@@ -35,6 +35,13 @@ func (u *User) GetLogin() string {
 	return u.Login
 }
 
+func (u *User) GetCloudID() string {
+	if u == nil {
+		return ""
+	}
+	return u.CloudID
+}
+
 func (u *User) GetEmail() string {
 	if u == nil {
 		return ""
@@ -42,6 +49,13 @@ func (u *User) GetEmail() string {
 	return u.Email
 }
 
+func (u *User) GetSNILS() string {
+	if u == nil {
+		return ""
+	}
+	return u.SNILS
+}
+
 // MarshalLogObject маршаллер для добавления пользователя в логи
 func (u *User) MarshalLogObject(enc zapcore.ObjectEncoder) error {
 	if u == nil {
@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// Состояния автоматического выключателя зависимого сервиса
+const (
+	DependencyClosed   = "closed"
+	DependencyOpen     = "open"
+	DependencyHalfOpen = "half-open"
+)
+
+// DependencyStatus состояние зависимого сервиса
+type DependencyStatus struct {
+	// Name наименование сервиса
+	Name string `json:"name"`
+	// Target адрес сервиса
+	Target string `json:"target"`
+	// Required сервис обязателен для готовности приложения
+	Required bool `json:"required"`
+	// State состояние автоматического выключателя: closed, open, half-open
+	State string `json:"state"`
+	// Failures количество ошибок подряд
+	Failures int `json:"failures"`
+	// OpenedAt время размыкания выключателя
+	OpenedAt *time.Time `json:"openedAt,omitempty"`
+}
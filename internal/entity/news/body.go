@@ -0,0 +1,348 @@
+package news
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// BodyBlockType тип блока в теле новости
+type BodyBlockType string
+
+const (
+	BodyBlockParagraph BodyBlockType = "paragraph"
+	BodyBlockHeading   BodyBlockType = "heading"
+	BodyBlockImage     BodyBlockType = "image"
+	BodyBlockEmbed     BodyBlockType = "embed"
+	BodyBlockList      BodyBlockType = "list"
+)
+
+const (
+	// BodyMaxBlocks максимальное количество блоков в теле новости
+	BodyMaxBlocks = 500
+	// BodyMaxListItems максимальное количество пунктов в списке
+	BodyMaxListItems = 100
+	// BodyHeadingMinLevel BodyHeadingMaxLevel допустимые уровни заголовков, h1 занят заголовком новости
+	BodyHeadingMinLevel = 2
+	BodyHeadingMaxLevel = 4
+	// BodyWordsPerMinute скорость чтения, по которой оценивается время чтения новости
+	BodyWordsPerMinute = 180
+)
+
+// bodyEmbedHosts хосты, контент которых можно встраивать в новость. Поддомены разрешены
+var bodyEmbedHosts = []string{
+	"mos.ru",
+	"rutube.ru",
+	"vk.com",
+	"vkvideo.ru",
+	"youtube.com",
+	"youtu.be",
+}
+
+// Body тело новости: упорядоченный список блоков.
+//
+//	Текст абзацев, заголовков, подписей и пунктов списков может содержать inline-разметку из SanitizeHTML
+type Body struct {
+	Blocks []*BodyBlock `json:"blocks"`
+}
+
+// BodyBlock блок тела новости. Заполняются только поля, относящиеся к типу блока
+type BodyBlock struct {
+	Type BodyBlockType `json:"type"`
+	// Text текст абзаца или заголовка
+	Text string `json:"text,omitempty"`
+	// Level уровень заголовка
+	Level int `json:"level,omitempty"`
+	// FileID идентификатор изображения в файловом сервисе
+	FileID *uuid.UUID `json:"fileId,omitempty"`
+	// Caption подпись к изображению или встраиваемому контенту
+	Caption string `json:"caption,omitempty"`
+	// URL адрес встраиваемого контента
+	URL string `json:"url,omitempty"`
+	// Ordered нумерованный список
+	Ordered bool `json:"ordered,omitempty"`
+	// Items пункты списка
+	Items []string `json:"items,omitempty"`
+}
+
+// ParseBody разбирает тело новости. Пустое тело и null считаются телом без блоков
+func ParseBody(raw []byte) (*Body, error) {
+	body := &Body{}
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return body, nil
+	}
+	if err := json.Unmarshal(raw, body); err != nil {
+		return nil, err
+	}
+	return body, nil
+}
+
+// Sanitize очищает разметку во всех текстовых полях блоков
+func (b *Body) Sanitize() {
+	if b == nil {
+		return
+	}
+	for _, block := range b.Blocks {
+		if block == nil {
+			continue
+		}
+		block.Text = SanitizeHTML(block.Text)
+		block.Caption = SanitizeHTML(block.Caption)
+		for i := range block.Items {
+			block.Items[i] = SanitizeHTML(block.Items[i])
+		}
+	}
+}
+
+// PlainText текст новости без разметки, блоки разделены переводом строки
+func (b *Body) PlainText() string {
+	if b == nil {
+		return ""
+	}
+	parts := make([]string, 0, len(b.Blocks))
+	add := func(s string) {
+		if s = HTMLToText(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	for _, block := range b.Blocks {
+		if block == nil {
+			continue
+		}
+		switch block.Type {
+		case BodyBlockParagraph, BodyBlockHeading:
+			add(block.Text)
+		case BodyBlockImage, BodyBlockEmbed:
+			add(block.Caption)
+		case BodyBlockList:
+			for _, item := range block.Items {
+				add(item)
+			}
+		}
+	}
+	return strings.Join(parts, "\n")
+}
+
+// Snippet начало текста новости не длиннее limit символов, обрезанное по границе слова
+func (b *Body) Snippet(limit int) string {
+	text := strings.Join(strings.Fields(b.PlainText()), " ")
+	if limit <= 0 || utf8.RuneCountInString(text) <= limit {
+		return text
+	}
+	runes := []rune(text)[:limit]
+	if i := lastSpace(runes); i > 0 {
+		runes = runes[:i]
+	}
+	return strings.TrimRightFunc(string(runes), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + "…"
+}
+
+// ReadingTime оценка времени чтения новости в минутах. Для новости без текста 0
+func (b *Body) ReadingTime() int {
+	words := len(strings.Fields(b.PlainText()))
+	if words == 0 {
+		return 0
+	}
+	return (words + BodyWordsPerMinute - 1) / BodyWordsPerMinute
+}
+
+// IsEmbedURLAllowed можно ли встроить контент по адресу: только https с разрешенного хоста
+func IsEmbedURLAllowed(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Scheme != "https" || u.User != nil {
+		return false
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range bodyEmbedHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// allowedInlineTags inline-теги, которые сохраняются в тексте новости
+var allowedInlineTags = map[atom.Atom]bool{
+	atom.A:      true,
+	atom.B:      true,
+	atom.Br:     true,
+	atom.Em:     true,
+	atom.I:      true,
+	atom.S:      true,
+	atom.Strong: true,
+	atom.Sub:    true,
+	atom.Sup:    true,
+	atom.U:      true,
+}
+
+// droppedContentTags теги, которые удаляются вместе с содержимым
+var droppedContentTags = map[atom.Atom]bool{
+	atom.Iframe:   true,
+	atom.Noscript: true,
+	atom.Object:   true,
+	atom.Script:   true,
+	atom.Style:    true,
+	atom.Template: true,
+	atom.Textarea: true,
+	atom.Title:    true,
+}
+
+// SanitizeHTML оставляет в тексте только разрешенные inline-теги без атрибутов.
+//
+//	У ссылок сохраняется только href со схемой http, https или mailto. Текст экранируется, незакрытые теги закрываются
+func SanitizeHTML(s string) string {
+	if !strings.ContainsAny(s, "<>&\"'") {
+		return strings.TrimSpace(s)
+	}
+
+	var (
+		out     strings.Builder
+		open    []atom.Atom
+		dropped int
+	)
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		token := z.Token()
+		switch tt {
+		case html.TextToken:
+			if dropped == 0 {
+				out.WriteString(html.EscapeString(token.Data))
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if droppedContentTags[token.DataAtom] {
+				if tt == html.StartTagToken {
+					dropped++
+				}
+				continue
+			}
+			if dropped > 0 || !allowedInlineTags[token.DataAtom] {
+				continue
+			}
+			if token.DataAtom == atom.Br {
+				out.WriteString("<br>")
+				continue
+			}
+			out.WriteString("<" + token.Data)
+			if token.DataAtom == atom.A {
+				if href, ok := safeHref(token.Attr); ok {
+					out.WriteString(` href="` + html.EscapeString(href) + `" rel="nofollow noopener noreferrer"`)
+				}
+			}
+			out.WriteString(">")
+			if tt == html.StartTagToken {
+				open = append(open, token.DataAtom)
+			} else {
+				out.WriteString("</" + token.Data + ">")
+			}
+		case html.EndTagToken:
+			if droppedContentTags[token.DataAtom] {
+				if dropped > 0 {
+					dropped--
+				}
+				continue
+			}
+			if dropped > 0 || !allowedInlineTags[token.DataAtom] {
+				continue
+			}
+			idx := -1
+			for i := len(open) - 1; i >= 0; i-- {
+				if open[i] == token.DataAtom {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				continue
+			}
+			for i := len(open) - 1; i >= idx; i-- {
+				out.WriteString("</" + open[i].String() + ">")
+			}
+			open = open[:idx]
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		out.WriteString("</" + open[i].String() + ">")
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
+// HTMLToText текст без разметки. Содержимое удаляемых тегов не попадает в результат
+func HTMLToText(s string) string {
+	if !strings.ContainsAny(s, "<>&") {
+		return strings.TrimSpace(s)
+	}
+
+	var (
+		out     strings.Builder
+		dropped int
+	)
+	z := html.NewTokenizer(strings.NewReader(s))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		token := z.Token()
+		switch tt {
+		case html.TextToken:
+			if dropped == 0 {
+				out.WriteString(token.Data)
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			switch {
+			case droppedContentTags[token.DataAtom] && tt == html.StartTagToken:
+				dropped++
+			case token.DataAtom == atom.Br:
+				out.WriteString(" ")
+			}
+		case html.EndTagToken:
+			if droppedContentTags[token.DataAtom] && dropped > 0 {
+				dropped--
+			}
+		}
+	}
+
+	return strings.TrimSpace(out.String())
+}
+
+func safeHref(attrs []html.Attribute) (string, bool) {
+	for _, attr := range attrs {
+		if attr.Namespace != "" || !strings.EqualFold(attr.Key, "href") {
+			continue
+		}
+		u, err := url.Parse(strings.TrimSpace(attr.Val))
+		if err != nil {
+			return "", false
+		}
+		switch strings.ToLower(u.Scheme) {
+		case "http", "https", "mailto":
+			return u.String(), true
+		default:
+			return "", false
+		}
+	}
+	return "", false
+}
+
+func lastSpace(runes []rune) int {
+	for i := len(runes) - 1; i >= 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			return i
+		}
+	}
+	return -1
+}
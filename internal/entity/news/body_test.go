@@ -0,0 +1,123 @@
+package news
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SanitizeHTML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{
+			name: "plain text",
+			in:   "  Новость дня ",
+			want: "Новость дня",
+		},
+		{
+			name: "allowed tags keep, attributes dropped",
+			in:   `<b class="x" onclick="alert(1)">жирный</b> и <em>курсив</em><br/>`,
+			want: "<b>жирный</b> и <em>курсив</em><br>",
+		},
+		{
+			name: "script removed with content",
+			in:   `до<script>alert("x")</script>после`,
+			want: "допосле",
+		},
+		{
+			name: "unknown tags unwrapped",
+			in:   `<div><span style="color:red">текст</span></div>`,
+			want: "текст",
+		},
+		{
+			name: "safe link",
+			in:   `<a href="https://mos.ru/news?id=1&amp;a=2" target="_blank">ссылка</a>`,
+			want: `<a href="https://mos.ru/news?id=1&amp;a=2" rel="nofollow noopener noreferrer">ссылка</a>`,
+		},
+		{
+			name: "javascript link loses href",
+			in:   `<a href="javascript:alert(1)">ссылка</a>`,
+			want: "<a>ссылка</a>",
+		},
+		{
+			name: "unclosed tags closed",
+			in:   "<b><i>текст",
+			want: "<b><i>текст</i></b>",
+		},
+		{
+			name: "stray closing tag dropped",
+			in:   "текст</b>",
+			want: "текст",
+		},
+		{
+			name: "text escaped",
+			in:   `1 < 2 & "кавычки"`,
+			want: "1 &lt; 2 &amp; &#34;кавычки&#34;",
+		},
+		{
+			name: "iframe removed",
+			in:   `<iframe src="https://evil.example"></iframe>видео`,
+			want: "видео",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SanitizeHTML(tt.in))
+		})
+	}
+}
+
+func Test_IsEmbedURLAllowed(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "allowed host", url: "https://rutube.ru/video/1", want: true},
+		{name: "allowed subdomain", url: "https://www.youtube.com/embed/1", want: true},
+		{name: "http scheme", url: "http://rutube.ru/video/1", want: false},
+		{name: "foreign host", url: "https://evil.example/video", want: false},
+		{name: "suffix without dot", url: "https://notmos.ru/", want: false},
+		{name: "userinfo", url: "https://mos.ru@evil.example/", want: false},
+		{name: "empty", url: "", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsEmbedURLAllowed(tt.url))
+		})
+	}
+}
+
+func Test_Body_PlainText(t *testing.T) {
+	body, err := ParseBody([]byte(`{"blocks":[
+		{"type":"heading","level":2,"text":"Заголовок"},
+		{"type":"paragraph","text":"Первый <b>абзац</b><script>x</script>"},
+		{"type":"image","fileId":"5f0c1b2e-6d7a-4c1e-9a3b-2f4d5e6a7b8c","caption":"Подпись"},
+		{"type":"embed","url":"https://rutube.ru/video/1"},
+		{"type":"list","items":["один","два"]}
+	]}`))
+	assert.NoError(t, err)
+
+	assert.Equal(t, "Заголовок\nПервый абзац\nПодпись\nодин\nдва", body.PlainText())
+	assert.Equal(t, "Заголовок Первый…", body.Snippet(20))
+	assert.Equal(t, "Заголовок Первый абзац Подпись один два", body.Snippet(100))
+	assert.Equal(t, 1, body.ReadingTime())
+
+	long := &Body{Blocks: []*BodyBlock{{
+		Type: BodyBlockParagraph,
+		Text: strings.Repeat("слово ", BodyWordsPerMinute*2+1),
+	}}}
+	assert.Equal(t, 3, long.ReadingTime())
+
+	empty, err := ParseBody([]byte("null"))
+	assert.NoError(t, err)
+	assert.Equal(t, "", empty.PlainText())
+	assert.Equal(t, 0, empty.ReadingTime())
+
+	_, err = ParseBody([]byte(`"<p>html</p>"`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,68 @@
+package news
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CommentReport жалоба читателя на комментарий
+type CommentReport struct {
+	ID        uuid.UUID
+	CommentID uuid.UUID
+	NewsID    uuid.UUID
+	// PortalID портал, на котором оставлена жалоба
+	PortalID   int
+	ReporterID uuid.UUID
+	Reason     string
+	CreatedAt  time.Time
+}
+
+// CommentModeration скрытие комментария модератором.
+//
+//	Хранится в web-api, сервис новостей не хранит скрытие комментариев
+type CommentModeration struct {
+	CommentID uuid.UUID
+	HiddenAt  time.Time
+	// HiddenBy сотрудник, скрывший комментарий
+	HiddenBy uuid.UUID
+}
+
+// Apply отмечает комментарий скрытым
+func (m *CommentModeration) Apply(comment *NewsComment) {
+	if m == nil || comment == nil {
+		return
+	}
+	hiddenAt := m.HiddenAt
+	comment.HiddenAt = &hiddenAt
+}
+
+const (
+	// CommentMaxDepth максимальная вложенность ответов: ответ на комментарий и ответ на ответ
+	CommentMaxDepth = 2
+	// CommentMaxMentions максимальное количество упоминаний сотрудников в комментарии
+	CommentMaxMentions = 10
+)
+
+// CommentThread место комментария в ветке обсуждения и упоминания в нем.
+//
+//	Хранится в web-api, сервис новостей отдает комментарии плоским списком
+type CommentThread struct {
+	CommentID uuid.UUID
+	NewsID    uuid.UUID
+	// ParentID комментарий, на который дан ответ, nil для комментария верхнего уровня
+	ParentID *uuid.UUID
+	// Depth вложенность: 0 - комментарий верхнего уровня, 1 - ответ, 2 - ответ на ответ
+	Depth     int
+	Mentions  []*Author
+	CreatedAt time.Time
+}
+
+// Apply переносит ветку и упоминания в комментарий
+func (t *CommentThread) Apply(comment *NewsComment) {
+	if t == nil || comment == nil {
+		return
+	}
+	comment.ParentID = t.ParentID
+	comment.Mentions = t.Mentions
+}
@@ -0,0 +1,18 @@
+package news
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// StatusTransition запись истории переходов новости между статусами
+type StatusTransition struct {
+	NewsID uuid.UUID
+	From   NewsStatus
+	To     NewsStatus
+	// Actor сотрудник, выполнивший переход
+	Actor     Author
+	Comment   string
+	CreatedAt time.Time
+}
@@ -133,6 +133,13 @@ func (n *NewsFull) GetUpdatedAtPtr() *time.Time {
 	return n.UpdatedAt
 }
 
+func (n *NewsFull) GetReactionsPtr() *Reactions {
+	if n == nil {
+		return nil
+	}
+	return n.Reactions
+}
+
 func (p *Participant) GetIDPtr() *uuid.UUID {
 	if p == nil {
 		return nil
@@ -175,6 +182,20 @@ func (n *NewsComment) GetDeletedAtPtr() *time.Time {
 	return n.DeletedAt
 }
 
+func (n *NewsComment) GetHiddenAtPtr() *time.Time {
+	if n == nil {
+		return nil
+	}
+	return n.HiddenAt
+}
+
+func (n *NewsComment) GetParentIDPtr() *uuid.UUID {
+	if n == nil {
+		return nil
+	}
+	return n.ParentID
+}
+
 func (n *News) GetImageID() uuid.UUID {
 	if n == nil || n.ImageID == nil {
 		return uuid.Nil
@@ -301,6 +322,13 @@ func (n *NewsFull) GetUpdatedAt() time.Time {
 	return *n.UpdatedAt
 }
 
+func (n *NewsFull) GetReactions() (_Reactions Reactions) {
+	if n == nil || n.Reactions == nil {
+		return
+	}
+	return *n.Reactions
+}
+
 func (p *Participant) GetID() uuid.UUID {
 	if p == nil || p.ID == nil {
 		return uuid.Nil
@@ -342,3 +370,17 @@ func (n *NewsComment) GetDeletedAt() time.Time {
 	}
 	return *n.DeletedAt
 }
+
+func (n *NewsComment) GetHiddenAt() time.Time {
+	if n == nil || n.HiddenAt == nil {
+		return time.Time{}
+	}
+	return *n.HiddenAt
+}
+
+func (n *NewsComment) GetParentID() uuid.UUID {
+	if n == nil || n.ParentID == nil {
+		return uuid.Nil
+	}
+	return *n.ParentID
+}
@@ -89,6 +89,8 @@ type NewsFull struct {
 	Visibility      *NewsNamedVisibility
 	CreatedAt       *time.Time
 	UpdatedAt       *time.Time
+	// Reactions реакции на новость, заполняются только для читателя
+	Reactions *Reactions
 }
 
 func (n *NewsFull) GetStatus() NewsStatus {
@@ -106,6 +108,18 @@ func (n *NewsFull) GetStatus() NewsStatus {
 	}
 }
 
+// PortalIDs порталы, на которых опубликована новость
+func (n *NewsFull) PortalIDs() []int {
+	portals := n.GetVisibility().Portals
+	portalIDs := make([]int, 0, len(portals))
+	for _, portal := range portals {
+		if portal != nil {
+			portalIDs = append(portalIDs, portal.ID)
+		}
+	}
+	return portalIDs
+}
+
 type NewsPortal struct {
 	ID   int
 	Name string
@@ -131,11 +145,19 @@ type Participant struct {
 
 type NewsComment struct {
 	ID        uuid.UUID
+	NewsID    uuid.UUID
 	Message   string
 	Author    Author
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
 	DeletedAt *time.Time
+	HiddenAt  *time.Time
+	// ParentID комментарий, на который дан ответ, nil для комментария верхнего уровня
+	ParentID *uuid.UUID
+	// Replies количество ответов на комментарий
+	Replies int
+	// Mentions упомянутые в тексте сотрудники
+	Mentions []*Author
 }
 
 type NewsVisibility struct {
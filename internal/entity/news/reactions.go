@@ -0,0 +1,33 @@
+package news
+
+import "slices"
+
+// ReactionType тип реакции на новость
+type ReactionType string
+
+const (
+	ReactionLike ReactionType = "like"
+)
+
+// reactionTypes поддерживаемые типы реакций. Новый тип реакции достаточно добавить в список
+var reactionTypes = []ReactionType{
+	ReactionLike,
+}
+
+// IsValid тип реакции поддерживается
+func (t ReactionType) IsValid() bool {
+	return slices.Contains(reactionTypes, t)
+}
+
+// ReactionTypes список поддерживаемых типов реакций
+func ReactionTypes() []ReactionType {
+	return slices.Clone(reactionTypes)
+}
+
+// Reactions реакции сотрудников на новость
+type Reactions struct {
+	// Counts количество реакций каждого типа
+	Counts map[ReactionType]int
+	// Mine реакции текущего сотрудника
+	Mine []ReactionType
+}
@@ -0,0 +1,152 @@
+package news
+
+import (
+	"bytes"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Поля новости, которые попадают в список изменений ревизии. Названия совпадают с полями админского API
+const (
+	RevisionFieldTitle         = "title"
+	RevisionFieldSlug          = "slug"
+	RevisionFieldImage         = "titleImageId"
+	RevisionFieldCategory      = "categoryId"
+	RevisionFieldOrganization  = "providerOrganizationId"
+	RevisionFieldProduct       = "providerProductId"
+	RevisionFieldParticipants  = "participantsIds"
+	RevisionFieldContent       = "content"
+	RevisionFieldFlags         = "flags"
+	RevisionFieldPublicationAt = "publishDate"
+)
+
+// NewsSnapshot редактируемые поля новости на момент сохранения
+type NewsSnapshot struct {
+	Title           string
+	Slug            string
+	ImageID         *uuid.UUID
+	CategoryID      uuid.UUID
+	OrganizationID  *uuid.UUID
+	ProductID       *uuid.UUID
+	Participants    []uuid.UUID
+	Body            []byte
+	OnMain          bool
+	Pinned          bool
+	CanDisplayViews bool
+	CanReacts       bool
+	CanCommented    bool
+	PublicationAt   *time.Time
+}
+
+// SnapshotFromNews снимок текущей версии новости
+func SnapshotFromNews(n *NewsFull) *NewsSnapshot {
+	if n == nil {
+		return nil
+	}
+
+	snapshot := &NewsSnapshot{
+		Title:           n.Title,
+		Slug:            n.Slug,
+		ImageID:         n.ImageID,
+		Body:            n.Body,
+		OnMain:          n.OnMain,
+		Pinned:          n.Pinned,
+		CanDisplayViews: n.CanDisplayViews,
+		CanReacts:       n.CanReacts,
+		CanCommented:    n.CanCommented,
+	}
+	if n.Category != nil {
+		snapshot.CategoryID = n.Category.ID
+	}
+	if n.Organization != nil {
+		snapshot.OrganizationID = &n.Organization.ID
+	}
+	if n.Product != nil {
+		snapshot.ProductID = &n.Product.ID
+	}
+	for _, participant := range n.Participants {
+		if participant != nil && participant.ID != nil {
+			snapshot.Participants = append(snapshot.Participants, *participant.ID)
+		}
+	}
+	if n.PublicationAt != nil && !n.PublicationAt.IsZero() {
+		snapshot.PublicationAt = n.PublicationAt
+	}
+	return snapshot
+}
+
+// ChangedFields поля, отличающиеся от предыдущей версии. Без предыдущей версии изменений нет
+func (s *NewsSnapshot) ChangedFields(prev *NewsSnapshot) []string {
+	if s == nil || prev == nil {
+		return nil
+	}
+
+	var fields []string
+	add := func(field string, changed bool) {
+		if changed {
+			fields = append(fields, field)
+		}
+	}
+	add(RevisionFieldTitle, s.Title != prev.Title)
+	add(RevisionFieldSlug, s.Slug != prev.Slug)
+	add(RevisionFieldImage, !equalUUID(s.ImageID, prev.ImageID))
+	add(RevisionFieldCategory, s.CategoryID != prev.CategoryID)
+	add(RevisionFieldOrganization, !equalUUID(s.OrganizationID, prev.OrganizationID))
+	add(RevisionFieldProduct, !equalUUID(s.ProductID, prev.ProductID))
+	add(RevisionFieldParticipants, !slices.Equal(s.Participants, prev.Participants))
+	add(RevisionFieldContent, !bytes.Equal(s.Body, prev.Body))
+	add(RevisionFieldFlags, s.OnMain != prev.OnMain || s.Pinned != prev.Pinned ||
+		s.CanDisplayViews != prev.CanDisplayViews || s.CanReacts != prev.CanReacts || s.CanCommented != prev.CanCommented)
+	add(RevisionFieldPublicationAt, !equalTime(s.PublicationAt, prev.PublicationAt))
+	return fields
+}
+
+// Revision сохраненная версия новости
+type Revision struct {
+	ID     uuid.UUID
+	NewsID uuid.UUID
+	// Author редактор, сохранивший версию
+	Author    Author
+	CreatedAt time.Time
+	// ChangedFields поля, измененные относительно предыдущей версии. Для первой версии пусто
+	ChangedFields []string
+	// RestoredFrom ревизия, из которой восстановлена версия
+	RestoredFrom *uuid.UUID
+	Snapshot     *NewsSnapshot
+}
+
+// Draft автосохраненный черновик правок редактора, не опубликованный в новость
+type Draft struct {
+	NewsID   uuid.UUID
+	EditorID uuid.UUID
+	Snapshot *NewsSnapshot
+	// BaseUpdatedAt версия новости, от которой редактор начал правки
+	BaseUpdatedAt *time.Time
+	SavedAt       time.Time
+}
+
+// SameVersion совпадают ли версии новости по времени изменения.
+//
+//	Время сравнивается с точностью до миллисекунды, потому что клиенты теряют наносекунды при сериализации
+func SameVersion(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Truncate(time.Millisecond).Equal(b.Truncate(time.Millisecond))
+}
+
+func equalUUID(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+func equalTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,85 @@
+package news
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewsSnapshot_ChangedFields(t *testing.T) {
+	imageID := uuid.New()
+	publicationAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	base := func() *NewsSnapshot {
+		return &NewsSnapshot{
+			Title:         "title",
+			Slug:          "slug",
+			ImageID:       &imageID,
+			CategoryID:    uuid.MustParse("6f1f4c1e-7a57-4b0a-9b1e-3c2b1e6c9f10"),
+			Participants:  []uuid.UUID{imageID},
+			Body:          []byte(`{"blocks":[]}`),
+			OnMain:        true,
+			PublicationAt: &publicationAt,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		change func(s *NewsSnapshot)
+		prev   *NewsSnapshot
+		want   []string
+	}{
+		{
+			name:   "without previous version",
+			change: func(s *NewsSnapshot) { s.Title = "new" },
+			prev:   nil,
+			want:   nil,
+		},
+		{
+			name:   "nothing changed",
+			change: func(s *NewsSnapshot) { s.ImageID = &[]uuid.UUID{imageID}[0] },
+			prev:   base(),
+			want:   nil,
+		},
+		{
+			name: "fields changed",
+			change: func(s *NewsSnapshot) {
+				s.Title = "new"
+				s.ImageID = nil
+				s.Participants = nil
+				s.Body = []byte(`{"blocks":[{"type":"paragraph","text":"text"}]}`)
+			},
+			prev: base(),
+			want: []string{RevisionFieldTitle, RevisionFieldImage, RevisionFieldParticipants, RevisionFieldContent},
+		},
+		{
+			name: "flags and publication time changed",
+			change: func(s *NewsSnapshot) {
+				s.CanCommented = true
+				later := publicationAt.Add(time.Hour)
+				s.PublicationAt = &later
+			},
+			prev: base(),
+			want: []string{RevisionFieldFlags, RevisionFieldPublicationAt},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snapshot := base()
+			tt.change(snapshot)
+			assert.Equal(t, tt.want, snapshot.ChangedFields(tt.prev))
+		})
+	}
+}
+
+func Test_SameVersion(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 1, 10, 0, 0, 123456789, time.UTC)
+	fromClient := time.Date(2025, 1, 1, 13, 0, 0, 123000000, time.FixedZone("MSK", 3*60*60))
+	later := updatedAt.Add(time.Second)
+
+	assert.True(t, SameVersion(&updatedAt, &fromClient))
+	assert.False(t, SameVersion(&updatedAt, &later))
+	assert.False(t, SameVersion(&updatedAt, nil))
+	assert.True(t, SameVersion(nil, nil))
+}
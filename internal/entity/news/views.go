@@ -0,0 +1,29 @@
+package news
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// View просмотр новости сотрудником
+type View struct {
+	NewsID     uuid.UUID
+	EmployeeID uuid.UUID
+	ViewedAt   time.Time
+}
+
+// DailyViews количество просмотров новости за день
+type DailyViews struct {
+	// Date начало дня в UTC
+	Date  time.Time
+	Views int
+}
+
+// ViewsStats статистика просмотров новости
+type ViewsStats struct {
+	// Total количество просмотров за все время
+	Total int
+	// Days просмотры по дням периода, дни без просмотров включены с нулем
+	Days []*DailyViews
+}
@@ -0,0 +1,120 @@
+package news
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// VisibilityConditionAnd сотрудник должен подходить под все заданные правила
+	VisibilityConditionAnd = "AND"
+	// VisibilityConditionOr сотруднику достаточно подходить под одно из правил
+	VisibilityConditionOr = "OR"
+)
+
+// VisibilityProfile данные сотрудника, по которым проверяется видимость категории
+type VisibilityProfile struct {
+	EmployeeID uuid.UUID
+	// PortalIDs порталы (ОИВ) сотрудника
+	PortalIDs []int
+	// ComplexIDs комплексы, в которые входят порталы сотрудника
+	ComplexIDs       []int
+	OrgIDs           []uuid.UUID
+	ProductIDs       []uuid.UUID
+	SubdivisionNames []string
+	PositionNames    []string
+	// RoleNames роли сотрудника в управленческой структуре (УШР)
+	RoleNames []string
+}
+
+// IsEmpty не задано ни одного правила, категория видна всем
+func (v *CategoryVisibility) IsEmpty() bool {
+	return v == nil || len(v.rules(nil)) == 0
+}
+
+// NeedsComplexes для проверки нужны комплексы сотрудника
+func (v *CategoryVisibility) NeedsComplexes() bool {
+	return v != nil && len(v.ComplexIDs) > 0
+}
+
+// IsVisibleTo видна ли категория сотруднику.
+//
+//	Проверяются только заданные правила. По умолчанию правила объединяются через AND, при условии OR достаточно одного
+func (v *CategoryVisibility) IsVisibleTo(profile *VisibilityProfile) bool {
+	rules := v.rules(profile)
+	if len(rules) == 0 {
+		return true
+	}
+	if profile == nil {
+		return false
+	}
+
+	if strings.EqualFold(strings.TrimSpace(v.Condition), VisibilityConditionOr) {
+		return slices.Contains(rules, true)
+	}
+	return !slices.Contains(rules, false)
+}
+
+// rules результаты проверки заданных правил. Без профиля возвращается только количество правил
+func (v *CategoryVisibility) rules(profile *VisibilityProfile) []bool {
+	if v == nil {
+		return nil
+	}
+	if profile == nil {
+		profile = &VisibilityProfile{}
+	}
+
+	var rules []bool
+	if len(v.ComplexIDs) > 0 {
+		rules = append(rules, intersects(v.ComplexIDs, profile.ComplexIDs))
+	}
+	if len(v.OIVs) > 0 {
+		rules = append(rules, intersects(v.OIVs, profile.PortalIDs))
+	}
+	if len(v.OrgIDs) > 0 {
+		rules = append(rules, intersects(v.OrgIDs, profile.OrgIDs))
+	}
+	if len(v.ProductIDs) > 0 {
+		rules = append(rules, intersects(v.ProductIDs, profile.ProductIDs))
+	}
+	if len(v.SubdivisionNames) > 0 {
+		rules = append(rules, intersectsNames(v.SubdivisionNames, profile.SubdivisionNames))
+	}
+	if len(v.PositionNames) > 0 {
+		rules = append(rules, intersectsNames(v.PositionNames, profile.PositionNames))
+	}
+	if len(v.EmployeeIDs) > 0 {
+		rules = append(rules, profile.EmployeeID != uuid.Nil && slices.Contains(v.EmployeeIDs, profile.EmployeeID))
+	}
+	if len(v.RoleNames) > 0 {
+		rules = append(rules, intersectsNames(v.RoleNames, profile.RoleNames))
+	}
+	return rules
+}
+
+func intersects[T comparable](a, b []T) bool {
+	for _, item := range a {
+		if slices.Contains(b, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// intersectsNames сравнивает названия без учета регистра и пробелов по краям
+func intersectsNames(a, b []string) bool {
+	for _, x := range a {
+		x = strings.TrimSpace(x)
+		if x == "" {
+			continue
+		}
+		for _, y := range b {
+			if strings.EqualFold(x, strings.TrimSpace(y)) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,138 @@
+package news
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_CategoryVisibility_IsVisibleTo(t *testing.T) {
+	employeeID := uuid.New()
+	orgID := uuid.New()
+	productID := uuid.New()
+
+	profile := &VisibilityProfile{
+		EmployeeID:       employeeID,
+		PortalIDs:        []int{1},
+		ComplexIDs:       []int{10},
+		OrgIDs:           []uuid.UUID{orgID},
+		ProductIDs:       []uuid.UUID{productID},
+		SubdivisionNames: []string{"Отдел разработки", "Управление"},
+		PositionNames:    []string{"Инженер"},
+		RoleNames:        []string{"Руководитель продукта"},
+	}
+
+	tests := []struct {
+		name       string
+		visibility *CategoryVisibility
+		profile    *VisibilityProfile
+		want       bool
+	}{
+		{
+			name:       "nil visibility",
+			visibility: nil,
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "no rules",
+			visibility: &CategoryVisibility{Condition: VisibilityConditionAnd},
+			profile:    nil,
+			want:       true,
+		},
+		{
+			name:       "rules without profile",
+			visibility: &CategoryVisibility{OIVs: []int{1}},
+			profile:    nil,
+			want:       false,
+		},
+		{
+			name:       "portal matches",
+			visibility: &CategoryVisibility{OIVs: []int{2, 1}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "complex does not match",
+			visibility: &CategoryVisibility{ComplexIDs: []int{11}},
+			profile:    profile,
+			want:       false,
+		},
+		{
+			name:       "employee listed",
+			visibility: &CategoryVisibility{EmployeeIDs: []uuid.UUID{uuid.New(), employeeID}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "names compared case insensitive",
+			visibility: &CategoryVisibility{SubdivisionNames: []string{" отдел РАЗРАБОТКИ "}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name: "and: all rules match",
+			visibility: &CategoryVisibility{
+				OIVs:          []int{1},
+				OrgIDs:        []uuid.UUID{orgID},
+				ProductIDs:    []uuid.UUID{productID},
+				PositionNames: []string{"инженер"},
+				RoleNames:     []string{"Руководитель продукта"},
+			},
+			profile: profile,
+			want:    true,
+		},
+		{
+			name: "and: one rule fails",
+			visibility: &CategoryVisibility{
+				OIVs:          []int{1},
+				PositionNames: []string{"Аналитик"},
+			},
+			profile: profile,
+			want:    false,
+		},
+		{
+			name: "empty condition means and",
+			visibility: &CategoryVisibility{
+				Condition:  "",
+				OIVs:       []int{1},
+				ComplexIDs: []int{11},
+			},
+			profile: profile,
+			want:    false,
+		},
+		{
+			name: "or: one rule matches",
+			visibility: &CategoryVisibility{
+				Condition:     "or",
+				OIVs:          []int{5},
+				PositionNames: []string{"Инженер"},
+			},
+			profile: profile,
+			want:    true,
+		},
+		{
+			name: "or: nothing matches",
+			visibility: &CategoryVisibility{
+				Condition:   VisibilityConditionOr,
+				OIVs:        []int{5},
+				EmployeeIDs: []uuid.UUID{uuid.New()},
+				RoleNames:   []string{"Администратор"},
+			},
+			profile: profile,
+			want:    false,
+		},
+		{
+			name:       "empty profile fields",
+			visibility: &CategoryVisibility{RoleNames: []string{"Администратор"}},
+			profile:    &VisibilityProfile{EmployeeID: employeeID},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.visibility.IsVisibleTo(tt.profile))
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package entity
+
+// CheckResult результат проверки готовности зависимости
+type CheckResult struct {
+	// Name наименование зависимости
+	Name string
+	// Required без зависимости приложение не может обслуживать запросы
+	Required bool
+	// Err ошибка проверки, nil если зависимость доступна
+	Err error
+}
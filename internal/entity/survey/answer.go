@@ -75,6 +75,10 @@ type RespondentAnswer struct {
 	RespondentId *RespondentID
 	// Контент ответа. Используется в случае, если выбран вариант ответа "Другое".
 	Content string
+	// Портал, с которого респондент отправил ответ.
+	PortalID int
+	// Организация респондента.
+	OrganizationID *uuid.UUID
 }
 
 type AnswerIDs []AnswerID
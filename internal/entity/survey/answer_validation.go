@@ -0,0 +1,182 @@
+package entitySurveys
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+)
+
+const (
+	ErrSurveyNotAvailable diterrors.StringError = "survey is not available"
+	ErrAnswersInvalid     diterrors.StringError = "incorrect survey answers"
+)
+
+// IsAvailable опрос опубликован и проводится в момент now. Незаполненные границы периода не проверяются
+func (s *Survey) IsAvailable(now time.Time) bool {
+	if s == nil || !s.IsPublished || s.DeletedAt != nil {
+		return false
+	}
+	if !s.ActivePeriodStart.IsZero() && now.Before(s.ActivePeriodStart) {
+		return false
+	}
+	if !s.ActivePeriodEnd.IsZero() && now.After(s.ActivePeriodEnd) {
+		return false
+	}
+	return true
+}
+
+// ValidateAnswers проверка ответов респондента по правилам вопросов опроса.
+//
+//	Опрос должен быть загружен с вопросами и вариантами ответа. Ошибки возвращаются по одной на вопрос,
+//	в поле указывается id вопроса. Ответам, прошедшим проверку, проставляется QuestionID
+func (s *Survey) ValidateAnswers(answers []*RespondentAnswer, now time.Time) error {
+	if !s.IsAvailable(now) {
+		return diterrors.NewValidationError(ErrSurveyNotAvailable, diterrors.ErrValidationFields{
+			Field:   "survey_id",
+			Message: "survey is not published or is out of its active period",
+		})
+	}
+
+	type chosenVariant struct {
+		question *Question
+		variant  *AnswerVariant
+	}
+	variants := make(map[AnswerID]chosenVariant)
+	for _, question := range s.Questions {
+		if question == nil || question.ID == nil || !question.IsActive || question.DeletedAt != nil {
+			continue
+		}
+		for _, variant := range question.Answers {
+			if variant != nil && variant.ID != nil && variant.DeletedAt == nil {
+				variants[*variant.ID] = chosenVariant{question: question, variant: variant}
+			}
+		}
+	}
+
+	var fields []diterrors.ErrValidationFields
+	chosen := make(map[QuestionID][]*RespondentAnswer)
+	for i, answer := range answers {
+		if answer == nil {
+			continue
+		}
+		found, ok := variants[answer.ChosenVariant]
+		if !ok {
+			fields = append(fields, diterrors.ErrValidationFields{
+				Field:   fmt.Sprintf("answers[%d].chosen_variant_id", i),
+				Message: "answer variant doesn't belong to active survey questions",
+			})
+			continue
+		}
+		if answer.QuestionID != nil && *answer.QuestionID != *found.question.ID {
+			fields = append(fields, diterrors.ErrValidationFields{
+				Field:   fmt.Sprintf("answers[%d].question_id", i),
+				Message: "answer variant doesn't belong to the question",
+			})
+			continue
+		}
+		questionID := *found.question.ID
+		answer.QuestionID = &questionID
+		chosen[questionID] = append(chosen[questionID], answer)
+	}
+
+	for _, question := range s.Questions {
+		if question == nil || question.ID == nil || !question.IsActive || question.DeletedAt != nil {
+			continue
+		}
+		questionAnswers := chosen[*question.ID]
+		var message string
+		if len(questionAnswers) == 0 {
+			if question.IsRequired {
+				message = "answer is required"
+			}
+		} else {
+			message = question.validateAnswers(questionAnswers, func(id AnswerID) *AnswerVariant {
+				return variants[id].variant
+			})
+		}
+		if message != "" {
+			fields = append(fields, diterrors.ErrValidationFields{Field: question.ID.String(), Message: message})
+		}
+	}
+
+	if len(fields) > 0 {
+		return diterrors.NewValidationError(ErrAnswersInvalid, fields...)
+	}
+	return nil
+}
+
+// validateAnswers проверка количества выбранных вариантов и контента ответов на вопрос.
+// Возвращает описание первой найденной ошибки
+func (q *Question) validateAnswers(answers []*RespondentAnswer, variant func(id AnswerID) *AnswerVariant) string {
+	seen := make(map[AnswerID]struct{}, len(answers))
+	for _, answer := range answers {
+		if _, ok := seen[answer.ChosenVariant]; ok {
+			return "answer variant is chosen more than once"
+		}
+		seen[answer.ChosenVariant] = struct{}{}
+	}
+
+	count := len(answers)
+	if q.Type.IsMultiple() {
+		if rules := q.Rules; rules != nil {
+			if rules.PickMinCount != nil && count < *rules.PickMinCount {
+				return fmt.Sprintf("at least %d answer variants must be chosen", *rules.PickMinCount)
+			}
+			if rules.PickMaxCount != nil && count > *rules.PickMaxCount {
+				return fmt.Sprintf("no more than %d answer variants can be chosen", *rules.PickMaxCount)
+			}
+		}
+	} else if count > 1 {
+		return "only one answer variant can be chosen"
+	}
+
+	for _, answer := range answers {
+		if message := variant(answer.ChosenVariant).checkContent(answer.Content); message != "" {
+			return message
+		}
+	}
+	return ""
+}
+
+// checkContent проверка контента ответа по типу и ограничениям варианта
+func (a *AnswerVariant) checkContent(content string) string {
+	if !a.WithContent {
+		if content != "" {
+			return "content is not allowed for the answer variant"
+		}
+		return ""
+	}
+	if content == "" {
+		return "content is required"
+	}
+
+	rules := a.Rules
+	if rules == nil {
+		rules = &AnswerRules{}
+	}
+	switch a.ContentType {
+	case ContentTypeDigit:
+		digit, err := strconv.Atoi(content)
+		if err != nil {
+			return "content must be an integer"
+		}
+		if rules.ContentMinDigit != nil && digit < *rules.ContentMinDigit {
+			return fmt.Sprintf("content must not be less than %d", *rules.ContentMinDigit)
+		}
+		if rules.ContentMaxDigit != nil && digit > *rules.ContentMaxDigit {
+			return fmt.Sprintf("content must not be greater than %d", *rules.ContentMaxDigit)
+		}
+	default:
+		length := utf8.RuneCountInString(content)
+		if rules.ContentMinLength != nil && length < *rules.ContentMinLength {
+			return fmt.Sprintf("content must be at least %d characters", *rules.ContentMinLength)
+		}
+		if rules.ContentMaxLength != nil && length > *rules.ContentMaxLength {
+			return fmt.Sprintf("content must be at most %d characters", *rules.ContentMaxLength)
+		}
+	}
+	return ""
+}
@@ -0,0 +1,184 @@
+package entitySurveys
+
+import (
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Survey_ValidateAnswers(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	radioID, checkboxID, numberID := QuestionID(uuid.New()), QuestionID(uuid.New()), QuestionID(uuid.New())
+	yes, no := AnswerID(uuid.New()), AnswerID(uuid.New())
+	first, second, third, other := AnswerID(uuid.New()), AnswerID(uuid.New()), AnswerID(uuid.New()), AnswerID(uuid.New())
+	number := AnswerID(uuid.New())
+
+	survey := func() *Survey {
+		return &Survey{
+			IsPublished:       true,
+			ActivePeriodStart: now.Add(-time.Hour),
+			ActivePeriodEnd:   now.Add(time.Hour),
+			Questions: []*Question{
+				{
+					ID:         &radioID,
+					Type:       QuestionTypeRadio,
+					IsActive:   true,
+					IsRequired: true,
+					Answers:    []*AnswerVariant{{ID: &yes}, {ID: &no}},
+				},
+				{
+					ID:       &checkboxID,
+					Type:     QuestionTypeCheckbox,
+					IsActive: true,
+					Rules:    &QuestionRules{PickMinCount: intPtr(2), PickMaxCount: intPtr(3)},
+					Answers: []*AnswerVariant{
+						{ID: &first}, {ID: &second}, {ID: &third},
+						{ID: &other, WithContent: true, ContentType: ContentTypeText, Rules: &AnswerRules{ContentMinLength: intPtr(3), ContentMaxLength: intPtr(5)}},
+					},
+				},
+				{
+					ID:       &numberID,
+					Type:     QuestionTypeNumber,
+					IsActive: true,
+					Answers: []*AnswerVariant{
+						{ID: &number, WithContent: true, ContentType: ContentTypeDigit, Rules: &AnswerRules{ContentMinDigit: intPtr(18), ContentMaxDigit: intPtr(99)}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(s *Survey)
+		answers []*RespondentAnswer
+		wantErr error
+	}{
+		{
+			name: "valid",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: yes},
+				{ChosenVariant: first},
+				{ChosenVariant: other, Content: "Свой"},
+				{ChosenVariant: number, Content: "42"},
+			},
+		},
+		{
+			name:    "only required question is answered",
+			answers: []*RespondentAnswer{{ChosenVariant: no}},
+		},
+		{
+			name:    "not published",
+			modify:  func(s *Survey) { s.IsPublished = false },
+			answers: []*RespondentAnswer{{ChosenVariant: yes}},
+			wantErr: diterrors.NewValidationError(ErrSurveyNotAvailable, diterrors.ErrValidationFields{
+				Field:   "survey_id",
+				Message: "survey is not published or is out of its active period",
+			}),
+		},
+		{
+			name:    "active period is over",
+			modify:  func(s *Survey) { s.ActivePeriodEnd = now.Add(-time.Minute) },
+			answers: []*RespondentAnswer{{ChosenVariant: yes}},
+			wantErr: diterrors.NewValidationError(ErrSurveyNotAvailable, diterrors.ErrValidationFields{
+				Field:   "survey_id",
+				Message: "survey is not published or is out of its active period",
+			}),
+		},
+		{
+			name: "one error per question",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: first},
+				{ChosenVariant: number, Content: "17"},
+			},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: radioID.String(), Message: "answer is required"},
+				diterrors.ErrValidationFields{Field: checkboxID.String(), Message: "at least 2 answer variants must be chosen"},
+				diterrors.ErrValidationFields{Field: numberID.String(), Message: "content must not be less than 18"},
+			),
+		},
+		{
+			name: "radio receives two variants",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: yes},
+				{ChosenVariant: no},
+			},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: radioID.String(), Message: "only one answer variant can be chosen"},
+			),
+		},
+		{
+			name: "pick max count and duplicates",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: yes},
+				{ChosenVariant: first},
+				{ChosenVariant: second},
+				{ChosenVariant: third},
+				{ChosenVariant: other, Content: "Свой"},
+				{ChosenVariant: number, Content: "20"},
+				{ChosenVariant: number, Content: "21"},
+			},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: checkboxID.String(), Message: "no more than 3 answer variants can be chosen"},
+				diterrors.ErrValidationFields{Field: numberID.String(), Message: "answer variant is chosen more than once"},
+			),
+		},
+		{
+			name: "content rules",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: yes, Content: "лишнее"},
+				{ChosenVariant: first},
+				{ChosenVariant: other, Content: "Слишком длинно"},
+				{ChosenVariant: number, Content: "сорок"},
+			},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: radioID.String(), Message: "content is not allowed for the answer variant"},
+				diterrors.ErrValidationFields{Field: checkboxID.String(), Message: "content must be at most 5 characters"},
+				diterrors.ErrValidationFields{Field: numberID.String(), Message: "content must be an integer"},
+			),
+		},
+		{
+			name: "variant from another question or survey",
+			answers: []*RespondentAnswer{
+				{ChosenVariant: yes},
+				{ChosenVariant: first, QuestionID: &radioID},
+				{ChosenVariant: AnswerID(uuid.New())},
+			},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: "answers[1].question_id", Message: "answer variant doesn't belong to the question"},
+				diterrors.ErrValidationFields{Field: "answers[2].chosen_variant_id", Message: "answer variant doesn't belong to active survey questions"},
+			),
+		},
+		{
+			name:    "inactive question can't be answered",
+			modify:  func(s *Survey) { s.Questions[2].IsActive = false },
+			answers: []*RespondentAnswer{{ChosenVariant: yes}, {ChosenVariant: number, Content: "42"}},
+			wantErr: diterrors.NewValidationError(ErrAnswersInvalid,
+				diterrors.ErrValidationFields{Field: "answers[1].chosen_variant_id", Message: "answer variant doesn't belong to active survey questions"},
+			),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := survey()
+			if tt.modify != nil {
+				tt.modify(s)
+			}
+
+			err := s.ValidateAnswers(tt.answers, now)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+				return
+			}
+			assert.NoError(t, err)
+			for _, answer := range tt.answers {
+				assert.NotNil(t, answer.QuestionID)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package entitySurveys
+
+import "time"
+
+// Completion статус прохождения опроса текущим пользователем
+type Completion struct {
+	// uuid опроса.
+	SurveyID SurveyID
+	// Опрос уже пройден.
+	Completed bool
+	// Время прохождения опроса.
+	CompletedAt *time.Time
+}
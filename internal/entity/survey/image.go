@@ -8,6 +8,9 @@ import (
 
 //go:generate ditgen -source=image.go
 
+// ImageMaxSize максимальный размер изображения варианта ответа
+const ImageMaxSize = 5 << 20
+
 type ImageID string
 type ImageData []byte
 
@@ -0,0 +1,265 @@
+package entitySurveys
+
+import (
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/google/uuid"
+)
+
+// AnswersFilter фильтр ответов на опрос
+type AnswersFilter struct {
+	// uuid опроса.
+	SurveyID SurveyID
+	// uuid вопроса. Если не задан, выбираются ответы на все вопросы.
+	QuestionID *QuestionID
+	// Порталы респондентов. Если не заданы, выбираются ответы со всех порталов.
+	PortalIDs []int
+	// Организации респондентов. Если не заданы, выбираются ответы всех организаций.
+	OrganizationIDs []uuid.UUID
+}
+
+// IsByRespondent ответы ограничены порталами или организациями респондентов
+func (f AnswersFilter) IsByRespondent() bool {
+	return len(f.PortalIDs) != 0 || len(f.OrganizationIDs) != 0
+}
+
+// AnswersPagination параметры постраничной выборки ответов.
+//
+//	Следующая страница запрашивается по id последнего ответа предыдущей
+type AnswersPagination struct {
+	Limit  uint32
+	LastID *uuid.UUID
+}
+
+// AnswersWithPagination страница ответов на опрос
+type AnswersWithPagination struct {
+	AnswersPagination
+	Answers []*RespondentAnswer
+}
+
+// Results статистика ответов на опрос
+type Results struct {
+	// uuid опроса.
+	SurveyID SurveyID
+	// Общее количество ответов.
+	AnswersCount int
+	// Статистика по вопросам в порядке отображения.
+	Questions []*QuestionResults
+
+	questions map[QuestionID]*QuestionResults
+	variants  map[AnswerID]*VariantResults
+}
+
+// QuestionResults статистика ответов на вопрос
+type QuestionResults struct {
+	// uuid вопроса.
+	QuestionID QuestionID
+	// Текст вопроса.
+	Text string
+	// Тип вопроса.
+	Type QuestionType
+	// Количество ответов на вопрос.
+	AnswersCount int
+	// Количество ответов с произвольным текстом.
+	//  Сами ответы выдаются постранично отдельным запросом.
+	ContentCount int
+	// Статистика по вариантам ответа. Заполняется для вопросов с выбором.
+	Variants []*VariantResults
+	// Статистика числовых ответов. Заполняется для вопросов inputNumber.
+	Numbers *NumberStats
+
+	numbers []int
+}
+
+// VariantResults статистика выбора варианта ответа
+type VariantResults struct {
+	// uuid варианта ответа.
+	VariantID AnswerID
+	// Текст варианта ответа.
+	Text string
+	// Количество выборов варианта.
+	Count int
+	// Доля выборов варианта от всех ответов на вопрос, в процентах.
+	Percent float64
+}
+
+// NumberStats статистика числовых ответов
+type NumberStats struct {
+	Count  int
+	Min    int
+	Max    int
+	Mean   float64
+	Median float64
+}
+
+// NewResults пустая статистика по вопросам опроса. Опрос должен быть загружен с вопросами и вариантами ответа
+func NewResults(survey *Survey) *Results {
+	results := &Results{
+		questions: make(map[QuestionID]*QuestionResults),
+		variants:  make(map[AnswerID]*VariantResults),
+	}
+	if survey == nil {
+		return results
+	}
+	if survey.ID != nil {
+		results.SurveyID = *survey.ID
+	}
+
+	for _, question := range survey.Questions {
+		if question == nil || question.ID == nil || question.DeletedAt != nil {
+			continue
+		}
+		questionResults := &QuestionResults{
+			QuestionID: *question.ID,
+			Text:       question.Text,
+			Type:       question.Type,
+		}
+		if question.Type.IsChoice() {
+			for _, variant := range question.Answers {
+				if variant == nil || variant.ID == nil || variant.DeletedAt != nil {
+					continue
+				}
+				variantResults := &VariantResults{VariantID: *variant.ID, Text: variant.Text}
+				questionResults.Variants = append(questionResults.Variants, variantResults)
+				results.variants[*variant.ID] = variantResults
+			}
+		}
+		results.Questions = append(results.Questions, questionResults)
+		results.questions[*question.ID] = questionResults
+	}
+	return results
+}
+
+// Add учитывает страницу ответов. Ответы на вопросы, которых нет в опросе, пропускаются
+func (r *Results) Add(answers []*RespondentAnswer) {
+	for _, answer := range answers {
+		if answer == nil || answer.QuestionID == nil {
+			continue
+		}
+		question, ok := r.questions[*answer.QuestionID]
+		if !ok {
+			continue
+		}
+
+		r.AnswersCount++
+		question.AnswersCount++
+		if variant, ok := r.variants[answer.ChosenVariant]; ok {
+			variant.Count++
+		}
+		if answer.Content == "" {
+			continue
+		}
+		if question.Type == QuestionTypeNumber {
+			if number, err := strconv.Atoi(answer.Content); err == nil {
+				question.numbers = append(question.numbers, number)
+			}
+			continue
+		}
+		question.ContentCount++
+	}
+}
+
+// Complete рассчитывает доли вариантов и статистику числовых ответов после учета всех ответов
+func (r *Results) Complete() {
+	for _, question := range r.Questions {
+		for _, variant := range question.Variants {
+			variant.Percent = percent(variant.Count, question.AnswersCount)
+		}
+		if question.Type == QuestionTypeNumber {
+			question.Numbers = numberStats(question.numbers)
+			question.numbers = nil
+		}
+	}
+}
+
+func percent(count, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(float64(count)*10000/float64(total)) / 100
+}
+
+func numberStats(numbers []int) *NumberStats {
+	if len(numbers) == 0 {
+		return &NumberStats{}
+	}
+
+	slices.Sort(numbers)
+	stats := &NumberStats{
+		Count: len(numbers),
+		Min:   numbers[0],
+		Max:   numbers[len(numbers)-1],
+	}
+
+	var sum float64
+	for _, number := range numbers {
+		sum += float64(number)
+	}
+	stats.Mean = sum / float64(len(numbers))
+
+	middle := len(numbers) / 2
+	if len(numbers)%2 == 0 {
+		stats.Median = float64(numbers[middle-1]+numbers[middle]) / 2
+	} else {
+		stats.Median = float64(numbers[middle])
+	}
+	return stats
+}
+
+// ResponseRow строка выгрузки ответов на опрос
+type ResponseRow struct {
+	AnswerID     *uuid.UUID
+	RespondentID   *RespondentID
+	PortalID       int
+	OrganizationID *uuid.UUID
+	QuestionID     QuestionID
+	QuestionText string
+	VariantID    AnswerID
+	VariantText  string
+	Content      string
+}
+
+// ResponseRows строки выгрузки для страницы ответов. Ответы на вопросы, которых нет в опросе, пропускаются
+func (s *Survey) ResponseRows(answers []*RespondentAnswer) []*ResponseRow {
+	questions := make(map[QuestionID]*Question)
+	variants := make(map[AnswerID]*AnswerVariant)
+	for _, question := range s.Questions {
+		if question == nil || question.ID == nil {
+			continue
+		}
+		questions[*question.ID] = question
+		for _, variant := range question.Answers {
+			if variant != nil && variant.ID != nil {
+				variants[*variant.ID] = variant
+			}
+		}
+	}
+
+	rows := make([]*ResponseRow, 0, len(answers))
+	for _, answer := range answers {
+		if answer == nil || answer.QuestionID == nil {
+			continue
+		}
+		question, ok := questions[*answer.QuestionID]
+		if !ok {
+			continue
+		}
+		row := &ResponseRow{
+			AnswerID:       answer.ID,
+			RespondentID:   answer.RespondentId,
+			PortalID:       answer.PortalID,
+			OrganizationID: answer.OrganizationID,
+			QuestionID:     *answer.QuestionID,
+			QuestionText:   question.Text,
+			VariantID:      answer.ChosenVariant,
+			Content:        answer.Content,
+		}
+		if variant, ok := variants[answer.ChosenVariant]; ok {
+			row.VariantText = variant.Text
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
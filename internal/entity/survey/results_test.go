@@ -0,0 +1,132 @@
+package entitySurveys
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Results(t *testing.T) {
+	surveyID := SurveyID(uuid.New())
+	radioID, numberID, textID := QuestionID(uuid.New()), QuestionID(uuid.New()), QuestionID(uuid.New())
+	yes, no, other := AnswerID(uuid.New()), AnswerID(uuid.New()), AnswerID(uuid.New())
+	number, text := AnswerID(uuid.New()), AnswerID(uuid.New())
+
+	survey := &Survey{
+		ID: &surveyID,
+		Questions: []*Question{
+			{
+				ID:      &radioID,
+				Text:    "Нравится?",
+				Type:    QuestionTypeRadio,
+				Answers: []*AnswerVariant{{ID: &yes, Text: "Да"}, {ID: &no, Text: "Нет"}, {ID: &other, Text: "Другое", WithContent: true}},
+			},
+			{
+				ID:      &numberID,
+				Text:    "Возраст",
+				Type:    QuestionTypeNumber,
+				Answers: []*AnswerVariant{{ID: &number, WithContent: true, ContentType: ContentTypeDigit}},
+			},
+			{
+				ID:      &textID,
+				Text:    "Комментарий",
+				Type:    QuestionTypeText,
+				Answers: []*AnswerVariant{{ID: &text, WithContent: true, ContentType: ContentTypeText}},
+			},
+		},
+	}
+
+	unknownQuestion := QuestionID(uuid.New())
+	results := NewResults(survey)
+	results.Add([]*RespondentAnswer{
+		{QuestionID: &radioID, ChosenVariant: yes},
+		{QuestionID: &radioID, ChosenVariant: yes},
+		{QuestionID: &radioID, ChosenVariant: other, Content: "Не знаю"},
+		{QuestionID: &numberID, ChosenVariant: number, Content: "30"},
+		{QuestionID: &numberID, ChosenVariant: number, Content: "20"},
+	})
+	results.Add([]*RespondentAnswer{
+		{QuestionID: &numberID, ChosenVariant: number, Content: "45"},
+		{QuestionID: &numberID, ChosenVariant: number, Content: "25"},
+		{QuestionID: &textID, ChosenVariant: text, Content: "Спасибо"},
+		{QuestionID: &unknownQuestion, ChosenVariant: yes},
+	})
+	results.Complete()
+
+	assert.Equal(t, surveyID, results.SurveyID)
+	assert.Equal(t, 8, results.AnswersCount)
+	assert.Len(t, results.Questions, 3)
+
+	radio := results.Questions[0]
+	assert.Equal(t, 3, radio.AnswersCount)
+	assert.Equal(t, 1, radio.ContentCount)
+	assert.Nil(t, radio.Numbers)
+	assert.Equal(t, []*VariantResults{
+		{VariantID: yes, Text: "Да", Count: 2, Percent: 66.67},
+		{VariantID: no, Text: "Нет", Count: 0, Percent: 0},
+		{VariantID: other, Text: "Другое", Count: 1, Percent: 33.33},
+	}, radio.Variants)
+
+	numbers := results.Questions[1]
+	assert.Equal(t, 4, numbers.AnswersCount)
+	assert.Equal(t, 0, numbers.ContentCount)
+	assert.Nil(t, numbers.Variants)
+	assert.Equal(t, &NumberStats{Count: 4, Min: 20, Max: 45, Mean: 30, Median: 27.5}, numbers.Numbers)
+
+	texts := results.Questions[2]
+	assert.Equal(t, 1, texts.AnswersCount)
+	assert.Equal(t, 1, texts.ContentCount)
+}
+
+func Test_numberStats(t *testing.T) {
+	tests := []struct {
+		name    string
+		numbers []int
+		want    *NumberStats
+	}{
+		{
+			name: "empty",
+			want: &NumberStats{},
+		},
+		{
+			name:    "odd",
+			numbers: []int{5, -1, 3},
+			want:    &NumberStats{Count: 3, Min: -1, Max: 5, Mean: 7.0 / 3, Median: 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, numberStats(tt.numbers))
+		})
+	}
+}
+
+func Test_Survey_ResponseRows(t *testing.T) {
+	questionID := QuestionID(uuid.New())
+	variantID := AnswerID(uuid.New())
+	answerID := uuid.New()
+	respondentID := RespondentID(uuid.New())
+	survey := &Survey{
+		Questions: []*Question{
+			{ID: &questionID, Text: "Нравится?", Answers: []*AnswerVariant{{ID: &variantID, Text: "Да"}}},
+		},
+	}
+
+	unknownQuestion := QuestionID(uuid.New())
+	rows := survey.ResponseRows([]*RespondentAnswer{
+		{ID: &answerID, QuestionID: &questionID, ChosenVariant: variantID, RespondentId: &respondentID, PortalID: 7, Content: "текст"},
+		{QuestionID: &unknownQuestion, ChosenVariant: variantID},
+	})
+
+	assert.Equal(t, []*ResponseRow{{
+		AnswerID:     &answerID,
+		RespondentID: &respondentID,
+		PortalID:     7,
+		QuestionID:   questionID,
+		QuestionText: "Нравится?",
+		VariantID:    variantID,
+		VariantText:  "Да",
+		Content:      "текст",
+	}}, rows)
+}
@@ -17,9 +17,19 @@ func (s SurveyID) String() string {
 	return uuid.UUID(s).String()
 }
 
+// SurveyRespondent респонденты опроса.
+//
+//	Опрос адресован пользователям из Ids и сотрудникам указанных порталов, организаций и подразделений.
+//	Если ни одно условие не задано, опрос адресован всем
 type SurveyRespondent struct {
 	Ids  RespondentIDs
 	Type RespondentType
+	// Порталы (ОИВ) сотрудников.
+	PortalIDs []int
+	// Организации сотрудников.
+	OrganizationIDs []uuid.UUID
+	// Подразделения сотрудников, включая вложенные.
+	SubdivisionIDs []uuid.UUID
 }
 
 type RespondentType int
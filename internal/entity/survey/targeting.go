@@ -0,0 +1,66 @@
+package entitySurveys
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RespondentProfile данные пользователя, по которым проверяется, адресован ли ему опрос
+type RespondentProfile struct {
+	UserID uuid.UUID
+	// Порталы (ОИВ) сотрудника.
+	PortalIDs       []int
+	OrganizationIDs []uuid.UUID
+	// Подразделения сотрудника вместе с вышестоящими.
+	SubdivisionIDs []uuid.UUID
+}
+
+// AvailableFilter фильтр опросов, которые респондент может пройти:
+// опубликованных, проводимых в момент ActiveAt и адресованных Respondent
+type AvailableFilter struct {
+	ActiveAt   time.Time
+	Respondent *RespondentProfile
+}
+
+// IsTargeted для опроса задан круг респондентов
+func (sr *SurveyRespondent) IsTargeted() bool {
+	return sr != nil &&
+		(len(sr.Ids) > 0 || len(sr.PortalIDs) > 0 || len(sr.OrganizationIDs) > 0 || len(sr.SubdivisionIDs) > 0)
+}
+
+// PortalIDs порталы, к которым относится опрос. Опрос без порталов респондентов относится ко всем порталам
+func (s *Survey) PortalIDs() []int {
+	if respondent := s.GetRespondent(); respondent != nil {
+		return respondent.PortalIDs
+	}
+	return nil
+}
+
+// IsTargetedTo адресован ли опрос пользователю.
+//
+//	Опрос без заданного круга респондентов адресован всем. Иначе пользователю достаточно подойти под одно из условий
+func (s *Survey) IsTargetedTo(profile *RespondentProfile) bool {
+	respondent := s.GetRespondent()
+	if !respondent.IsTargeted() {
+		return true
+	}
+	if profile == nil {
+		return false
+	}
+
+	return slices.Contains(respondent.Ids, RespondentID(profile.UserID)) ||
+		intersects(respondent.PortalIDs, profile.PortalIDs) ||
+		intersects(respondent.OrganizationIDs, profile.OrganizationIDs) ||
+		intersects(respondent.SubdivisionIDs, profile.SubdivisionIDs)
+}
+
+func intersects[T comparable](a, b []T) bool {
+	for _, item := range a {
+		if slices.Contains(b, item) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,85 @@
+package entitySurveys
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSurvey_IsTargetedTo(t *testing.T) {
+	userID := uuid.New()
+	orgID := uuid.New()
+	subdivisionID := uuid.New()
+	profile := &RespondentProfile{
+		UserID:          userID,
+		PortalIDs:       []int{7},
+		OrganizationIDs: []uuid.UUID{orgID},
+		SubdivisionIDs:  []uuid.UUID{subdivisionID},
+	}
+
+	tests := []struct {
+		name       string
+		respondent *SurveyRespondent
+		profile    *RespondentProfile
+		want       bool
+	}{
+		{
+			name:    "no respondent",
+			profile: profile,
+			want:    true,
+		},
+		{
+			name:       "not targeted",
+			respondent: &SurveyRespondent{Type: RespondentTypeAnonymous},
+			want:       true,
+		},
+		{
+			name:       "user id",
+			respondent: &SurveyRespondent{Type: RespondentTypeUser, Ids: RespondentIDs{RespondentID(uuid.New()), RespondentID(userID)}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "portal",
+			respondent: &SurveyRespondent{Type: RespondentTypeUser, PortalIDs: []int{1, 7}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "organization",
+			respondent: &SurveyRespondent{Type: RespondentTypeUser, OrganizationIDs: []uuid.UUID{orgID}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name:       "subdivision",
+			respondent: &SurveyRespondent{Type: RespondentTypeUser, SubdivisionIDs: []uuid.UUID{subdivisionID}},
+			profile:    profile,
+			want:       true,
+		},
+		{
+			name: "no match",
+			respondent: &SurveyRespondent{
+				Type:            RespondentTypeUser,
+				Ids:             RespondentIDs{RespondentID(uuid.New())},
+				PortalIDs:       []int{1},
+				OrganizationIDs: []uuid.UUID{uuid.New()},
+				SubdivisionIDs:  []uuid.UUID{uuid.New()},
+			},
+			profile: profile,
+			want:    false,
+		},
+		{
+			name:       "targeted without profile",
+			respondent: &SurveyRespondent{Type: RespondentTypeUser, PortalIDs: []int{7}},
+			want:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			survey := &Survey{Respondent: tt.respondent}
+			assert.Equal(t, tt.want, survey.IsTargetedTo(tt.profile))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package entitySurveys
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+)
+
+const (
+	ErrSurveyInvalid     diterrors.StringError = "incorrect survey"
+	ErrSurveyNoQuestions diterrors.StringError = "survey has no active questions"
+)
+
+// IsChoice вопрос с выбором из вариантов ответа
+func (qt QuestionType) IsChoice() bool {
+	switch qt {
+	case QuestionTypeCheckbox, QuestionTypeRadio, QuestionTypeCheckboxImg, QuestionTypeRadioImg:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsMultiple вопрос с множественным выбором
+func (qt QuestionType) IsMultiple() bool {
+	return qt == QuestionTypeCheckbox || qt == QuestionTypeCheckboxImg
+}
+
+// WithImages варианты ответа вопроса отображаются картинками
+func (qt QuestionType) WithImages() bool {
+	return qt == QuestionTypeCheckboxImg || qt == QuestionTypeRadioImg
+}
+
+// Validate проверка структуры опроса перед сохранением.
+//
+//	Возвращает все найденные ошибки с путем до поля, например questions[1].answers[0].image
+func (s *Survey) Validate() error {
+	if s == nil {
+		return diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	var fields []diterrors.ErrValidationFields
+	add := func(field, message string) {
+		fields = append(fields, diterrors.ErrValidationFields{Field: field, Message: message})
+	}
+
+	if strings.TrimSpace(s.Title) == "" {
+		add("title", "title is required")
+	}
+	if !s.ActivePeriodStart.IsZero() && !s.ActivePeriodEnd.IsZero() && !s.ActivePeriodEnd.After(s.ActivePeriodStart) {
+		add("active_period_end", "active period must end after it starts")
+	}
+	if s.Respondent == nil || s.Respondent.Type == RespondentTypeAll {
+		add("respondent_type", "unknown respondent type")
+	}
+
+	for i, question := range s.Questions {
+		if question == nil || question.DeletedAt != nil {
+			continue
+		}
+		for _, field := range question.validate() {
+			add(fmt.Sprintf("questions[%d].%s", i, field.Field), field.Message)
+		}
+	}
+
+	if len(fields) > 0 {
+		return diterrors.NewValidationError(ErrSurveyInvalid, fields...)
+	}
+	return nil
+}
+
+// ValidatePublish проверка опроса перед публикацией: опрос должен быть корректен
+// и содержать хотя бы один активный вопрос
+func (s *Survey) ValidatePublish() error {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	for _, question := range s.Questions {
+		if question != nil && question.IsActive && question.DeletedAt == nil {
+			return nil
+		}
+	}
+	return diterrors.NewValidationError(ErrSurveyNoQuestions, diterrors.ErrValidationFields{
+		Field:   "questions",
+		Message: "at least one active question is required",
+	})
+}
+
+func (q *Question) validate() []diterrors.ErrValidationFields {
+	var fields []diterrors.ErrValidationFields
+	add := func(field, message string) {
+		fields = append(fields, diterrors.ErrValidationFields{Field: field, Message: message})
+	}
+
+	if strings.TrimSpace(q.Text) == "" {
+		add("text", "text is required")
+	}
+	if q.Type == QuestionTypeInvalid || q.Type == "" {
+		add("type", "unknown question type")
+	}
+
+	answers := make([]*AnswerVariant, 0, len(q.Answers))
+	for _, answer := range q.Answers {
+		if answer != nil && answer.DeletedAt == nil {
+			answers = append(answers, answer)
+		}
+	}
+	if len(answers) == 0 {
+		add("answers", "at least one answer variant is required")
+	}
+
+	if rules := q.Rules; rules != nil {
+		pickMin, pickMax := rules.PickMinCount, rules.PickMaxCount
+		switch {
+		case (pickMin != nil || pickMax != nil) && !q.Type.IsMultiple():
+			add("rules", "pick counts are allowed only for multiple choice questions")
+		case pickMin != nil && *pickMin < 0, pickMax != nil && *pickMax < 1:
+			add("rules", "pick counts must be positive")
+		case pickMin != nil && pickMax != nil && *pickMin > *pickMax:
+			add("rules", "pick_min_count must not exceed pick_max_count")
+		case pickMin != nil && *pickMin > len(answers):
+			add("rules", "pick_min_count must not exceed the number of answer variants")
+		}
+	}
+
+	for i, answer := range q.Answers {
+		if answer == nil || answer.DeletedAt != nil {
+			continue
+		}
+		path := fmt.Sprintf("answers[%d]", i)
+		if q.Type.IsChoice() && !answer.WithContent && strings.TrimSpace(answer.Text) == "" {
+			add(path+".text", "text is required")
+		}
+		if q.Type.WithImages() && (answer.Image == nil || answer.Image.ID == "") {
+			add(path+".image", "image is required for image questions")
+		}
+		if !q.Type.WithImages() && answer.Image != nil {
+			add(path+".image", "image is allowed only for image questions")
+		}
+		if answer.WithContent {
+			for _, field := range answer.validateContent() {
+				add(path+"."+field.Field, field.Message)
+			}
+		}
+	}
+
+	return fields
+}
+
+func (a *AnswerVariant) validateContent() []diterrors.ErrValidationFields {
+	var fields []diterrors.ErrValidationFields
+	if a.ContentType != ContentTypeText && a.ContentType != ContentTypeDigit {
+		fields = append(fields, diterrors.ErrValidationFields{Field: "content_type", Message: "unknown content type"})
+	}
+
+	rules := a.Rules
+	if rules == nil {
+		return fields
+	}
+	if rules.ContentMinLength != nil && *rules.ContentMinLength < 0 {
+		fields = append(fields, diterrors.ErrValidationFields{Field: "rules", Message: "content_min_length must not be negative"})
+	}
+	if rules.ContentMinLength != nil && rules.ContentMaxLength != nil && *rules.ContentMinLength > *rules.ContentMaxLength {
+		fields = append(fields, diterrors.ErrValidationFields{Field: "rules", Message: "content_min_length must not exceed content_max_length"})
+	}
+	if rules.ContentMinDigit != nil && rules.ContentMaxDigit != nil && *rules.ContentMinDigit > *rules.ContentMaxDigit {
+		fields = append(fields, diterrors.ErrValidationFields{Field: "rules", Message: "content_min_digit must not exceed content_max_digit"})
+	}
+	return fields
+}
+
+// NormalizeWeights упорядочивает вопросы и варианты ответов по весу и перенумеровывает веса с 1.
+//
+//	При равных весах сохраняется порядок, в котором вопросы и варианты переданы, поэтому клиент
+//	может задавать порядок как весами, так и положением в списке
+func (s *Survey) NormalizeWeights() {
+	if s == nil {
+		return
+	}
+
+	s.Questions = slices.DeleteFunc(s.Questions, func(q *Question) bool { return q == nil })
+	slices.SortStableFunc(s.Questions, func(a, b *Question) int { return a.Weight - b.Weight })
+	for i, question := range s.Questions {
+		question.Weight = i + 1
+
+		question.Answers = slices.DeleteFunc(question.Answers, func(a *AnswerVariant) bool { return a == nil })
+		slices.SortStableFunc(question.Answers, func(a, b *AnswerVariant) int { return a.Weight - b.Weight })
+		for j, answer := range question.Answers {
+			answer.Weight = j + 1
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package entitySurveys
+
+import (
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Survey_Validate(t *testing.T) {
+	intPtr := func(v int) *int { return &v }
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	validSurvey := func() *Survey {
+		return &Survey{
+			Title:             "Опрос",
+			ActivePeriodStart: start,
+			ActivePeriodEnd:   start.Add(24 * time.Hour),
+			Respondent:        &SurveyRespondent{Type: RespondentTypeAnonymous},
+			Questions: []*Question{
+				{
+					Text:     "Выберите",
+					Type:     QuestionTypeCheckbox,
+					IsActive: true,
+					Rules:    &QuestionRules{PickMinCount: intPtr(1), PickMaxCount: intPtr(2)},
+					Answers: []*AnswerVariant{
+						{Text: "Да"},
+						{Text: "Другое", WithContent: true, ContentType: ContentTypeText, Rules: &AnswerRules{ContentMaxLength: intPtr(100)}},
+					},
+				},
+				{
+					Text:    "Картинка",
+					Type:    QuestionTypeRadioImg,
+					Answers: []*AnswerVariant{{Text: "Первая", Image: &Image{ID: "image"}}},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		modify     func(s *Survey)
+		wantFields []diterrors.ErrValidationFields
+	}{
+		{
+			name:   "valid",
+			modify: func(*Survey) {},
+		},
+		{
+			name: "survey fields",
+			modify: func(s *Survey) {
+				s.Title = " "
+				s.ActivePeriodEnd = s.ActivePeriodStart
+				s.Respondent = nil
+			},
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "title", Message: "title is required"},
+				{Field: "active_period_end", Message: "active period must end after it starts"},
+				{Field: "respondent_type", Message: "unknown respondent type"},
+			},
+		},
+		{
+			name: "question fields",
+			modify: func(s *Survey) {
+				s.Questions[0].Text = ""
+				s.Questions[0].Rules.PickMinCount = intPtr(3)
+				s.Questions[0].Rules.PickMaxCount = intPtr(2)
+				s.Questions[1].Type = QuestionTypeInvalid
+			},
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "questions[0].text", Message: "text is required"},
+				{Field: "questions[0].rules", Message: "pick_min_count must not exceed pick_max_count"},
+				{Field: "questions[1].type", Message: "unknown question type"},
+				{Field: "questions[1].answers[0].image", Message: "image is allowed only for image questions"},
+			},
+		},
+		{
+			name: "pick counts for single choice",
+			modify: func(s *Survey) {
+				s.Questions[1].Rules = &QuestionRules{PickMaxCount: intPtr(1)}
+			},
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "questions[1].rules", Message: "pick counts are allowed only for multiple choice questions"},
+			},
+		},
+		{
+			name: "answer fields",
+			modify: func(s *Survey) {
+				s.Questions[0].Answers[0].Text = ""
+				s.Questions[0].Answers[0].Image = &Image{ID: "image"}
+				s.Questions[0].Answers[1].ContentType = ContentTypeInvalid
+				s.Questions[0].Answers[1].Rules = &AnswerRules{ContentMinLength: intPtr(10), ContentMaxLength: intPtr(5)}
+				s.Questions[1].Answers[0].Image = nil
+			},
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "questions[0].answers[0].text", Message: "text is required"},
+				{Field: "questions[0].answers[0].image", Message: "image is allowed only for image questions"},
+				{Field: "questions[0].answers[1].content_type", Message: "unknown content type"},
+				{Field: "questions[0].answers[1].rules", Message: "content_min_length must not exceed content_max_length"},
+				{Field: "questions[1].answers[0].image", Message: "image is required for image questions"},
+			},
+		},
+		{
+			name: "deleted questions and answers are skipped",
+			modify: func(s *Survey) {
+				deletedAt := start
+				s.Questions[0].Answers[0].Text = ""
+				s.Questions[0].Answers[0].DeletedAt = &deletedAt
+				s.Questions[1].Text = ""
+				s.Questions[1].DeletedAt = &deletedAt
+			},
+		},
+		{
+			name: "no answer variants",
+			modify: func(s *Survey) {
+				s.Questions[1].Answers = nil
+			},
+			wantFields: []diterrors.ErrValidationFields{
+				{Field: "questions[1].answers", Message: "at least one answer variant is required"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			survey := validSurvey()
+			tt.modify(survey)
+
+			err := survey.Validate()
+			if len(tt.wantFields) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, diterrors.NewValidationError(ErrSurveyInvalid, tt.wantFields...), err)
+		})
+	}
+}
+
+func Test_Survey_ValidatePublish(t *testing.T) {
+	survey := &Survey{
+		Title:      "Опрос",
+		Respondent: &SurveyRespondent{Type: RespondentTypeUser},
+		Questions: []*Question{
+			{Text: "Вопрос", Type: QuestionTypeText, Answers: []*AnswerVariant{{WithContent: true, ContentType: ContentTypeText}}},
+		},
+	}
+	assert.ErrorIs(t, survey.ValidatePublish(), ErrSurveyNoQuestions)
+
+	survey.Questions[0].IsActive = true
+	assert.NoError(t, survey.ValidatePublish())
+}
+
+func Test_Survey_NormalizeWeights(t *testing.T) {
+	survey := &Survey{
+		Questions: []*Question{
+			{Text: "c", Weight: 10},
+			nil,
+			{Text: "a", Weight: 0, Answers: []*AnswerVariant{{Text: "y", Weight: 5}, {Text: "x", Weight: 5}, {Text: "w", Weight: 1}}},
+			{Text: "b", Weight: 0},
+		},
+	}
+
+	survey.NormalizeWeights()
+
+	var questions []string
+	for _, q := range survey.Questions {
+		questions = append(questions, q.Text)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, questions)
+	assert.Equal(t, []int{1, 2, 3}, []int{survey.Questions[0].Weight, survey.Questions[1].Weight, survey.Questions[2].Weight})
+
+	answers := survey.Questions[0].Answers
+	assert.Equal(t, []string{"w", "y", "x"}, []string{answers[0].Text, answers[1].Text, answers[2].Text})
+	assert.Equal(t, []int{1, 2, 3}, []int{answers[0].Weight, answers[1].Weight, answers[2].Weight})
+}
@@ -0,0 +1,48 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type accessListRepository struct {
+	path string
+}
+
+// NewAccessListRepository репозиторий файла списка доступа
+func NewAccessListRepository(path string) *accessListRepository {
+	return &accessListRepository{
+		path: path,
+	}
+}
+
+// ModTime время последнего изменения файла списка доступа
+func (r *accessListRepository) ModTime(_ context.Context) (time.Time, error) {
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("can't stat access list file: %w", err)
+	}
+	return info.ModTime(), nil
+}
+
+// Load читает файл списка доступа
+func (r *accessListRepository) Load(_ context.Context) (*entityAuth.AccessListFile, error) {
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return nil, fmt.Errorf("can't read access list file: %w", err)
+	}
+
+	file := &entityAuth.AccessListFile{}
+	if len(data) == 0 {
+		return file, nil
+	}
+	if err = json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("can't unmarshal access list file: %w", err)
+	}
+	return file, nil
+}
@@ -129,8 +129,8 @@ func (a *authRepository) AuthPortal(ctx context.Context, params entityAuth.AuthP
 	}, nil
 }
 
-func (a *authRepository) CreateSession(ctx context.Context, user *entityAuth.UserSudir, clientIP net.IP, device *entityAuth.Device, auth *entityAuth.Auth1C) (entityAuth.TokensPair, error) {
-	if len(user.Portals) == 0 {
+func (a *authRepository) CreateSession(ctx context.Context, user *entityAuth.UserSudir, portal *entityAuth.Portal, clientIP net.IP, device *entityAuth.Device, auth *entityAuth.Auth1C) (entityAuth.TokensPair, error) {
+	if portal == nil {
 		return entityAuth.TokensPair{}, repositories.ErrNoUserPortals
 	}
 
@@ -143,9 +143,9 @@ func (a *authRepository) CreateSession(ctx context.Context, user *entityAuth.Use
 			Email:     user.Email,
 			Snils:     user.SNILS,
 			Portal: &authv1.CreateSessionRequest_UserPortal{
-				Id:   int32(user.Portals[0].ID),
-				Name: user.Portals[0].Name,
-				Url:  user.Portals[0].URL,
+				Id:   int32(portal.ID),
+				Name: portal.Name,
+				Url:  portal.URL,
 				Sid:  auth.GetPortalSession(),
 			},
 			Employee: &authv1.CreateSessionRequest_Employee{
@@ -272,6 +272,7 @@ func Test_authRepository_CreateSession(t *testing.T) {
 	type args struct {
 		ctx      context.Context
 		user     *entityAuth.UserSudir
+		portal   *entityAuth.Portal
 		clientIP net.IP
 		device   *entityAuth.Device
 		auth     *entityAuth.Auth1C
@@ -286,7 +287,7 @@ func Test_authRepository_CreateSession(t *testing.T) {
 		want func(a args, f fields) (entityAuth.TokensPair, error)
 	}{
 		{
-			name: "no user portals err",
+			name: "no portal err",
 			args: args{
 				ctx:  ctx,
 				user: &entityAuth.UserSudir{},
@@ -298,8 +299,9 @@ func Test_authRepository_CreateSession(t *testing.T) {
 		{
 			name: "client err",
 			args: args{
-				ctx:  ctx,
-				user: &entityAuth.UserSudir{Portals: []*entityAuth.Portal{{}}},
+				ctx:    ctx,
+				user:   &entityAuth.UserSudir{Portals: []*entityAuth.Portal{{}}},
+				portal: &entityAuth.Portal{},
 			},
 			want: func(a args, f fields) (entityAuth.TokensPair, error) {
 				testRequest := &authv1.CreateSessionRequest{
@@ -325,14 +327,19 @@ func Test_authRepository_CreateSession(t *testing.T) {
 			name: "correct",
 			args: args{
 				ctx:    ctx,
-				user:   &entityAuth.UserSudir{Portals: []*entityAuth.Portal{{}}},
+				user:   &entityAuth.UserSudir{Portals: []*entityAuth.Portal{{}, {ID: 2, Name: "testName", URL: "testURL"}}},
+				portal: &entityAuth.Portal{ID: 2, Name: "testName", URL: "testURL"},
 				device: &entityAuth.Device{UserAgent: "testUserAgent"},
 			},
 			want: func(a args, f fields) (entityAuth.TokensPair, error) {
 				testRequest := &authv1.CreateSessionRequest{
 					User: &authv1.CreateSessionRequest_User{
-						Id:       uuid.Nil.String(),
-						Portal:   &authv1.CreateSessionRequest_UserPortal{},
+						Id: uuid.Nil.String(),
+						Portal: &authv1.CreateSessionRequest_UserPortal{
+							Id:   2,
+							Name: "testName",
+							Url:  "testURL",
+						},
 						Employee: &authv1.CreateSessionRequest_Employee{},
 						Person:   &authv1.CreateSessionRequest_Person{},
 					},
@@ -378,7 +385,7 @@ func Test_authRepository_CreateSession(t *testing.T) {
 			}
 			want, wantErr := tt.want(tt.args, f)
 			ar := NewAuthRepository(f.client, f.mapper, url.URL{}, "test-app-name", time.Second, time.Second, f.tu, f.logger)
-			got, err := ar.CreateSession(tt.args.ctx, tt.args.user, tt.args.clientIP, tt.args.device, tt.args.auth)
+			got, err := ar.CreateSession(tt.args.ctx, tt.args.user, tt.args.portal, tt.args.clientIP, tt.args.device, tt.args.auth)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
 				assert.Equal(t, entityAuth.TokensPair{}, got)
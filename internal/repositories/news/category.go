@@ -38,7 +38,7 @@ func (c *categoryRepository) Create(ctx context.Context, nc *dtoNews.NewCategory
 
 	res, err := c.categoryAPIClient.Create(ctx, &categoryv1.CreateRequest{
 		Name:       nc.Name,
-		Visibility: &categoryv1.Visibility{PortalIds: c.sharedMapper.IntSliceToInt32(nc.GetVisibility().OIVs)},
+		Visibility: c.visibilityToPb(nc.GetVisibilityPtr()),
 	})
 	if err != nil {
 		gErr := diterrors.NewLocalizedError(diterrors.LocalizeLocale, err)
@@ -82,7 +82,7 @@ func (c *categoryRepository) Update(ctx context.Context, category *dtoNews.Updat
 	res, err := c.categoryAPIClient.Update(ctx, &categoryv1.UpdateRequest{
 		Id:         category.ID.String(),
 		Name:       wrapperspb.String(category.Name),
-		Visibility: &categoryv1.Visibility{PortalIds: c.sharedMapper.IntSliceToInt32(category.GetVisibility().OIVs)},
+		Visibility: c.visibilityToPb(category.GetVisibilityPtr()),
 	})
 	if err != nil {
 		gErr := diterrors.NewLocalizedError(diterrors.LocalizeLocale, err)
@@ -104,12 +104,9 @@ func (c *categoryRepository) Update(ctx context.Context, category *dtoNews.Updat
 	}
 
 	return &entityNews.Category{
-		ID: id,
-		Visibility: entityNews.CategoryVisibility{
-			OIVs:       c.sharedMapper.Int32SliceToInt(res.GetCategory().GetVisibility().GetPortalIds()),
-			ComplexIDs: c.sharedMapper.Int32SliceToInt(res.GetCategory().GetVisibility().GetComplexIds()),
-		},
-		Name: res.GetCategory().GetName(),
+		ID:         id,
+		Visibility: c.visibilityToEntity(res.GetCategory().GetVisibility()),
+		Name:       res.GetCategory().GetName(),
 	}, nil
 }
 
@@ -184,12 +181,9 @@ func (c *categoryRepository) Filter(ctx context.Context, filter *dtoNews.FilterC
 		}
 		if id, err := uuid.Parse(category.GetId()); err == nil && id != uuid.Nil {
 			eRes = append(eRes, &entityNews.Category{
-				ID:   id,
-				Name: category.GetName(),
-				Visibility: entityNews.CategoryVisibility{
-					ComplexIDs: c.sharedMapper.Int32SliceToInt(category.GetVisibility().GetComplexIds()),
-					OIVs:       c.sharedMapper.Int32SliceToInt(category.GetVisibility().GetPortalIds()),
-				},
+				ID:         id,
+				Name:       category.GetName(),
+				Visibility: c.visibilityToEntity(category.GetVisibility()),
 			})
 		}
 	}
@@ -198,3 +192,46 @@ func (c *categoryRepository) Filter(ctx context.Context, filter *dtoNews.FilterC
 
 	return res, nil
 }
+
+// visibilityToPb правила видимости категории. Без правил в запросе Update сервис новостей их не меняет
+func (c *categoryRepository) visibilityToPb(v *dtoNews.CategoryVisibility) *categoryv1.Visibility {
+	if v == nil {
+		return nil
+	}
+	return &categoryv1.Visibility{
+		Condition:        v.Condition,
+		ComplexIds:       c.sharedMapper.IntSliceToInt32(v.ComplexIDs),
+		PortalIds:        c.sharedMapper.IntSliceToInt32(v.OIVs),
+		OrgIds:           uuidsToStrings(v.OrgIDs),
+		ProductIds:       uuidsToStrings(v.ProductIDs),
+		SubdivisionNames: v.SubdivisionNames,
+		PositionNames:    v.PositionNames,
+		EmployeeIds:      uuidsToStrings(v.EmployeeIDs),
+		RoleNames:        v.RoleNames,
+	}
+}
+
+func (c *categoryRepository) visibilityToEntity(v *categoryv1.Visibility) entityNews.CategoryVisibility {
+	return entityNews.CategoryVisibility{
+		Condition:        v.GetCondition(),
+		ComplexIDs:       c.sharedMapper.Int32SliceToInt(v.GetComplexIds()),
+		OIVs:             c.sharedMapper.Int32SliceToInt(v.GetPortalIds()),
+		OrgIDs:           c.sharedMapper.StringSliceToUUID(v.GetOrgIds(), true),
+		ProductIDs:       c.sharedMapper.StringSliceToUUID(v.GetProductIds(), true),
+		SubdivisionNames: v.GetSubdivisionNames(),
+		PositionNames:    v.GetPositionNames(),
+		EmployeeIDs:      c.sharedMapper.StringSliceToUUID(v.GetEmployeeIds(), true),
+		RoleNames:        v.GetRoleNames(),
+	}
+}
+
+func uuidsToStrings(ids []uuid.UUID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(ids))
+	for _, id := range ids {
+		res = append(res, id.String())
+	}
+	return res
+}
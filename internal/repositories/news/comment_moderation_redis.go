@@ -0,0 +1,78 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisCommentModerationRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisCommentModerationRepository комментарии, скрытые модераторами, в Redis.
+//
+//	Скрытие комментария хранится отдельным ключом без срока жизни, восстановление комментария удаляет ключ
+func NewRedisCommentModerationRepository(client redis.UniversalClient, prefix string) *redisCommentModerationRepository {
+	return &redisCommentModerationRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisCommentModerationRepository) Hide(ctx context.Context, moderation *entityNews.CommentModeration) error {
+	data, err := json.Marshal(moderation)
+	if err != nil {
+		return fmt.Errorf("can't marshal comment moderation: %w", err)
+	}
+	if err = r.client.Set(ctx, r.hiddenKey(moderation.CommentID), data, 0).Err(); err != nil {
+		return fmt.Errorf("can't save comment moderation to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisCommentModerationRepository) Unhide(ctx context.Context, commentID uuid.UUID) error {
+	if err := r.client.Del(ctx, r.hiddenKey(commentID)).Err(); err != nil {
+		return fmt.Errorf("can't delete comment moderation from redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisCommentModerationRepository) List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*entityNews.CommentModeration, error) {
+	moderation := make(map[uuid.UUID]*entityNews.CommentModeration, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return moderation, nil
+	}
+
+	keys := make([]string, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		keys = append(keys, r.hiddenKey(id))
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get comments moderation from redis: %w", err)
+	}
+
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		hidden := &entityNews.CommentModeration{}
+		if err = json.Unmarshal([]byte(data), hidden); err != nil {
+			return nil, fmt.Errorf("can't unmarshal comment moderation: %w", err)
+		}
+		moderation[hidden.CommentID] = hidden
+	}
+	return moderation, nil
+}
+
+func (r *redisCommentModerationRepository) hiddenKey(commentID uuid.UUID) string {
+	return r.prefix + "news-comment-hidden:" + commentID.String()
+}
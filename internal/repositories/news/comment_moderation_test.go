@@ -0,0 +1,113 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type commentReportsRepository interface {
+	Add(ctx context.Context, report *entityNews.CommentReport) error
+	List(ctx context.Context, portalID int) ([]*entityNews.CommentReport, error)
+	Delete(ctx context.Context, ids ...uuid.UUID) error
+}
+
+func Test_redisCommentModerationRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.TODO()
+	r := NewRedisCommentModerationRepository(client, "test:")
+	commentID := uuid.New()
+	otherID := uuid.New()
+
+	got, err := r.List(ctx, []uuid.UUID{commentID, otherID})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	hidden := &entityNews.CommentModeration{
+		CommentID: commentID,
+		HiddenAt:  time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+		HiddenBy:  uuid.New(),
+	}
+	assert.NoError(t, r.Hide(ctx, hidden))
+
+	got, err = r.List(ctx, []uuid.UUID{commentID, otherID})
+	assert.NoError(t, err)
+	assert.Equal(t, map[uuid.UUID]*entityNews.CommentModeration{commentID: hidden}, got)
+
+	got, err = r.List(ctx, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+
+	assert.NoError(t, r.Unhide(ctx, commentID))
+	got, err = r.List(ctx, []uuid.UUID{commentID})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
+
+func Test_commentReportsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository commentReportsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisCommentReportsRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			commentID := uuid.New()
+			createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+			report := func(reporterID uuid.UUID, portalID int, createdAt time.Time) *entityNews.CommentReport {
+				return &entityNews.CommentReport{
+					ID:         uuid.New(),
+					CommentID:  commentID,
+					NewsID:     uuid.New(),
+					PortalID:   portalID,
+					ReporterID: reporterID,
+					Reason:     "спам",
+					CreatedAt:  createdAt,
+				}
+			}
+			reporter := uuid.New()
+			first := report(reporter, 1, createdAt)
+			second := report(uuid.New(), 1, createdAt.Add(-time.Hour))
+			foreign := report(uuid.New(), 2, createdAt)
+
+			for _, r := range []*entityNews.CommentReport{first, second, foreign} {
+				assert.NoError(t, tt.repository.Add(ctx, r))
+			}
+			// Повторная жалоба сотрудника не сохраняется
+			assert.NoError(t, tt.repository.Add(ctx, report(reporter, 1, createdAt)))
+
+			got, err := tt.repository.List(ctx, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.CommentReport{second, first}, got)
+
+			assert.NoError(t, tt.repository.Delete(ctx, second.ID, uuid.New()))
+
+			got, err = tt.repository.List(ctx, 1)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.CommentReport{first}, got)
+
+			got, err = tt.repository.List(ctx, 2)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.CommentReport{foreign}, got)
+		})
+	}
+}
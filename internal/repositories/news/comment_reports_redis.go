@@ -0,0 +1,108 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisCommentReportsRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisCommentReportsRepository жалобы на комментарии в Redis.
+//
+//	Жалобы портала хранятся в hash по идентификатору жалобы.
+//	Повторная жалоба сотрудника на комментарий отсекается отдельным ключом и не сохраняется
+func NewRedisCommentReportsRepository(client redis.UniversalClient, prefix string) *redisCommentReportsRepository {
+	return &redisCommentReportsRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisCommentReportsRepository) Add(ctx context.Context, report *entityNews.CommentReport) error {
+	added, err := r.client.SetNX(ctx, r.reporterKey(report.CommentID, report.ReporterID), report.ID.String(), 0).Result()
+	if err != nil {
+		return fmt.Errorf("can't check comment report in redis: %w", err)
+	}
+	if !added {
+		return nil
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("can't marshal comment report: %w", err)
+	}
+	pipe := r.client.TxPipeline()
+	pipe.HSet(ctx, r.reportsKey(report.PortalID), report.ID.String(), data)
+	pipe.HSet(ctx, r.indexKey(), report.ID.String(), report.PortalID)
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("can't save comment report to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisCommentReportsRepository) List(ctx context.Context, portalID int) ([]*entityNews.CommentReport, error) {
+	values, err := r.client.HVals(ctx, r.reportsKey(portalID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get comment reports from redis: %w", err)
+	}
+
+	reports := make([]*entityNews.CommentReport, 0, len(values))
+	for _, value := range values {
+		report := &entityNews.CommentReport{}
+		if err = json.Unmarshal([]byte(value), report); err != nil {
+			return nil, fmt.Errorf("can't unmarshal comment report: %w", err)
+		}
+		reports = append(reports, report)
+	}
+	sort.Slice(reports, func(i, j int) bool { return reports[i].CreatedAt.Before(reports[j].CreatedAt) })
+	return reports, nil
+}
+
+func (r *redisCommentReportsRepository) Delete(ctx context.Context, ids ...uuid.UUID) error {
+	for _, id := range ids {
+		portal, err := r.client.HGet(ctx, r.indexKey(), id.String()).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("can't get comment report portal from redis: %w", err)
+		}
+		portalID, err := strconv.Atoi(portal)
+		if err != nil {
+			return fmt.Errorf("can't parse comment report portal <%s>: %w", portal, err)
+		}
+
+		pipe := r.client.TxPipeline()
+		pipe.HDel(ctx, r.reportsKey(portalID), id.String())
+		pipe.HDel(ctx, r.indexKey(), id.String())
+		if _, err = pipe.Exec(ctx); err != nil {
+			return fmt.Errorf("can't delete comment report from redis: %w", err)
+		}
+	}
+	return nil
+}
+
+func (r *redisCommentReportsRepository) reportsKey(portalID int) string {
+	return r.prefix + "news-comment-reports:" + strconv.Itoa(portalID)
+}
+
+// indexKey портал каждой жалобы, нужен для удаления жалобы по идентификатору
+func (r *redisCommentReportsRepository) indexKey() string {
+	return r.prefix + "news-comment-reports-portals"
+}
+
+func (r *redisCommentReportsRepository) reporterKey(commentID, reporterID uuid.UUID) string {
+	return r.prefix + "news-comment-reporter:" + commentID.String() + ":" + reporterID.String()
+}
@@ -0,0 +1,176 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisCommentThreadsRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisCommentThreadsRepository ветки обсуждений комментариев в Redis.
+//
+//	Ветка комментария хранится отдельным ключом, ответы на комментарий - в sorted set по времени создания,
+//	все ответы к новости - в set, чтобы вычитать их из количества комментариев сервиса новостей
+func NewRedisCommentThreadsRepository(client redis.UniversalClient, prefix string) *redisCommentThreadsRepository {
+	return &redisCommentThreadsRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisCommentThreadsRepository) Save(ctx context.Context, thread *entityNews.CommentThread) error {
+	data, err := json.Marshal(thread)
+	if err != nil {
+		return fmt.Errorf("can't marshal comment thread: %w", err)
+	}
+
+	pipe := r.client.TxPipeline()
+	pipe.Set(ctx, r.threadKey(thread.CommentID), data, 0)
+	if thread.ParentID != nil {
+		pipe.ZAdd(ctx, r.repliesKey(*thread.ParentID), redis.Z{
+			Score:  float64(thread.CreatedAt.UnixMilli()),
+			Member: thread.CommentID.String(),
+		})
+		pipe.SAdd(ctx, r.newsRepliesKey(thread.NewsID), thread.CommentID.String())
+	}
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("can't save comment thread to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisCommentThreadsRepository) List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*entityNews.CommentThread, error) {
+	threads := make(map[uuid.UUID]*entityNews.CommentThread, len(commentIDs))
+	if len(commentIDs) == 0 {
+		return threads, nil
+	}
+
+	keys := make([]string, 0, len(commentIDs))
+	for _, id := range commentIDs {
+		keys = append(keys, r.threadKey(id))
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get comment threads from redis: %w", err)
+	}
+
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		thread := &entityNews.CommentThread{}
+		if err = json.Unmarshal([]byte(data), thread); err != nil {
+			return nil, fmt.Errorf("can't unmarshal comment thread: %w", err)
+		}
+		threads[thread.CommentID] = thread
+	}
+	return threads, nil
+}
+
+func (r *redisCommentThreadsRepository) Replies(
+	ctx context.Context,
+	parentID uuid.UUID,
+	afterID *uuid.UUID,
+	limit int,
+	order dto.OrderDirection,
+) ([]uuid.UUID, int, error) {
+	key := r.repliesKey(parentID)
+	total, err := r.client.ZCard(ctx, key).Result()
+	if err != nil {
+		return nil, 0, fmt.Errorf("can't count comment replies in redis: %w", err)
+	}
+
+	var start int64
+	if afterID != nil {
+		rank := r.client.ZRank
+		if order == dto.OrderDirectionDesc {
+			rank = r.client.ZRevRank
+		}
+		after, rankErr := rank(ctx, key, afterID.String()).Result()
+		if rankErr != nil && !errors.Is(rankErr, redis.Nil) {
+			return nil, 0, fmt.Errorf("can't get comment reply position from redis: %w", rankErr)
+		}
+		if rankErr == nil {
+			start = after + 1
+		}
+	}
+	stop := int64(-1)
+	if limit > 0 {
+		stop = start + int64(limit) - 1
+	}
+
+	var members []string
+	if order == dto.OrderDirectionDesc {
+		members, err = r.client.ZRevRange(ctx, key, start, stop).Result()
+	} else {
+		members, err = r.client.ZRange(ctx, key, start, stop).Result()
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("can't get comment replies from redis: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, parseErr := uuid.Parse(member)
+		if parseErr != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, int(total), nil
+}
+
+func (r *redisCommentThreadsRepository) RepliesCount(ctx context.Context, parentIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(parentIDs))
+	if len(parentIDs) == 0 {
+		return counts, nil
+	}
+
+	pipe := r.client.Pipeline()
+	cmds := make([]*redis.IntCmd, 0, len(parentIDs))
+	for _, id := range parentIDs {
+		cmds = append(cmds, pipe.ZCard(ctx, r.repliesKey(id)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("can't count comment replies in redis: %w", err)
+	}
+
+	for i, cmd := range cmds {
+		if count := cmd.Val(); count > 0 {
+			counts[parentIDs[i]] = int(count)
+		}
+	}
+	return counts, nil
+}
+
+func (r *redisCommentThreadsRepository) NewsRepliesCount(ctx context.Context, newsID uuid.UUID) (int, error) {
+	count, err := r.client.SCard(ctx, r.newsRepliesKey(newsID)).Result()
+	if err != nil {
+		return 0, fmt.Errorf("can't count news comment replies in redis: %w", err)
+	}
+	return int(count), nil
+}
+
+func (r *redisCommentThreadsRepository) threadKey(commentID uuid.UUID) string {
+	return r.prefix + "news-comment-thread:" + commentID.String()
+}
+
+func (r *redisCommentThreadsRepository) repliesKey(parentID uuid.UUID) string {
+	return r.prefix + "news-comment-replies:" + parentID.String()
+}
+
+func (r *redisCommentThreadsRepository) newsRepliesKey(newsID uuid.UUID) string {
+	return r.prefix + "news-comment-news-replies:" + newsID.String()
+}
@@ -0,0 +1,102 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type commentThreadsRepository interface {
+	Save(ctx context.Context, thread *entityNews.CommentThread) error
+	List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*entityNews.CommentThread, error)
+	Replies(ctx context.Context, parentID uuid.UUID, afterID *uuid.UUID, limit int, order dto.OrderDirection) ([]uuid.UUID, int, error)
+	RepliesCount(ctx context.Context, parentIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	NewsRepliesCount(ctx context.Context, newsID uuid.UUID) (int, error)
+}
+
+func Test_commentThreadsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository commentThreadsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisCommentThreadsRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			parentID := uuid.New()
+			createdAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+			mentionID := uuid.New()
+
+			replies := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+			// сохраняем не по порядку, выдача должна идти по времени создания
+			for _, i := range []int{1, 0, 2} {
+				assert.NoError(t, tt.repository.Save(ctx, &entityNews.CommentThread{
+					CommentID: replies[i],
+					NewsID:    newsID,
+					ParentID:  &parentID,
+					Depth:     1,
+					CreatedAt: createdAt.Add(time.Duration(i) * time.Minute),
+				}))
+			}
+			mention := &entityNews.CommentThread{
+				CommentID: uuid.New(),
+				NewsID:    newsID,
+				Mentions:  []*entityNews.Author{{ID: &mentionID, LastName: "Иванов", FirstName: "Иван"}},
+				CreatedAt: createdAt,
+			}
+			assert.NoError(t, tt.repository.Save(ctx, mention))
+
+			threads, err := tt.repository.List(ctx, []uuid.UUID{replies[0], mention.CommentID, uuid.New()})
+			assert.NoError(t, err)
+			assert.Len(t, threads, 2)
+			assert.Equal(t, &parentID, threads[replies[0]].ParentID)
+			assert.Equal(t, mention.Mentions, threads[mention.CommentID].Mentions)
+
+			got, total, err := tt.repository.Replies(ctx, parentID, nil, 2, dto.OrderDirectionAsc)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, total)
+			assert.Equal(t, replies[:2], got)
+
+			got, _, err = tt.repository.Replies(ctx, parentID, &replies[1], 2, dto.OrderDirectionAsc)
+			assert.NoError(t, err)
+			assert.Equal(t, replies[2:], got)
+
+			got, _, err = tt.repository.Replies(ctx, parentID, &replies[2], 0, dto.OrderDirectionDesc)
+			assert.NoError(t, err)
+			assert.Equal(t, []uuid.UUID{replies[1], replies[0]}, got)
+
+			counts, err := tt.repository.RepliesCount(ctx, []uuid.UUID{parentID, mention.CommentID})
+			assert.NoError(t, err)
+			assert.Equal(t, map[uuid.UUID]int{parentID: 3}, counts)
+
+			// повторное сохранение ветки не увеличивает количество ответов к новости
+			assert.NoError(t, tt.repository.Save(ctx, &entityNews.CommentThread{
+				CommentID: replies[0],
+				NewsID:    newsID,
+				ParentID:  &parentID,
+				Depth:     1,
+				CreatedAt: createdAt,
+			}))
+			newsReplies, err := tt.repository.NewsRepliesCount(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, newsReplies)
+		})
+	}
+}
@@ -85,3 +85,39 @@ func (c *commentsRepository) List(ctx context.Context, params *dtoNews.FilterCom
 
 	return c.mapper.CommentsToEntity(resp.GetComments()), int(resp.GetPagination().GetTotal()), nil
 }
+
+func (c *commentsRepository) Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsComment, error) {
+	resp, err := c.newsApi.Get(ctx, &newsv1.GetRequest{
+		Id: id.String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("newsFacadeApi.Get: %w", diterrors.GrpcErrorToError(err))
+	}
+	if resp.GetComment() == nil {
+		return nil, fmt.Errorf("newsFacadeApi.Get: comment not found: %w", diterrors.ErrNotFound)
+	}
+
+	return c.mapper.CommentToEntity(resp.GetComment()), nil
+}
+
+func (c *commentsRepository) Update(ctx context.Context, id uuid.UUID, text string) (*entityNews.NewsComment, error) {
+	resp, err := c.newsApi.Update(ctx, &newsv1.UpdateRequest{
+		Id:   id.String(),
+		Text: text,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("newsFacadeApi.Update: %w", diterrors.GrpcErrorToError(err))
+	}
+
+	return c.mapper.CommentToEntity(resp.GetComment()), nil
+}
+
+func (c *commentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := c.newsApi.Delete(ctx, &newsv1.DeleteRequest{
+		Id: id.String(),
+	})
+	if err != nil {
+		return fmt.Errorf("newsFacadeApi.Delete: %w", diterrors.GrpcErrorToError(err))
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisDraftsRepository struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisDraftsRepository черновики правок новостей в Redis.
+//
+//	Черновик удаляется через ttl после последнего автосохранения
+func NewRedisDraftsRepository(client redis.UniversalClient, prefix string, ttl time.Duration) *redisDraftsRepository {
+	return &redisDraftsRepository{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (r *redisDraftsRepository) Save(ctx context.Context, draft *entityNews.Draft) error {
+	data, err := json.Marshal(draft)
+	if err != nil {
+		return fmt.Errorf("can't marshal news draft: %w", err)
+	}
+	if err = r.client.Set(ctx, r.draftKey(draft.NewsID, draft.EditorID), data, r.ttl).Err(); err != nil {
+		return fmt.Errorf("can't save news draft to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisDraftsRepository) Get(ctx context.Context, newsID, editorID uuid.UUID) (*entityNews.Draft, error) {
+	value, err := r.client.Get(ctx, r.draftKey(newsID, editorID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't get news draft from redis: %w", err)
+	}
+
+	draft := &entityNews.Draft{}
+	if err = json.Unmarshal(value, draft); err != nil {
+		return nil, fmt.Errorf("can't unmarshal news draft: %w", err)
+	}
+	return draft, nil
+}
+
+func (r *redisDraftsRepository) Delete(ctx context.Context, newsID, editorID uuid.UUID) error {
+	if err := r.client.Del(ctx, r.draftKey(newsID, editorID)).Err(); err != nil {
+		return fmt.Errorf("can't delete news draft from redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisDraftsRepository) draftKey(newsID, editorID uuid.UUID) string {
+	return r.prefix + "news-draft:" + newsID.String() + ":" + editorID.String()
+}
@@ -0,0 +1,59 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisHistoryRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisHistoryRepository история переходов новостей между статусами в Redis.
+//
+//	История новости хранится списком, записи только добавляются в конец
+func NewRedisHistoryRepository(client redis.UniversalClient, prefix string) *redisHistoryRepository {
+	return &redisHistoryRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisHistoryRepository) Append(ctx context.Context, transition *entityNews.StatusTransition) error {
+	data, err := json.Marshal(transition)
+	if err != nil {
+		return fmt.Errorf("can't marshal news status transition: %w", err)
+	}
+	if err = r.client.RPush(ctx, r.historyKey(transition.NewsID), data).Err(); err != nil {
+		return fmt.Errorf("can't append news status transition to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisHistoryRepository) List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.StatusTransition, error) {
+	values, err := r.client.LRange(ctx, r.historyKey(newsID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get news history from redis: %w", err)
+	}
+
+	history := make([]*entityNews.StatusTransition, 0, len(values))
+	for _, value := range values {
+		transition := &entityNews.StatusTransition{}
+		if err = json.Unmarshal([]byte(value), transition); err != nil {
+			return nil, fmt.Errorf("can't unmarshal news status transition: %w", err)
+		}
+		history = append(history, transition)
+	}
+	return history, nil
+}
+
+func (r *redisHistoryRepository) historyKey(newsID uuid.UUID) string {
+	return r.prefix + "news-history:" + newsID.String()
+}
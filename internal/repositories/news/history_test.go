@@ -0,0 +1,72 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type historyRepository interface {
+	Append(ctx context.Context, transition *entityNews.StatusTransition) error
+	List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.StatusTransition, error)
+}
+
+func Test_historyRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository historyRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisHistoryRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			actorID := uuid.New()
+			createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			transitions := []*entityNews.StatusTransition{
+				{
+					NewsID:    newsID,
+					From:      entityNews.NewsStatusDraft,
+					To:        entityNews.NewsStatusWaitingPublish,
+					Actor:     entityNews.Author{ID: &actorID, LastName: "Иванов", FirstName: "Иван"},
+					Comment:   "на согласование",
+					CreatedAt: createdAt,
+				},
+				{
+					NewsID:    newsID,
+					From:      entityNews.NewsStatusWaitingPublish,
+					To:        entityNews.NewsStatusPublished,
+					CreatedAt: createdAt.Add(time.Hour),
+				},
+			}
+
+			got, err := tt.repository.List(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Empty(t, got)
+
+			for _, transition := range transitions {
+				assert.NoError(t, tt.repository.Append(ctx, transition))
+			}
+			assert.NoError(t, tt.repository.Append(ctx, &entityNews.StatusTransition{NewsID: uuid.New()}))
+
+			got, err = tt.repository.List(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Equal(t, transitions, got)
+		})
+	}
+}
@@ -20,6 +20,7 @@ type NewsMapper interface {
 	StatusToPb(status entityNews.NewsStatus) newsv1.NewsStatus
 	StatusToEntity(statusPb newsv1.NewsStatus) entityNews.NewsStatus
 	VisibilityToPb(vis *entityNews.NewsVisibility) *newsv1.Visibility
+	SearchToPb(search *dtoNews.SearchNews) *newsv1.FilterRequest
 	CommentsToEntity(commentsPb []*newsv1.Comment) []*entityNews.NewsComment
 	CommentToEntity(comment *newsv1.Comment) *entityNews.NewsComment
 	NewCommentToPb(comment *dtoNews.NewComment) *newsv1.Comment
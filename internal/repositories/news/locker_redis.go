@@ -0,0 +1,44 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+type redisNewsLocker struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisNewsLocker блокировки новостей в Redis, общие для всех реплик.
+//
+//	Блокировка - ключ с владельцем и временем жизни, как и аренда планировщика
+func NewRedisNewsLocker(client redis.UniversalClient, prefix string) *redisNewsLocker {
+	return &redisNewsLocker{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisNewsLocker) Lock(ctx context.Context, newsID uuid.UUID, owner string, ttl time.Duration) (bool, error) {
+	locked, err := acquireLeaseScript.Run(ctx, r.client, []string{r.lockKey(newsID)}, owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("can't lock news in redis: %w", err)
+	}
+	return locked == 1, nil
+}
+
+func (r *redisNewsLocker) Unlock(ctx context.Context, newsID uuid.UUID, owner string) error {
+	if err := releaseLeaseScript.Run(ctx, r.client, []string{r.lockKey(newsID)}, owner).Err(); err != nil {
+		return fmt.Errorf("can't unlock news in redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisNewsLocker) lockKey(newsID uuid.UUID) string {
+	return r.prefix + "news-lock:" + newsID.String()
+}
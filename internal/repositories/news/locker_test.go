@@ -0,0 +1,52 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_redisNewsLocker(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	ctx := context.TODO()
+	locker := NewRedisNewsLocker(client, "test:")
+	newsID := uuid.New()
+	otherID := uuid.New()
+
+	locked, err := locker.Lock(ctx, newsID, "first", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	// новость заблокирована, другие новости блокируются независимо
+	locked, err = locker.Lock(ctx, newsID, "second", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+	locked, err = locker.Lock(ctx, otherID, "second", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	// чужая блокировка не снимается
+	assert.NoError(t, locker.Unlock(ctx, newsID, "second"))
+	locked, err = locker.Lock(ctx, newsID, "second", time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, locked)
+
+	assert.NoError(t, locker.Unlock(ctx, newsID, "first"))
+	locked, err = locker.Lock(ctx, newsID, "second", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+
+	// не снятая блокировка истекает
+	mr.FastForward(2 * time.Minute)
+	locked, err = locker.Lock(ctx, newsID, "first", time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, locked)
+}
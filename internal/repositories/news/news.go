@@ -154,30 +154,31 @@ func (r *newsRepository) Search(ctx context.Context, search *dtoNews.SearchNews)
 		if err != nil {
 			return nil, fmt.Errorf("newsRepository.Search: can't search news without params: %w", diterrors.GrpcErrorToError(err))
 		}
-		// TODO: POKAZ Total для показа берется длина новостей
 		return &dtoNews.SearchNewsResult{
 			News:  r.newsMapper.NewsFullToEntity(found.GetNews()),
-			Total: len(found.GetNews()),
+			Total: int(found.GetPagination().GetTotal()),
 		}, nil
 	}
 
 	// TODO: Должен работать с news-search
-	found, err := r.newsApi.Filter(ctx, &newsv1.FilterRequest{
-		Filters: &newsv1.FilterRequest_Title{Title: search.Query},
-		Options: &newsv1.FilterRequest_Options{
-			WithComments: false,
-			OnlyMain:     false,
-		},
-		// TODO: Сделать пагинацию
-		Pagination: &sharedv1.ScrollPaginationRequest{
-			Limit: int32(search.Pagination.Limit),
-		},
-	})
+	offset := search.Offset()
+	limit := search.GetPagination().Limit
+	req := r.newsMapper.SearchToPb(search)
+	// Сервис новостей поддерживает только пагинацию по курсору, поэтому страница по номеру
+	// вычитывается от начала выдачи. Запрашивается на одну новость больше, чтобы понять, есть ли следующая страница
+	req.Pagination = &sharedv1.ScrollPaginationRequest{
+		Limit: int32(offset + limit + 1),
+	}
+	if search.GetScrollPtr() != nil {
+		req.Pagination.LastId = search.GetScrollPtr().GetLastID().String()
+	}
+
+	found, err := r.newsApi.Filter(ctx, req)
 	if err != nil {
 		return nil, fmt.Errorf("newsRepository.Search: can't search news: %w", diterrors.GrpcErrorToError(err))
 	}
 
-	sNews := r.newsMapper.NewsFullToEntity(found.GetNews())
+	sNews, next := page(r.newsMapper.NewsFullToEntity(found.GetNews()), offset, limit)
 
 	portalsIds := make([]int32, 0, len(sNews))
 	organizationsIds := make(uuid.UUIDs, 0, len(sNews))
@@ -258,20 +259,49 @@ func (r *newsRepository) Search(ctx context.Context, search *dtoNews.SearchNews)
 			}
 		}
 	}
-	// TODO: POKAZ Total для показа берется длина новостей
 	return &dtoNews.SearchNewsResult{
 		News:  sNews,
-		Total: len(sNews),
+		Total: int(found.GetPagination().GetTotal()),
+		Next:  next,
 	}, nil
 }
 
+// page новости страницы после пропуска offset новостей и позиция следующей страницы.
+//
+//	news должен содержать на одну новость больше страницы, если следующая страница есть
+func page(news []*entityNews.NewsFull, offset, limit int) ([]*entityNews.NewsFull, *dtoNews.SearchNewsScroll) {
+	if offset >= len(news) {
+		return []*entityNews.NewsFull{}, nil
+	}
+	news = news[offset:]
+	if len(news) <= limit {
+		return news, nil
+	}
+
+	news = news[:limit]
+	last := news[limit-1]
+	return news, &dtoNews.SearchNewsScroll{
+		LastID:    &last.ID,
+		CreatedAt: last.CreatedAt,
+		Limit:     limit,
+	}
+}
+
 func (r *newsRepository) Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error) {
+	return r.get(ctx, id, true)
+}
+
+func (r *newsRepository) GetWithoutComments(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error) {
+	return r.get(ctx, id, false)
+}
+
+func (r *newsRepository) get(ctx context.Context, id uuid.UUID, withComments bool) (*entityNews.NewsFull, error) {
 	found, err := r.newsApi.Get(ctx, &newsv1.GetRequest{
 		By: &newsv1.GetRequest_Id{
 			Id: id.String(),
 		},
 		Options: &newsv1.GetRequest_Options{
-			WithComments: true,
+			WithComments: withComments,
 			OnlyMain:     false,
 		},
 	})
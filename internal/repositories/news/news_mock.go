@@ -156,6 +156,20 @@ func (mr *MockNewsMapperMockRecorder) ParticipantsIdsToPb(participants any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParticipantsIdsToPb", reflect.TypeOf((*MockNewsMapper)(nil).ParticipantsIdsToPb), participants)
 }
 
+// SearchToPb mocks base method.
+func (m *MockNewsMapper) SearchToPb(search *news.SearchNews) *newsv1.FilterRequest {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchToPb", search)
+	ret0, _ := ret[0].(*newsv1.FilterRequest)
+	return ret0
+}
+
+// SearchToPb indicates an expected call of SearchToPb.
+func (mr *MockNewsMapperMockRecorder) SearchToPb(search any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchToPb", reflect.TypeOf((*MockNewsMapper)(nil).SearchToPb), search)
+}
+
 // StatusToEntity mocks base method.
 func (m *MockNewsMapper) StatusToEntity(statusPb newsv1.NewsStatus) news0.NewsStatus {
 	m.ctrl.T.Helper()
@@ -0,0 +1,74 @@
+package news
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisReactionsRepository struct {
+	client redis.UniversalClient
+	prefix string
+	types  []entityNews.ReactionType
+}
+
+// NewRedisReactionsRepository реакции сотрудников на новости в Redis.
+//
+//	Для каждой новости и типа реакции хранится множество сотрудников, поэтому повторная реакция ничего не меняет
+func NewRedisReactionsRepository(client redis.UniversalClient, prefix string) *redisReactionsRepository {
+	return &redisReactionsRepository{
+		client: client,
+		prefix: prefix,
+		types:  entityNews.ReactionTypes(),
+	}
+}
+
+func (r *redisReactionsRepository) Add(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error {
+	if err := r.client.SAdd(ctx, r.reactionsKey(newsID, reaction), employeeID.String()).Err(); err != nil {
+		return fmt.Errorf("can't add news reaction to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisReactionsRepository) Remove(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error {
+	if err := r.client.SRem(ctx, r.reactionsKey(newsID, reaction), employeeID.String()).Err(); err != nil {
+		return fmt.Errorf("can't remove news reaction from redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisReactionsRepository) Get(ctx context.Context, newsID, employeeID uuid.UUID) (*entityNews.Reactions, error) {
+	pipe := r.client.Pipeline()
+	counts := make([]*redis.IntCmd, 0, len(r.types))
+	mine := make([]*redis.BoolCmd, 0, len(r.types))
+	for _, reaction := range r.types {
+		key := r.reactionsKey(newsID, reaction)
+		counts = append(counts, pipe.SCard(ctx, key))
+		mine = append(mine, pipe.SIsMember(ctx, key, employeeID.String()))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return nil, fmt.Errorf("can't get news reactions from redis: %w", err)
+	}
+
+	reactions := &entityNews.Reactions{
+		Counts: make(map[entityNews.ReactionType]int, len(r.types)),
+		Mine:   []entityNews.ReactionType{},
+	}
+	for i, reaction := range r.types {
+		if count := counts[i].Val(); count > 0 {
+			reactions.Counts[reaction] = int(count)
+		}
+		if mine[i].Val() {
+			reactions.Mine = append(reactions.Mine, reaction)
+		}
+	}
+	return reactions, nil
+}
+
+func (r *redisReactionsRepository) reactionsKey(newsID uuid.UUID, reaction entityNews.ReactionType) string {
+	return r.prefix + "news-reactions:" + newsID.String() + ":" + string(reaction)
+}
@@ -0,0 +1,73 @@
+package news
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type reactionsRepository interface {
+	Add(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error
+	Remove(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error
+	Get(ctx context.Context, newsID, employeeID uuid.UUID) (*entityNews.Reactions, error)
+}
+
+func Test_reactionsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository reactionsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisReactionsRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			me := uuid.New()
+			other := uuid.New()
+
+			got, err := tt.repository.Get(ctx, newsID, me)
+			assert.NoError(t, err)
+			assert.Equal(t, &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{},
+				Mine:   []entityNews.ReactionType{},
+			}, got)
+
+			// Повторная реакция не увеличивает счетчик
+			assert.NoError(t, tt.repository.Add(ctx, newsID, me, entityNews.ReactionLike))
+			assert.NoError(t, tt.repository.Add(ctx, newsID, me, entityNews.ReactionLike))
+			assert.NoError(t, tt.repository.Add(ctx, newsID, other, entityNews.ReactionLike))
+			assert.NoError(t, tt.repository.Add(ctx, uuid.New(), me, entityNews.ReactionLike))
+
+			got, err = tt.repository.Get(ctx, newsID, me)
+			assert.NoError(t, err)
+			assert.Equal(t, &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 2},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			}, got)
+
+			assert.NoError(t, tt.repository.Remove(ctx, newsID, me, entityNews.ReactionLike))
+			assert.NoError(t, tt.repository.Remove(ctx, newsID, me, entityNews.ReactionLike))
+
+			got, err = tt.repository.Get(ctx, newsID, me)
+			assert.NoError(t, err)
+			assert.Equal(t, &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 1},
+				Mine:   []entityNews.ReactionType{},
+			}, got)
+		})
+	}
+}
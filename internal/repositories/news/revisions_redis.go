@@ -0,0 +1,84 @@
+package news
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type redisRevisionsRepository struct {
+	client redis.UniversalClient
+	prefix string
+	limit  int
+}
+
+// NewRedisRevisionsRepository версии новостей в Redis.
+//
+//	Версии новости хранятся списком, новые в начале. Хранится не больше limit последних версий, 0 - без ограничения
+func NewRedisRevisionsRepository(client redis.UniversalClient, prefix string, limit int) *redisRevisionsRepository {
+	return &redisRevisionsRepository{
+		client: client,
+		prefix: prefix,
+		limit:  limit,
+	}
+}
+
+func (r *redisRevisionsRepository) Add(ctx context.Context, revision *entityNews.Revision) error {
+	data, err := json.Marshal(revision)
+	if err != nil {
+		return fmt.Errorf("can't marshal news revision: %w", err)
+	}
+
+	key := r.revisionsKey(revision.NewsID)
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.LPush(ctx, key, data)
+		if r.limit > 0 {
+			pipe.LTrim(ctx, key, 0, int64(r.limit-1))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("can't add news revision to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisRevisionsRepository) List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.Revision, error) {
+	values, err := r.client.LRange(ctx, r.revisionsKey(newsID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get news revisions from redis: %w", err)
+	}
+
+	revisions := make([]*entityNews.Revision, 0, len(values))
+	for _, value := range values {
+		revision := &entityNews.Revision{}
+		if err = json.Unmarshal([]byte(value), revision); err != nil {
+			return nil, fmt.Errorf("can't unmarshal news revision: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+func (r *redisRevisionsRepository) Get(ctx context.Context, newsID, revisionID uuid.UUID) (*entityNews.Revision, error) {
+	revisions, err := r.List(ctx, newsID)
+	if err != nil {
+		return nil, err
+	}
+	for _, revision := range revisions {
+		if revision.ID == revisionID {
+			return revision, nil
+		}
+	}
+	return nil, fmt.Errorf("news revision <%s>: %w", revisionID, diterrors.ErrNotFound)
+}
+
+func (r *redisRevisionsRepository) revisionsKey(newsID uuid.UUID) string {
+	return r.prefix + "news-revisions:" + newsID.String()
+}
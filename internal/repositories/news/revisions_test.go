@@ -0,0 +1,163 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type revisionsRepository interface {
+	Add(ctx context.Context, revision *entityNews.Revision) error
+	List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.Revision, error)
+	Get(ctx context.Context, newsID, revisionID uuid.UUID) (*entityNews.Revision, error)
+}
+
+type draftsRepository interface {
+	Save(ctx context.Context, draft *entityNews.Draft) error
+	Get(ctx context.Context, newsID, editorID uuid.UUID) (*entityNews.Draft, error)
+	Delete(ctx context.Context, newsID, editorID uuid.UUID) error
+}
+
+func Test_revisionsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository revisionsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisRevisionsRepository(client, "test:", 2),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			authorID := uuid.New()
+			createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+			publicationAt := createdAt.Add(24 * time.Hour)
+
+			revisions := []*entityNews.Revision{
+				{
+					ID:        uuid.New(),
+					NewsID:    newsID,
+					Author:    entityNews.Author{ID: &authorID, LastName: "Иванов", FirstName: "Иван"},
+					CreatedAt: createdAt,
+					Snapshot:  &entityNews.NewsSnapshot{Title: "первая", CategoryID: uuid.New()},
+				},
+				{
+					ID:            uuid.New(),
+					NewsID:        newsID,
+					CreatedAt:     createdAt.Add(time.Hour),
+					ChangedFields: []string{entityNews.RevisionFieldTitle},
+					Snapshot:      &entityNews.NewsSnapshot{Title: "вторая", PublicationAt: &publicationAt},
+				},
+			}
+			restored := &entityNews.Revision{
+				ID:            uuid.New(),
+				NewsID:        newsID,
+				CreatedAt:     createdAt.Add(2 * time.Hour),
+				ChangedFields: []string{entityNews.RevisionFieldTitle},
+				RestoredFrom:  &revisions[0].ID,
+				Snapshot:      &entityNews.NewsSnapshot{Title: "первая", Body: []byte(`{"blocks":[]}`)},
+			}
+
+			got, err := tt.repository.List(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Empty(t, got)
+
+			for _, revision := range revisions {
+				assert.NoError(t, tt.repository.Add(ctx, revision))
+			}
+			assert.NoError(t, tt.repository.Add(ctx, &entityNews.Revision{ID: uuid.New(), NewsID: uuid.New()}))
+
+			got, err = tt.repository.List(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.Revision{revisions[1], revisions[0]}, got)
+
+			// старые версии сверх лимита удаляются
+			assert.NoError(t, tt.repository.Add(ctx, restored))
+			got, err = tt.repository.List(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.Revision{restored, revisions[1]}, got)
+
+			revision, err := tt.repository.Get(ctx, newsID, revisions[1].ID)
+			assert.NoError(t, err)
+			assert.Equal(t, revisions[1], revision)
+
+			_, err = tt.repository.Get(ctx, newsID, revisions[0].ID)
+			assert.ErrorIs(t, err, diterrors.ErrNotFound)
+		})
+	}
+}
+
+func Test_draftsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository draftsRepository
+		advance    func(d time.Duration)
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisDraftsRepository(client, "test:", time.Hour),
+			advance:    mr.FastForward,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			editorID := uuid.New()
+			baseUpdatedAt := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+			draft := &entityNews.Draft{
+				NewsID:        newsID,
+				EditorID:      editorID,
+				Snapshot:      &entityNews.NewsSnapshot{Title: "черновик", Participants: []uuid.UUID{uuid.New()}},
+				BaseUpdatedAt: &baseUpdatedAt,
+				SavedAt:       time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC),
+			}
+
+			got, err := tt.repository.Get(ctx, newsID, editorID)
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+
+			assert.NoError(t, tt.repository.Save(ctx, draft))
+
+			got, err = tt.repository.Get(ctx, newsID, editorID)
+			assert.NoError(t, err)
+			assert.Equal(t, draft, got)
+
+			// черновик другого редактора не виден
+			got, err = tt.repository.Get(ctx, newsID, uuid.New())
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+
+			assert.NoError(t, tt.repository.Delete(ctx, newsID, editorID))
+			got, err = tt.repository.Get(ctx, newsID, editorID)
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+
+			// не сохранявшийся черновик истекает
+			assert.NoError(t, tt.repository.Save(ctx, draft))
+			tt.advance(2 * time.Hour)
+			got, err = tt.repository.Get(ctx, newsID, editorID)
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// acquireLeaseScript захватывает свободную аренду или продлевает аренду того же владельца
+var acquireLeaseScript = redis.NewScript(`
+local current = redis.call('GET', KEYS[1])
+if current == false or current == ARGV[1] then
+	redis.call('SET', KEYS[1], ARGV[1], 'PX', ARGV[2])
+	return 1
+end
+return 0
+`)
+
+// releaseLeaseScript удаляет аренду, только если она принадлежит владельцу
+var releaseLeaseScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+type redisSchedulerLease struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisSchedulerLease аренда планировщика публикаций в Redis, общая для всех реплик.
+//
+//	Аренда - ключ с владельцем и временем жизни, не продленная аренда освобождается сама
+func NewRedisSchedulerLease(client redis.UniversalClient, prefix string) *redisSchedulerLease {
+	return &redisSchedulerLease{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisSchedulerLease) Acquire(ctx context.Context, owner string, ttl time.Duration) (bool, error) {
+	acquired, err := acquireLeaseScript.Run(ctx, r.client, []string{r.leaseKey()}, owner, ttl.Milliseconds()).Int()
+	if err != nil {
+		return false, fmt.Errorf("can't acquire scheduler lease in redis: %w", err)
+	}
+	return acquired == 1, nil
+}
+
+func (r *redisSchedulerLease) Release(ctx context.Context, owner string) error {
+	if err := releaseLeaseScript.Run(ctx, r.client, []string{r.leaseKey()}, owner).Err(); err != nil {
+		return fmt.Errorf("can't release scheduler lease in redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisSchedulerLease) leaseKey() string {
+	return r.prefix + "news-scheduler-lease"
+}
@@ -0,0 +1,68 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+type schedulerLease interface {
+	Acquire(ctx context.Context, owner string, ttl time.Duration) (bool, error)
+	Release(ctx context.Context, owner string) error
+}
+
+func Test_schedulerLease(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name    string
+		lease   schedulerLease
+		advance func(d time.Duration)
+	}{
+		{
+			name:    "redis",
+			lease:   NewRedisSchedulerLease(client, "test:"),
+			advance: mr.FastForward,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+
+			acquired, err := tt.lease.Acquire(ctx, "first", time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, acquired)
+
+			// аренда занята, но владелец может ее продлить
+			acquired, err = tt.lease.Acquire(ctx, "second", time.Minute)
+			assert.NoError(t, err)
+			assert.False(t, acquired)
+			acquired, err = tt.lease.Acquire(ctx, "first", time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, acquired)
+
+			// чужая аренда не освобождается
+			assert.NoError(t, tt.lease.Release(ctx, "second"))
+			acquired, err = tt.lease.Acquire(ctx, "second", time.Minute)
+			assert.NoError(t, err)
+			assert.False(t, acquired)
+
+			// не продленная аренда истекает
+			tt.advance(2 * time.Minute)
+			acquired, err = tt.lease.Acquire(ctx, "second", time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, acquired)
+
+			assert.NoError(t, tt.lease.Release(ctx, "second"))
+			acquired, err = tt.lease.Acquire(ctx, "first", time.Minute)
+			assert.NoError(t, err)
+			assert.True(t, acquired)
+		})
+	}
+}
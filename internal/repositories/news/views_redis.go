@@ -0,0 +1,116 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+const (
+	// viewsDayLayout формат дня в ключах счетчиков просмотров
+	viewsDayLayout = "2006-01-02"
+	// viewsTotalField поле общего количества просмотров
+	viewsTotalField = "total"
+)
+
+// recordViewScript учитывает просмотр, если сотрудник не смотрел новость в течение окна дедупликации.
+//
+//	Отметка просмотра и счетчики меняются атомарно: упавший запрос не оставит отметку без учтенного просмотра
+var recordViewScript = redis.NewScript(`
+local seen
+if tonumber(ARGV[1]) > 0 then
+	seen = redis.call('SET', KEYS[1], 1, 'NX', 'PX', ARGV[1])
+else
+	seen = redis.call('SET', KEYS[1], 1, 'NX')
+end
+if not seen then
+	return 0
+end
+redis.call('HINCRBY', KEYS[2], ARGV[2], 1)
+redis.call('HINCRBY', KEYS[2], '` + viewsTotalField + `', 1)
+return 1
+`)
+
+type redisViewsRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisViewsRepository счетчики просмотров новостей в Redis.
+//
+//	Для каждой новости хранится hash с количеством просмотров по дням и общим количеством.
+//	Повторные просмотры отсекаются ключом с временем жизни, равным окну дедупликации
+func NewRedisViewsRepository(client redis.UniversalClient, prefix string) *redisViewsRepository {
+	return &redisViewsRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisViewsRepository) Record(ctx context.Context, views []*entityNews.View, window time.Duration) (int, error) {
+	pipe := r.client.Pipeline()
+	recorded := make([]*redis.Cmd, 0, len(views))
+	for _, view := range views {
+		keys := []string{r.seenKey(view.NewsID, view.EmployeeID), r.viewsKey(view.NewsID)}
+		recorded = append(recorded, recordViewScript.Eval(ctx, pipe, keys, window.Milliseconds(), view.ViewedAt.UTC().Format(viewsDayLayout)))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("can't record news views in redis: %w", err)
+	}
+
+	counted := 0
+	for _, cmd := range recorded {
+		if cmd.Val() == int64(1) {
+			counted++
+		}
+	}
+	return counted, nil
+}
+
+func (r *redisViewsRepository) Count(ctx context.Context, newsID uuid.UUID) (int, error) {
+	total, err := r.client.HGet(ctx, r.viewsKey(newsID), viewsTotalField).Int()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("can't get news views from redis: %w", err)
+	}
+	return total, nil
+}
+
+func (r *redisViewsRepository) Stats(ctx context.Context, newsID uuid.UUID, from, to time.Time) ([]*entityNews.DailyViews, error) {
+	values, err := r.client.HGetAll(ctx, r.viewsKey(newsID)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get news views stats from redis: %w", err)
+	}
+
+	days := make([]*entityNews.DailyViews, 0, len(values))
+	for field, value := range values {
+		date, parseErr := time.Parse(viewsDayLayout, field)
+		if parseErr != nil || date.Before(from) || date.After(to) {
+			continue
+		}
+		views, convErr := strconv.Atoi(value)
+		if convErr != nil {
+			return nil, fmt.Errorf("can't parse news views <%s>: %w", value, convErr)
+		}
+		days = append(days, &entityNews.DailyViews{Date: date, Views: views})
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Date.Before(days[j].Date) })
+	return days, nil
+}
+
+// viewsKey ключ счетчиков просмотров. id новости в фигурных скобках, чтобы в Redis Cluster счетчики
+// и отметки просмотров одной новости попадали в один слот и менялись одним скриптом
+func (r *redisViewsRepository) viewsKey(newsID uuid.UUID) string {
+	return r.prefix + "news-views:{" + newsID.String() + "}"
+}
+
+func (r *redisViewsRepository) seenKey(newsID, employeeID uuid.UUID) string {
+	return r.prefix + "news-views-seen:{" + newsID.String() + "}:" + employeeID.String()
+}
@@ -0,0 +1,75 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+type viewsRepository interface {
+	Record(ctx context.Context, views []*entityNews.View, window time.Duration) (int, error)
+	Count(ctx context.Context, newsID uuid.UUID) (int, error)
+	Stats(ctx context.Context, newsID uuid.UUID, from, to time.Time) ([]*entityNews.DailyViews, error)
+}
+
+func Test_viewsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository viewsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisViewsRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			newsID := uuid.New()
+			me := uuid.New()
+			other := uuid.New()
+			today := time.Now().UTC().Truncate(24 * time.Hour)
+			yesterday := today.Add(-24 * time.Hour)
+
+			count, err := tt.repository.Count(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Zero(t, count)
+
+			// Повторный просмотр в окне дедупликации не учитывается
+			counted, err := tt.repository.Record(ctx, []*entityNews.View{
+				{NewsID: newsID, EmployeeID: me, ViewedAt: yesterday.Add(time.Hour)},
+				{NewsID: newsID, EmployeeID: me, ViewedAt: yesterday.Add(2 * time.Hour)},
+				{NewsID: newsID, EmployeeID: other, ViewedAt: today.Add(time.Hour)},
+				{NewsID: uuid.New(), EmployeeID: me, ViewedAt: today.Add(time.Hour)},
+			}, time.Hour)
+			assert.NoError(t, err)
+			assert.Equal(t, 3, counted)
+
+			count, err = tt.repository.Count(ctx, newsID)
+			assert.NoError(t, err)
+			assert.Equal(t, 2, count)
+
+			stats, err := tt.repository.Stats(ctx, newsID, yesterday, today)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.DailyViews{
+				{Date: yesterday, Views: 1},
+				{Date: today, Views: 1},
+			}, stats)
+
+			stats, err = tt.repository.Stats(ctx, newsID, today, today)
+			assert.NoError(t, err)
+			assert.Equal(t, []*entityNews.DailyViews{{Date: today, Views: 1}}, stats)
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type redisActivityRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisActivityRepository хранилище активности сессий в Redis.
+//
+//	Сессии пользователя индексируются по идентификатору СУДИР. Отзыв сессии хранится в отдельном ключе,
+//	который сохранение активности не перезаписывает, поэтому одновременная запись активности не отменяет отзыв
+func NewRedisActivityRepository(client redis.UniversalClient, prefix string) *redisActivityRepository {
+	return &redisActivityRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisActivityRepository) GetActivity(ctx context.Context, sessionID string) (*entityAuth.SessionActivity, error) {
+	var (
+		data    *redis.StringCmd
+		revoked *redis.IntCmd
+	)
+	_, err := r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		data = pipe.Get(ctx, r.activityKey(sessionID))
+		revoked = pipe.Exists(ctx, r.revokedKey(sessionID))
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, fmt.Errorf("can't get session activity from redis: %w", err)
+	}
+
+	if errors.Is(data.Err(), redis.Nil) {
+		if revoked.Val() == 0 {
+			return nil, nil
+		}
+		// Отзыв переживает запись активности, например после ее вытеснения из Redis
+		return &entityAuth.SessionActivity{SessionID: sessionID, Revoked: true}, nil
+	}
+	activity, err := unmarshalActivity([]byte(data.Val()))
+	if err != nil {
+		return nil, err
+	}
+	activity.Revoked = revoked.Val() > 0
+	return activity, nil
+}
+
+func (r *redisActivityRepository) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	if err := r.client.SetNX(ctx, r.revokedKey(sessionID), 1, ttl).Err(); err != nil {
+		return fmt.Errorf("can't revoke session in redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisActivityRepository) SaveActivity(ctx context.Context, activity *entityAuth.SessionActivity, ttl time.Duration) error {
+	data, err := json.Marshal(activity)
+	if err != nil {
+		return fmt.Errorf("can't marshal session activity: %w", err)
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, r.activityKey(activity.SessionID), data, ttl)
+		if activity.CloudID != "" {
+			indexKey := r.userKey(activity.CloudID)
+			pipe.SAdd(ctx, indexKey, activity.SessionID)
+			// Индекс живет не меньше самой долгой сессии пользователя
+			pipe.ExpireGT(ctx, indexKey, ttl)
+			pipe.ExpireNX(ctx, indexKey, ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("can't save session activity to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisActivityRepository) ListActivities(ctx context.Context, cloudID string) ([]*entityAuth.SessionActivity, error) {
+	indexKey := r.userKey(cloudID)
+	sessionIDs, err := r.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get user sessions from redis: %w", err)
+	}
+	if len(sessionIDs) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	revokedKeys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, r.activityKey(sessionID))
+		revokedKeys = append(revokedKeys, r.revokedKey(sessionID))
+	}
+	var values, revoked *redis.SliceCmd
+	_, err = r.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		values = pipe.MGet(ctx, keys...)
+		revoked = pipe.MGet(ctx, revokedKeys...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("can't get session activities from redis: %w", err)
+	}
+
+	activities := make([]*entityAuth.SessionActivity, 0, len(keys))
+	expired := make([]any, 0)
+	for i, value := range values.Val() {
+		data, ok := value.(string)
+		if !ok {
+			// Запись активности истекла, сессию из индекса убираем
+			expired = append(expired, sessionIDs[i])
+			continue
+		}
+		activity, err := unmarshalActivity([]byte(data))
+		if err != nil {
+			return nil, err
+		}
+		activity.Revoked = revoked.Val()[i] != nil
+		activities = append(activities, activity)
+	}
+	if len(expired) > 0 {
+		if err = r.client.SRem(ctx, indexKey, expired...).Err(); err != nil {
+			return nil, fmt.Errorf("can't clean user sessions in redis: %w", err)
+		}
+	}
+	return activities, nil
+}
+
+func (r *redisActivityRepository) activityKey(sessionID string) string {
+	return r.prefix + "session-activity:" + sessionID
+}
+
+func (r *redisActivityRepository) revokedKey(sessionID string) string {
+	return r.prefix + "session-revoked:" + sessionID
+}
+
+func (r *redisActivityRepository) userKey(cloudID string) string {
+	return r.prefix + "user-sessions:" + cloudID
+}
+
+func unmarshalActivity(data []byte) (*entityAuth.SessionActivity, error) {
+	activity := &entityAuth.SessionActivity{}
+	if err := json.Unmarshal(data, activity); err != nil {
+		return nil, fmt.Errorf("can't unmarshal session activity: %w", err)
+	}
+	return activity, nil
+}
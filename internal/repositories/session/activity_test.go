@@ -0,0 +1,82 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func testActivity(cloudID string, lastActive time.Time) *entityAuth.SessionActivity {
+	session := testSession(uuid.New(), lastActive.Add(time.Hour))
+	session.User.CloudID = cloudID
+	return entityAuth.NewSessionActivity(session, lastActive)
+}
+
+func Test_redisActivityRepository(t *testing.T) {
+	ctx := context.TODO()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	now := time.Now().Truncate(time.Second).UTC()
+	activity1 := testActivity("cloudID1", now)
+	activity2 := testActivity("cloudID1", now)
+	activity3 := testActivity("cloudID2", now)
+
+	r := NewRedisActivityRepository(client, "test:")
+
+	got, err := r.GetActivity(ctx, activity1.SessionID)
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.NoError(t, r.SaveActivity(ctx, activity1, time.Minute))
+	assert.NoError(t, r.SaveActivity(ctx, activity2, time.Hour))
+	assert.NoError(t, r.SaveActivity(ctx, activity3, time.Hour))
+	assert.Equal(t, time.Minute, mr.TTL("test:session-activity:"+activity1.SessionID))
+	// Индекс живет не меньше самой долгой сессии пользователя
+	assert.Equal(t, time.Hour, mr.TTL("test:user-sessions:cloudID1"))
+
+	got, err = r.GetActivity(ctx, activity1.SessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, activity1.SessionID, got.SessionID)
+	assert.Equal(t, activity1.UserAgent, got.UserAgent)
+	assert.True(t, activity1.UserIP.Equal(got.UserIP))
+	assert.True(t, activity1.LastActiveTime.Equal(got.LastActiveTime))
+
+	list, err := r.ListActivities(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	// Истекшая запись убирается из индекса
+	mr.FastForward(time.Minute)
+	list, err = r.ListActivities(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+	assert.Equal(t, activity2.SessionID, list[0].SessionID)
+	members, err := mr.Members("test:user-sessions:cloudID1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{activity2.SessionID}, members)
+
+	// Отзыв хранится отдельно и не перезаписывается сохранением активности
+	assert.NoError(t, r.RevokeSession(ctx, activity2.SessionID, time.Hour))
+	assert.NoError(t, r.SaveActivity(ctx, activity2, time.Hour))
+	got, err = r.GetActivity(ctx, activity2.SessionID)
+	assert.NoError(t, err)
+	assert.True(t, got.Revoked)
+	list, err = r.ListActivities(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.True(t, list[0].Revoked)
+
+	// Отзыв переживает запись активности
+	mr.Del("test:session-activity:" + activity2.SessionID)
+	got, err = r.GetActivity(ctx, activity2.SessionID)
+	assert.NoError(t, err)
+	assert.Equal(t, &entityAuth.SessionActivity{SessionID: activity2.SessionID, Revoked: true}, got)
+}
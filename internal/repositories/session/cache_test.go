@@ -0,0 +1,153 @@
+package session
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func testSession(id uuid.UUID, expiredAt time.Time) *entityAuth.Session {
+	sessionID := entityAuth.SessionID(id)
+	return &entityAuth.Session{
+		ID: &sessionID,
+		User: &entityAuth.User{
+			ID:    uuid.New(),
+			Login: "testLogin",
+			Email: "test@example.com",
+		},
+		UserAuthType: entityAuth.UserAuthTypeAuth,
+		UserIP:       net.ParseIP("127.0.0.1"),
+		Device:       &entityAuth.Device{UserAgent: "testUserAgent"},
+		ActivePortal: &entityAuth.ActivePortal{
+			Portal: entityAuth.Portal{ID: 1, Name: "testPortal", URL: "testURL"},
+			SID:    "testSID",
+		},
+		AccessExpiredTime: expiredAt,
+		IsActive:          true,
+	}
+}
+
+func Test_lruCache(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	session1 := testSession(uuid.New(), now.Add(time.Hour))
+	session2 := testSession(uuid.New(), now.Add(time.Hour))
+
+	c := NewLRUCache(2)
+	c.now = func() time.Time { return now }
+
+	assert.NoError(t, c.Set(ctx, "hash1", session1, time.Minute))
+	assert.NoError(t, c.Set(ctx, "hash2", session1, time.Minute))
+	assert.NoError(t, c.Set(ctx, "hash3", session2, time.Minute))
+	assert.Equal(t, 2, c.Len())
+
+	// Вытеснена наиболее давно использованная запись
+	got, err := c.Get(ctx, "hash1")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	got, err = c.Get(ctx, "hash2")
+	assert.NoError(t, err)
+	assert.Equal(t, session1, got)
+
+	// Удаление всех записей сессии
+	assert.NoError(t, c.DeleteBySessionID(ctx, session1.GetID().String()))
+	got, err = c.Get(ctx, "hash2")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Equal(t, 1, c.Len())
+
+	// Истечение срока хранения
+	c.now = func() time.Time { return now.Add(time.Minute) }
+	got, err = c.Get(ctx, "hash3")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+	assert.Equal(t, 0, c.Len())
+}
+
+func Test_redisCache(t *testing.T) {
+	ctx := context.TODO()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	expiredAt := time.Now().Add(time.Hour).Truncate(time.Second)
+	session := testSession(uuid.New(), expiredAt)
+	c := NewRedisCache(client, "test:", time.Minute)
+
+	got, err := c.Get(ctx, "hash1")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+
+	assert.NoError(t, c.Set(ctx, "hash1", session, 30*time.Second))
+	assert.NoError(t, c.Set(ctx, "hash2", session, 30*time.Second))
+	assert.True(t, mr.Exists("test:session:hash1"))
+	assert.Equal(t, 30*time.Second, mr.TTL("test:session:hash1"))
+	assert.Equal(t, time.Minute, mr.TTL("test:session-tokens:"+session.GetID().String()))
+
+	got, err = c.Get(ctx, "hash1")
+	assert.NoError(t, err)
+	assert.Equal(t, session.GetID(), got.GetID())
+	assert.Equal(t, session.GetUser(), got.GetUser())
+	assert.Equal(t, session.GetActivePortal(), got.GetActivePortal())
+	assert.True(t, session.UserIP.Equal(got.UserIP))
+	assert.True(t, session.AccessExpiredTime.Equal(got.AccessExpiredTime))
+
+	assert.NoError(t, c.Delete(ctx, "hash1"))
+	assert.False(t, mr.Exists("test:session:hash1"))
+
+	assert.NoError(t, c.DeleteBySessionID(ctx, session.GetID().String()))
+	assert.False(t, mr.Exists("test:session:hash2"))
+	assert.False(t, mr.Exists("test:session-tokens:"+session.GetID().String()))
+
+	assert.NoError(t, c.Set(ctx, "hash3", session, 30*time.Second))
+	mr.FastForward(31 * time.Second)
+	got, err = c.Get(ctx, "hash3")
+	assert.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func Test_fallbackCache(t *testing.T) {
+	ctx := context.TODO()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr(), MaxRetries: -1})
+	t.Cleanup(func() { _ = client.Close() })
+
+	session := testSession(uuid.New(), time.Now().Add(time.Hour))
+	lru := NewLRUCache(10)
+	logger := ditzap.NewMockLogger(gomock.NewController(t))
+	c := NewFallbackCache(NewRedisCache(client, "test:", time.Minute), lru, logger)
+
+	// Основной кэш доступен
+	assert.NoError(t, c.Set(ctx, "hash1", session, time.Minute))
+	assert.True(t, mr.Exists("test:session:hash1"))
+	assert.Equal(t, 0, lru.Len())
+
+	// Основной кэш недоступен
+	mr.SetError("unavailable")
+	logger.EXPECT().Warn("primary session cache is unavailable", gomock.Any()).Times(2)
+	assert.NoError(t, c.Set(ctx, "hash2", session, time.Minute))
+	assert.Equal(t, 1, lru.Len())
+	got, err := c.Get(ctx, "hash2")
+	assert.NoError(t, err)
+	assert.Equal(t, session, got)
+
+	// Удаление выполняется в обоих кэшах
+	err = c.DeleteBySessionID(ctx, session.GetID().String())
+	assert.Error(t, err)
+	assert.Equal(t, 0, lru.Len())
+
+	mr.SetError("")
+	assert.NoError(t, c.DeleteBySessionID(ctx, session.GetID().String()))
+	assert.False(t, mr.Exists("test:session:hash1"))
+}
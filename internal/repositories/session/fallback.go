@@ -0,0 +1,62 @@
+package session
+
+import (
+	"context"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type fallbackCache struct {
+	primary  Cache
+	fallback Cache
+	logger   ditzap.Logger
+}
+
+// NewFallbackCache кэш сессий, использующий fallback при недоступности основного кэша.
+//
+//	Записи в fallback попадают только при ошибках основного кэша, удаление выполняется в обоих
+func NewFallbackCache(primary, fallback Cache, logger ditzap.Logger) *fallbackCache {
+	return &fallbackCache{
+		primary:  primary,
+		fallback: fallback,
+		logger:   logger,
+	}
+}
+
+func (c *fallbackCache) Get(ctx context.Context, tokenHash string) (*entityAuth.Session, error) {
+	session, err := c.primary.Get(ctx, tokenHash)
+	if err == nil {
+		return session, nil
+	}
+	c.logger.Warn("primary session cache is unavailable", zap.Error(err))
+	return c.fallback.Get(ctx, tokenHash)
+}
+
+func (c *fallbackCache) Set(ctx context.Context, tokenHash string, session *entityAuth.Session, ttl time.Duration) error {
+	err := c.primary.Set(ctx, tokenHash, session, ttl)
+	if err == nil {
+		return nil
+	}
+	c.logger.Warn("primary session cache is unavailable", zap.Error(err))
+	return c.fallback.Set(ctx, tokenHash, session, ttl)
+}
+
+func (c *fallbackCache) Delete(ctx context.Context, tokenHash string) error {
+	fallbackErr := c.fallback.Delete(ctx, tokenHash)
+	if err := c.primary.Delete(ctx, tokenHash); err != nil {
+		return err
+	}
+	return fallbackErr
+}
+
+func (c *fallbackCache) DeleteBySessionID(ctx context.Context, sessionID string) error {
+	fallbackErr := c.fallback.DeleteBySessionID(ctx, sessionID)
+	if err := c.primary.DeleteBySessionID(ctx, sessionID); err != nil {
+		return err
+	}
+	return fallbackErr
+}
@@ -0,0 +1,35 @@
+package session
+
+import (
+	"context"
+	"net"
+	"time"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+//go:generate mockgen -source=interfaces.go -destination=./session_mock.go -package=session
+
+// AuthRepository репозиторий авторизации, перед которым работает кэш сессий
+type AuthRepository interface {
+	GetRedirectURL(ctx context.Context, callbackURI string) (string, error)
+	Auth(ctx context.Context, code, state, callbackURI string) (*entityAuth.AuthSudir, error)
+	AuthPortal(ctx context.Context, params entityAuth.AuthPortalParams) (*entityAuth.Auth1C, error)
+	CreateSession(ctx context.Context, user *entityAuth.UserSudir, portal *entityAuth.Portal, clientIP net.IP, device *entityAuth.Device, auth *entityAuth.Auth1C) (entityAuth.TokensPair, error)
+	GetSession(ctx context.Context, accessToken string) (*entityAuth.Session, error)
+	Logout(ctx context.Context, session *entityAuth.Session, accessToken, refreshToken string) error
+	ChangePortal(ctx context.Context, portalID int, session *entityAuth.Session) ([]*entityAuth.Portal, string, error)
+	RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*entityAuth.TokensPair, error)
+}
+
+// Cache кэш сессий по хэшу токена доступа
+type Cache interface {
+	// Get возвращает сессию из кэша. Если сессия отсутствует, возвращает nil
+	Get(ctx context.Context, tokenHash string) (*entityAuth.Session, error)
+	// Set сохраняет сессию в кэш на время ttl
+	Set(ctx context.Context, tokenHash string, session *entityAuth.Session, ttl time.Duration) error
+	// Delete удаляет сессию из кэша по хэшу токена доступа
+	Delete(ctx context.Context, tokenHash string) error
+	// DeleteBySessionID удаляет из кэша все записи сессии
+	DeleteBySessionID(ctx context.Context, sessionID string) error
+}
@@ -0,0 +1,49 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type redisLastPortalRepository struct {
+	client redis.UniversalClient
+	prefix string
+	ttl    time.Duration
+}
+
+// NewRedisLastPortalRepository хранилище последнего выбранного пользователем портала в Redis.
+//
+//	Выбор хранится ttl с момента последнего сохранения
+func NewRedisLastPortalRepository(client redis.UniversalClient, prefix string, ttl time.Duration) *redisLastPortalRepository {
+	return &redisLastPortalRepository{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (r *redisLastPortalRepository) GetLastPortal(ctx context.Context, cloudID string) (int, error) {
+	portalID, err := r.client.Get(ctx, r.key(cloudID)).Int()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("can't get last portal from redis: %w", err)
+	}
+	return portalID, nil
+}
+
+func (r *redisLastPortalRepository) SaveLastPortal(ctx context.Context, cloudID string, portalID int) error {
+	if err := r.client.Set(ctx, r.key(cloudID), portalID, r.ttl).Err(); err != nil {
+		return fmt.Errorf("can't save last portal to redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisLastPortalRepository) key(cloudID string) string {
+	return r.prefix + "last-portal:" + cloudID
+}
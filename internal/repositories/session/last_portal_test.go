@@ -0,0 +1,41 @@
+package session
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_redisLastPortalRepository(t *testing.T) {
+	ctx := context.TODO()
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	r := NewRedisLastPortalRepository(client, "test:", time.Hour)
+
+	got, err := r.GetLastPortal(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	assert.NoError(t, r.SaveLastPortal(ctx, "cloudID1", 1))
+	assert.NoError(t, r.SaveLastPortal(ctx, "cloudID1", 2))
+	assert.Equal(t, time.Hour, mr.TTL("test:last-portal:cloudID1"))
+
+	got, err = r.GetLastPortal(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, got)
+
+	mr.FastForward(time.Hour)
+	got, err = r.GetLastPortal(ctx, "cloudID1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, got)
+
+	mr.Close()
+	_, err = r.GetLastPortal(ctx, "cloudID1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,128 @@
+package session
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type lruEntry struct {
+	tokenHash string
+	sessionID string
+	session   *entityAuth.Session
+	expiresAt time.Time
+}
+
+type lruCache struct {
+	capacity int
+	now      func() time.Time
+
+	mu        sync.Mutex
+	order     *list.List
+	items     map[string]*list.Element
+	bySession map[string]map[string]struct{}
+}
+
+// NewLRUCache кэш сессий в памяти процесса с вытеснением давно неиспользуемых записей
+func NewLRUCache(capacity int) *lruCache {
+	return &lruCache{
+		capacity:  capacity,
+		now:       time.Now,
+		order:     list.New(),
+		items:     make(map[string]*list.Element),
+		bySession: make(map[string]map[string]struct{}),
+	}
+}
+
+func (c *lruCache) Get(_ context.Context, tokenHash string) (*entityAuth.Session, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.items[tokenHash]
+	if !ok {
+		return nil, nil
+	}
+	entry := element.Value.(*lruEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, nil
+	}
+	c.order.MoveToFront(element)
+	return entry.session, nil
+}
+
+func (c *lruCache) Set(_ context.Context, tokenHash string, session *entityAuth.Session, ttl time.Duration) error {
+	if c.capacity <= 0 {
+		return nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[tokenHash]; ok {
+		c.remove(element)
+	}
+
+	entry := &lruEntry{
+		tokenHash: tokenHash,
+		sessionID: session.GetID().String(),
+		session:   session,
+		expiresAt: c.now().Add(ttl),
+	}
+	c.items[tokenHash] = c.order.PushFront(entry)
+	if entry.sessionID != "" {
+		if c.bySession[entry.sessionID] == nil {
+			c.bySession[entry.sessionID] = make(map[string]struct{})
+		}
+		c.bySession[entry.sessionID][tokenHash] = struct{}{}
+	}
+
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+func (c *lruCache) Delete(_ context.Context, tokenHash string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.items[tokenHash]; ok {
+		c.remove(element)
+	}
+	return nil
+}
+
+func (c *lruCache) DeleteBySessionID(_ context.Context, sessionID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for tokenHash := range c.bySession[sessionID] {
+		if element, ok := c.items[tokenHash]; ok {
+			c.remove(element)
+		}
+	}
+	return nil
+}
+
+// Len количество записей в кэше
+func (c *lruCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *lruCache) remove(element *list.Element) {
+	entry := element.Value.(*lruEntry)
+	c.order.Remove(element)
+	delete(c.items, entry.tokenHash)
+	if hashes, ok := c.bySession[entry.sessionID]; ok {
+		delete(hashes, entry.tokenHash)
+		if len(hashes) == 0 {
+			delete(c.bySession, entry.sessionID)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package session
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	cacheResultHit   = "hit"
+	cacheResultMiss  = "miss"
+	cacheResultError = "error"
+)
+
+var (
+	cacheRequestsMetric *prometheus.CounterVec
+)
+
+// InitMetrics регистрирует метрики кэша сессий
+func InitMetrics() {
+	cacheRequestsMetric = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "session_cache_requests_total",
+		Help: "Number of session cache lookups by result (hit, miss, error)",
+	}, []string{"result"})
+}
+
+func observeCacheRequest(result string) {
+	if cacheRequestsMetric == nil {
+		return
+	}
+	cacheRequestsMetric.WithLabelValues(result).Inc()
+}
@@ -0,0 +1,101 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type redisCache struct {
+	client redis.UniversalClient
+	prefix string
+	// indexTTL время жизни индекса записей сессии, не меньше времени жизни любой записи
+	indexTTL time.Duration
+}
+
+// NewRedisCache кэш сессий в Redis.
+//
+//	prefix добавляется ко всем ключам, maxTTL — максимальное время хранения записи
+func NewRedisCache(client redis.UniversalClient, prefix string, maxTTL time.Duration) *redisCache {
+	return &redisCache{
+		client:   client,
+		prefix:   prefix,
+		indexTTL: maxTTL,
+	}
+}
+
+func (c *redisCache) Get(ctx context.Context, tokenHash string) (*entityAuth.Session, error) {
+	data, err := c.client.Get(ctx, c.sessionKey(tokenHash)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't get session from redis: %w", err)
+	}
+
+	session := &entityAuth.Session{}
+	if err = json.Unmarshal(data, session); err != nil {
+		return nil, fmt.Errorf("can't unmarshal session: %w", err)
+	}
+	return session, nil
+}
+
+func (c *redisCache) Set(ctx context.Context, tokenHash string, session *entityAuth.Session, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("can't marshal session: %w", err)
+	}
+
+	_, err = c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, c.sessionKey(tokenHash), data, ttl)
+		if sessionID := session.GetID().String(); sessionID != "" {
+			indexKey := c.indexKey(sessionID)
+			pipe.SAdd(ctx, indexKey, tokenHash)
+			pipe.Expire(ctx, indexKey, max(ttl, c.indexTTL))
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("can't set session to redis: %w", err)
+	}
+	return nil
+}
+
+func (c *redisCache) Delete(ctx context.Context, tokenHash string) error {
+	if err := c.client.Del(ctx, c.sessionKey(tokenHash)).Err(); err != nil {
+		return fmt.Errorf("can't delete session from redis: %w", err)
+	}
+	return nil
+}
+
+func (c *redisCache) DeleteBySessionID(ctx context.Context, sessionID string) error {
+	indexKey := c.indexKey(sessionID)
+	hashes, err := c.client.SMembers(ctx, indexKey).Result()
+	if err != nil {
+		return fmt.Errorf("can't get session index from redis: %w", err)
+	}
+
+	keys := make([]string, 0, len(hashes)+1)
+	for _, tokenHash := range hashes {
+		keys = append(keys, c.sessionKey(tokenHash))
+	}
+	keys = append(keys, indexKey)
+	if err = c.client.Del(ctx, keys...).Err(); err != nil {
+		return fmt.Errorf("can't delete session from redis: %w", err)
+	}
+	return nil
+}
+
+func (c *redisCache) sessionKey(tokenHash string) string {
+	return c.prefix + "session:" + tokenHash
+}
+
+func (c *redisCache) indexKey(sessionID string) string {
+	return c.prefix + "session-tokens:" + sessionID
+}
@@ -0,0 +1,108 @@
+package session
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type sessionRepository struct {
+	AuthRepository
+
+	cache  Cache
+	maxTTL time.Duration
+	now    func() time.Time
+	logger ditzap.Logger
+}
+
+// NewSessionRepository репозиторий авторизации с кэшированием сессий.
+//
+//	Сессия хранится в кэше не дольше maxTTL и не дольше срока действия токена доступа
+func NewSessionRepository(repository AuthRepository, cache Cache, maxTTL time.Duration, logger ditzap.Logger) *sessionRepository {
+	return &sessionRepository{
+		AuthRepository: repository,
+		cache:          cache,
+		maxTTL:         maxTTL,
+		now:            time.Now,
+		logger:         logger,
+	}
+}
+
+// TokenHash хэш токена доступа, используемый в качестве ключа кэша
+func TokenHash(accessToken string) string {
+	sum := sha256.Sum256([]byte(accessToken))
+	return hex.EncodeToString(sum[:])
+}
+
+func (r *sessionRepository) GetSession(ctx context.Context, accessToken string) (*entityAuth.Session, error) {
+	tokenHash := TokenHash(accessToken)
+	if session := r.cached(ctx, tokenHash); session != nil {
+		return session, nil
+	}
+
+	session, err := r.AuthRepository.GetSession(ctx, accessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	ttl := min(r.maxTTL, session.AccessExpiredTime.Sub(r.now()))
+	if ttl > 0 {
+		if err = r.cache.Set(ctx, tokenHash, session, ttl); err != nil {
+			r.logger.Warn("can't set session to cache", zap.Error(err))
+		}
+	}
+	return session, nil
+}
+
+func (r *sessionRepository) Logout(ctx context.Context, session *entityAuth.Session, accessToken, refreshToken string) error {
+	r.invalidate(ctx, session, accessToken)
+	return r.AuthRepository.Logout(ctx, session, accessToken, refreshToken)
+}
+
+func (r *sessionRepository) ChangePortal(ctx context.Context, portalID int, session *entityAuth.Session) ([]*entityAuth.Portal, string, error) {
+	portals, portalSession, err := r.AuthRepository.ChangePortal(ctx, portalID, session)
+	r.invalidate(ctx, session, "")
+	return portals, portalSession, err
+}
+
+func (r *sessionRepository) RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*entityAuth.TokensPair, error) {
+	tokensPair, err := r.AuthRepository.RefreshTokensPair(ctx, accessToken, refreshToken)
+	r.invalidate(ctx, nil, accessToken)
+	return tokensPair, err
+}
+
+// cached возвращает действующую сессию из кэша или nil
+func (r *sessionRepository) cached(ctx context.Context, tokenHash string) *entityAuth.Session {
+	session, err := r.cache.Get(ctx, tokenHash)
+	switch {
+	case err != nil:
+		observeCacheRequest(cacheResultError)
+		r.logger.Warn("can't get session from cache", zap.Error(err))
+	case session != nil && r.now().Before(session.AccessExpiredTime):
+		observeCacheRequest(cacheResultHit)
+		return session
+	default:
+		observeCacheRequest(cacheResultMiss)
+	}
+	return nil
+}
+
+// invalidate удаляет из кэша записи сессии и токена доступа
+func (r *sessionRepository) invalidate(ctx context.Context, session *entityAuth.Session, accessToken string) {
+	if sessionID := session.GetID().String(); sessionID != "" {
+		if err := r.cache.DeleteBySessionID(ctx, sessionID); err != nil {
+			r.logger.Warn("can't delete session from cache", zap.String("session_id", sessionID), zap.Error(err))
+		}
+	}
+	if accessToken != "" {
+		if err := r.cache.Delete(ctx, TokenHash(accessToken)); err != nil {
+			r.logger.Warn("can't delete session from cache", zap.Error(err))
+		}
+	}
+}
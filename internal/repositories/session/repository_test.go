@@ -0,0 +1,143 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func Test_sessionRepository_GetSession(t *testing.T) {
+	type fields struct {
+		repo   *MockAuthRepository
+		cache  *MockCache
+		logger *ditzap.MockLogger
+	}
+
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	accessToken := "testAccessToken"
+	tokenHash := TokenHash(accessToken)
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name    string
+		want    func(f fields) (*entityAuth.Session, error)
+		wantErr error
+	}{
+		{
+			name: "cache hit",
+			want: func(f fields) (*entityAuth.Session, error) {
+				session := testSession(uuid.New(), now.Add(time.Hour))
+				f.cache.EXPECT().Get(ctx, tokenHash).Return(session, nil)
+				return session, nil
+			},
+		},
+		{
+			name: "cache miss",
+			want: func(f fields) (*entityAuth.Session, error) {
+				session := testSession(uuid.New(), now.Add(time.Hour))
+				f.cache.EXPECT().Get(ctx, tokenHash).Return(nil, nil)
+				f.repo.EXPECT().GetSession(ctx, accessToken).Return(session, nil)
+				f.cache.EXPECT().Set(ctx, tokenHash, session, time.Minute).Return(nil)
+				return session, nil
+			},
+		},
+		{
+			name: "ttl limited by access token expiration",
+			want: func(f fields) (*entityAuth.Session, error) {
+				session := testSession(uuid.New(), now.Add(10*time.Second))
+				f.cache.EXPECT().Get(ctx, tokenHash).Return(nil, nil)
+				f.repo.EXPECT().GetSession(ctx, accessToken).Return(session, nil)
+				f.cache.EXPECT().Set(ctx, tokenHash, session, 10*time.Second).Return(nil)
+				return session, nil
+			},
+		},
+		{
+			name: "cached session expired",
+			want: func(f fields) (*entityAuth.Session, error) {
+				f.cache.EXPECT().Get(ctx, tokenHash).Return(testSession(uuid.New(), now), nil)
+				f.repo.EXPECT().GetSession(ctx, accessToken).Return(nil, testErr)
+				return nil, testErr
+			},
+		},
+		{
+			name: "cache err",
+			want: func(f fields) (*entityAuth.Session, error) {
+				session := testSession(uuid.New(), now.Add(time.Hour))
+				f.cache.EXPECT().Get(ctx, tokenHash).Return(nil, testErr)
+				f.logger.EXPECT().Warn("can't get session from cache", gomock.Any())
+				f.repo.EXPECT().GetSession(ctx, accessToken).Return(session, nil)
+				f.cache.EXPECT().Set(ctx, tokenHash, session, time.Minute).Return(testErr)
+				f.logger.EXPECT().Warn("can't set session to cache", gomock.Any())
+				return session, nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:   NewMockAuthRepository(ctrl),
+				cache:  NewMockCache(ctrl),
+				logger: ditzap.NewMockLogger(ctrl),
+			}
+			want, wantErr := tt.want(f)
+
+			r := NewSessionRepository(f.repo, f.cache, time.Minute, f.logger)
+			r.now = func() time.Time { return now }
+
+			got, err := r.GetSession(ctx, accessToken)
+			if wantErr != nil {
+				assert.ErrorIs(t, err, wantErr)
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
+func Test_sessionRepository_invalidation(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	repo := NewMockAuthRepository(ctrl)
+	logger := ditzap.NewMockLogger(ctrl)
+	cache := NewLRUCache(10)
+
+	accessToken := "testAccessToken"
+	session := testSession(uuid.New(), time.Now().Add(time.Hour))
+	r := NewSessionRepository(repo, cache, time.Minute, logger)
+
+	fill := func() {
+		repo.EXPECT().GetSession(ctx, accessToken).Return(session, nil)
+		_, err := r.GetSession(ctx, accessToken)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, cache.Len())
+	}
+
+	fill()
+	repo.EXPECT().Logout(ctx, session, accessToken, "testRefreshToken").Return(nil)
+	assert.NoError(t, r.Logout(ctx, session, accessToken, "testRefreshToken"))
+	assert.Equal(t, 0, cache.Len())
+
+	fill()
+	repo.EXPECT().ChangePortal(ctx, 2, session).Return(nil, "testPortalSession", nil)
+	_, _, err := r.ChangePortal(ctx, 2, session)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	fill()
+	repo.EXPECT().RefreshTokensPair(ctx, accessToken, "testRefreshToken").Return(&entityAuth.TokensPair{}, nil)
+	_, err = r.RefreshTokensPair(ctx, accessToken, "testRefreshToken")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, cache.Len())
+}
@@ -0,0 +1,245 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source=interfaces.go -destination=./session_mock.go -package=session
+//
+
+// Package session is a generated GoMock package.
+package session
+
+import (
+	context "context"
+	net "net"
+	reflect "reflect"
+	time "time"
+
+	auth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAuthRepository is a mock of AuthRepository interface.
+type MockAuthRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthRepositoryMockRecorder is the mock recorder for MockAuthRepository.
+type MockAuthRepositoryMockRecorder struct {
+	mock *MockAuthRepository
+}
+
+// NewMockAuthRepository creates a new mock instance.
+func NewMockAuthRepository(ctrl *gomock.Controller) *MockAuthRepository {
+	mock := &MockAuthRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthRepository) EXPECT() *MockAuthRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Auth mocks base method.
+func (m *MockAuthRepository) Auth(ctx context.Context, code, state, callbackURI string) (*auth.AuthSudir, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Auth", ctx, code, state, callbackURI)
+	ret0, _ := ret[0].(*auth.AuthSudir)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Auth indicates an expected call of Auth.
+func (mr *MockAuthRepositoryMockRecorder) Auth(ctx, code, state, callbackURI any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Auth", reflect.TypeOf((*MockAuthRepository)(nil).Auth), ctx, code, state, callbackURI)
+}
+
+// AuthPortal mocks base method.
+func (m *MockAuthRepository) AuthPortal(ctx context.Context, params auth.AuthPortalParams) (*auth.Auth1C, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthPortal", ctx, params)
+	ret0, _ := ret[0].(*auth.Auth1C)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthPortal indicates an expected call of AuthPortal.
+func (mr *MockAuthRepositoryMockRecorder) AuthPortal(ctx, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthPortal", reflect.TypeOf((*MockAuthRepository)(nil).AuthPortal), ctx, params)
+}
+
+// ChangePortal mocks base method.
+func (m *MockAuthRepository) ChangePortal(ctx context.Context, portalID int, session *auth.Session) ([]*auth.Portal, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangePortal", ctx, portalID, session)
+	ret0, _ := ret[0].([]*auth.Portal)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ChangePortal indicates an expected call of ChangePortal.
+func (mr *MockAuthRepositoryMockRecorder) ChangePortal(ctx, portalID, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangePortal", reflect.TypeOf((*MockAuthRepository)(nil).ChangePortal), ctx, portalID, session)
+}
+
+// CreateSession mocks base method.
+func (m *MockAuthRepository) CreateSession(ctx context.Context, user *auth.UserSudir, portal *auth.Portal, clientIP net.IP, device *auth.Device, arg5 *auth.Auth1C) (auth.TokensPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, user, portal, clientIP, device, arg5)
+	ret0, _ := ret[0].(auth.TokensPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSession indicates an expected call of CreateSession.
+func (mr *MockAuthRepositoryMockRecorder) CreateSession(ctx, user, portal, clientIP, device, arg5 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuthRepository)(nil).CreateSession), ctx, user, portal, clientIP, device, arg5)
+}
+
+// GetRedirectURL mocks base method.
+func (m *MockAuthRepository) GetRedirectURL(ctx context.Context, callbackURI string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRedirectURL", ctx, callbackURI)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRedirectURL indicates an expected call of GetRedirectURL.
+func (mr *MockAuthRepositoryMockRecorder) GetRedirectURL(ctx, callbackURI any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRedirectURL", reflect.TypeOf((*MockAuthRepository)(nil).GetRedirectURL), ctx, callbackURI)
+}
+
+// GetSession mocks base method.
+func (m *MockAuthRepository) GetSession(ctx context.Context, accessToken string) (*auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, accessToken)
+	ret0, _ := ret[0].(*auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockAuthRepositoryMockRecorder) GetSession(ctx, accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockAuthRepository)(nil).GetSession), ctx, accessToken)
+}
+
+// Logout mocks base method.
+func (m *MockAuthRepository) Logout(ctx context.Context, session *auth.Session, accessToken, refreshToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Logout", ctx, session, accessToken, refreshToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Logout indicates an expected call of Logout.
+func (mr *MockAuthRepositoryMockRecorder) Logout(ctx, session, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthRepository)(nil).Logout), ctx, session, accessToken, refreshToken)
+}
+
+// RefreshTokensPair mocks base method.
+func (m *MockAuthRepository) RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*auth.TokensPair, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshTokensPair", ctx, accessToken, refreshToken)
+	ret0, _ := ret[0].(*auth.TokensPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshTokensPair indicates an expected call of RefreshTokensPair.
+func (mr *MockAuthRepositoryMockRecorder) RefreshTokensPair(ctx, accessToken, refreshToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokensPair", reflect.TypeOf((*MockAuthRepository)(nil).RefreshTokensPair), ctx, accessToken, refreshToken)
+}
+
+// MockCache is a mock of Cache interface.
+type MockCache struct {
+	ctrl     *gomock.Controller
+	recorder *MockCacheMockRecorder
+	isgomock struct{}
+}
+
+// MockCacheMockRecorder is the mock recorder for MockCache.
+type MockCacheMockRecorder struct {
+	mock *MockCache
+}
+
+// NewMockCache creates a new mock instance.
+func NewMockCache(ctrl *gomock.Controller) *MockCache {
+	mock := &MockCache{ctrl: ctrl}
+	mock.recorder = &MockCacheMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCache) EXPECT() *MockCacheMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockCache) Delete(ctx context.Context, tokenHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, tokenHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCacheMockRecorder) Delete(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCache)(nil).Delete), ctx, tokenHash)
+}
+
+// DeleteBySessionID mocks base method.
+func (m *MockCache) DeleteBySessionID(ctx context.Context, sessionID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBySessionID", ctx, sessionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBySessionID indicates an expected call of DeleteBySessionID.
+func (mr *MockCacheMockRecorder) DeleteBySessionID(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBySessionID", reflect.TypeOf((*MockCache)(nil).DeleteBySessionID), ctx, sessionID)
+}
+
+// Get mocks base method.
+func (m *MockCache) Get(ctx context.Context, tokenHash string) (*auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, tokenHash)
+	ret0, _ := ret[0].(*auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCacheMockRecorder) Get(ctx, tokenHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCache)(nil).Get), ctx, tokenHash)
+}
+
+// Set mocks base method.
+func (m *MockCache) Set(ctx context.Context, tokenHash string, session *auth.Session, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Set", ctx, tokenHash, session, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Set indicates an expected call of Set.
+func (mr *MockCacheMockRecorder) Set(ctx, tokenHash, session, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCache)(nil).Set), ctx, tokenHash, session, ttl)
+}
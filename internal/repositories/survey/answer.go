@@ -31,6 +31,12 @@ func (ar answerRepository) Add(ctx context.Context, answers []*surveys.Responden
 	req := &answerv1.AddRequest{
 		Answers: ar.mapper.NewAnswersToPb(answers),
 	}
+	if len(answers) != 0 && answers[0].PortalID != 0 {
+		req.PortalId = int64(answers[0].PortalID)
+	}
+	if len(answers) != 0 && answers[0].OrganizationID != nil {
+		req.OrganizationId = answers[0].OrganizationID.String()
+	}
 	if respondent != nil {
 		req.Respondent = &answerv1.Respondent{
 			Id: respondent.String(),
@@ -55,3 +61,51 @@ func (ar answerRepository) Add(ctx context.Context, answers []*surveys.Responden
 
 	return result, nil
 }
+
+// GetAll ответы на опрос постранично.
+//
+//	Ответы отдаются в порядке id, следующая страница запрашивается по id последнего ответа
+func (ar answerRepository) GetAll(
+	ctx context.Context,
+	filter surveys.AnswersFilter,
+	pagination surveys.AnswersPagination,
+) (*surveys.AnswersWithPagination, error) {
+	req := &answerv1.GetAllRequest{
+		SurveyId:        filter.SurveyID.String(),
+		PortalIds:       make([]int64, 0, len(filter.PortalIDs)),
+		OrganizationIds: make([]string, 0, len(filter.OrganizationIDs)),
+		Limit:           pagination.Limit,
+	}
+	if filter.QuestionID != nil {
+		req.QuestionId = filter.QuestionID.String()
+	}
+	for _, portalID := range filter.PortalIDs {
+		req.PortalIds = append(req.PortalIds, int64(portalID))
+	}
+	for _, organizationID := range filter.OrganizationIDs {
+		req.OrganizationIds = append(req.OrganizationIds, organizationID.String())
+	}
+	if pagination.LastID != nil {
+		req.LastId = pagination.LastID.String()
+	}
+
+	resp, err := ar.client.GetAll(ctx, req)
+	if err != nil {
+		return nil, surveyError("can't get answers", err)
+	}
+
+	answers, err := ar.mapper.AnswersToEntities(resp.GetAnswers())
+	if err != nil {
+		return nil, fmt.Errorf("can't convert answers to entities: %w", err)
+	}
+
+	result := &surveys.AnswersWithPagination{
+		AnswersPagination: surveys.AnswersPagination{Limit: pagination.Limit},
+		Answers:           answers,
+	}
+	if len(answers) != 0 {
+		result.LastID = answers[len(answers)-1].ID
+	}
+
+	return result, nil
+}
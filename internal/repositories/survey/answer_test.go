@@ -46,9 +46,11 @@ func Test_answerRepository_Add(t *testing.T) {
 				ctx: ctx,
 				answers: []*surveys.RespondentAnswer{
 					{
-						ChosenVariant: surveys.AnswerID(testUUID),
-						Content:       testContent,
-						RespondentId:  &respondentID,
+						ChosenVariant:  surveys.AnswerID(testUUID),
+						Content:        testContent,
+						RespondentId:   &respondentID,
+						PortalID:       7,
+						OrganizationID: &testUUID,
 					},
 				},
 			},
@@ -56,7 +58,9 @@ func Test_answerRepository_Add(t *testing.T) {
 				answerIDs := []string{testUUID.String()}
 				answersPb := localMapper.NewAnswersToPb(a.answers)
 				f.client.EXPECT().Add(a.ctx, &answerv1.AddRequest{
-					Answers: answersPb,
+					Answers:        answersPb,
+					PortalId:       7,
+					OrganizationId: testUUID.String(),
 					Respondent: &answerv1.Respondent{
 						Id: a.answers[0].RespondentId.String(),
 					},
@@ -190,3 +194,103 @@ func Test_answerRepository_Add(t *testing.T) {
 		})
 	}
 }
+
+func Test_answerRepository_GetAll(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("some error")
+	surveyID := surveys.SurveyID(uuid.New())
+	questionID := surveys.QuestionID(uuid.New())
+	lastID := uuid.New()
+	answerID := uuid.New()
+	organizationID := uuid.New()
+	answersPb := []*answerv1.Answer{{Id: answerID.String()}}
+
+	tests := []struct {
+		name       string
+		filter     surveys.AnswersFilter
+		pagination surveys.AnswersPagination
+		prepare    func(client *answerv1.MockAnswerAPIClient, mapper *MockAnswerMapper)
+		want       *surveys.AnswersWithPagination
+		wantErr    error
+	}{
+		{
+			name: "correct",
+			filter: surveys.AnswersFilter{
+				SurveyID:        surveyID,
+				QuestionID:      &questionID,
+				PortalIDs:       []int{7},
+				OrganizationIDs: []uuid.UUID{organizationID},
+			},
+			pagination: surveys.AnswersPagination{Limit: 10, LastID: &lastID},
+			prepare: func(client *answerv1.MockAnswerAPIClient, mapper *MockAnswerMapper) {
+				client.EXPECT().GetAll(ctx, &answerv1.GetAllRequest{
+					SurveyId:        surveyID.String(),
+					QuestionId:      questionID.String(),
+					PortalIds:       []int64{7},
+					OrganizationIds: []string{organizationID.String()},
+					Limit:           10,
+					LastId:          lastID.String(),
+				}).Return(&answerv1.GetAllResponse{Answers: answersPb}, nil)
+				mapper.EXPECT().AnswersToEntities(answersPb).Return([]*surveys.RespondentAnswer{{ID: &answerID}}, nil)
+			},
+			want: &surveys.AnswersWithPagination{
+				AnswersPagination: surveys.AnswersPagination{Limit: 10, LastID: &answerID},
+				Answers:           []*surveys.RespondentAnswer{{ID: &answerID}},
+			},
+		},
+		{
+			name:       "empty page",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			prepare: func(client *answerv1.MockAnswerAPIClient, mapper *MockAnswerMapper) {
+				client.EXPECT().GetAll(ctx, &answerv1.GetAllRequest{
+					SurveyId:        surveyID.String(),
+					PortalIds:       []int64{},
+					OrganizationIds: []string{},
+					Limit:           10,
+				}).Return(&answerv1.GetAllResponse{}, nil)
+				mapper.EXPECT().AnswersToEntities(gomock.Any()).Return([]*surveys.RespondentAnswer{}, nil)
+			},
+			want: &surveys.AnswersWithPagination{
+				AnswersPagination: surveys.AnswersPagination{Limit: 10},
+				Answers:           []*surveys.RespondentAnswer{},
+			},
+		},
+		{
+			name:       "not found",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			prepare: func(client *answerv1.MockAnswerAPIClient, mapper *MockAnswerMapper) {
+				client.EXPECT().GetAll(ctx, gomock.Any()).Return(nil, status.Error(codes.NotFound, testErr.Error()))
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name:       "mapper error",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			prepare: func(client *answerv1.MockAnswerAPIClient, mapper *MockAnswerMapper) {
+				client.EXPECT().GetAll(ctx, gomock.Any()).Return(&answerv1.GetAllResponse{Answers: answersPb}, nil)
+				mapper.EXPECT().AnswersToEntities(answersPb).Return(nil, testErr)
+			},
+			wantErr: fmt.Errorf("can't convert answers to entities: %w", testErr),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := answerv1.NewMockAnswerAPIClient(ctrl)
+			mapper := NewMockAnswerMapper(ctrl)
+			tt.prepare(client, mapper)
+
+			got, err := NewAnswerRepository(client, mapper).GetAll(ctx, tt.filter, tt.pagination)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
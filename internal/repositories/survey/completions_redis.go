@@ -0,0 +1,97 @@
+package survey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+)
+
+type redisCompletionsRepository struct {
+	client redis.UniversalClient
+	prefix string
+}
+
+// NewRedisCompletionsRepository отметки о прохождении опросов в Redis.
+//
+//	Отметка хранится без срока действия, значение - время прохождения опроса в той точности,
+//	в которой его передал вызывающий (для анонимных опросов - до дня)
+func NewRedisCompletionsRepository(client redis.UniversalClient, prefix string) *redisCompletionsRepository {
+	return &redisCompletionsRepository{
+		client: client,
+		prefix: prefix,
+	}
+}
+
+func (r *redisCompletionsRepository) Add(ctx context.Context, surveyID surveys.SurveyID, respondentKey string, completedAt time.Time) (bool, error) {
+	added, err := r.client.SetNX(ctx, r.completionKey(surveyID, respondentKey), completedAt.UTC().Format(time.RFC3339Nano), 0).Result()
+	if err != nil {
+		return false, fmt.Errorf("can't add survey completion to redis: %w", err)
+	}
+	return added, nil
+}
+
+func (r *redisCompletionsRepository) Get(ctx context.Context, surveyID surveys.SurveyID, respondentKey string) (*time.Time, error) {
+	value, err := r.client.Get(ctx, r.completionKey(surveyID, respondentKey)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("can't get survey completion from redis: %w", err)
+	}
+
+	completedAt, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return nil, fmt.Errorf("can't parse survey completion time: %w", err)
+	}
+	return &completedAt, nil
+}
+
+func (r *redisCompletionsRepository) GetMany(
+	ctx context.Context,
+	respondentKeys map[surveys.SurveyID]string,
+) (map[surveys.SurveyID]time.Time, error) {
+	if len(respondentKeys) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]surveys.SurveyID, 0, len(respondentKeys))
+	keys := make([]string, 0, len(respondentKeys))
+	for surveyID, respondentKey := range respondentKeys {
+		ids = append(ids, surveyID)
+		keys = append(keys, r.completionKey(surveyID, respondentKey))
+	}
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey completions from redis: %w", err)
+	}
+
+	result := make(map[surveys.SurveyID]time.Time, len(values))
+	for i, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		completedAt, err := time.Parse(time.RFC3339Nano, data)
+		if err != nil {
+			return nil, fmt.Errorf("can't parse survey completion time: %w", err)
+		}
+		result[ids[i]] = completedAt
+	}
+	return result, nil
+}
+
+func (r *redisCompletionsRepository) Delete(ctx context.Context, surveyID surveys.SurveyID, respondentKey string) error {
+	if err := r.client.Del(ctx, r.completionKey(surveyID, respondentKey)).Err(); err != nil {
+		return fmt.Errorf("can't delete survey completion from redis: %w", err)
+	}
+	return nil
+}
+
+func (r *redisCompletionsRepository) completionKey(surveyID surveys.SurveyID, respondentKey string) string {
+	return r.prefix + "survey-completion:" + surveyID.String() + ":" + respondentKey
+}
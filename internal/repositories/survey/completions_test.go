@@ -0,0 +1,77 @@
+package survey
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+)
+
+type completionsRepository interface {
+	Add(ctx context.Context, surveyID surveys.SurveyID, respondentKey string, completedAt time.Time) (bool, error)
+	Get(ctx context.Context, surveyID surveys.SurveyID, respondentKey string) (*time.Time, error)
+	GetMany(ctx context.Context, respondentKeys map[surveys.SurveyID]string) (map[surveys.SurveyID]time.Time, error)
+	Delete(ctx context.Context, surveyID surveys.SurveyID, respondentKey string) error
+}
+
+func Test_completionsRepository(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = client.Close() })
+
+	tests := []struct {
+		name       string
+		repository completionsRepository
+	}{
+		{
+			name:       "redis",
+			repository: NewRedisCompletionsRepository(client, "test:"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.TODO()
+			surveyID := surveys.SurveyID(uuid.New())
+			completedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+			got, err := tt.repository.Get(ctx, surveyID, "respondent")
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+
+			added, err := tt.repository.Add(ctx, surveyID, "respondent", completedAt)
+			assert.NoError(t, err)
+			assert.True(t, added)
+
+			added, err = tt.repository.Add(ctx, surveyID, "respondent", completedAt.Add(time.Hour))
+			assert.NoError(t, err)
+			assert.False(t, added, "repeat completion must be rejected")
+
+			added, err = tt.repository.Add(ctx, surveyID, "another", completedAt)
+			assert.NoError(t, err)
+			assert.True(t, added)
+
+			got, err = tt.repository.Get(ctx, surveyID, "respondent")
+			assert.NoError(t, err)
+			assert.Equal(t, &completedAt, got)
+
+			otherSurveyID := surveys.SurveyID(uuid.New())
+			many, err := tt.repository.GetMany(ctx, map[surveys.SurveyID]string{
+				surveyID:      "respondent",
+				otherSurveyID: "respondent",
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, map[surveys.SurveyID]time.Time{surveyID: completedAt}, many)
+
+			assert.NoError(t, tt.repository.Delete(ctx, surveyID, "respondent"))
+			got, err = tt.repository.Get(ctx, surveyID, "respondent")
+			assert.NoError(t, err)
+			assert.Nil(t, got)
+		})
+	}
+}
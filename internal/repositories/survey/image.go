@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	imagev1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/image/v1"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"google.golang.org/grpc/codes"
 )
 
 type imageRepository struct {
@@ -29,3 +31,23 @@ func (ir imageRepository) Get(ctx context.Context, imageName string) ([]byte, er
 
 	return resp.GetImage(), nil
 }
+
+func (ir imageRepository) Add(ctx context.Context, image *surveys.Image) (*surveys.Image, error) {
+	resp, err := ir.client.Add(ctx, &imagev1.AddRequest{Image: ir.mapper.NewImageToPb(image)})
+	if err != nil {
+		msg := diterrors.NewLocalizedError(diterrors.LocalizeLocale, err)
+		switch msg.Code() {
+		case codes.InvalidArgument:
+			return nil, diterrors.NewValidationError(msg)
+		default:
+			return nil, fmt.Errorf("can't add image: %w", msg)
+		}
+	}
+
+	result, err := ir.mapper.ImageToEntity(resp.GetImage())
+	if err != nil {
+		return nil, fmt.Errorf("can't convert image to entity: %w", err)
+	}
+
+	return result, nil
+}
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	imagev1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/image/v1"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc/codes"
@@ -80,3 +81,61 @@ func Test_imageRepository_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_imageRepository_Add(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("some error")
+	image := &surveys.Image{Data: "test"}
+	imagePb := &imagev1.AddRequest_Image{Payload: "test"}
+	addedPb := &imagev1.Image{Id: "test id"}
+	added := &surveys.Image{ID: "test id"}
+
+	tests := []struct {
+		name    string
+		prepare func(client *imagev1.MockImageAPIClient, mapper *MockImageMapper)
+		want    *surveys.Image
+		wantErr error
+	}{
+		{
+			name: "correct",
+			prepare: func(client *imagev1.MockImageAPIClient, mapper *MockImageMapper) {
+				client.EXPECT().Add(ctx, &imagev1.AddRequest{Image: imagePb}).Return(&imagev1.AddResponse{Image: addedPb}, nil)
+				mapper.EXPECT().ImageToEntity(addedPb).Return(added, nil)
+			},
+			want: added,
+		},
+		{
+			name: "default err",
+			prepare: func(client *imagev1.MockImageAPIClient, _ *MockImageMapper) {
+				client.EXPECT().Add(ctx, &imagev1.AddRequest{Image: imagePb}).Return(nil, status.Error(codes.Internal, testErr.Error()))
+			},
+			wantErr: fmt.Errorf("can't add image: %s", testErr.Error()),
+		},
+		{
+			name: "convert image error",
+			prepare: func(client *imagev1.MockImageAPIClient, mapper *MockImageMapper) {
+				client.EXPECT().Add(ctx, &imagev1.AddRequest{Image: imagePb}).Return(&imagev1.AddResponse{Image: addedPb}, nil)
+				mapper.EXPECT().ImageToEntity(addedPb).Return(nil, testErr)
+			},
+			wantErr: fmt.Errorf("can't convert image to entity: %w", testErr),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := imagev1.NewMockImageAPIClient(ctrl)
+			mapper := NewMockImageMapper(ctrl)
+			mapper.EXPECT().NewImageToPb(image).Return(imagePb)
+			tt.prepare(client, mapper)
+
+			got, err := NewImageRepository(client, mapper).Add(ctx, image)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
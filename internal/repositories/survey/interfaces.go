@@ -19,6 +19,7 @@ type SurveyMapper interface {
 	RespondentToPb(respondent *surveys.SurveyRespondent) *respondentv1.Respondent
 	RespondentToEntity(respondent *respondentv1.Respondent) (*surveys.SurveyRespondent, error)
 	OptionsToPb(options *surveys.SurveyFilterOptions) *sharedv1.Options
+	AvailableFilterToPb(filter *surveys.AvailableFilter) *surveyv1.GetAllRequest_Available
 	SurveyToPb(survey *surveys.Survey) *surveyv1.Survey
 	NewSurveyToPb(survey *surveys.Survey) *surveyv1.AddRequest_Survey
 	SurveyToEntity(surveyPb *surveyv1.Survey) (*surveys.Survey, error)
@@ -46,6 +46,20 @@ func (m *MockSurveyMapper) EXPECT() *MockSurveyMapperMockRecorder {
 	return m.recorder
 }
 
+// AvailableFilterToPb mocks base method.
+func (m *MockSurveyMapper) AvailableFilterToPb(filter *entitySurveys.AvailableFilter) *surveyv1.GetAllRequest_Available {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AvailableFilterToPb", filter)
+	ret0, _ := ret[0].(*surveyv1.GetAllRequest_Available)
+	return ret0
+}
+
+// AvailableFilterToPb indicates an expected call of AvailableFilterToPb.
+func (mr *MockSurveyMapperMockRecorder) AvailableFilterToPb(filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AvailableFilterToPb", reflect.TypeOf((*MockSurveyMapper)(nil).AvailableFilterToPb), filter)
+}
+
 // NewSurveyToPb mocks base method.
 func (m *MockSurveyMapper) NewSurveyToPb(survey *entitySurveys.Survey) *surveyv1.AddRequest_Survey {
 	m.ctrl.T.Helper()
@@ -7,6 +7,7 @@ import (
 	surveyv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/survey/v1"
 	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
 )
 
@@ -50,3 +51,113 @@ func (sr surveyRepository) Get(
 
 	return result, nil
 }
+
+// GetAll список опросов постранично
+func (sr surveyRepository) GetAll(
+	ctx context.Context,
+	ids surveys.SurveyIDs,
+	respondent *surveys.SurveyRespondent,
+	options surveys.SurveyFilterOptions,
+	pagination surveys.Pagination,
+) (*surveys.SurveysWithPagination, error) {
+	resp, err := sr.client.GetAll(ctx, &surveyv1.GetAllRequest{
+		Ids:        ids.ToStringSlice(),
+		Respondent: sr.mapper.RespondentToPb(respondent),
+		Options:    sr.mapper.OptionsToPb(&options),
+		Pagination: sr.mapper.PaginationToPb(&pagination),
+	})
+	if err != nil {
+		return nil, surveyError("can't get surveys", err)
+	}
+
+	return sr.surveysWithPagination(resp)
+}
+
+// GetAvailable опубликованные и проводимые опросы, адресованные респонденту, постранично.
+//
+//	Фильтрация выполняется сервисом опросов
+func (sr surveyRepository) GetAvailable(
+	ctx context.Context,
+	filter surveys.AvailableFilter,
+	options surveys.SurveyFilterOptions,
+	pagination surveys.Pagination,
+) (*surveys.SurveysWithPagination, error) {
+	resp, err := sr.client.GetAll(ctx, &surveyv1.GetAllRequest{
+		Available:  sr.mapper.AvailableFilterToPb(&filter),
+		Options:    sr.mapper.OptionsToPb(&options),
+		Pagination: sr.mapper.PaginationToPb(&pagination),
+	})
+	if err != nil {
+		return nil, surveyError("can't get available surveys", err)
+	}
+
+	return sr.surveysWithPagination(resp)
+}
+
+func (sr surveyRepository) surveysWithPagination(resp *surveyv1.GetAllResponse) (*surveys.SurveysWithPagination, error) {
+	result, err := sr.mapper.SurveysToEntities(resp.GetSurveys())
+	if err != nil {
+		return nil, fmt.Errorf("can't convert surveys to entities: %w", err)
+	}
+
+	paginationResult, err := sr.mapper.PaginationToEntity(resp.GetPagination())
+	if err != nil {
+		return nil, fmt.Errorf("can't convert pagination to entity: %w", err)
+	}
+
+	return &surveys.SurveysWithPagination{
+		Pagination: *paginationResult,
+		Surveys:    result,
+	}, nil
+}
+
+func (sr surveyRepository) Add(ctx context.Context, survey *surveys.Survey) (*surveys.SurveyID, error) {
+	resp, err := sr.client.Add(ctx, &surveyv1.AddRequest{
+		Survey: sr.mapper.NewSurveyToPb(survey),
+	})
+	if err != nil {
+		return nil, surveyError("can't add survey", err)
+	}
+
+	id, err := uuid.Parse(resp.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("can't parse survey id: %w", err)
+	}
+	surveyID := surveys.SurveyID(id)
+
+	return &surveyID, nil
+}
+
+// Update сохраняет опрос целиком: вопросы и варианты ответа без идентификатора создаются,
+// отсутствующие в опросе удаляются
+func (sr surveyRepository) Update(ctx context.Context, survey *surveys.Survey) error {
+	_, err := sr.client.Update(ctx, &surveyv1.UpdateRequest{
+		Survey: sr.mapper.SurveyToPb(survey),
+	})
+	if err != nil {
+		return surveyError("can't update survey", err)
+	}
+
+	return nil
+}
+
+func (sr surveyRepository) Delete(ctx context.Context, id surveys.SurveyID) error {
+	_, err := sr.client.Delete(ctx, &surveyv1.DeleteRequest{Id: id.String()})
+	if err != nil {
+		return surveyError("can't delete survey", err)
+	}
+
+	return nil
+}
+
+func surveyError(message string, err error) error {
+	msg := diterrors.NewLocalizedError(diterrors.LocalizeLocale, err)
+	switch msg.Code() {
+	case codes.InvalidArgument:
+		return diterrors.NewValidationError(msg)
+	case codes.NotFound:
+		return diterrors.ErrNotFound
+	default:
+		return fmt.Errorf("%s: %w", message, msg)
+	}
+}
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	answervariantv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/answervariant/v1"
 	questionv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/question/v1"
 	respondentv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/respondent/v1"
+	sharedv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/shared/v1"
 	surveyv1 "git.mos.ru/buch-cloud/moscow-team-2.0/infrastructure/protolib.git/gen/infogorod/surveys/survey/v1"
 	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
@@ -239,3 +241,199 @@ func Test_surveyRepository_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_surveyRepository_Add(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("some error")
+	testUUID := uuid.New()
+	surveyID := surveys.SurveyID(testUUID)
+	survey := &surveys.Survey{Title: "test"}
+	surveyPb := &surveyv1.AddRequest_Survey{Title: "test"}
+
+	tests := []struct {
+		name    string
+		prepare func(client *surveyv1.MockSurveyAPIClient)
+		want    *surveys.SurveyID
+		wantErr error
+	}{
+		{
+			name: "correct",
+			prepare: func(client *surveyv1.MockSurveyAPIClient) {
+				client.EXPECT().Add(ctx, &surveyv1.AddRequest{Survey: surveyPb}).
+					Return(&surveyv1.AddResponse{Id: testUUID.String()}, nil)
+			},
+			want: &surveyID,
+		},
+		{
+			name: "invalid argument error",
+			prepare: func(client *surveyv1.MockSurveyAPIClient) {
+				client.EXPECT().Add(ctx, &surveyv1.AddRequest{Survey: surveyPb}).
+					Return(nil, status.Error(codes.InvalidArgument, testErr.Error()))
+			},
+			wantErr: diterrors.NewValidationError(testErr),
+		},
+		{
+			name: "parse id error",
+			prepare: func(client *surveyv1.MockSurveyAPIClient) {
+				client.EXPECT().Add(ctx, &surveyv1.AddRequest{Survey: surveyPb}).
+					Return(&surveyv1.AddResponse{Id: "not uuid"}, nil)
+			},
+			wantErr: fmt.Errorf("can't parse survey id: %w", errors.New("invalid UUID length: 8")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := surveyv1.NewMockSurveyAPIClient(ctrl)
+			mapper := NewMockSurveyMapper(ctrl)
+			mapper.EXPECT().NewSurveyToPb(survey).Return(surveyPb)
+			tt.prepare(client)
+
+			got, err := NewSurveyRepository(client, mapper).Add(ctx, survey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_surveyRepository_Update(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("some error")
+	surveyID := surveys.SurveyID(uuid.New())
+	survey := &surveys.Survey{ID: &surveyID, Title: "test"}
+	surveyPb := &surveyv1.Survey{Id: surveyID.String(), Title: "test"}
+
+	tests := []struct {
+		name    string
+		err     error
+		wantErr error
+	}{
+		{
+			name: "correct",
+		},
+		{
+			name:    "not found",
+			err:     status.Error(codes.NotFound, testErr.Error()),
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name:    "default error",
+			err:     status.Error(codes.Internal, testErr.Error()),
+			wantErr: fmt.Errorf("can't update survey: %s", testErr.Error()),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			client := surveyv1.NewMockSurveyAPIClient(ctrl)
+			mapper := NewMockSurveyMapper(ctrl)
+			mapper.EXPECT().SurveyToPb(survey).Return(surveyPb)
+			client.EXPECT().Update(ctx, &surveyv1.UpdateRequest{Survey: surveyPb}).Return(&surveyv1.UpdateResponse{}, tt.err)
+
+			err := NewSurveyRepository(client, mapper).Update(ctx, survey)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func Test_surveyRepository_Delete(t *testing.T) {
+	ctx := context.TODO()
+	surveyID := surveys.SurveyID(uuid.New())
+
+	ctrl := gomock.NewController(t)
+	client := surveyv1.NewMockSurveyAPIClient(ctrl)
+	client.EXPECT().Delete(ctx, &surveyv1.DeleteRequest{Id: surveyID.String()}).
+		Return(nil, status.Error(codes.NotFound, "not found"))
+
+	err := NewSurveyRepository(client, NewMockSurveyMapper(ctrl)).Delete(ctx, surveyID)
+	assert.ErrorIs(t, err, diterrors.ErrNotFound)
+}
+
+func Test_surveyRepository_GetAll(t *testing.T) {
+	ctx := context.TODO()
+	testUUID := uuid.New()
+	surveyID := surveys.SurveyID(testUUID)
+	lastDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	tUtils := timeUtils.NewTimeUtils()
+	localMapper := mapper.NewSurveyMapper(tUtils)
+
+	ids := surveys.SurveyIDs{surveyID}
+	respondent := &surveys.SurveyRespondent{Type: surveys.RespondentTypeAnonymous}
+	options := surveys.SurveyFilterOptions{WithQuestions: true}
+	pagination := surveys.Pagination{Limit: 10, LastId: &surveyID, LastDate: &lastDate}
+
+	surveysPb := []*surveyv1.Survey{{Id: testUUID.String()}}
+	paginationPb := &sharedv1.PaginationResponse{Limit: 10, Total: 11}
+	surveysEntity := []*surveys.Survey{{ID: &surveyID}}
+
+	ctrl := gomock.NewController(t)
+	client := surveyv1.NewMockSurveyAPIClient(ctrl)
+	surveyMapper := NewMockSurveyMapper(ctrl)
+	surveyMapper.EXPECT().RespondentToPb(respondent).Return(localMapper.RespondentToPb(respondent))
+	surveyMapper.EXPECT().OptionsToPb(&options).Return(localMapper.OptionsToPb(&options))
+	surveyMapper.EXPECT().PaginationToPb(&pagination).Return(localMapper.PaginationToPb(&pagination))
+	client.EXPECT().GetAll(ctx, &surveyv1.GetAllRequest{
+		Ids:        []string{testUUID.String()},
+		Respondent: localMapper.RespondentToPb(respondent),
+		Options:    localMapper.OptionsToPb(&options),
+		Pagination: localMapper.PaginationToPb(&pagination),
+	}).Return(&surveyv1.GetAllResponse{Surveys: surveysPb, Pagination: paginationPb}, nil)
+	surveyMapper.EXPECT().SurveysToEntities(surveysPb).Return(surveysEntity, nil)
+	surveyMapper.EXPECT().PaginationToEntity(paginationPb).Return(&surveys.Pagination{Limit: 10, Total: 11}, nil)
+
+	got, err := NewSurveyRepository(client, surveyMapper).GetAll(ctx, ids, respondent, options, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, &surveys.SurveysWithPagination{
+		Pagination: surveys.Pagination{Limit: 10, Total: 11},
+		Surveys:    surveysEntity,
+	}, got)
+}
+
+func Test_surveyRepository_GetAvailable(t *testing.T) {
+	ctx := context.TODO()
+	testUUID := uuid.New()
+	surveyID := surveys.SurveyID(testUUID)
+	tUtils := timeUtils.NewTimeUtils()
+	localMapper := mapper.NewSurveyMapper(tUtils)
+
+	filter := surveys.AvailableFilter{
+		ActiveAt:   time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		Respondent: &surveys.RespondentProfile{UserID: uuid.New(), PortalIDs: []int{7}},
+	}
+	options := surveys.SurveyFilterOptions{}
+	pagination := surveys.Pagination{Limit: 10}
+
+	surveysPb := []*surveyv1.Survey{{Id: testUUID.String()}}
+	paginationPb := &sharedv1.PaginationResponse{Limit: 10, Total: 1}
+	surveysEntity := []*surveys.Survey{{ID: &surveyID}}
+
+	ctrl := gomock.NewController(t)
+	client := surveyv1.NewMockSurveyAPIClient(ctrl)
+	surveyMapper := NewMockSurveyMapper(ctrl)
+	surveyMapper.EXPECT().AvailableFilterToPb(&filter).Return(localMapper.AvailableFilterToPb(&filter))
+	surveyMapper.EXPECT().OptionsToPb(&options).Return(localMapper.OptionsToPb(&options))
+	surveyMapper.EXPECT().PaginationToPb(&pagination).Return(localMapper.PaginationToPb(&pagination))
+	client.EXPECT().GetAll(ctx, &surveyv1.GetAllRequest{
+		Available:  localMapper.AvailableFilterToPb(&filter),
+		Options:    localMapper.OptionsToPb(&options),
+		Pagination: localMapper.PaginationToPb(&pagination),
+	}).Return(&surveyv1.GetAllResponse{Surveys: surveysPb, Pagination: paginationPb}, nil)
+	surveyMapper.EXPECT().SurveysToEntities(surveysPb).Return(surveysEntity, nil)
+	surveyMapper.EXPECT().PaginationToEntity(paginationPb).Return(&surveys.Pagination{Limit: 10, Total: 1}, nil)
+
+	got, err := NewSurveyRepository(client, surveyMapper).GetAvailable(ctx, filter, options, pagination)
+	assert.NoError(t, err)
+	assert.Equal(t, &surveys.SurveysWithPagination{
+		Pagination: surveys.Pagination{Limit: 10, Total: 1},
+		Surveys:    surveysEntity,
+	}, got)
+}
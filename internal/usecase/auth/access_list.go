@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+type accessListUseCase struct {
+	repository AccessListRepository
+	logger     ditzap.Logger
+	now        func() time.Time
+
+	accessList atomic.Pointer[entityAuth.AccessList]
+
+	mu        sync.Mutex
+	modTime   time.Time
+	reloadErr error
+}
+
+// NewAccessListUseCase use-кейс списка доступа пользователей к приложению
+func NewAccessListUseCase(repository AccessListRepository, logger ditzap.Logger) *accessListUseCase {
+	return &accessListUseCase{
+		repository: repository,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Reload перечитывает список доступа.
+//
+//	При ошибке продолжает действовать ранее загруженный список
+func (a *accessListUseCase) Reload(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	modTime, err := a.repository.ModTime(ctx)
+	if err != nil {
+		a.reloadErr = fmt.Errorf("can't reload access list: %w", err)
+		return a.reloadErr
+	}
+	a.reloadErr = a.load(ctx, modTime)
+	return a.reloadErr
+}
+
+// Watch проверяет изменение списка доступа с заданным интервалом и перечитывает его.
+//
+//	Завершается при отмене контекста
+func (a *accessListUseCase) Watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := a.reloadIfModified(ctx); err != nil {
+				a.logger.Error("can't reload access list", zap.Error(err))
+			}
+		}
+	}
+}
+
+// CheckAccess проверяет доступ пользователя к порталу
+func (a *accessListUseCase) CheckAccess(email string, portalID int) error {
+	accessList := a.accessList.Load()
+	if accessList == nil {
+		return nil
+	}
+	if !accessList.Allowed(email, portalID, a.now()) {
+		return ErrUserAccessDenied
+	}
+	return nil
+}
+
+// Check проверка готовности: список загружен и последнее перечитывание файла было успешным
+func (a *accessListUseCase) Check(_ context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.reloadErr != nil {
+		return a.reloadErr
+	}
+	if a.accessList.Load() == nil {
+		return ErrAccessListNotLoaded
+	}
+	return nil
+}
+
+// Info сведения о загруженном списке доступа
+func (a *accessListUseCase) Info() *entityAuth.AccessListInfo {
+	return a.accessList.Load().Info()
+}
+
+func (a *accessListUseCase) reloadIfModified(ctx context.Context) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	modTime, err := a.repository.ModTime(ctx)
+	if err != nil {
+		a.reloadErr = err
+		return err
+	}
+	if modTime.Equal(a.modTime) {
+		return nil
+	}
+	a.reloadErr = a.load(ctx, modTime)
+	return a.reloadErr
+}
+
+func (a *accessListUseCase) load(ctx context.Context, modTime time.Time) error {
+	file, err := a.repository.Load(ctx)
+	if err != nil {
+		return fmt.Errorf("can't load access list: %w", err)
+	}
+
+	accessList := entityAuth.NewAccessList(file, a.now())
+	a.accessList.Store(accessList)
+	a.modTime = modTime
+
+	info := accessList.Info()
+	a.logger.Info("access list loaded",
+		zap.String("version", info.Version),
+		zap.Int("entries", info.Entries),
+	)
+	return nil
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func Test_accessListUseCase_Reload(t *testing.T) {
+	type fields struct {
+		repo   *MockAccessListRepository
+		logger *ditzap.MockLogger
+	}
+
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	modTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		prepare   func(f fields)
+		wantErr   error
+		wantInfo  *entityAuth.AccessListInfo
+		wantCheck map[string]error
+	}{
+		{
+			name: "mod time err",
+			prepare: func(f fields) {
+				f.repo.EXPECT().ModTime(ctx).Return(time.Time{}, testErr)
+			},
+			wantErr: testErr,
+			wantCheck: map[string]error{
+				"user@example.com": nil,
+			},
+		},
+		{
+			name: "load err",
+			prepare: func(f fields) {
+				f.repo.EXPECT().ModTime(ctx).Return(modTime, nil)
+				f.repo.EXPECT().Load(ctx).Return(nil, testErr)
+			},
+			wantErr: testErr,
+			wantCheck: map[string]error{
+				"user@example.com": nil,
+			},
+		},
+		{
+			name: "correct",
+			prepare: func(f fields) {
+				f.repo.EXPECT().ModTime(ctx).Return(modTime, nil)
+				f.repo.EXPECT().Load(ctx).Return(&entityAuth.AccessListFile{
+					Version: "v1",
+					Allow:   []*entityAuth.AccessRule{{Email: "*@example.com"}},
+					Deny:    []string{"blocked@example.com"},
+				}, nil)
+				f.logger.EXPECT().Info("access list loaded", gomock.Any(), gomock.Any())
+			},
+			wantInfo: &entityAuth.AccessListInfo{
+				Version: "v1",
+				Entries: 2,
+			},
+			wantCheck: map[string]error{
+				"user@example.com":    nil,
+				"blocked@example.com": ErrUserAccessDenied,
+				"user@mail.ru":        ErrUserAccessDenied,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:   NewMockAccessListRepository(ctrl),
+				logger: ditzap.NewMockLogger(ctrl),
+			}
+			tt.prepare(f)
+
+			au := NewAccessListUseCase(f.repo, f.logger)
+			au.now = func() time.Time { return modTime }
+
+			err := au.Reload(ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			info := au.Info()
+			if tt.wantInfo != nil {
+				tt.wantInfo.LoadedAt = modTime
+			}
+			assert.Equal(t, tt.wantInfo, info)
+
+			for email, wantErr := range tt.wantCheck {
+				assert.Equal(t, wantErr, au.CheckAccess(email, 0), email)
+			}
+		})
+	}
+}
+
+func Test_accessListUseCase_reloadIfModified(t *testing.T) {
+	ctx := context.TODO()
+	ctrl := gomock.NewController(t)
+	repo := NewMockAccessListRepository(ctrl)
+	logger := ditzap.NewMockLogger(ctrl)
+
+	modTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	au := NewAccessListUseCase(repo, logger)
+	assert.ErrorIs(t, au.Check(ctx), ErrAccessListNotLoaded)
+
+	repo.EXPECT().ModTime(ctx).Return(modTime, nil)
+	repo.EXPECT().Load(ctx).Return(&entityAuth.AccessListFile{Version: "v1"}, nil)
+	logger.EXPECT().Info("access list loaded", gomock.Any(), gomock.Any())
+	assert.NoError(t, au.Reload(ctx))
+
+	// Файл не изменился, список не перечитывается
+	repo.EXPECT().ModTime(ctx).Return(modTime, nil)
+	assert.NoError(t, au.reloadIfModified(ctx))
+	assert.Equal(t, "v1", au.Info().Version)
+
+	// Файл изменился, но не читается — действует прежний список
+	repo.EXPECT().ModTime(ctx).Return(modTime.Add(time.Minute), nil)
+	repo.EXPECT().Load(ctx).Return(nil, errors.New("test error"))
+	assert.Error(t, au.reloadIfModified(ctx))
+	assert.Equal(t, "v1", au.Info().Version)
+	assert.Error(t, au.Check(ctx))
+
+	// Файл изменился
+	repo.EXPECT().ModTime(ctx).Return(modTime.Add(time.Minute), nil)
+	repo.EXPECT().Load(ctx).Return(&entityAuth.AccessListFile{Version: "v2"}, nil)
+	logger.EXPECT().Info("access list loaded", gomock.Any(), gomock.Any())
+	assert.NoError(t, au.reloadIfModified(ctx))
+	assert.Equal(t, "v2", au.Info().Version)
+	assert.NoError(t, au.Check(ctx))
+}
@@ -0,0 +1,306 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+type localActivity struct {
+	activity entityAuth.SessionActivity
+	syncedAt time.Time
+}
+
+type activityUseCase struct {
+	repository  ActivityRepository
+	idleTimeout time.Duration
+	interval    time.Duration
+	now         func() time.Time
+	logger      ditzap.Logger
+
+	mu       sync.Mutex
+	local    map[string]*localActivity
+	prunedAt time.Time
+}
+
+// NewActivityUseCase учет активности сессий.
+//
+//	Активность сохраняется в хранилище не чаще, чем раз в interval, между сохранениями она учитывается
+//	в памяти процесса. Поэтому простой и отзыв сессии определяются с точностью до interval.
+//	Нулевой idleTimeout отключает ограничение времени простоя
+func NewActivityUseCase(repository ActivityRepository, idleTimeout, interval time.Duration, logger ditzap.Logger) *activityUseCase {
+	return &activityUseCase{
+		repository:  repository,
+		idleTimeout: idleTimeout,
+		interval:    interval,
+		now:         time.Now,
+		logger:      logger,
+		local:       make(map[string]*localActivity),
+	}
+}
+
+// Track фиксирует активность сессии.
+//
+//	Для отозванной или простаивавшей дольше допустимого сессии возвращает diterrors.ErrUnauthenticated
+func (uc *activityUseCase) Track(ctx context.Context, session *entityAuth.Session) error {
+	sessionID := session.GetID().String()
+	if sessionID == "" {
+		return nil
+	}
+
+	now := uc.now()
+	local := uc.getLocal(sessionID)
+	if local != nil && now.Sub(local.syncedAt) < uc.interval {
+		activity := local.activity
+		if err := uc.check(&activity, now); err != nil {
+			return err
+		}
+		activity.LastActiveTime = now
+		uc.setLocal(&activity, local.syncedAt, now)
+		return nil
+	}
+
+	return uc.sync(ctx, session, local, now)
+}
+
+// Session сессия по идентификатору, восстановленная из сохраненной активности без обращения к сервису авторизации.
+//
+//	Если активность сессии не сохранялась, возвращает diterrors.ErrNotFound. Для отозванной
+//	или простаивавшей дольше допустимого сессии возвращает diterrors.ErrUnauthenticated
+func (uc *activityUseCase) Session(ctx context.Context, sessionID string) (*entityAuth.Session, error) {
+	if sessionID == "" {
+		return nil, diterrors.ErrNotFound
+	}
+
+	now := uc.now()
+	if local := uc.getLocal(sessionID); local != nil && now.Sub(local.syncedAt) < uc.interval {
+		activity := local.activity
+		if err := uc.check(&activity, now); err != nil {
+			return nil, err
+		}
+		return activity.Session(), nil
+	}
+
+	activity, err := uc.repository.GetActivity(ctx, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("can't get session activity: %w", err)
+	}
+	if activity == nil {
+		return nil, diterrors.ErrNotFound
+	}
+	if err = uc.check(activity, now); err != nil {
+		return nil, err
+	}
+	// Активность только что прочитана из хранилища, следующий Track обновит ее в памяти процесса
+	uc.setLocal(activity, now, now)
+	return activity.Session(), nil
+}
+
+// Update сохраняет активность сессии сразу, без ожидания интервала синхронизации.
+//
+//	Используется после входа и смены портала, чтобы роуты без получения полной сессии видели актуальные данные
+func (uc *activityUseCase) Update(ctx context.Context, session *entityAuth.Session) error {
+	sessionID := session.GetID().String()
+	if sessionID == "" {
+		return nil
+	}
+	return uc.sync(ctx, session, uc.getLocal(sessionID), uc.now())
+}
+
+// sync объединяет активность сессии с сохраненной и сохраняет ее
+func (uc *activityUseCase) sync(ctx context.Context, session *entityAuth.Session, local *localActivity, now time.Time) error {
+	sessionID := session.GetID().String()
+	stored, err := uc.repository.GetActivity(ctx, sessionID)
+	if err != nil {
+		// Без сохраненной активности нельзя проверить отзыв сессии, поэтому запрос не пропускается
+		uc.logger.Error("can't get session activity", zap.String("session_id", sessionID), zap.Error(err))
+		return fmt.Errorf("can't get session activity: %w", err)
+	}
+
+	// Время последней активности берется из сессии и известной активности, новая сессия считается активной сейчас
+	activity := entityAuth.NewSessionActivity(session, time.Time{})
+	for _, known := range []*entityAuth.SessionActivity{stored, localActivityOf(local)} {
+		if known == nil {
+			continue
+		}
+		if !known.CreatedTime.IsZero() {
+			activity.CreatedTime = known.CreatedTime
+		}
+		if known.LastActiveTime.After(activity.LastActiveTime) {
+			activity.LastActiveTime = known.LastActiveTime
+		}
+		activity.Revoked = activity.Revoked || known.Revoked
+	}
+	if activity.LastActiveTime.IsZero() {
+		activity.LastActiveTime = now
+	}
+	if err = uc.check(activity, now); err != nil {
+		uc.setLocal(activity, now, now)
+		return err
+	}
+
+	activity.LastActiveTime = now
+	uc.save(ctx, activity, now)
+	uc.setLocal(activity, now, now)
+	return nil
+}
+
+// ListSessions возвращает действующие сессии пользователя текущей сессии
+func (uc *activityUseCase) ListSessions(ctx context.Context) ([]*entityAuth.SessionActivity, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		uc.logger.Error(usecase.ErrGetSessionFromContext.Error(), zap.Error(err))
+		return nil, usecase.ErrGetSessionFromContext
+	}
+
+	activities, err := uc.repository.ListActivities(ctx, session.GetUser().GetCloudID())
+	if err != nil {
+		uc.logger.Error("can't list session activities", zap.Error(err))
+		return nil, fmt.Errorf("can't list session activities: %w", err)
+	}
+
+	now := uc.now()
+	currentID := session.GetID().String()
+	sessions := make([]*entityAuth.SessionActivity, 0, len(activities))
+	for _, activity := range activities {
+		if local := uc.getLocal(activity.SessionID); local != nil && local.activity.LastActiveTime.After(activity.LastActiveTime) {
+			activity.LastActiveTime = local.activity.LastActiveTime
+		}
+		if uc.check(activity, now) != nil {
+			continue
+		}
+		activity.Current = activity.SessionID == currentID
+		sessions = append(sessions, activity)
+	}
+	slices.SortFunc(sessions, func(a, b *entityAuth.SessionActivity) int {
+		return b.LastActiveTime.Compare(a.LastActiveTime)
+	})
+	return sessions, nil
+}
+
+// RevokeSession отзывает сессию пользователя. Текущую сессию отозвать нельзя, для нее используется выход
+func (uc *activityUseCase) RevokeSession(ctx context.Context, sessionID string) error {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		uc.logger.Error(usecase.ErrGetSessionFromContext.Error(), zap.Error(err))
+		return usecase.ErrGetSessionFromContext
+	}
+	if sessionID == session.GetID().String() {
+		return diterrors.NewValidationError(ErrRevokeCurrent)
+	}
+
+	activity, err := uc.repository.GetActivity(ctx, sessionID)
+	if err != nil {
+		uc.logger.Error("can't get session activity", zap.String("session_id", sessionID), zap.Error(err))
+		return fmt.Errorf("can't get session activity: %w", err)
+	}
+	if activity == nil || activity.Revoked || activity.CloudID != session.GetUser().GetCloudID() {
+		return diterrors.ErrNotFound
+	}
+	return uc.revoke(ctx, activity)
+}
+
+// RevokeOtherSessions отзывает все сессии пользователя, кроме текущей. Возвращает количество отозванных сессий
+func (uc *activityUseCase) RevokeOtherSessions(ctx context.Context) (int, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		uc.logger.Error(usecase.ErrGetSessionFromContext.Error(), zap.Error(err))
+		return 0, usecase.ErrGetSessionFromContext
+	}
+
+	activities, err := uc.repository.ListActivities(ctx, session.GetUser().GetCloudID())
+	if err != nil {
+		uc.logger.Error("can't list session activities", zap.Error(err))
+		return 0, fmt.Errorf("can't list session activities: %w", err)
+	}
+
+	var revoked int
+	currentID := session.GetID().String()
+	for _, activity := range activities {
+		if activity.SessionID == currentID || activity.Revoked {
+			continue
+		}
+		if err = uc.revoke(ctx, activity); err != nil {
+			return revoked, err
+		}
+		revoked++
+	}
+	return revoked, nil
+}
+
+func (uc *activityUseCase) revoke(ctx context.Context, activity *entityAuth.SessionActivity) error {
+	now := uc.now()
+	activity.Revoked = true
+	if ttl := activity.ExpiredTime.Sub(now); ttl > 0 {
+		if err := uc.repository.RevokeSession(ctx, activity.SessionID, ttl); err != nil {
+			uc.logger.Error("can't revoke session", zap.String("session_id", activity.SessionID), zap.Error(err))
+			return fmt.Errorf("can't revoke session: %w", err)
+		}
+	}
+	uc.setLocal(activity, now, now)
+	return nil
+}
+
+// check проверяет, что сессия не отозвана и не простаивала дольше допустимого
+func (uc *activityUseCase) check(activity *entityAuth.SessionActivity, now time.Time) error {
+	if activity.Revoked {
+		return fmt.Errorf("%w: %w", ErrSessionRevoked, diterrors.ErrUnauthenticated)
+	}
+	if uc.idleTimeout > 0 && now.Sub(activity.LastActiveTime) > uc.idleTimeout {
+		return fmt.Errorf("%w: %w", ErrSessionIdle, diterrors.ErrUnauthenticated)
+	}
+	return nil
+}
+
+// save сохраняет активность до истечения срока действия сессии
+func (uc *activityUseCase) save(ctx context.Context, activity *entityAuth.SessionActivity, now time.Time) {
+	ttl := activity.ExpiredTime.Sub(now)
+	if ttl <= 0 {
+		return
+	}
+	if err := uc.repository.SaveActivity(ctx, activity, ttl); err != nil {
+		uc.logger.Warn("can't save session activity", zap.String("session_id", activity.SessionID), zap.Error(err))
+	}
+}
+
+func (uc *activityUseCase) getLocal(sessionID string) *localActivity {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+	return uc.local[sessionID]
+}
+
+// setLocal запоминает активность в памяти процесса, заодно удаляя давно не синхронизированные записи
+func (uc *activityUseCase) setLocal(activity *entityAuth.SessionActivity, syncedAt, now time.Time) {
+	uc.mu.Lock()
+	defer uc.mu.Unlock()
+
+	if now.Sub(uc.prunedAt) >= uc.interval {
+		for sessionID, local := range uc.local {
+			if now.Sub(local.syncedAt) >= uc.interval {
+				delete(uc.local, sessionID)
+			}
+		}
+		uc.prunedAt = now
+	}
+	uc.local[activity.SessionID] = &localActivity{
+		activity: *activity,
+		syncedAt: syncedAt,
+	}
+}
+
+func localActivityOf(local *localActivity) *entityAuth.SessionActivity {
+	if local == nil {
+		return nil
+	}
+	return &local.activity
+}
@@ -0,0 +1,480 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+func testActivitySession(cloudID string, now time.Time) *entityAuth.Session {
+	sessionID := entityAuth.SessionID(uuid.New())
+	return &entityAuth.Session{
+		ID:                 &sessionID,
+		User:               &entityAuth.User{CloudID: cloudID},
+		UserIP:             net.ParseIP("127.0.0.1"),
+		Device:             &entityAuth.Device{UserAgent: "testUserAgent"},
+		AccessExpiredTime:  now.Add(time.Hour),
+		RefreshExpiredTime: now.Add(24 * time.Hour),
+		CreatedTime:        now.Add(-time.Hour),
+	}
+}
+
+func Test_activityUseCase_Track(t *testing.T) {
+	type fields struct {
+		repo   *MockActivityRepository
+		logger *ditzap.MockLogger
+	}
+
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	testErr := errors.New("test error")
+
+	tests := []struct {
+		name    string
+		prepare func(f fields, session *entityAuth.Session)
+		wantErr error
+	}{
+		{
+			name: "new session",
+			prepare: func(f fields, session *entityAuth.Session) {
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, nil)
+				want := entityAuth.NewSessionActivity(session, now)
+				f.repo.EXPECT().SaveActivity(ctx, want, 24*time.Hour).Return(nil)
+			},
+		},
+		{
+			name: "stored activity",
+			prepare: func(f fields, session *entityAuth.Session) {
+				stored := entityAuth.NewSessionActivity(session, now.Add(-10*time.Minute))
+				stored.CreatedTime = now.Add(-2 * time.Hour)
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(stored, nil)
+				want := entityAuth.NewSessionActivity(session, now)
+				want.CreatedTime = stored.CreatedTime
+				f.repo.EXPECT().SaveActivity(ctx, want, 24*time.Hour).Return(nil)
+			},
+		},
+		{
+			name: "idle timeout exceeded",
+			prepare: func(f fields, session *entityAuth.Session) {
+				stored := entityAuth.NewSessionActivity(session, now.Add(-2*time.Hour))
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(stored, nil)
+			},
+			wantErr: ErrSessionIdle,
+		},
+		{
+			name: "revoked",
+			prepare: func(f fields, session *entityAuth.Session) {
+				stored := entityAuth.NewSessionActivity(session, now)
+				stored.Revoked = true
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(stored, nil)
+			},
+			wantErr: ErrSessionRevoked,
+		},
+		{
+			name: "repository err",
+			prepare: func(f fields, session *entityAuth.Session) {
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, testErr)
+				f.logger.EXPECT().Error("can't get session activity", gomock.Any(), gomock.Any())
+			},
+			wantErr: testErr,
+		},
+		{
+			name: "save err",
+			prepare: func(f fields, session *entityAuth.Session) {
+				f.repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, nil)
+				f.repo.EXPECT().SaveActivity(ctx, gomock.Any(), 24*time.Hour).Return(testErr)
+				f.logger.EXPECT().Warn("can't save session activity", gomock.Any(), gomock.Any())
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:   NewMockActivityRepository(ctrl),
+				logger: ditzap.NewMockLogger(ctrl),
+			}
+			session := testActivitySession("testCloudID", now)
+			tt.prepare(f, session)
+
+			uc := NewActivityUseCase(f.repo, time.Hour, time.Minute, f.logger)
+			uc.now = func() time.Time { return now }
+
+			err := uc.Track(ctx, session)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				if tt.wantErr != testErr {
+					assert.ErrorIs(t, err, diterrors.ErrUnauthenticated)
+				}
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_activityUseCase_Track_debounce(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	repo := NewMockActivityRepository(ctrl)
+	session := testActivitySession("testCloudID", now)
+
+	uc := NewActivityUseCase(repo, 0, time.Minute, ditzap.NewMockLogger(ctrl))
+	uc.now = func() time.Time { return now }
+
+	repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, nil)
+	repo.EXPECT().SaveActivity(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	assert.NoError(t, uc.Track(ctx, session))
+
+	// В пределах интервала активность учитывается только в памяти
+	now = now.Add(30 * time.Second)
+	assert.NoError(t, uc.Track(ctx, session))
+
+	now = now.Add(time.Minute)
+	stored := entityAuth.NewSessionActivity(session, now.Add(-time.Minute))
+	repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(stored, nil)
+	repo.EXPECT().SaveActivity(ctx, gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, activity *entityAuth.SessionActivity, _ time.Duration) {
+			assert.Equal(t, now, activity.LastActiveTime)
+		}).
+		Return(nil)
+	assert.NoError(t, uc.Track(ctx, session))
+}
+
+func Test_activityUseCase_Session(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	testErr := errors.New("test error")
+
+	session := testActivitySession("testCloudID", now)
+	session.User.Email = "user@mos.ru"
+	session.ActivePortal = &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1, URL: "https://portal"}, SID: "testSID"}
+	sessionID := session.GetID().String()
+
+	tests := []struct {
+		name    string
+		prepare func(repo *MockActivityRepository)
+		want    *entityAuth.Session
+		wantErr error
+	}{
+		{
+			name: "unknown session",
+			prepare: func(repo *MockActivityRepository) {
+				repo.EXPECT().GetActivity(ctx, sessionID).Return(nil, nil)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name: "repository err",
+			prepare: func(repo *MockActivityRepository) {
+				repo.EXPECT().GetActivity(ctx, sessionID).Return(nil, testErr)
+			},
+			wantErr: testErr,
+		},
+		{
+			name: "revoked",
+			prepare: func(repo *MockActivityRepository) {
+				stored := entityAuth.NewSessionActivity(session, now)
+				stored.Revoked = true
+				repo.EXPECT().GetActivity(ctx, sessionID).Return(stored, nil)
+			},
+			wantErr: ErrSessionRevoked,
+		},
+		{
+			name: "idle timeout exceeded",
+			prepare: func(repo *MockActivityRepository) {
+				repo.EXPECT().GetActivity(ctx, sessionID).Return(entityAuth.NewSessionActivity(session, now.Add(-2*time.Hour)), nil)
+			},
+			wantErr: ErrSessionIdle,
+		},
+		{
+			name: "correct",
+			prepare: func(repo *MockActivityRepository) {
+				repo.EXPECT().GetActivity(ctx, sessionID).Return(entityAuth.NewSessionActivity(session, now.Add(-time.Minute)), nil)
+			},
+			want: func() *entityAuth.Session {
+				lastActiveTime := now.Add(-time.Minute)
+				return &entityAuth.Session{
+					ID:                 session.ID,
+					User:               &entityAuth.User{CloudID: "testCloudID", Email: "user@mos.ru"},
+					UserIP:             session.UserIP,
+					Device:             session.Device,
+					ActivePortal:       session.ActivePortal,
+					LastActiveTime:     &lastActiveTime,
+					RefreshExpiredTime: session.RefreshExpiredTime,
+					CreatedTime:        session.CreatedTime,
+					IsActive:           true,
+				}
+			}(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockActivityRepository(ctrl)
+			tt.prepare(repo)
+
+			uc := NewActivityUseCase(repo, time.Hour, time.Minute, ditzap.NewMockLogger(ctrl))
+			uc.now = func() time.Time { return now }
+
+			got, err := uc.Session(ctx, sessionID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			// Повторная проверка в пределах интервала не обращается к хранилищу
+			got, err = uc.Session(ctx, sessionID)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_activityUseCase_Update(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	repo := NewMockActivityRepository(ctrl)
+	session := testActivitySession("testCloudID", now)
+
+	uc := NewActivityUseCase(repo, 0, time.Minute, ditzap.NewMockLogger(ctrl))
+	uc.now = func() time.Time { return now }
+
+	repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, nil)
+	repo.EXPECT().SaveActivity(ctx, gomock.Any(), gomock.Any()).Return(nil)
+	assert.NoError(t, uc.Track(ctx, session))
+
+	// Смена портала сохраняется сразу, не дожидаясь интервала синхронизации
+	now = now.Add(10 * time.Second)
+	changed := *session
+	changed.ActivePortal = &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 2}, SID: "newSID"}
+	repo.EXPECT().GetActivity(ctx, session.GetID().String()).Return(nil, nil)
+	repo.EXPECT().SaveActivity(ctx, gomock.Any(), gomock.Any()).
+		Do(func(_ context.Context, activity *entityAuth.SessionActivity, _ time.Duration) {
+			assert.Equal(t, changed.ActivePortal, activity.ActivePortal)
+		}).
+		Return(nil)
+	assert.NoError(t, uc.Update(ctx, &changed))
+
+	got, err := uc.Session(ctx, session.GetID().String())
+	assert.NoError(t, err)
+	assert.Equal(t, changed.ActivePortal, got.ActivePortal)
+}
+
+func Test_activityUseCase_ListSessions(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	testErr := errors.New("test error")
+
+	current := testActivitySession("testCloudID", now)
+	currentActivity := entityAuth.NewSessionActivity(current, now.Add(-time.Minute))
+	other := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+	idle := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now.Add(-2*time.Hour))
+	revoked := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+	revoked.Revoked = true
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		prepare func(repo *MockActivityRepository, logger *ditzap.MockLogger)
+		want    []*entityAuth.SessionActivity
+		wantErr error
+	}{
+		{
+			name: "get session err",
+			ctx:  ctx,
+			prepare: func(repo *MockActivityRepository, logger *ditzap.MockLogger) {
+				logger.EXPECT().Error(usecase.ErrGetSessionFromContext.Error(), gomock.Any())
+			},
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name: "repository err",
+			ctx:  entity.WithSession(ctx, current),
+			prepare: func(repo *MockActivityRepository, logger *ditzap.MockLogger) {
+				repo.EXPECT().ListActivities(gomock.Any(), "testCloudID").Return(nil, testErr)
+				logger.EXPECT().Error("can't list session activities", gomock.Any())
+			},
+			wantErr: testErr,
+		},
+		{
+			name: "correct",
+			ctx:  entity.WithSession(ctx, current),
+			prepare: func(repo *MockActivityRepository, logger *ditzap.MockLogger) {
+				repo.EXPECT().ListActivities(gomock.Any(), "testCloudID").
+					Return([]*entityAuth.SessionActivity{currentActivity, idle, revoked, other}, nil)
+			},
+			want: []*entityAuth.SessionActivity{other, currentActivity},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockActivityRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			tt.prepare(repo, logger)
+
+			uc := NewActivityUseCase(repo, time.Hour, time.Minute, logger)
+			uc.now = func() time.Time { return now }
+
+			got, err := uc.ListSessions(tt.ctx)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.True(t, currentActivity.Current)
+			assert.False(t, other.Current)
+		})
+	}
+}
+
+func Test_activityUseCase_RevokeSession(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	testErr := errors.New("test error")
+
+	current := testActivitySession("testCloudID", now)
+	sessionCtx := entity.WithSession(ctx, current)
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		sessionID string
+		prepare   func(repo *MockActivityRepository, logger *ditzap.MockLogger, sessionID string)
+		wantErr   error
+		// wantValidationErr ожидается ошибка валидации
+		wantValidationErr bool
+	}{
+		{
+			name:      "get session err",
+			ctx:       ctx,
+			sessionID: uuid.NewString(),
+			prepare: func(repo *MockActivityRepository, logger *ditzap.MockLogger, _ string) {
+				logger.EXPECT().Error(usecase.ErrGetSessionFromContext.Error(), gomock.Any())
+			},
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name:              "current session",
+			ctx:               sessionCtx,
+			sessionID:         current.GetID().String(),
+			prepare:           func(*MockActivityRepository, *ditzap.MockLogger, string) {},
+			wantValidationErr: true,
+		},
+		{
+			name:      "other user session",
+			ctx:       sessionCtx,
+			sessionID: uuid.NewString(),
+			prepare: func(repo *MockActivityRepository, _ *ditzap.MockLogger, sessionID string) {
+				activity := entityAuth.NewSessionActivity(testActivitySession("otherCloudID", now), now)
+				repo.EXPECT().GetActivity(gomock.Any(), sessionID).Return(activity, nil)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name:      "not found",
+			ctx:       sessionCtx,
+			sessionID: uuid.NewString(),
+			prepare: func(repo *MockActivityRepository, _ *ditzap.MockLogger, sessionID string) {
+				repo.EXPECT().GetActivity(gomock.Any(), sessionID).Return(nil, nil)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name:      "save err",
+			ctx:       sessionCtx,
+			sessionID: uuid.NewString(),
+			prepare: func(repo *MockActivityRepository, logger *ditzap.MockLogger, sessionID string) {
+				activity := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+				repo.EXPECT().GetActivity(gomock.Any(), sessionID).Return(activity, nil)
+				repo.EXPECT().RevokeSession(gomock.Any(), activity.SessionID, 24*time.Hour).Return(testErr)
+				logger.EXPECT().Error("can't revoke session", gomock.Any(), gomock.Any())
+			},
+			wantErr: testErr,
+		},
+		{
+			name:      "correct",
+			ctx:       sessionCtx,
+			sessionID: uuid.NewString(),
+			prepare: func(repo *MockActivityRepository, _ *ditzap.MockLogger, sessionID string) {
+				activity := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+				repo.EXPECT().GetActivity(gomock.Any(), sessionID).Return(activity, nil)
+				repo.EXPECT().RevokeSession(gomock.Any(), activity.SessionID, 24*time.Hour).Return(nil)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockActivityRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			tt.prepare(repo, logger, tt.sessionID)
+
+			uc := NewActivityUseCase(repo, time.Hour, time.Minute, logger)
+			uc.now = func() time.Time { return now }
+
+			err := uc.RevokeSession(tt.ctx, tt.sessionID)
+			if tt.wantValidationErr {
+				assert.True(t, errors.As(err, new(diterrors.ValidationError)), err)
+			} else if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_activityUseCase_RevokeOtherSessions(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	ctrl := gomock.NewController(t)
+	repo := NewMockActivityRepository(ctrl)
+
+	current := testActivitySession("testCloudID", now)
+	other := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+	revoked := entityAuth.NewSessionActivity(testActivitySession("testCloudID", now), now)
+	revoked.Revoked = true
+
+	repo.EXPECT().ListActivities(gomock.Any(), "testCloudID").Return([]*entityAuth.SessionActivity{
+		entityAuth.NewSessionActivity(current, now), other, revoked,
+	}, nil)
+	repo.EXPECT().RevokeSession(gomock.Any(), other.SessionID, 24*time.Hour).Return(nil)
+
+	uc := NewActivityUseCase(repo, time.Hour, time.Minute, ditzap.NewMockLogger(ctrl))
+	uc.now = func() time.Time { return now }
+
+	got, err := uc.RevokeOtherSessions(entity.WithSession(ctx, current))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, got)
+	assert.True(t, other.Revoked)
+
+	// Отозванная сессия отклоняется без обращения к хранилищу
+	otherSessionID := entityAuth.SessionID(uuid.MustParse(other.SessionID))
+	otherSession := testActivitySession("testCloudID", now)
+	otherSession.ID = &otherSessionID
+	assert.ErrorIs(t, uc.Track(ctx, otherSession), ErrSessionRevoked)
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
@@ -19,14 +21,36 @@ type authUseCase struct {
 	repository Repository
 	logger     ditzap.Logger
 
-	accessList entityAuth.AccessList
+	accessChecker        AccessChecker
+	tokenVerifier        TokenVerifier
+	activityTracker      ActivityTracker
+	portalsRepository    PortalsRepository
+	lastPortalRepository LastPortalRepository
 }
 
-func NewAuthUseCase(repository Repository, logger ditzap.Logger, accessList entityAuth.AccessList) *authUseCase {
+// NewAuthUseCase use-кейсы авторизации.
+//
+//	accessChecker, tokenVerifier и activityTracker необязательны: без них не проверяется список доступа,
+//	не выполняется локальная проверка токена доступа и не учитывается активность сессий.
+//	portalsRepository и lastPortalRepository необязательны: без них при входе не учитываются
+//	основное место работы и последний выбранный пользователем портал
+func NewAuthUseCase(
+	repository Repository,
+	logger ditzap.Logger,
+	accessChecker AccessChecker,
+	tokenVerifier TokenVerifier,
+	activityTracker ActivityTracker,
+	portalsRepository PortalsRepository,
+	lastPortalRepository LastPortalRepository,
+) *authUseCase {
 	return &authUseCase{
-		repository: repository,
-		logger:     logger,
-		accessList: accessList,
+		repository:           repository,
+		logger:               logger,
+		accessChecker:        accessChecker,
+		tokenVerifier:        tokenVerifier,
+		activityTracker:      activityTracker,
+		portalsRepository:    portalsRepository,
+		lastPortalRepository: lastPortalRepository,
 	}
 }
 
@@ -46,7 +70,11 @@ func (a *authUseCase) GetAuthURL(ctx context.Context, callbackURI string) (strin
 	return redirectURL, nil
 }
 
-func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string) (*entityAuth.Auth, error) {
+// Auth аутентификация пользователя в СУДИР и на портале.
+//
+//	Ненулевой portalID задает портал, на котором пользователь хочет работать. Если аутентификация
+//	на портале не удалась, выполняется попытка аутентификации на следующем портале пользователя
+func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string, portalID int) (*entityAuth.Auth, error) {
 	info, err := a.repository.Auth(ctx, code, state, callbackURI)
 	if err != nil {
 		if errors.Is(err, diterrors.ErrNotFound) {
@@ -72,9 +100,9 @@ func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string)
 		return nil, diterrors.ErrPermissionDenied
 	}
 
-	if a.accessList != nil && len(a.accessList) > 0 {
-		if !a.accessList.Have(info.GetUser().Email) {
-			return nil, ErrUserAccessDenied
+	if a.accessChecker != nil {
+		if err = a.accessChecker.CheckAccess(info.GetUser().Email, 0); err != nil {
+			return nil, err
 		}
 	}
 
@@ -96,34 +124,50 @@ func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string)
 		)
 		return nil, ErrPortalsNotFound
 	}
-	auth1C, err := a.repository.AuthPortal(ctx, entityAuth.AuthPortalParams{
-		// TODO: временно аутентифицируем на первом портале
-		//  в дальнейшем брать основное место работы по умолчанию
-		//  если несколько мест работы
-		PortalURL: info.GetUser().Portals[0].URL,
-		User: entityAuth.User1C{
-			CloudID: info.GetUser().CloudID,
-			SNILS:   info.GetUser().SNILS,
-			Email:   info.GetUser().Email,
-		},
-	})
+	candidates, err := a.portalCandidates(ctx, info.GetUser(), portalID)
 	if err != nil {
+		return nil, err
+	}
+	if len(candidates) == 0 {
+		return nil, ErrPortalsNotFound
+	}
+
+	var (
+		auth1C         *entityAuth.Auth1C
+		selectedPortal *entityAuth.Portal
+	)
+	for _, portal := range candidates {
+		auth1C, err = a.repository.AuthPortal(ctx, entityAuth.AuthPortalParams{
+			PortalURL: portal.URL,
+			User: entityAuth.User1C{
+				CloudID: info.GetUser().CloudID,
+				SNILS:   info.GetUser().SNILS,
+				Email:   info.GetUser().Email,
+			},
+		})
+		if err == nil {
+			selectedPortal = portal
+			break
+		}
 		// TODO: сделать рефакторинг.
 		if !errors.As(err, new(diterrors.ValidationError)) && errors.As(err, new(diterrors.LocalizedError)) {
 			a.logger.Error("cant authenticate user into portal",
 				zap.String("user_id", info.GetUser().CloudID),
-				zap.String("portal_url", info.GetUser().Portals[0].URL),
+				zap.String("portal_url", portal.URL),
 				zap.Error(err),
 			)
-		} else if errors.Is(err, diterrors.ErrNotFound) {
-			return nil, err //nolint:wrapcheck
-		} else {
+		} else if !errors.Is(err, diterrors.ErrNotFound) {
 			a.logger.Debug("cant authenticate user into portal",
 				zap.String("user_id", info.GetUser().CloudID),
-				zap.String("portal_url", info.GetUser().Portals[0].URL),
+				zap.String("portal_url", portal.URL),
 				zap.Error(err),
 			)
 		}
+	}
+	if selectedPortal == nil {
+		if errors.Is(err, diterrors.ErrNotFound) {
+			return nil, err //nolint:wrapcheck
+		}
 		return nil, fmt.Errorf("cant authenticate user: %w", err)
 	}
 
@@ -134,7 +178,7 @@ func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string)
 		return nil, ErrInvalidDevice
 	}
 
-	tokensPair, err := a.repository.CreateSession(ctx, info.GetUser(), clientIP, device, auth1C)
+	tokensPair, err := a.repository.CreateSession(ctx, info.GetUser(), selectedPortal, clientIP, device, auth1C)
 	if err != nil {
 		if errors.Is(err, repositories.ErrNoUserPortals) {
 			return nil, ErrPortalsNotFound
@@ -146,6 +190,21 @@ func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string)
 		return nil, fmt.Errorf("can't create session: %w", err)
 	}
 
+	a.rememberPortal(ctx, info.GetUser().CloudID, selectedPortal.ID)
+	a.registerSession(ctx, tokensPair, &entityAuth.Session{
+		User: &entityAuth.User{
+			CloudID: info.GetUser().CloudID,
+			Login:   info.GetUser().Login,
+			Email:   info.GetUser().Email,
+			SNILS:   info.GetUser().SNILS,
+		},
+		UserIP:       clientIP,
+		Device:       device,
+		ActivePortal: &entityAuth.ActivePortal{Portal: *selectedPortal, SID: auth1C.PortalSession},
+		CreatedTime:  time.Now(),
+	})
+	selectedPortal.IsSelected = true
+
 	return &entityAuth.Auth{
 		JWTToken:      tokensPair.AccessToken,
 		RefreshToken:  tokensPair.RefreshToken,
@@ -157,26 +216,110 @@ func (a *authUseCase) Auth(ctx context.Context, code, state, callbackURI string)
 func (a *authUseCase) GetSession(ctx context.Context, accessToken string) (*entityAuth.Session, error) {
 	session, err := a.repository.GetSession(ctx, accessToken)
 	if err != nil {
-		switch {
-		case errors.As(err, new(diterrors.ValidationError)):
-			return nil, err
-		case errors.Is(err, diterrors.ErrFailedPrecondition):
-			return nil, err
-		case errors.Is(err, diterrors.ErrNotFound):
+		return nil, a.sessionError(accessToken, err)
+	}
+	return session, nil
+}
+
+// CheckToken проверка токена доступа для роутов, которым достаточно аутентификации.
+//
+//	Подпись и срок действия токена проверяются локально, затем по идентификатору сессии из токена
+//	проверяется, что сессия известна, не отозвана и не простаивала. Сессия в сервисе авторизации
+//	не запрашивается: пользователь и активный портал берутся из сохраненной активности сессии,
+//	по ним же проверяется список доступа. Без ключей проверки токена или учета активности
+//	проверить токен локально нельзя, поэтому сессия получается так же, как на остальных роутах
+func (a *authUseCase) CheckToken(ctx context.Context, accessToken string) (*entityAuth.Session, error) {
+	if a.tokenVerifier == nil || a.activityTracker == nil {
+		session, err := a.GetSession(ctx, accessToken)
+		if err != nil {
 			return nil, err
-		case errors.Is(err, diterrors.ErrUnauthenticated):
+		}
+		if err = a.CheckAccess(ctx, session); err != nil {
 			return nil, err
-		default:
-			a.logger.Warn("can't get session in repository",
-				ditzap.JWTField("access_token", accessToken),
-				zap.Error(err),
-			)
-			return nil, fmt.Errorf("can't get session in repository: %w", err)
 		}
+		return session, nil
+	}
+
+	claims, err := a.tokenVerifier.Verify(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	session, err := a.activityTracker.Session(ctx, claims.SessionID)
+	if err != nil {
+		return nil, a.sessionError(accessToken, err)
+	}
+	if err = a.CheckAccess(ctx, session); err != nil {
+		return nil, err
 	}
 	return session, nil
 }
 
+// registerSession сохраняет активность созданной сессии, чтобы роуты, которым достаточно аутентификации,
+// принимали токен сразу после входа. Ошибки не прерывают вход: сессия будет сохранена при первом запросе
+// с получением полной сессии
+func (a *authUseCase) registerSession(ctx context.Context, tokens entityAuth.TokensPair, session *entityAuth.Session) {
+	if a.tokenVerifier == nil || a.activityTracker == nil {
+		return
+	}
+
+	claims, err := a.tokenVerifier.Verify(tokens.AccessToken.Value)
+	if err != nil {
+		a.logger.Warn("can't verify created access token", zap.Error(err))
+		return
+	}
+	id, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		a.logger.Warn("invalid session id in created access token", zap.String("session_id", claims.SessionID), zap.Error(err))
+		return
+	}
+	sessionID := entityAuth.SessionID(id)
+	session.ID = &sessionID
+	session.AccessExpiredTime = claims.ExpiresAt
+	if tokens.RefreshToken.ExpiredAt != nil {
+		session.RefreshExpiredTime = *tokens.RefreshToken.ExpiredAt
+	}
+
+	if err = a.activityTracker.Update(ctx, session); err != nil {
+		a.logger.Warn("can't save session activity", zap.String("session_id", claims.SessionID), zap.Error(err))
+	}
+}
+
+// sessionError ошибки получения сессии, которые обрабатываются вызывающим кодом, возвращаются как есть
+func (a *authUseCase) sessionError(accessToken string, err error) error {
+	switch {
+	case errors.As(err, new(diterrors.ValidationError)):
+		return err
+	case errors.Is(err, diterrors.ErrFailedPrecondition):
+		return err
+	case errors.Is(err, diterrors.ErrNotFound):
+		return err
+	case errors.Is(err, diterrors.ErrUnauthenticated):
+		return err
+	default:
+		a.logger.Warn("can't get session in repository",
+			ditzap.JWTField("access_token", accessToken),
+			zap.Error(err),
+		)
+		return fmt.Errorf("can't get session in repository: %w", err)
+	}
+}
+
+// CheckAccess проверяет доступ пользователя сессии к активному порталу по списку доступа
+func (a *authUseCase) CheckAccess(_ context.Context, session *entityAuth.Session) error {
+	if a.accessChecker == nil {
+		return nil
+	}
+	return a.accessChecker.CheckAccess(session.GetUser().GetEmail(), session.GetActivePortal().GetPortalID())
+}
+
+// TrackActivity фиксирует активность сессии
+func (a *authUseCase) TrackActivity(ctx context.Context, session *entityAuth.Session) error {
+	if a.activityTracker == nil {
+		return nil
+	}
+	return a.activityTracker.Track(ctx, session)
+}
+
 func (a *authUseCase) Logout(ctx context.Context, accessToken, refreshToken string) error {
 	session, err := entity.SessionFromContext(ctx)
 	if err != nil {
@@ -251,6 +394,16 @@ func (a *authUseCase) ChangePortal(ctx context.Context, selectedPortalID int) ([
 		return nil, "", ErrUnavailablePortal
 	}
 
+	a.rememberPortal(ctx, session.GetUser().GetCloudID(), selectedPortal.ID)
+
+	if a.activityTracker != nil {
+		changed := *session
+		changed.ActivePortal = &entityAuth.ActivePortal{Portal: *selectedPortal, SID: portalID}
+		if err = a.activityTracker.Update(ctx, &changed); err != nil {
+			a.logger.Warn("can't update session activity", zap.String("session_id", session.GetID().String()), zap.Error(err))
+		}
+	}
+
 	return portals, portalID, nil
 }
 
@@ -13,8 +13,10 @@ import (
 	context "context"
 	net "net"
 	reflect "reflect"
+	time "time"
 
 	auth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	portal "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portal"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -89,18 +91,18 @@ func (mr *MockRepositoryMockRecorder) ChangePortal(ctx, portalID, session any) *
 }
 
 // CreateSession mocks base method.
-func (m *MockRepository) CreateSession(ctx context.Context, user *auth.UserSudir, clientIP net.IP, device *auth.Device, arg4 *auth.Auth1C) (auth.TokensPair, error) {
+func (m *MockRepository) CreateSession(ctx context.Context, user *auth.UserSudir, arg2 *auth.Portal, clientIP net.IP, device *auth.Device, arg5 *auth.Auth1C) (auth.TokensPair, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, user, clientIP, device, arg4)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, user, arg2, clientIP, device, arg5)
 	ret0, _ := ret[0].(auth.TokensPair)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockRepositoryMockRecorder) CreateSession(ctx, user, clientIP, device, arg4 any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) CreateSession(ctx, user, arg2, clientIP, device, arg5 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), ctx, user, clientIP, device, arg4)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRepository)(nil).CreateSession), ctx, user, arg2, clientIP, device, arg5)
 }
 
 // GetRedirectURL mocks base method.
@@ -162,6 +164,98 @@ func (mr *MockRepositoryMockRecorder) RefreshTokensPair(ctx, accessToken, refres
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshTokensPair", reflect.TypeOf((*MockRepository)(nil).RefreshTokensPair), ctx, accessToken, refreshToken)
 }
 
+// MockPortalsRepository is a mock of PortalsRepository interface.
+type MockPortalsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPortalsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPortalsRepositoryMockRecorder is the mock recorder for MockPortalsRepository.
+type MockPortalsRepositoryMockRecorder struct {
+	mock *MockPortalsRepository
+}
+
+// NewMockPortalsRepository creates a new mock instance.
+func NewMockPortalsRepository(ctrl *gomock.Controller) *MockPortalsRepository {
+	mock := &MockPortalsRepository{ctrl: ctrl}
+	mock.recorder = &MockPortalsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPortalsRepository) EXPECT() *MockPortalsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Filter mocks base method.
+func (m *MockPortalsRepository) Filter(ctx context.Context, options portal.PortalsFilterOptions) ([]*portal.Portal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, options)
+	ret0, _ := ret[0].([]*portal.Portal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockPortalsRepositoryMockRecorder) Filter(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockPortalsRepository)(nil).Filter), ctx, options)
+}
+
+// MockLastPortalRepository is a mock of LastPortalRepository interface.
+type MockLastPortalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLastPortalRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLastPortalRepositoryMockRecorder is the mock recorder for MockLastPortalRepository.
+type MockLastPortalRepositoryMockRecorder struct {
+	mock *MockLastPortalRepository
+}
+
+// NewMockLastPortalRepository creates a new mock instance.
+func NewMockLastPortalRepository(ctrl *gomock.Controller) *MockLastPortalRepository {
+	mock := &MockLastPortalRepository{ctrl: ctrl}
+	mock.recorder = &MockLastPortalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLastPortalRepository) EXPECT() *MockLastPortalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetLastPortal mocks base method.
+func (m *MockLastPortalRepository) GetLastPortal(ctx context.Context, cloudID string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastPortal", ctx, cloudID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastPortal indicates an expected call of GetLastPortal.
+func (mr *MockLastPortalRepositoryMockRecorder) GetLastPortal(ctx, cloudID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastPortal", reflect.TypeOf((*MockLastPortalRepository)(nil).GetLastPortal), ctx, cloudID)
+}
+
+// SaveLastPortal mocks base method.
+func (m *MockLastPortalRepository) SaveLastPortal(ctx context.Context, cloudID string, portalID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveLastPortal", ctx, cloudID, portalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveLastPortal indicates an expected call of SaveLastPortal.
+func (mr *MockLastPortalRepositoryMockRecorder) SaveLastPortal(ctx, cloudID, portalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveLastPortal", reflect.TypeOf((*MockLastPortalRepository)(nil).SaveLastPortal), ctx, cloudID, portalID)
+}
+
 // MockRedirectSessionRepository is a mock of RedirectSessionRepository interface.
 type MockRedirectSessionRepository struct {
 	ctrl     *gomock.Controller
@@ -200,3 +294,283 @@ func (mr *MockRedirectSessionRepositoryMockRecorder) CreateSession(ctx, userInfo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockRedirectSessionRepository)(nil).CreateSession), ctx, userInfo)
 }
+
+// MockAccessListRepository is a mock of AccessListRepository interface.
+type MockAccessListRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessListRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessListRepositoryMockRecorder is the mock recorder for MockAccessListRepository.
+type MockAccessListRepositoryMockRecorder struct {
+	mock *MockAccessListRepository
+}
+
+// NewMockAccessListRepository creates a new mock instance.
+func NewMockAccessListRepository(ctrl *gomock.Controller) *MockAccessListRepository {
+	mock := &MockAccessListRepository{ctrl: ctrl}
+	mock.recorder = &MockAccessListRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessListRepository) EXPECT() *MockAccessListRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Load mocks base method.
+func (m *MockAccessListRepository) Load(ctx context.Context) (*auth.AccessListFile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Load", ctx)
+	ret0, _ := ret[0].(*auth.AccessListFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Load indicates an expected call of Load.
+func (mr *MockAccessListRepositoryMockRecorder) Load(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Load", reflect.TypeOf((*MockAccessListRepository)(nil).Load), ctx)
+}
+
+// ModTime mocks base method.
+func (m *MockAccessListRepository) ModTime(ctx context.Context) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModTime", ctx)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModTime indicates an expected call of ModTime.
+func (mr *MockAccessListRepositoryMockRecorder) ModTime(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModTime", reflect.TypeOf((*MockAccessListRepository)(nil).ModTime), ctx)
+}
+
+// MockAccessChecker is a mock of AccessChecker interface.
+type MockAccessChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessCheckerMockRecorder is the mock recorder for MockAccessChecker.
+type MockAccessCheckerMockRecorder struct {
+	mock *MockAccessChecker
+}
+
+// NewMockAccessChecker creates a new mock instance.
+func NewMockAccessChecker(ctrl *gomock.Controller) *MockAccessChecker {
+	mock := &MockAccessChecker{ctrl: ctrl}
+	mock.recorder = &MockAccessCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessChecker) EXPECT() *MockAccessCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockAccessChecker) CheckAccess(email string, portalID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", email, portalID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockAccessCheckerMockRecorder) CheckAccess(email, portalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockAccessChecker)(nil).CheckAccess), email, portalID)
+}
+
+// MockTokenVerifier is a mock of TokenVerifier interface.
+type MockTokenVerifier struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenVerifierMockRecorder
+	isgomock struct{}
+}
+
+// MockTokenVerifierMockRecorder is the mock recorder for MockTokenVerifier.
+type MockTokenVerifierMockRecorder struct {
+	mock *MockTokenVerifier
+}
+
+// NewMockTokenVerifier creates a new mock instance.
+func NewMockTokenVerifier(ctrl *gomock.Controller) *MockTokenVerifier {
+	mock := &MockTokenVerifier{ctrl: ctrl}
+	mock.recorder = &MockTokenVerifierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenVerifier) EXPECT() *MockTokenVerifierMockRecorder {
+	return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockTokenVerifier) Verify(accessToken string) (*auth.TokenClaims, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Verify", accessToken)
+	ret0, _ := ret[0].(*auth.TokenClaims)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTokenVerifierMockRecorder) Verify(accessToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTokenVerifier)(nil).Verify), accessToken)
+}
+
+// MockActivityRepository is a mock of ActivityRepository interface.
+type MockActivityRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityRepositoryMockRecorder is the mock recorder for MockActivityRepository.
+type MockActivityRepositoryMockRecorder struct {
+	mock *MockActivityRepository
+}
+
+// NewMockActivityRepository creates a new mock instance.
+func NewMockActivityRepository(ctrl *gomock.Controller) *MockActivityRepository {
+	mock := &MockActivityRepository{ctrl: ctrl}
+	mock.recorder = &MockActivityRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityRepository) EXPECT() *MockActivityRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetActivity mocks base method.
+func (m *MockActivityRepository) GetActivity(ctx context.Context, sessionID string) (*auth.SessionActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivity", ctx, sessionID)
+	ret0, _ := ret[0].(*auth.SessionActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivity indicates an expected call of GetActivity.
+func (mr *MockActivityRepositoryMockRecorder) GetActivity(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivity", reflect.TypeOf((*MockActivityRepository)(nil).GetActivity), ctx, sessionID)
+}
+
+// ListActivities mocks base method.
+func (m *MockActivityRepository) ListActivities(ctx context.Context, cloudID string) ([]*auth.SessionActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListActivities", ctx, cloudID)
+	ret0, _ := ret[0].([]*auth.SessionActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListActivities indicates an expected call of ListActivities.
+func (mr *MockActivityRepositoryMockRecorder) ListActivities(ctx, cloudID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListActivities", reflect.TypeOf((*MockActivityRepository)(nil).ListActivities), ctx, cloudID)
+}
+
+// RevokeSession mocks base method.
+func (m *MockActivityRepository) RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSession", ctx, sessionID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RevokeSession indicates an expected call of RevokeSession.
+func (mr *MockActivityRepositoryMockRecorder) RevokeSession(ctx, sessionID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSession", reflect.TypeOf((*MockActivityRepository)(nil).RevokeSession), ctx, sessionID, ttl)
+}
+
+// SaveActivity mocks base method.
+func (m *MockActivityRepository) SaveActivity(ctx context.Context, activity *auth.SessionActivity, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveActivity", ctx, activity, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveActivity indicates an expected call of SaveActivity.
+func (mr *MockActivityRepositoryMockRecorder) SaveActivity(ctx, activity, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveActivity", reflect.TypeOf((*MockActivityRepository)(nil).SaveActivity), ctx, activity, ttl)
+}
+
+// MockActivityTracker is a mock of ActivityTracker interface.
+type MockActivityTracker struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityTrackerMockRecorder
+	isgomock struct{}
+}
+
+// MockActivityTrackerMockRecorder is the mock recorder for MockActivityTracker.
+type MockActivityTrackerMockRecorder struct {
+	mock *MockActivityTracker
+}
+
+// NewMockActivityTracker creates a new mock instance.
+func NewMockActivityTracker(ctrl *gomock.Controller) *MockActivityTracker {
+	mock := &MockActivityTracker{ctrl: ctrl}
+	mock.recorder = &MockActivityTrackerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivityTracker) EXPECT() *MockActivityTrackerMockRecorder {
+	return m.recorder
+}
+
+// Session mocks base method.
+func (m *MockActivityTracker) Session(ctx context.Context, sessionID string) (*auth.Session, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Session", ctx, sessionID)
+	ret0, _ := ret[0].(*auth.Session)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Session indicates an expected call of Session.
+func (mr *MockActivityTrackerMockRecorder) Session(ctx, sessionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Session", reflect.TypeOf((*MockActivityTracker)(nil).Session), ctx, sessionID)
+}
+
+// Track mocks base method.
+func (m *MockActivityTracker) Track(ctx context.Context, session *auth.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockActivityTrackerMockRecorder) Track(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockActivityTracker)(nil).Track), ctx, session)
+}
+
+// Update mocks base method.
+func (m *MockActivityTracker) Update(ctx context.Context, session *auth.Session) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, session)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockActivityTrackerMockRecorder) Update(ctx, session any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockActivityTracker)(nil).Update), ctx, session)
+}
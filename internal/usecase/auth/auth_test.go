@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net"
 	"testing"
+	"time"
 
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
@@ -82,7 +83,7 @@ func Test_authUseCase_GetAuthURL(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			want, wantErr := tt.want(tt.args, f)
-			au := NewAuthUseCase(f.repo, f.logger, nil)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, nil, nil)
 			got, err := au.GetAuthURL(tt.args.ctx, tt.args.callbackURI)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
@@ -105,6 +106,7 @@ func Test_authUseCase_Auth(t *testing.T) {
 		code        string
 		state       string
 		callbackURI string
+		portalID    int
 		device      *entityAuth.Device
 		clientIP    net.IP
 	}
@@ -421,7 +423,7 @@ func Test_authUseCase_Auth(t *testing.T) {
 				}
 				testAuth1C := &entityAuth.Auth1C{PortalSession: "testPortalSession"}
 				f.repo.EXPECT().AuthPortal(a.ctx, testAuthParams).Return(testAuth1C, nil)
-				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), nil, testDevice, testAuth1C).Return(entityAuth.TokensPair{}, repositories.ErrNoUserPortals)
+				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), testAuthSudir.GetUser().Portals[0], nil, testDevice, testAuth1C).Return(entityAuth.TokensPair{}, repositories.ErrNoUserPortals)
 				return nil, ErrPortalsNotFound
 			},
 		},
@@ -456,7 +458,7 @@ func Test_authUseCase_Auth(t *testing.T) {
 				}
 				testAuth1C := &entityAuth.Auth1C{PortalSession: "testPortalSession"}
 				f.repo.EXPECT().AuthPortal(a.ctx, testAuthParams).Return(testAuth1C, nil)
-				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), nil, testDevice, testAuth1C).Return(entityAuth.TokensPair{}, testErr)
+				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), testAuthSudir.GetUser().Portals[0], nil, testDevice, testAuth1C).Return(entityAuth.TokensPair{}, testErr)
 				f.logger.EXPECT().Error("can't create session",
 					zap.String("user_id", testAuthSudir.GetUser().CloudID),
 					zap.Error(testErr),
@@ -499,7 +501,7 @@ func Test_authUseCase_Auth(t *testing.T) {
 				testAuth1C := &entityAuth.Auth1C{PortalSession: "testPortalSession"}
 				f.repo.EXPECT().AuthPortal(a.ctx, testAuthParams).Return(testAuth1C, nil)
 				testTokensPair := entityAuth.TokensPair{AccessToken: entityAuth.Token{Value: "testToken"}, RefreshToken: entityAuth.Token{Value: "testToken"}}
-				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), nil, testDevice, testAuth1C).Return(testTokensPair, nil)
+				f.repo.EXPECT().CreateSession(a.ctx, testAuthSudir.GetUser(), testAuthSudir.GetUser().Portals[0], nil, testDevice, testAuth1C).Return(testTokensPair, nil)
 				return &entityAuth.Auth{
 					JWTToken:      testTokensPair.AccessToken,
 					RefreshToken:  testTokensPair.RefreshToken,
@@ -517,9 +519,12 @@ func Test_authUseCase_Auth(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			want, wantErr := tt.want(tt.args, f)
-			accessList := entityAuth.AccessList{"test@example.com"}
-			au := NewAuthUseCase(f.repo, f.logger, accessList)
-			got, err := au.Auth(tt.args.ctx, tt.args.code, tt.args.state, tt.args.callbackURI)
+			accessList := NewAccessListUseCase(nil, f.logger)
+			accessList.accessList.Store(entityAuth.NewAccessList(&entityAuth.AccessListFile{
+				Allow: []*entityAuth.AccessRule{{Email: "test@example.com"}},
+			}, time.Now()))
+			au := NewAuthUseCase(f.repo, f.logger, accessList, nil, nil, nil, nil)
+			got, err := au.Auth(tt.args.ctx, tt.args.code, tt.args.state, tt.args.callbackURI, tt.args.portalID)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
 				assert.Nil(t, got)
@@ -629,7 +634,7 @@ func Test_authUseCase_GetSession(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			want, wantErr := tt.want(tt.args, f)
-			au := NewAuthUseCase(f.repo, f.logger, nil)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, nil, nil)
 			got, err := au.GetSession(tt.args.ctx, tt.args.accessToken)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
@@ -642,6 +647,154 @@ func Test_authUseCase_GetSession(t *testing.T) {
 	}
 }
 
+func Test_authUseCase_CheckToken(t *testing.T) {
+	type fields struct {
+		repo     *MockRepository
+		logger   *ditzap.MockLogger
+		verifier *MockTokenVerifier
+		checker  *MockAccessChecker
+		tracker  *MockActivityTracker
+	}
+	type args struct {
+		ctx         context.Context
+		accessToken string
+	}
+
+	ctx := context.TODO()
+	testErr := errors.New("test error")
+	testArgs := args{
+		ctx:         ctx,
+		accessToken: "testAccessToken",
+	}
+	testClaims := &entityAuth.TokenClaims{SessionID: "testSessionID"}
+	testSession := &entityAuth.Session{
+		User:         &entityAuth.User{Email: "user@mos.ru"},
+		ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+	}
+
+	tests := []struct {
+		name            string
+		args            args
+		withoutVerifier bool
+		withChecker     bool
+		want            func(a args, f fields) (*entityAuth.Session, error)
+	}{
+		{
+			name: "invalid token",
+			args: testArgs,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				testValidationErr := diterrors.NewValidationError(testErr)
+				f.verifier.EXPECT().Verify(a.accessToken).Return(nil, testValidationErr)
+				return nil, testValidationErr
+			},
+		},
+		{
+			name: "expired token",
+			args: testArgs,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.verifier.EXPECT().Verify(a.accessToken).Return(nil, diterrors.ErrFailedPrecondition)
+				return nil, diterrors.ErrFailedPrecondition
+			},
+		},
+		{
+			name: "session not exists",
+			args: testArgs,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.verifier.EXPECT().Verify(a.accessToken).Return(testClaims, nil)
+				f.tracker.EXPECT().Session(a.ctx, "testSessionID").Return(nil, diterrors.ErrNotFound)
+				return nil, diterrors.ErrNotFound
+			},
+		},
+		{
+			name: "session revoked",
+			args: testArgs,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				revokedErr := fmt.Errorf("%w: %w", ErrSessionRevoked, diterrors.ErrUnauthenticated)
+				f.verifier.EXPECT().Verify(a.accessToken).Return(testClaims, nil)
+				f.tracker.EXPECT().Session(a.ctx, "testSessionID").Return(nil, revokedErr)
+				return nil, revokedErr
+			},
+		},
+		{
+			name: "session exists internal err",
+			args: testArgs,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.verifier.EXPECT().Verify(a.accessToken).Return(testClaims, nil)
+				f.tracker.EXPECT().Session(a.ctx, "testSessionID").Return(nil, testErr)
+				f.logger.EXPECT().Warn("can't get session in repository",
+					ditzap.JWTField("access_token", a.accessToken),
+					zap.Error(testErr))
+				return nil, fmt.Errorf("can't get session in repository: %w", testErr)
+			},
+		},
+		{
+			name:        "access denied",
+			args:        testArgs,
+			withChecker: true,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.verifier.EXPECT().Verify(a.accessToken).Return(testClaims, nil)
+				f.tracker.EXPECT().Session(a.ctx, "testSessionID").Return(testSession, nil)
+				f.checker.EXPECT().CheckAccess("user@mos.ru", 1).Return(ErrUserAccessDenied)
+				return nil, ErrUserAccessDenied
+			},
+		},
+		{
+			name:        "correct, full session is not requested",
+			args:        testArgs,
+			withChecker: true,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.verifier.EXPECT().Verify(a.accessToken).Return(testClaims, nil)
+				f.tracker.EXPECT().Session(a.ctx, "testSessionID").Return(testSession, nil)
+				f.checker.EXPECT().CheckAccess("user@mos.ru", 1).Return(nil)
+				return testSession, nil
+			},
+		},
+		{
+			name:            "without verifier session is requested",
+			args:            testArgs,
+			withoutVerifier: true,
+			withChecker:     true,
+			want: func(a args, f fields) (*entityAuth.Session, error) {
+				f.repo.EXPECT().GetSession(a.ctx, a.accessToken).Return(testSession, nil)
+				f.checker.EXPECT().CheckAccess("user@mos.ru", 1).Return(nil)
+				return testSession, nil
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:     NewMockRepository(ctrl),
+				logger:   ditzap.NewMockLogger(ctrl),
+				verifier: NewMockTokenVerifier(ctrl),
+				checker:  NewMockAccessChecker(ctrl),
+				tracker:  NewMockActivityTracker(ctrl),
+			}
+			want, wantErr := tt.want(tt.args, f)
+			var (
+				verifier TokenVerifier = f.verifier
+				checker  AccessChecker
+			)
+			if tt.withoutVerifier {
+				verifier = nil
+			}
+			if tt.withChecker {
+				checker = f.checker
+			}
+			au := NewAuthUseCase(f.repo, f.logger, checker, verifier, f.tracker, nil, nil)
+			got, err := au.CheckToken(tt.args.ctx, tt.args.accessToken)
+			if wantErr != nil {
+				assert.EqualError(t, err, wantErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, want, got)
+			}
+		})
+	}
+}
+
 func Test_authUseCase_Logout(t *testing.T) {
 	type fields struct {
 		repo   *MockRepository
@@ -770,7 +923,7 @@ func Test_authUseCase_Logout(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			wantErr := tt.want(tt.args, f)
-			au := NewAuthUseCase(f.repo, f.logger, nil)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, nil, nil)
 			err := au.Logout(tt.args.ctx, tt.args.accessToken, tt.args.refreshToken)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
@@ -888,7 +1041,7 @@ func Test_authUseCase_ChangePortal(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			wantP, wantS, wantErr := tt.want(tt.args, f)
-			au := NewAuthUseCase(f.repo, f.logger, nil)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, nil, nil)
 			gotP, gotS, err := au.ChangePortal(tt.args.ctx, tt.args.portalID)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
@@ -955,7 +1108,7 @@ func Test_authUseCase_RefreshTokensPair(t *testing.T) {
 				logger: ditzap.NewMockLogger(ctrl),
 			}
 			want, wantErr := tt.want(tt.args, f)
-			au := NewAuthUseCase(f.repo, f.logger, nil)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, nil, nil)
 			got, err := au.RefreshTokensPair(tt.args.ctx, tt.args.accessToken, tt.args.refreshToken)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
@@ -0,0 +1,76 @@
+package auth
+
+import (
+	"context"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+type authorizationUseCase struct {
+	policy *entityAuth.Policy
+	logger ditzap.Logger
+}
+
+// NewAuthorizationUseCase use-кейс проверки прав пользователя.
+//
+//	Если политика не задана, в правах отказывается всем пользователям.
+func NewAuthorizationUseCase(policy *entityAuth.Policy, logger ditzap.Logger) *authorizationUseCase {
+	return &authorizationUseCase{
+		policy: policy,
+		logger: logger,
+	}
+}
+
+// CheckPermissions проверяет наличие прав у пользователя сессии на активном портале
+func (a *authorizationUseCase) CheckPermissions(ctx context.Context, permissions ...entityAuth.Permission) error {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		a.logger.Debug("can't get session from context", zap.Error(err))
+		return usecase.ErrGetSessionFromContext
+	}
+
+	return a.check(session.GetUser().GetEmail(), session.GetActivePortal().GetPortalID(), permissions)
+}
+
+// CheckPortalPermissions проверяет наличие прав у пользователя сессии на всех порталах ресурса.
+//
+//	Права на ресурс без порталов есть только у пользователей с правами на все порталы.
+func (a *authorizationUseCase) CheckPortalPermissions(ctx context.Context, portalIDs []int, permissions ...entityAuth.Permission) error {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		a.logger.Debug("can't get session from context", zap.Error(err))
+		return usecase.ErrGetSessionFromContext
+	}
+
+	email := session.GetUser().GetEmail()
+	if len(portalIDs) == 0 {
+		// Привязка без порталов выдает права на все порталы, поэтому проверяется по нулевому порталу
+		return a.check(email, 0, permissions)
+	}
+	for _, portalID := range portalIDs {
+		if err := a.check(email, portalID, permissions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// check проверяет наличие прав у пользователя на портале
+func (a *authorizationUseCase) check(email string, portalID int, permissions []entityAuth.Permission) error {
+	if a.policy == nil || !a.policy.Grants(email, portalID).Has(permissions...) {
+		a.logger.Debug("permission denied",
+			zap.String("email", email),
+			zap.Int("portal_id", portalID),
+			zap.Any("permissions", permissions),
+		)
+		return ErrPermissionDenied
+	}
+
+	return nil
+}
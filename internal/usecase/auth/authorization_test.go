@@ -0,0 +1,265 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+func Test_authorizationUseCase_CheckPermissions(t *testing.T) {
+	type fields struct {
+		logger *ditzap.MockLogger
+	}
+	type args struct {
+		ctx         context.Context
+		permissions []entityAuth.Permission
+	}
+
+	testPolicy := testAuthorizationPolicy()
+
+	tests := []struct {
+		name    string
+		policy  *entityAuth.Policy
+		args    args
+		prepare func(f fields)
+		wantErr error
+	}{
+		{
+			name:   "no session",
+			policy: testPolicy,
+			args: args{
+				ctx:         context.TODO(),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsRead},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("can't get session from context", gomock.Any())
+			},
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name:   "nil policy",
+			policy: nil,
+			args: args{
+				ctx:         testSessionCtx("user@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsDelete},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "admin on any portal",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("admin@mos.ru", 42),
+				permissions: []entityAuth.Permission{entityAuth.PermissionBannersWrite},
+			},
+		},
+		{
+			name:   "editor on own portal",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("editor@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsRead, entityAuth.PermissionNewsWrite},
+			},
+		},
+		{
+			name:   "editor without permission",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("editor@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsDelete},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "editor on another portal",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("editor@mos.ru", 2),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsRead},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "unknown user",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("user@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsRead},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				logger: ditzap.NewMockLogger(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+			au := NewAuthorizationUseCase(tt.policy, f.logger)
+			err := au.CheckPermissions(tt.args.ctx, tt.args.permissions...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_authorizationUseCase_CheckPortalPermissions(t *testing.T) {
+	type fields struct {
+		logger *ditzap.MockLogger
+	}
+	type args struct {
+		ctx         context.Context
+		portalIDs   []int
+		permissions []entityAuth.Permission
+	}
+
+	testPolicy := testAuthorizationPolicy()
+
+	tests := []struct {
+		name    string
+		policy  *entityAuth.Policy
+		args    args
+		prepare func(f fields)
+		wantErr error
+	}{
+		{
+			name:   "no session",
+			policy: testPolicy,
+			args: args{
+				ctx:         context.TODO(),
+				portalIDs:   []int{1},
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsWrite},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("can't get session from context", gomock.Any())
+			},
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name:   "editor on resource portal",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("editor@mos.ru", 1),
+				portalIDs:   []int{1},
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsWrite},
+			},
+		},
+		{
+			name:   "resource of another portal",
+			policy: testPolicy,
+			args: args{
+				// Активный портал редактора не дает прав на ресурс другого портала
+				ctx:         testSessionCtx("editor@mos.ru", 1),
+				portalIDs:   []int{1, 2},
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsWrite},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "resource without portals",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("editor@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsWrite},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+		{
+			name:   "admin on resource without portals",
+			policy: testPolicy,
+			args: args{
+				ctx:         testSessionCtx("admin@mos.ru", 1),
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsDelete},
+			},
+		},
+		{
+			name:   "nil policy",
+			policy: nil,
+			args: args{
+				ctx:         testSessionCtx("admin@mos.ru", 1),
+				portalIDs:   []int{1},
+				permissions: []entityAuth.Permission{entityAuth.PermissionNewsRead},
+			},
+			prepare: func(f fields) {
+				f.logger.EXPECT().Debug("permission denied", gomock.Any(), gomock.Any(), gomock.Any())
+			},
+			wantErr: ErrPermissionDenied,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				logger: ditzap.NewMockLogger(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+			au := NewAuthorizationUseCase(tt.policy, f.logger)
+			err := au.CheckPortalPermissions(tt.args.ctx, tt.args.portalIDs, tt.args.permissions...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func testAuthorizationPolicy() *entityAuth.Policy {
+	return &entityAuth.Policy{
+		Roles: map[string][]entityAuth.Permission{
+			"admin":       {entityAuth.PermissionAll},
+			"news_editor": {entityAuth.PermissionNewsRead, entityAuth.PermissionNewsWrite},
+		},
+		Bindings: []*entityAuth.RoleBinding{
+			{
+				Email: "admin@mos.ru",
+				Roles: []string{"admin"},
+			},
+			{
+				Email:     "Editor@mos.ru",
+				Roles:     []string{"news_editor"},
+				PortalIDs: []int{1},
+			},
+		},
+	}
+}
+
+func testSessionCtx(email string, portalID int) context.Context {
+	return entity.WithSession(context.TODO(), &entityAuth.Session{
+		User:         &entityAuth.User{Email: email},
+		ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: portalID}},
+	})
+}
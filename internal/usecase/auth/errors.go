@@ -1,6 +1,10 @@
 package auth
 
-import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+import (
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
 
 const (
 	ErrPortalsNotFound   diterrors.StringError = "portals not found"
@@ -12,4 +16,12 @@ const (
 	ErrEmptyPortalURL    diterrors.StringError = "selected portal url is empty"
 	ErrUserInfoRequired  diterrors.StringError = "user info required"
 	ErrUserAccessDenied  diterrors.StringError = "user access denied"
+	ErrSessionIdle       diterrors.StringError = "session idle timeout exceeded"
+	ErrSessionRevoked    diterrors.StringError = "session revoked"
+	ErrRevokeCurrent     diterrors.StringError = "current session can't be revoked"
+
+	ErrAccessListNotLoaded diterrors.StringError = "access list is not loaded"
 )
+
+// ErrPermissionDenied недостаточно прав. Общая ошибка use-кейсов, проверяющих права на ресурсы порталов
+const ErrPermissionDenied = usecase.ErrPermissionDenied
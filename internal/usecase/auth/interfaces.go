@@ -3,8 +3,10 @@ package auth
 import (
 	"context"
 	"net"
+	"time"
 
 	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityPortal "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portal"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./auth_mock.go -package=auth
@@ -13,8 +15,8 @@ type Repository interface {
 	GetRedirectURL(ctx context.Context, callbackURI string) (string, error)
 	Auth(ctx context.Context, code, state, callbackURI string) (*entityAuth.AuthSudir, error)
 	AuthPortal(ctx context.Context, params entityAuth.AuthPortalParams) (*entityAuth.Auth1C, error)
-	// CreateSession метод для создания сессии. На вход принимает пользователя СУДИР, его девайс и идентификатор сессии портала 1С. Возвращает Access и Refresh токены
-	CreateSession(ctx context.Context, user *entityAuth.UserSudir, clientIP net.IP, device *entityAuth.Device, auth *entityAuth.Auth1C) (entityAuth.TokensPair, error)
+	// CreateSession метод для создания сессии. На вход принимает пользователя СУДИР, выбранный портал, его девайс и идентификатор сессии портала 1С. Возвращает Access и Refresh токены
+	CreateSession(ctx context.Context, user *entityAuth.UserSudir, portal *entityAuth.Portal, clientIP net.IP, device *entityAuth.Device, auth *entityAuth.Auth1C) (entityAuth.TokensPair, error)
 	GetSession(ctx context.Context, accessToken string) (*entityAuth.Session, error)
 	Logout(ctx context.Context, session *entityAuth.Session, accessToken, refreshToken string) error
 	// ChangePortal метод для смены активного портала. На вход принимает идентификатор выбранного портала 1С и сессию. Возвращает порталы и сессию портала 1С
@@ -22,6 +24,61 @@ type Repository interface {
 	RefreshTokensPair(ctx context.Context, accessToken, refreshToken string) (*entityAuth.TokensPair, error)
 }
 
+// PortalsRepository поиск порталов организаций, в которых работает пользователь
+type PortalsRepository interface {
+	Filter(ctx context.Context, options entityPortal.PortalsFilterOptions) ([]*entityPortal.Portal, error)
+}
+
+// LastPortalRepository хранилище последнего выбранного пользователем портала
+type LastPortalRepository interface {
+	// GetLastPortal возвращает идентификатор последнего выбранного портала пользователя СУДИР. Если портал не сохранялся, возвращает 0
+	GetLastPortal(ctx context.Context, cloudID string) (int, error)
+	// SaveLastPortal сохраняет идентификатор выбранного портала пользователя СУДИР
+	SaveLastPortal(ctx context.Context, cloudID string, portalID int) error
+}
+
 type RedirectSessionRepository interface {
 	CreateSession(ctx context.Context, userInfo *entityAuth.RedirectSessionUserInfo) (string, error)
 }
+
+// AccessListRepository источник списка доступа
+type AccessListRepository interface {
+	// ModTime время последнего изменения списка доступа
+	ModTime(ctx context.Context) (time.Time, error)
+	Load(ctx context.Context) (*entityAuth.AccessListFile, error)
+}
+
+// AccessChecker проверка доступа пользователя к приложению
+type AccessChecker interface {
+	// CheckAccess проверяет доступ пользователя к порталу. Нулевой идентификатор портала означает, что портал еще не выбран
+	CheckAccess(email string, portalID int) error
+}
+
+// TokenVerifier локальная проверка токена доступа
+type TokenVerifier interface {
+	// Verify проверяет подпись и срок действия токена доступа
+	Verify(accessToken string) (*entityAuth.TokenClaims, error)
+}
+
+// ActivityRepository хранилище активности сессий
+type ActivityRepository interface {
+	// GetActivity возвращает активность сессии вместе с признаком отзыва. Если активность не сохранялась
+	// и сессия не отзывалась, возвращает nil
+	GetActivity(ctx context.Context, sessionID string) (*entityAuth.SessionActivity, error)
+	// SaveActivity сохраняет активность сессии на время ttl. Признак отзыва не сохраняется
+	SaveActivity(ctx context.Context, activity *entityAuth.SessionActivity, ttl time.Duration) error
+	// RevokeSession отзывает сессию на время ttl. Отзыв не перезаписывается сохранением активности
+	RevokeSession(ctx context.Context, sessionID string, ttl time.Duration) error
+	// ListActivities возвращает активность всех сессий пользователя СУДИР
+	ListActivities(ctx context.Context, cloudID string) ([]*entityAuth.SessionActivity, error)
+}
+
+// ActivityTracker учет активности сессий
+type ActivityTracker interface {
+	// Track фиксирует активность сессии, проверяя, что она не отозвана и не простаивала дольше допустимого
+	Track(ctx context.Context, session *entityAuth.Session) error
+	// Session сессия по идентификатору из сохраненной активности. Если сессия неизвестна, возвращает diterrors.ErrNotFound
+	Session(ctx context.Context, sessionID string) (*entityAuth.Session, error)
+	// Update сохраняет активность сессии после изменения ее данных
+	Update(ctx context.Context, session *entityAuth.Session) error
+}
@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityPortal "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portal"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/repositories"
+)
+
+// portalCandidates порталы пользователя в порядке попыток аутентификации.
+//
+//	Первым идет запрошенный портал, затем последний выбранный пользователем портал,
+//	портал основного места работы и остальные порталы в порядке, полученном из СУДИР.
+//	Если запрошенный портал недоступен пользователю, возвращает ErrUnavailablePortal
+func (a *authUseCase) portalCandidates(ctx context.Context, user *entityAuth.UserSudir, requestedPortalID int) ([]*entityAuth.Portal, error) {
+	candidates := make([]*entityAuth.Portal, 0, len(user.Portals))
+	added := make(map[*entityAuth.Portal]struct{}, len(user.Portals))
+	add := func(portalID int) bool {
+		if portalID == 0 {
+			return false
+		}
+		for _, portal := range user.Portals {
+			if portal.GetID() != portalID {
+				continue
+			}
+			if _, ok := added[portal]; !ok {
+				candidates = append(candidates, portal)
+				added[portal] = struct{}{}
+			}
+			return true
+		}
+		return false
+	}
+
+	if requestedPortalID != 0 && !add(requestedPortalID) {
+		return nil, ErrUnavailablePortal
+	}
+	add(a.lastPortalID(ctx, user))
+	for _, portalID := range a.mainPortalIDs(ctx, user) {
+		if add(portalID) {
+			break
+		}
+	}
+	for _, portal := range user.Portals {
+		if _, ok := added[portal]; ok || portal == nil {
+			continue
+		}
+		candidates = append(candidates, portal)
+		added[portal] = struct{}{}
+	}
+	return candidates, nil
+}
+
+// lastPortalID последний выбранный пользователем портал
+func (a *authUseCase) lastPortalID(ctx context.Context, user *entityAuth.UserSudir) int {
+	if a.lastPortalRepository == nil || user.CloudID == "" {
+		return 0
+	}
+	portalID, err := a.lastPortalRepository.GetLastPortal(ctx, user.CloudID)
+	if err != nil {
+		a.logger.Warn("can't get last selected portal",
+			zap.String("user_id", user.CloudID),
+			zap.Error(err),
+		)
+		return 0
+	}
+	return portalID
+}
+
+// mainPortalIDs порталы организации основного места работы.
+//
+//	Основным считается первое место работы из данных сотрудника в СУДИР
+func (a *authUseCase) mainPortalIDs(ctx context.Context, user *entityAuth.UserSudir) []int {
+	if a.portalsRepository == nil || len(user.Employees) == 0 || user.Employees[0] == nil || user.Employees[0].Inn == "" {
+		return nil
+	}
+
+	portals, err := a.portalsRepository.Filter(ctx, entityPortal.PortalsFilterOptions{
+		INNs:       entityPortal.OrganizationINNs{entityPortal.OrganizationINN(user.Employees[0].Inn)},
+		OnlyLinked: true,
+	})
+	if err != nil {
+		if !errors.Is(err, repositories.ErrNotFound) {
+			a.logger.Warn("can't get main workplace portal",
+				zap.String("user_id", user.CloudID),
+				zap.String("inn", user.Employees[0].Inn),
+				zap.Error(err),
+			)
+		}
+		return nil
+	}
+
+	portalIDs := make([]int, 0, len(portals))
+	for _, portal := range portals {
+		if portal != nil {
+			portalIDs = append(portalIDs, int(portal.Id))
+		}
+	}
+	return portalIDs
+}
+
+// rememberPortal запоминает выбранный портал, чтобы при следующем входе начать с него
+func (a *authUseCase) rememberPortal(ctx context.Context, cloudID string, portalID int) {
+	if a.lastPortalRepository == nil || cloudID == "" || portalID == 0 {
+		return
+	}
+	if err := a.lastPortalRepository.SaveLastPortal(ctx, cloudID, portalID); err != nil {
+		a.logger.Warn("can't save last selected portal",
+			zap.String("user_id", cloudID),
+			zap.Int("portal_id", portalID),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,202 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityPortal "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portal"
+)
+
+func Test_authUseCase_Auth_portalSelection(t *testing.T) {
+	type fields struct {
+		repo       *MockRepository
+		portals    *MockPortalsRepository
+		lastPortal *MockLastPortalRepository
+		logger     *ditzap.MockLogger
+	}
+
+	testDevice := &entityAuth.Device{UserAgent: "testUserAgent"}
+	ctx := entity.WithDevice(context.TODO(), testDevice)
+	testErr := errors.New("test error")
+	testCloudID := "testCloudID"
+	testAuth1C := &entityAuth.Auth1C{PortalSession: "testPortalSession"}
+	testTokensPair := entityAuth.TokensPair{
+		AccessToken:  entityAuth.Token{Value: "testAccessToken"},
+		RefreshToken: entityAuth.Token{Value: "testRefreshToken"},
+	}
+
+	newUser := func(employees ...*entityAuth.EmployeeInfo) *entityAuth.UserSudir {
+		return &entityAuth.UserSudir{
+			CloudID: testCloudID,
+			Email:   "test@example.com",
+			Portals: []*entityAuth.Portal{
+				{ID: 1, Name: "testName1", URL: "testURL1"},
+				{ID: 2, Name: "testName2", URL: "testURL2"},
+			},
+			Employees: employees,
+		}
+	}
+	authPortalParams := func(portal *entityAuth.Portal) entityAuth.AuthPortalParams {
+		return entityAuth.AuthPortalParams{
+			PortalURL: portal.URL,
+			User: entityAuth.User1C{
+				CloudID: testCloudID,
+				Email:   "test@example.com",
+			},
+		}
+	}
+	// expectSession ожидает успешную аутентификацию на портале и возвращает результат входа
+	expectSession := func(f fields, user *entityAuth.UserSudir, portal *entityAuth.Portal) *entityAuth.Auth {
+		f.repo.EXPECT().AuthPortal(ctx, authPortalParams(portal)).Return(testAuth1C, nil)
+		f.repo.EXPECT().CreateSession(ctx, user, portal, nil, testDevice, testAuth1C).Return(testTokensPair, nil)
+		f.lastPortal.EXPECT().SaveLastPortal(ctx, testCloudID, portal.ID).Return(nil)
+		return &entityAuth.Auth{
+			JWTToken:      testTokensPair.AccessToken,
+			RefreshToken:  testTokensPair.RefreshToken,
+			PortalSession: testAuth1C.PortalSession,
+			Portals:       user.Portals,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		portalID int
+		want     func(f fields) (*entityAuth.Auth, int, error)
+	}{
+		{
+			name: "first portal by default",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser()
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(0, nil)
+				return expectSession(f, user, user.Portals[0]), 1, nil
+			},
+		},
+		{
+			name:     "requested portal",
+			portalID: 2,
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser()
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(1, nil)
+				return expectSession(f, user, user.Portals[1]), 2, nil
+			},
+		},
+		{
+			name:     "requested portal unavailable err",
+			portalID: 3,
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: newUser()}, nil)
+				return nil, 0, ErrUnavailablePortal
+			},
+		},
+		{
+			name: "last selected portal",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser(&entityAuth.EmployeeInfo{Inn: "testInn"})
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(2, nil)
+				f.portals.EXPECT().Filter(ctx, entityPortal.PortalsFilterOptions{
+					INNs:       entityPortal.OrganizationINNs{"testInn"},
+					OnlyLinked: true,
+				}).Return([]*entityPortal.Portal{{Id: 1}}, nil)
+				return expectSession(f, user, user.Portals[1]), 2, nil
+			},
+		},
+		{
+			name: "main workplace portal",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser(&entityAuth.EmployeeInfo{Inn: "testInn"}, &entityAuth.EmployeeInfo{Inn: "otherInn"})
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(0, nil)
+				f.portals.EXPECT().Filter(ctx, entityPortal.PortalsFilterOptions{
+					INNs:       entityPortal.OrganizationINNs{"testInn"},
+					OnlyLinked: true,
+				}).Return([]*entityPortal.Portal{{Id: 5}, {Id: 2}}, nil)
+				return expectSession(f, user, user.Portals[1]), 2, nil
+			},
+		},
+		{
+			name: "last portal and main workplace errors ignored",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser(&entityAuth.EmployeeInfo{Inn: "testInn"})
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(0, testErr)
+				f.logger.EXPECT().Warn("can't get last selected portal",
+					zap.String("user_id", testCloudID),
+					zap.Error(testErr),
+				)
+				f.portals.EXPECT().Filter(ctx, entityPortal.PortalsFilterOptions{
+					INNs:       entityPortal.OrganizationINNs{"testInn"},
+					OnlyLinked: true,
+				}).Return(nil, testErr)
+				f.logger.EXPECT().Warn("can't get main workplace portal",
+					zap.String("user_id", testCloudID),
+					zap.String("inn", "testInn"),
+					zap.Error(testErr),
+				)
+				return expectSession(f, user, user.Portals[0]), 1, nil
+			},
+		},
+		{
+			name: "fallback to next portal",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser()
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(0, nil)
+				testValidationErr := diterrors.NewValidationError(testErr)
+				f.repo.EXPECT().AuthPortal(ctx, authPortalParams(user.Portals[0])).Return(nil, testValidationErr)
+				f.logger.EXPECT().Debug("cant authenticate user into portal",
+					zap.String("user_id", testCloudID),
+					zap.String("portal_url", "testURL1"),
+					zap.Error(testValidationErr),
+				)
+				return expectSession(f, user, user.Portals[1]), 2, nil
+			},
+		},
+		{
+			name: "all portals failed err",
+			want: func(f fields) (*entityAuth.Auth, int, error) {
+				user := newUser()
+				f.repo.EXPECT().Auth(ctx, "", "", "").Return(&entityAuth.AuthSudir{User: user}, nil)
+				f.lastPortal.EXPECT().GetLastPortal(ctx, testCloudID).Return(0, nil)
+				f.repo.EXPECT().AuthPortal(ctx, authPortalParams(user.Portals[0])).Return(nil, diterrors.ErrNotFound)
+				f.repo.EXPECT().AuthPortal(ctx, authPortalParams(user.Portals[1])).Return(nil, diterrors.ErrNotFound)
+				return nil, 0, diterrors.ErrNotFound
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:       NewMockRepository(ctrl),
+				portals:    NewMockPortalsRepository(ctrl),
+				lastPortal: NewMockLastPortalRepository(ctrl),
+				logger:     ditzap.NewMockLogger(ctrl),
+			}
+			want, wantSelected, wantErr := tt.want(f)
+			au := NewAuthUseCase(f.repo, f.logger, nil, nil, nil, f.portals, f.lastPortal)
+			got, err := au.Auth(ctx, "", "", "", tt.portalID)
+			if wantErr != nil {
+				assert.ErrorIs(t, err, wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, want, got)
+			for _, portal := range got.Portals {
+				assert.Equal(t, portal.ID == wantSelected, portal.IsSelected, portal.ID)
+			}
+		})
+	}
+}
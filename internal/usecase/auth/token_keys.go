@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// TokenKeys ключи проверки подписи токенов доступа
+type TokenKeys struct {
+	// ByID ключи по идентификатору (kid)
+	ByID map[string]any
+	// Default ключ для токенов без идентификатора ключа
+	Default any
+}
+
+// Empty проверяет, что не задан ни один ключ
+func (k *TokenKeys) Empty() bool {
+	return k == nil || (len(k.ByID) == 0 && k.Default == nil)
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	// RSA
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC, OKP
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+	// oct
+	K string `json:"k"`
+}
+
+// ParseJWKS разбирает набор ключей в формате JWKS (RFC 7517).
+//
+//	Единственный ключ набора используется также как ключ по умолчанию
+func ParseJWKS(data []byte) (*TokenKeys, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("can't unmarshal jwks: %w", err)
+	}
+
+	keys := &TokenKeys{ByID: make(map[string]any, len(set.Keys))}
+	for i, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("can't parse jwks key %d: %w", i, err)
+		}
+		keys.ByID[k.Kid] = key
+	}
+	if len(keys.ByID) == 1 {
+		for _, key := range keys.ByID {
+			keys.Default = key
+		}
+	}
+	return keys, nil
+}
+
+// ParsePEMKey разбирает открытый ключ (RSA, ECDSA, Ed25519) в формате PEM
+func ParsePEMKey(data []byte) (*TokenKeys, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("can't decode pem block")
+	}
+
+	var (
+		key any
+		err error
+	)
+	switch block.Type {
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can't parse public key: %w", err)
+	}
+	return &TokenKeys{Default: key}, nil
+}
+
+// NewHMACKey ключ проверки подписи HMAC
+func NewHMACKey(secret string) *TokenKeys {
+	return &TokenKeys{Default: []byte(secret)}
+}
+
+func (k jwk) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	case "oct":
+		secret, err := base64.RawURLEncoding.DecodeString(k.K)
+		if err != nil {
+			return nil, err
+		}
+		return secret, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
@@ -0,0 +1,89 @@
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/golang-jwt/jwt/v5"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+// tokenValidMethods допустимые алгоритмы подписи. Соответствие алгоритма типу ключа проверяет jwt
+var tokenValidMethods = []string{
+	"HS256", "HS384", "HS512",
+	"RS256", "RS384", "RS512",
+	"PS256", "PS384", "PS512",
+	"ES256", "ES384", "ES512",
+	"EdDSA",
+}
+
+type accessTokenClaims struct {
+	jwt.RegisteredClaims
+	SessionID string `json:"sid,omitempty"`
+}
+
+type tokenVerifier struct {
+	keys   *TokenKeys
+	parser *jwt.Parser
+}
+
+// NewTokenVerifier локальная проверка подписи и срока действия токена доступа.
+//
+//	Если issuer не пуст, проверяется также издатель токена
+func NewTokenVerifier(keys *TokenKeys, issuer string, leeway time.Duration) *tokenVerifier {
+	opts := []jwt.ParserOption{
+		jwt.WithValidMethods(tokenValidMethods),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	}
+	if issuer != "" {
+		opts = append(opts, jwt.WithIssuer(issuer))
+	}
+	return &tokenVerifier{
+		keys:   keys,
+		parser: jwt.NewParser(opts...),
+	}
+}
+
+// Verify проверяет подпись и срок действия токена доступа.
+//
+//	Для истекшего токена возвращает diterrors.ErrFailedPrecondition, для невалидного — diterrors.ValidationError
+func (v *tokenVerifier) Verify(accessToken string) (*entityAuth.TokenClaims, error) {
+	claims := &accessTokenClaims{}
+	_, err := v.parser.ParseWithClaims(accessToken, claims, v.keyFunc)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, fmt.Errorf("access token expired: %w", diterrors.ErrFailedPrecondition)
+		}
+		return nil, diterrors.NewValidationError(fmt.Errorf("invalid access token: %w", err))
+	}
+
+	tokenClaims := &entityAuth.TokenClaims{
+		SessionID: claims.SessionID,
+		Subject:   claims.Subject,
+		Issuer:    claims.Issuer,
+	}
+	if claims.ExpiresAt != nil {
+		tokenClaims.ExpiresAt = claims.ExpiresAt.Time
+	}
+	if tokenClaims.SessionID == "" {
+		tokenClaims.SessionID = claims.ID
+	}
+	return tokenClaims, nil
+}
+
+func (v *tokenVerifier) keyFunc(token *jwt.Token) (any, error) {
+	if kid, ok := token.Header["kid"].(string); ok && kid != "" {
+		if key, ok := v.keys.ByID[kid]; ok {
+			return key, nil
+		}
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+	if v.keys.Default == nil {
+		return nil, errors.New("token has no key id")
+	}
+	return v.keys.Default, nil
+}
@@ -0,0 +1,167 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+)
+
+func Test_tokenVerifier_Verify(t *testing.T) {
+	secret := "testSecret"
+	now := time.Unix(time.Now().Unix(), 0)
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+	jwks := fmt.Sprintf(`{"keys":[{"kty":"RSA","kid":"rsa-1","use":"sig","n":%q,"e":%q},{"kty":"RSA","kid":"enc-1","use":"enc","n":"","e":""}]}`,
+		base64.RawURLEncoding.EncodeToString(rsaKey.N.Bytes()),
+		base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaKey.E)).Bytes()),
+	)
+	jwksKeys, err := ParseJWKS([]byte(jwks))
+	assert.NoError(t, err)
+
+	sign := func(method jwt.SigningMethod, key any, kid string, claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(method, claims)
+		if kid != "" {
+			token.Header["kid"] = kid
+		}
+		signed, signErr := token.SignedString(key)
+		assert.NoError(t, signErr)
+		return signed
+	}
+
+	tests := []struct {
+		name              string
+		keys              *TokenKeys
+		issuer            string
+		token             string
+		want              *entityAuth.TokenClaims
+		wantValidationErr bool
+		wantErr           error
+	}{
+		{
+			name: "hmac correct",
+			keys: NewHMACKey(secret),
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "", jwt.MapClaims{
+				"sid": "testSessionID",
+				"sub": "testSubject",
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			want: &entityAuth.TokenClaims{
+				SessionID: "testSessionID",
+				Subject:   "testSubject",
+				ExpiresAt: now.Add(time.Minute),
+			},
+		},
+		{
+			name: "session id from jti",
+			keys: NewHMACKey(secret),
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "", jwt.MapClaims{
+				"jti": "testSessionID",
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			want: &entityAuth.TokenClaims{
+				SessionID: "testSessionID",
+				ExpiresAt: now.Add(time.Minute),
+			},
+		},
+		{
+			name: "expired",
+			keys: NewHMACKey(secret),
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "", jwt.MapClaims{
+				"exp": now.Add(-time.Minute).Unix(),
+			}),
+			wantErr: diterrors.ErrFailedPrecondition,
+		},
+		{
+			name: "no expiration",
+			keys: NewHMACKey(secret),
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "", jwt.MapClaims{
+				"sid": "testSessionID",
+			}),
+			wantValidationErr: true,
+		},
+		{
+			name: "bad signature",
+			keys: NewHMACKey(secret),
+			token: sign(jwt.SigningMethodHS256, []byte("otherSecret"), "", jwt.MapClaims{
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			wantValidationErr: true,
+		},
+		{
+			name:   "wrong issuer",
+			keys:   NewHMACKey(secret),
+			issuer: "testIssuer",
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "", jwt.MapClaims{
+				"iss": "otherIssuer",
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			wantValidationErr: true,
+		},
+		{
+			name:              "malformed",
+			keys:              NewHMACKey(secret),
+			token:             "testAccessToken",
+			wantValidationErr: true,
+		},
+		{
+			name: "jwks correct",
+			keys: jwksKeys,
+			token: sign(jwt.SigningMethodRS256, rsaKey, "rsa-1", jwt.MapClaims{
+				"sid": "testSessionID",
+				"iss": "testIssuer",
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			issuer: "testIssuer",
+			want: &entityAuth.TokenClaims{
+				SessionID: "testSessionID",
+				Issuer:    "testIssuer",
+				ExpiresAt: now.Add(time.Minute),
+			},
+		},
+		{
+			name: "jwks unknown key id",
+			keys: jwksKeys,
+			token: sign(jwt.SigningMethodRS256, rsaKey, "rsa-2", jwt.MapClaims{
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			wantValidationErr: true,
+		},
+		{
+			name: "algorithm does not match key",
+			keys: jwksKeys,
+			token: sign(jwt.SigningMethodHS256, []byte(secret), "rsa-1", jwt.MapClaims{
+				"exp": now.Add(time.Minute).Unix(),
+			}),
+			wantValidationErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewTokenVerifier(tt.keys, tt.issuer, 0)
+			got, err := v.Verify(tt.token)
+			switch {
+			case tt.wantValidationErr:
+				assert.True(t, errors.As(err, new(diterrors.ValidationError)), err)
+				assert.Nil(t, got)
+			case tt.wantErr != nil:
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
@@ -4,4 +4,5 @@ import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 
 const (
 	ErrGetSessionFromContext diterrors.StringError = "can't get session from context"
+	ErrPermissionDenied      diterrors.StringError = "user has no permission"
 )
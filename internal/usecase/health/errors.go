@@ -0,0 +1,7 @@
+package health
+
+import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+const (
+	ErrUnknownRequired diterrors.StringError = "unknown required dependencies"
+)
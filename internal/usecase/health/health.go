@@ -0,0 +1,163 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+// CheckFunc проверка доступности зависимости
+type CheckFunc func(ctx context.Context) error
+
+type check struct {
+	name     string
+	required bool
+	fn       CheckFunc
+}
+
+type healthUseCase struct {
+	required map[string]struct{}
+	timeout  time.Duration
+	logger   ditzap.Logger
+
+	mu     sync.RWMutex
+	checks []*check
+}
+
+// NewHealthUseCase use-кейс проверки готовности приложения.
+//
+//	Приложение готово, если доступны все обязательные зависимости из required.
+//	Недоступность остальных зависимостей только отражается в подробном ответе /readyz
+func NewHealthUseCase(required []string, timeout time.Duration, logger ditzap.Logger) *healthUseCase {
+	h := &healthUseCase{
+		required: make(map[string]struct{}, len(required)),
+		timeout:  timeout,
+		logger:   logger,
+	}
+	for _, name := range required {
+		if name = strings.TrimSpace(name); name != "" {
+			h.required[name] = struct{}{}
+		}
+	}
+	return h
+}
+
+// IsRequired зависимость name обязательна для готовности приложения
+func (h *healthUseCase) IsRequired(name string) bool {
+	_, required := h.required[name]
+	return required
+}
+
+// ValidateRequired проверяет, что для каждой обязательной зависимости зарегистрирована проверка.
+//
+//	Вызывается после регистрации всех проверок, чтобы опечатка в READINESS_REQUIRED не отключала проверку молча
+func (h *healthUseCase) ValidateRequired() error {
+	h.mu.RLock()
+	registered := make(map[string]struct{}, len(h.checks))
+	for _, c := range h.checks {
+		registered[c.name] = struct{}{}
+	}
+	h.mu.RUnlock()
+
+	unknown := make([]string, 0)
+	for name := range h.required {
+		if _, ok := registered[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		slices.Sort(unknown)
+		return fmt.Errorf("%w: %s", ErrUnknownRequired, strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Register добавляет проверку зависимости name
+func (h *healthUseCase) Register(name string, fn CheckFunc) {
+	_, required := h.required[name]
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checks = append(h.checks, &check{
+		name:     name,
+		required: required,
+		fn:       fn,
+	})
+}
+
+// Check выполняет проверки всех зависимостей параллельно.
+//
+//	Возвращает признак готовности и результаты проверок в порядке регистрации
+func (h *healthUseCase) Check(ctx context.Context) (bool, []*entity.CheckResult) {
+	h.mu.RLock()
+	checks := h.checks
+	h.mu.RUnlock()
+
+	results := make([]*entity.CheckResult, len(checks))
+	wg := sync.WaitGroup{}
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = h.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	ready := true
+	for _, result := range results {
+		if result.Required && result.Err != nil {
+			ready = false
+		}
+	}
+	return ready, results
+}
+
+// WatchRequired проверяет обязательные зависимости по истечении gracePeriod после запуска.
+//
+//	Если какая-то из них недоступна, вызывает stop для завершения приложения
+func (h *healthUseCase) WatchRequired(ctx context.Context, gracePeriod time.Duration, stop func()) {
+	timer := time.NewTimer(gracePeriod)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-timer.C:
+	}
+
+	ready, results := h.Check(ctx)
+	if ready {
+		return
+	}
+	for _, result := range results {
+		if result.Required && result.Err != nil {
+			h.logger.Error("required dependency is unavailable",
+				zap.String("dependency", result.Name),
+				zap.Error(result.Err),
+			)
+		}
+	}
+	stop()
+}
+
+func (h *healthUseCase) run(ctx context.Context, c *check) *entity.CheckResult {
+	if h.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.timeout)
+		defer cancel()
+	}
+	return &entity.CheckResult{
+		Name:     c.name,
+		Required: c.required,
+		Err:      c.fn(ctx),
+	}
+}
@@ -0,0 +1,170 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+)
+
+func ok(context.Context) error { return nil }
+
+func fail(context.Context) error { return errors.New("test error") }
+
+func Test_healthUseCase_Check(t *testing.T) {
+	tests := []struct {
+		name        string
+		checks      map[string]CheckFunc
+		order       []string
+		wantReady   bool
+		wantResults []*entity.CheckResult
+	}{
+		{
+			name:        "no checks",
+			wantReady:   true,
+			wantResults: []*entity.CheckResult{},
+		},
+		{
+			name: "all available",
+			checks: map[string]CheckFunc{
+				"portals": ok,
+				"redis":   ok,
+			},
+			order:     []string{"portals", "redis"},
+			wantReady: true,
+			wantResults: []*entity.CheckResult{
+				{Name: "portals", Required: true},
+				{Name: "redis"},
+			},
+		},
+		{
+			name: "optional unavailable",
+			checks: map[string]CheckFunc{
+				"portals": ok,
+				"redis":   fail,
+			},
+			order:     []string{"portals", "redis"},
+			wantReady: true,
+			wantResults: []*entity.CheckResult{
+				{Name: "portals", Required: true},
+				{Name: "redis", Err: errors.New("test error")},
+			},
+		},
+		{
+			name: "required unavailable",
+			checks: map[string]CheckFunc{
+				"redis":   ok,
+				"portals": fail,
+			},
+			order:     []string{"redis", "portals"},
+			wantReady: false,
+			wantResults: []*entity.CheckResult{
+				{Name: "redis"},
+				{Name: "portals", Required: true, Err: errors.New("test error")},
+			},
+		},
+		{
+			name: "check timeout",
+			checks: map[string]CheckFunc{
+				"portals": func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			order:     []string{"portals"},
+			wantReady: false,
+			wantResults: []*entity.CheckResult{
+				{Name: "portals", Required: true, Err: context.DeadlineExceeded},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthUseCase([]string{"portals", " "}, 10*time.Millisecond, ditzap.NewMockLogger(gomock.NewController(t)))
+			for _, name := range tt.order {
+				h.Register(name, tt.checks[name])
+			}
+
+			ready, results := h.Check(context.TODO())
+			assert.Equal(t, tt.wantReady, ready)
+			assert.Equal(t, tt.wantResults, results)
+		})
+	}
+}
+
+func Test_healthUseCase_WatchRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		check    CheckFunc
+		prepare  func(logger *ditzap.MockLogger)
+		wantStop bool
+	}{
+		{
+			name:  "required available",
+			check: ok,
+		},
+		{
+			name:  "required unavailable",
+			check: fail,
+			prepare: func(logger *ditzap.MockLogger) {
+				logger.EXPECT().Error("required dependency is unavailable", gomock.Any(), gomock.Any())
+			},
+			wantStop: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := ditzap.NewMockLogger(gomock.NewController(t))
+			if tt.prepare != nil {
+				tt.prepare(logger)
+			}
+			h := NewHealthUseCase([]string{"portals"}, time.Second, logger)
+			h.Register("portals", tt.check)
+			h.Register("redis", fail)
+
+			stopped := false
+			h.WatchRequired(context.TODO(), time.Millisecond, func() { stopped = true })
+			assert.Equal(t, tt.wantStop, stopped)
+		})
+	}
+}
+
+func Test_healthUseCase_ValidateRequired(t *testing.T) {
+	tests := []struct {
+		name     string
+		required []string
+		wantErr  error
+	}{
+		{
+			name:     "all registered",
+			required: []string{"portals", "redis"},
+		},
+		{
+			name:     "unknown names",
+			required: []string{"portals", "radis", "files"},
+			wantErr:  fmt.Errorf("%w: files, radis", ErrUnknownRequired),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHealthUseCase(tt.required, time.Second, ditzap.NewMockLogger(gomock.NewController(t)))
+			h.Register("portals", ok)
+			h.Register("redis", ok)
+
+			err := h.ValidateRequired()
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, ErrUnknownRequired)
+				assert.EqualError(t, err, tt.wantErr.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
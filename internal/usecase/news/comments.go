@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
 
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
 	"github.com/google/uuid"
@@ -16,21 +20,48 @@ import (
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
 )
 
+// commentsPageRequests сколько раз запрашивается сервис новостей, чтобы добрать страницу комментариев верхнего уровня
+const commentsPageRequests = 3
+
+// mentionPattern упоминание сотрудника в тексте комментария
+var mentionPattern = regexp.MustCompile(`@([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})`)
+
 type commentInteractor struct {
 	commentsRepository CommentsRepository
+	newsRepository     NewsRepository
 	employeeRepository EmployeesRepository
-	logger             ditzap.Logger
+	reportsRepository  CommentReportsRepository
+	threadsRepository  CommentThreadsRepository
+	moderation         CommentModerationRepository
+	visibility         VisibilityEvaluator
+	// editWindow время после создания, в течение которого автор может изменить или удалить комментарий
+	editWindow time.Duration
+	logger     ditzap.Logger
+	now        func() time.Time
 }
 
 func NewCommentInteractor(
 	commentsRepository CommentsRepository,
+	newsRepository NewsRepository,
 	employeeRepository EmployeesRepository,
+	reportsRepository CommentReportsRepository,
+	threadsRepository CommentThreadsRepository,
+	moderation CommentModerationRepository,
+	visibility VisibilityEvaluator,
+	editWindow time.Duration,
 	logger ditzap.Logger,
 ) *commentInteractor {
 	return &commentInteractor{
 		commentsRepository: commentsRepository,
+		newsRepository:     newsRepository,
 		employeeRepository: employeeRepository,
+		reportsRepository:  reportsRepository,
+		threadsRepository:  threadsRepository,
+		moderation:         moderation,
+		visibility:         visibility,
+		editWindow:         editWindow,
 		logger:             logger,
+		now:                time.Now,
 	}
 }
 
@@ -57,6 +88,21 @@ func (i *commentInteractor) Create(ctx context.Context, in dtoNews.NewComment) (
 		return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: invalid request: %w", errAuthor)
 	}
 
+	news, err := i.newsRepository.GetWithoutComments(ctx, in.NewsID)
+	if err != nil {
+		logger.Debug("commentInteractor.Create: can't get news", zap.Error(err))
+		return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: can't get news: %w", err)
+	}
+	if !news.CanCommented {
+		return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: %w", ErrCommentsDisabled)
+	}
+
+	thread, err := i.newThread(ctx, news, in)
+	if err != nil {
+		logger.Debug("commentInteractor.Create: can't reply", zap.Error(err))
+		return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: %w", err)
+	}
+
 	// 3) Создание комментария в репозитории
 	createdID, count, err := i.commentsRepository.Create(ctx, in)
 	if err != nil {
@@ -77,6 +123,18 @@ func (i *commentInteractor) Create(ctx context.Context, in dtoNews.NewComment) (
 		return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: can't create comment: %w", err)
 	}
 
+	if thread.ParentID != nil || len(thread.Mentions) > 0 {
+		thread.CommentID = createdID
+		if err = i.threadsRepository.Save(ctx, thread); err != nil {
+			logger.Error("commentInteractor.Create: can't save comment thread", zap.Error(err), zap.String("comment_id", createdID.String()))
+			// Без ветки ответ показался бы комментарием верхнего уровня, а повтор запроса создал бы его второй раз
+			if dErr := i.commentsRepository.Delete(ctx, createdID); dErr != nil {
+				logger.Error("commentInteractor.Create: can't delete comment without thread", zap.Error(dErr), zap.String("comment_id", createdID.String()))
+			}
+			return uuid.Nil, 0, fmt.Errorf("commentInteractor.Create: can't save comment thread: %w", err)
+		}
+	}
+
 	return createdID, count, nil
 }
 
@@ -91,11 +149,453 @@ func (i *commentInteractor) List(ctx context.Context, params *dtoNews.FilterComm
 		PortalID: session.ActivePortal.GetPortalID(),
 	}
 
-	comments, total, err := i.commentsRepository.List(ctx, params)
+	news, err := i.newsRepository.Get(ctx, params.NewsID)
+	if err != nil {
+		return nil, 0, fmt.Errorf("commentInteractor.List: can't get news: %w", err)
+	}
+	visible, err := i.visibility.IsVisible(ctx, news)
+	if err != nil {
+		return nil, 0, fmt.Errorf("commentInteractor.List: can't check visibility: %w", err)
+	}
+	if !visible {
+		return nil, 0, fmt.Errorf("commentInteractor.List: news is hidden by category visibility: %w", diterrors.ErrNotFound)
+	}
+
+	var (
+		comments []*entityNews.NewsComment
+		total    int
+	)
+	if params.ParentID != nil {
+		comments, total, err = i.replies(ctx, news, params)
+	} else {
+		comments, total, err = i.topLevel(ctx, params)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("commentInteractor.List: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	replies, err := i.threadsRepository.RepliesCount(ctx, ids)
+	if err != nil {
+		i.logger.Error("commentInteractor.List: can't count replies", zap.Error(err), zap.String("news_id", params.NewsID.String()))
+		return nil, 0, fmt.Errorf("commentInteractor.List: can't count replies: %w", err)
+	}
+	for _, comment := range comments {
+		comment.Replies = replies[comment.ID]
+	}
+
+	return comments, total, nil
+}
+
+// topLevel комментарии верхнего уровня. Сервис новостей отдает ответы вперемешку с ними,
+//
+//	поэтому ответы отбрасываются, страница добирается следующими запросами, а из общего количества вычитаются ответы
+func (i *commentInteractor) topLevel(ctx context.Context, params *dtoNews.FilterComments) ([]*entityNews.NewsComment, int, error) {
+	filter := *params
+	comments := make([]*entityNews.NewsComment, 0, params.Limit)
+	var total int
+	for range commentsPageRequests {
+		page, pageTotal, err := i.commentsRepository.List(ctx, &filter)
+		if err != nil {
+			i.logger.Error("commentInteractor.List: can't get comments", zap.Error(err), zap.String("news_id", params.NewsID.String()))
+			return nil, 0, fmt.Errorf("can't get comments: %w", err)
+		}
+		total = pageTotal
+
+		threads, err := i.commentThreads(ctx, page)
+		if err != nil {
+			return nil, 0, err
+		}
+		if err = i.moderate(ctx, page...); err != nil {
+			return nil, 0, err
+		}
+		for _, comment := range page {
+			if params.Limit > 0 && len(comments) == params.Limit {
+				break
+			}
+			threads[comment.ID].Apply(comment)
+			if comment.ParentID == nil {
+				comments = append(comments, comment)
+			}
+		}
+
+		if filter.Limit <= 0 || len(page) < filter.Limit || len(comments) == params.Limit {
+			break
+		}
+		filter.AfterID = &page[len(page)-1].ID
+	}
+
+	replies, err := i.threadsRepository.NewsRepliesCount(ctx, params.NewsID)
 	if err != nil {
-		i.logger.Error("commentInteractor.Get: can't get comments", zap.Error(err), zap.String("news_id", params.NewsID.String()))
-		return nil, 0, fmt.Errorf("commentInteractor.Get: can't get comments: %w", err)
+		i.logger.Error("commentInteractor.List: can't count news replies", zap.Error(err), zap.String("news_id", params.NewsID.String()))
+		return nil, 0, fmt.Errorf("can't count news replies: %w", err)
 	}
+	return comments, max(total-replies, 0), nil
+}
 
+// replies ответы на комментарий params.ParentID
+func (i *commentInteractor) replies(ctx context.Context, news *entityNews.NewsFull, params *dtoNews.FilterComments) ([]*entityNews.NewsComment, int, error) {
+	if findComment(news, *params.ParentID) == nil {
+		return nil, 0, ErrCommentNotFound
+	}
+
+	ids, total, err := i.threadsRepository.Replies(ctx, *params.ParentID, params.AfterID, params.Limit, params.Order)
+	if err != nil {
+		i.logger.Error("commentInteractor.List: can't get replies", zap.Error(err), zap.String("comment_id", params.ParentID.String()))
+		return nil, 0, fmt.Errorf("can't get replies: %w", err)
+	}
+
+	comments := make([]*entityNews.NewsComment, 0, len(ids))
+	for _, id := range ids {
+		if comment := findComment(news, id); comment != nil {
+			comments = append(comments, comment)
+		}
+	}
+	threads, err := i.commentThreads(ctx, comments)
+	if err != nil {
+		return nil, 0, err
+	}
+	for _, comment := range comments {
+		threads[comment.ID].Apply(comment)
+	}
+	if err = i.moderate(ctx, comments...); err != nil {
+		return nil, 0, err
+	}
 	return comments, total, nil
 }
+
+// moderate отмечает комментарии, скрытые модераторами
+func (i *commentInteractor) moderate(ctx context.Context, comments ...*entityNews.NewsComment) error {
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	moderation, err := i.moderation.List(ctx, ids)
+	if err != nil {
+		i.logger.Error("commentInteractor: can't get comments moderation", zap.Error(err))
+		return fmt.Errorf("can't get comments moderation: %w", err)
+	}
+	for _, comment := range comments {
+		moderation[comment.ID].Apply(comment)
+	}
+	return nil
+}
+
+// commentThreads ветки обсуждений комментариев
+func (i *commentInteractor) commentThreads(ctx context.Context, comments []*entityNews.NewsComment) (map[uuid.UUID]*entityNews.CommentThread, error) {
+	ids := make([]uuid.UUID, 0, len(comments))
+	for _, comment := range comments {
+		ids = append(ids, comment.ID)
+	}
+	threads, err := i.threadsRepository.List(ctx, ids)
+	if err != nil {
+		i.logger.Error("commentInteractor.List: can't get comment threads", zap.Error(err))
+		return nil, fmt.Errorf("can't get comment threads: %w", err)
+	}
+	return threads, nil
+}
+
+// newThread ветка обсуждения нового комментария: проверяет комментарий, на который дан ответ, и находит упомянутых сотрудников
+func (i *commentInteractor) newThread(ctx context.Context, news *entityNews.NewsFull, in dtoNews.NewComment) (*entityNews.CommentThread, error) {
+	thread := &entityNews.CommentThread{
+		NewsID:    news.ID,
+		ParentID:  in.ParentID,
+		CreatedAt: i.now(),
+	}
+
+	if in.ParentID != nil {
+		parent, err := i.comment(ctx, news.ID, *in.ParentID)
+		if err != nil {
+			return nil, err
+		}
+		if parent.DeletedAt != nil || parent.HiddenAt != nil {
+			return nil, ErrCommentRemoved
+		}
+
+		threads, err := i.threadsRepository.List(ctx, []uuid.UUID{parent.ID})
+		if err != nil {
+			return nil, fmt.Errorf("can't get parent comment thread: %w", err)
+		}
+		depth := 1
+		if parentThread := threads[parent.ID]; parentThread != nil {
+			depth = parentThread.Depth + 1
+		}
+		if depth > entityNews.CommentMaxDepth {
+			return nil, diterrors.NewValidationError(ErrCommentDepth, diterrors.ErrValidationFields{
+				Field:   "parent_id",
+				Message: fmt.Sprintf("replies are allowed only %d levels deep", entityNews.CommentMaxDepth),
+			})
+		}
+		thread.Depth = depth
+	}
+
+	for _, id := range mentionedIDs(in.Text) {
+		employee, err := i.employeeRepository.Get(ctx, id)
+		if err != nil || employee == nil {
+			i.logger.Debug("commentInteractor.Create: can't resolve mention", zap.Error(err), zap.String("employee_id", id.String()))
+			continue
+		}
+		if employee.IsFired {
+			continue
+		}
+		author := authorFromEmployee(employee)
+		thread.Mentions = append(thread.Mentions, &author)
+	}
+	return thread, nil
+}
+
+// Update изменяет текст комментария автором
+func (i *commentInteractor) Update(ctx context.Context, in dtoNews.UpdateComment) (*entityNews.NewsComment, error) {
+	if err := in.Validate(); err != nil {
+		return nil, fmt.Errorf("commentInteractor.Update: invalid request: %w", err)
+	}
+
+	if _, err := i.authorComment(ctx, in.NewsID, in.CommentID); err != nil {
+		return nil, fmt.Errorf("commentInteractor.Update: %w", err)
+	}
+
+	comment, err := i.commentsRepository.Update(ctx, in.CommentID, in.Text)
+	if err != nil {
+		i.logger.Error("commentInteractor.Update: can't update comment", zap.Error(err), zap.String("comment_id", in.CommentID.String()))
+		return nil, fmt.Errorf("commentInteractor.Update: can't update comment: %w", err)
+	}
+	return comment, nil
+}
+
+// Delete удаляет комментарий автором
+func (i *commentInteractor) Delete(ctx context.Context, newsID, commentID uuid.UUID) error {
+	if _, err := i.authorComment(ctx, newsID, commentID); err != nil {
+		return fmt.Errorf("commentInteractor.Delete: %w", err)
+	}
+
+	if err := i.commentsRepository.Delete(ctx, commentID); err != nil {
+		i.logger.Error("commentInteractor.Delete: can't delete comment", zap.Error(err), zap.String("comment_id", commentID.String()))
+		return fmt.Errorf("commentInteractor.Delete: can't delete comment: %w", err)
+	}
+	return nil
+}
+
+// Report сохраняет жалобу читателя на комментарий. Повторная жалоба сотрудника на тот же комментарий не создается
+func (i *commentInteractor) Report(ctx context.Context, in dtoNews.NewCommentReport) error {
+	if err := in.Validate(); err != nil {
+		return fmt.Errorf("commentInteractor.Report: invalid request: %w", err)
+	}
+
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return fmt.Errorf("commentInteractor.Report: can't get session: %w", err)
+	}
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		return fmt.Errorf("commentInteractor.Report: can't get employee: %w", err)
+	}
+
+	if _, err = i.comment(ctx, in.NewsID, in.CommentID); err != nil {
+		return fmt.Errorf("commentInteractor.Report: %w", err)
+	}
+
+	report := &entityNews.CommentReport{
+		ID:         uuid.New(),
+		CommentID:  in.CommentID,
+		NewsID:     in.NewsID,
+		PortalID:   session.ActivePortal.GetPortalID(),
+		ReporterID: employee.ID,
+		Reason:     strings.TrimSpace(in.Reason),
+		CreatedAt:  i.now(),
+	}
+	if err = i.reportsRepository.Add(ctx, report); err != nil {
+		i.logger.Error("commentInteractor.Report: can't save report", zap.Error(err), zap.String("comment_id", in.CommentID.String()))
+		return fmt.Errorf("commentInteractor.Report: can't save report: %w", err)
+	}
+	return nil
+}
+
+// SetHidden скрывает или восстанавливает комментарий к новости активного портала администратора.
+//
+//	При скрытии жалобы на комментарий считаются рассмотренными и удаляются из очереди
+func (i *commentInteractor) SetHidden(ctx context.Context, newsID, commentID uuid.UUID, hidden bool) error {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return fmt.Errorf("commentInteractor.SetHidden: can't get session: %w", err)
+	}
+
+	news, err := i.newsRepository.GetWithoutComments(ctx, newsID)
+	if err != nil {
+		return fmt.Errorf("commentInteractor.SetHidden: can't get news: %w", err)
+	}
+	if !onPortal(news, session.ActivePortal.GetPortalID()) {
+		return fmt.Errorf("commentInteractor.SetHidden: %w", ErrForeignPortal)
+	}
+
+	if _, err = i.comment(ctx, newsID, commentID); err != nil {
+		return fmt.Errorf("commentInteractor.SetHidden: %w", err)
+	}
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		return fmt.Errorf("commentInteractor.SetHidden: can't get employee: %w", err)
+	}
+
+	if hidden {
+		err = i.moderation.Hide(ctx, &entityNews.CommentModeration{
+			CommentID: commentID,
+			HiddenAt:  i.now(),
+			HiddenBy:  employee.ID,
+		})
+	} else {
+		err = i.moderation.Unhide(ctx, commentID)
+	}
+	if err != nil {
+		i.logger.Error("commentInteractor.SetHidden: can't hide comment", zap.Error(err), zap.String("comment_id", commentID.String()))
+		return fmt.Errorf("commentInteractor.SetHidden: can't hide comment: %w", err)
+	}
+
+	if hidden {
+		i.resolveReports(ctx, session.ActivePortal.GetPortalID(), commentID)
+	}
+	return nil
+}
+
+// Reports очередь жалоб на комментарии активного портала администратора, старые жалобы первыми
+func (i *commentInteractor) Reports(ctx context.Context) ([]*entityNews.CommentReport, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("commentInteractor.Reports: can't get session: %w", err)
+	}
+
+	reports, err := i.reportsRepository.List(ctx, session.ActivePortal.GetPortalID())
+	if err != nil {
+		i.logger.Error("commentInteractor.Reports: can't get reports", zap.Error(err))
+		return nil, fmt.Errorf("commentInteractor.Reports: can't get reports: %w", err)
+	}
+	return reports, nil
+}
+
+// DismissReport отклоняет жалобу без изменения комментария
+func (i *commentInteractor) DismissReport(ctx context.Context, id uuid.UUID) error {
+	reports, err := i.Reports(ctx)
+	if err != nil {
+		return fmt.Errorf("commentInteractor.DismissReport: %w", err)
+	}
+	if !slices.ContainsFunc(reports, func(report *entityNews.CommentReport) bool { return report.ID == id }) {
+		return fmt.Errorf("commentInteractor.DismissReport: %w", ErrReportNotFound)
+	}
+
+	if err = i.reportsRepository.Delete(ctx, id); err != nil {
+		i.logger.Error("commentInteractor.DismissReport: can't delete report", zap.Error(err))
+		return fmt.Errorf("commentInteractor.DismissReport: can't delete report: %w", err)
+	}
+	return nil
+}
+
+// comment комментарий к новости. Запрашивается только сам комментарий, а не новость со всеми комментариями
+func (i *commentInteractor) comment(ctx context.Context, newsID, commentID uuid.UUID) (*entityNews.NewsComment, error) {
+	comment, err := i.commentsRepository.Get(ctx, commentID)
+	if err != nil {
+		if errors.Is(err, diterrors.ErrNotFound) {
+			return nil, ErrCommentNotFound
+		}
+		return nil, fmt.Errorf("can't get comment: %w", err)
+	}
+	if comment.NewsID != newsID {
+		return nil, ErrCommentNotFound
+	}
+	if err = i.moderate(ctx, comment); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// authorComment комментарий, который текущий сотрудник может изменить или удалить
+func (i *commentInteractor) authorComment(ctx context.Context, newsID, commentID uuid.UUID) (*entityNews.NewsComment, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("can't get session: %w", err)
+	}
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		return nil, fmt.Errorf("can't get employee: %w", err)
+	}
+
+	comment, err := i.comment(ctx, newsID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkAuthorChange(comment, employee.ID, i.now(), i.editWindow); err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// resolveReports удаляет из очереди жалобы на комментарий. Ошибки только логируются, комментарий уже скрыт
+func (i *commentInteractor) resolveReports(ctx context.Context, portalID int, commentID uuid.UUID) {
+	reports, err := i.reportsRepository.List(ctx, portalID)
+	if err != nil {
+		i.logger.Warn("commentInteractor.resolveReports: can't get reports", zap.Error(err))
+		return
+	}
+
+	ids := make([]uuid.UUID, 0, len(reports))
+	for _, report := range reports {
+		if report.CommentID == commentID {
+			ids = append(ids, report.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+	if err = i.reportsRepository.Delete(ctx, ids...); err != nil {
+		i.logger.Warn("commentInteractor.resolveReports: can't delete reports", zap.Error(err))
+	}
+}
+
+// mentionedIDs идентификаторы сотрудников, упомянутых в тексте как @<идентификатор>.
+//
+//	Повторы отбрасываются, учитываются первые entityNews.CommentMaxMentions упоминаний
+func mentionedIDs(text string) []uuid.UUID {
+	matches := mentionPattern.FindAllStringSubmatch(text, -1)
+	ids := make([]uuid.UUID, 0, len(matches))
+	for _, match := range matches {
+		id, err := uuid.Parse(match[1])
+		if err != nil || slices.Contains(ids, id) {
+			continue
+		}
+		ids = append(ids, id)
+		if len(ids) == entityNews.CommentMaxMentions {
+			break
+		}
+	}
+	return ids
+}
+
+// checkAuthorChange проверяет, что сотрудник может изменить или удалить комментарий в момент now
+func checkAuthorChange(comment *entityNews.NewsComment, employeeID uuid.UUID, now time.Time, editWindow time.Duration) error {
+	if comment.DeletedAt != nil || comment.HiddenAt != nil {
+		return ErrCommentRemoved
+	}
+	if comment.Author.ID == nil || *comment.Author.ID != employeeID {
+		return ErrCommentNotAuthor
+	}
+	if comment.CreatedAt == nil || now.Sub(*comment.CreatedAt) > editWindow {
+		return ErrCommentEditExpired
+	}
+	return nil
+}
+
+// findComment комментарий новости по идентификатору
+func findComment(news *entityNews.NewsFull, commentID uuid.UUID) *entityNews.NewsComment {
+	for _, comment := range news.Comments {
+		if comment != nil && comment.ID == commentID {
+			return comment
+		}
+	}
+	return nil
+}
+
+// onPortal новость опубликована на портале. Новость без порталов не относится ни к одному порталу,
+// поэтому администратор портала ею не управляет
+func onPortal(news *entityNews.NewsFull, portalID int) bool {
+	return slices.Contains(news.PortalIDs(), portalID)
+}
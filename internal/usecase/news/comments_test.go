@@ -0,0 +1,398 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_checkAuthorChange(t *testing.T) {
+	authorID := uuid.New()
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-10 * time.Minute)
+	comment := func(modify func(c *entityNews.NewsComment)) *entityNews.NewsComment {
+		c := &entityNews.NewsComment{
+			ID:        uuid.New(),
+			Message:   "text",
+			Author:    entityNews.Author{ID: &authorID},
+			CreatedAt: &createdAt,
+		}
+		if modify != nil {
+			modify(c)
+		}
+		return c
+	}
+
+	tests := []struct {
+		name       string
+		comment    *entityNews.NewsComment
+		employeeID uuid.UUID
+		wantErr    error
+	}{
+		{
+			name:       "author within window",
+			comment:    comment(nil),
+			employeeID: authorID,
+		},
+		{
+			name:       "not author",
+			comment:    comment(nil),
+			employeeID: uuid.New(),
+			wantErr:    ErrCommentNotAuthor,
+		},
+		{
+			name: "window expired",
+			comment: comment(func(c *entityNews.NewsComment) {
+				expired := now.Add(-time.Hour)
+				c.CreatedAt = &expired
+			}),
+			employeeID: authorID,
+			wantErr:    ErrCommentEditExpired,
+		},
+		{
+			name: "hidden",
+			comment: comment(func(c *entityNews.NewsComment) {
+				c.HiddenAt = &now
+			}),
+			employeeID: authorID,
+			wantErr:    ErrCommentRemoved,
+		},
+		{
+			name: "deleted",
+			comment: comment(func(c *entityNews.NewsComment) {
+				c.DeletedAt = &now
+			}),
+			employeeID: authorID,
+			wantErr:    ErrCommentRemoved,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, checkAuthorChange(tt.comment, tt.employeeID, now, 15*time.Minute), tt.wantErr)
+		})
+	}
+}
+
+func Test_onPortal(t *testing.T) {
+	news := &entityNews.NewsFull{
+		Visibility: &entityNews.NewsNamedVisibility{Portals: []*entityNews.NewsPortal{{ID: 1}, {ID: 2}}},
+	}
+
+	assert.True(t, onPortal(news, 2))
+	assert.False(t, onPortal(news, 3))
+	assert.False(t, onPortal(&entityNews.NewsFull{}, 3))
+}
+
+func Test_mentionedIDs(t *testing.T) {
+	first := uuid.New()
+	second := uuid.New()
+
+	tests := []struct {
+		name string
+		text string
+		want []uuid.UUID
+	}{
+		{
+			name: "no mentions",
+			text: "просто текст @ и почта user@example.com",
+			want: []uuid.UUID{},
+		},
+		{
+			name: "mentions",
+			text: "@" + first.String() + ", посмотри вместе с @" + second.String() + " и @" + first.String(),
+			want: []uuid.UUID{first, second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, mentionedIDs(tt.text))
+		})
+	}
+
+	var text string
+	for range entityNews.CommentMaxMentions + 1 {
+		text += "@" + uuid.NewString() + " "
+	}
+	assert.Len(t, mentionedIDs(text), entityNews.CommentMaxMentions)
+}
+
+func Test_commentInteractor_topLevel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	commentsRepository := NewMockCommentsRepository(ctrl)
+	threadsRepository := NewMockCommentThreadsRepository(ctrl)
+	moderationRepository := NewMockCommentModerationRepository(ctrl)
+	interactor := NewCommentInteractor(commentsRepository, nil, nil, nil, threadsRepository, moderationRepository, nil, time.Minute, nil)
+
+	newsID := uuid.New()
+	parentID := uuid.New()
+	comments := make([]*entityNews.NewsComment, 5)
+	for i := range comments {
+		comments[i] = &entityNews.NewsComment{ID: uuid.New()}
+	}
+	// второй и третий комментарии - ответы, страница добирается вторым запросом
+	threads := map[uuid.UUID]*entityNews.CommentThread{
+		comments[1].ID: {CommentID: comments[1].ID, ParentID: &parentID, Depth: 1},
+		comments[2].ID: {CommentID: comments[2].ID, ParentID: &parentID, Depth: 1},
+	}
+
+	commentsRepository.EXPECT().List(gomock.Any(), &dtoNews.FilterComments{NewsID: newsID, Limit: 3}).
+		Return(comments[:3], 5, nil)
+	commentsRepository.EXPECT().List(gomock.Any(), &dtoNews.FilterComments{NewsID: newsID, Limit: 3, AfterID: &comments[2].ID}).
+		Return(comments[3:], 5, nil)
+	threadsRepository.EXPECT().List(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ids []uuid.UUID) (map[uuid.UUID]*entityNews.CommentThread, error) {
+			found := make(map[uuid.UUID]*entityNews.CommentThread)
+			for _, id := range ids {
+				if thread, ok := threads[id]; ok {
+					found[id] = thread
+				}
+			}
+			return found, nil
+		}).Times(2)
+	hiddenAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	moderationRepository.EXPECT().List(gomock.Any(), []uuid.UUID{comments[0].ID, comments[1].ID, comments[2].ID}).
+		Return(map[uuid.UUID]*entityNews.CommentModeration{}, nil)
+	moderationRepository.EXPECT().List(gomock.Any(), []uuid.UUID{comments[3].ID, comments[4].ID}).
+		Return(map[uuid.UUID]*entityNews.CommentModeration{comments[4].ID: {CommentID: comments[4].ID, HiddenAt: hiddenAt}}, nil)
+	threadsRepository.EXPECT().NewsRepliesCount(gomock.Any(), newsID).Return(2, nil)
+
+	got, total, err := interactor.topLevel(context.TODO(), &dtoNews.FilterComments{NewsID: newsID, Limit: 3})
+	assert.NoError(t, err)
+	// в общем количестве сервиса новостей учтены и ответы
+	assert.Equal(t, 3, total)
+	assert.Equal(t, []*entityNews.NewsComment{comments[0], comments[3], comments[4]}, got)
+	// скрытие модератором хранится в web-api и переносится в комментарий
+	assert.Equal(t, &hiddenAt, got[2].HiddenAt)
+	assert.Nil(t, got[1].HiddenAt)
+}
+
+func Test_commentInteractor_SetHidden(t *testing.T) {
+	newsID := uuid.New()
+	commentID := uuid.New()
+	reportID := uuid.New()
+	employee := &entityEmployee.Employee{ID: uuid.New()}
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	onActivePortal := &entityNews.NewsFull{
+		ID:         newsID,
+		Visibility: &entityNews.NewsNamedVisibility{Portals: []*entityNews.NewsPortal{{ID: 1}}},
+	}
+
+	tests := []struct {
+		name    string
+		hidden  bool
+		prepare func(news *MockNewsRepository, comments *MockCommentsRepository, employees *MockEmployeesRepository, reports *MockCommentReportsRepository, moderation *MockCommentModerationRepository)
+		wantErr error
+	}{
+		{
+			name: "news without portals",
+			prepare: func(n *MockNewsRepository, _ *MockCommentsRepository, _ *MockEmployeesRepository, _ *MockCommentReportsRepository, _ *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(&entityNews.NewsFull{ID: newsID}, nil)
+			},
+			wantErr: ErrForeignPortal,
+		},
+		{
+			name: "comment of another news",
+			prepare: func(n *MockNewsRepository, c *MockCommentsRepository, _ *MockEmployeesRepository, _ *MockCommentReportsRepository, _ *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(onActivePortal, nil)
+				c.EXPECT().Get(gomock.Any(), commentID).Return(&entityNews.NewsComment{ID: commentID, NewsID: uuid.New()}, nil)
+			},
+			wantErr: ErrCommentNotFound,
+		},
+		{
+			name: "comment not found",
+			prepare: func(n *MockNewsRepository, c *MockCommentsRepository, _ *MockEmployeesRepository, _ *MockCommentReportsRepository, _ *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(onActivePortal, nil)
+				c.EXPECT().Get(gomock.Any(), commentID).Return(nil, diterrors.ErrNotFound)
+			},
+			wantErr: ErrCommentNotFound,
+		},
+		{
+			name:   "hidden, reports resolved",
+			hidden: true,
+			prepare: func(n *MockNewsRepository, c *MockCommentsRepository, e *MockEmployeesRepository, r *MockCommentReportsRepository, m *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(onActivePortal, nil)
+				c.EXPECT().Get(gomock.Any(), commentID).Return(&entityNews.NewsComment{ID: commentID, NewsID: newsID}, nil)
+				m.EXPECT().List(gomock.Any(), []uuid.UUID{commentID}).Return(map[uuid.UUID]*entityNews.CommentModeration{}, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				m.EXPECT().Hide(gomock.Any(), &entityNews.CommentModeration{CommentID: commentID, HiddenAt: now, HiddenBy: employee.ID}).Return(nil)
+				r.EXPECT().List(gomock.Any(), 1).Return([]*entityNews.CommentReport{
+					{ID: reportID, CommentID: commentID},
+					{ID: uuid.New(), CommentID: uuid.New()},
+				}, nil)
+				r.EXPECT().Delete(gomock.Any(), reportID).Return(nil)
+			},
+		},
+		{
+			name:   "unhidden",
+			hidden: false,
+			prepare: func(n *MockNewsRepository, c *MockCommentsRepository, e *MockEmployeesRepository, _ *MockCommentReportsRepository, m *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(onActivePortal, nil)
+				c.EXPECT().Get(gomock.Any(), commentID).Return(&entityNews.NewsComment{ID: commentID, NewsID: newsID}, nil)
+				m.EXPECT().List(gomock.Any(), []uuid.UUID{commentID}).
+					Return(map[uuid.UUID]*entityNews.CommentModeration{commentID: {CommentID: commentID, HiddenAt: now}}, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				m.EXPECT().Unhide(gomock.Any(), commentID).Return(nil)
+			},
+		},
+		{
+			name:   "moderation error",
+			hidden: true,
+			prepare: func(n *MockNewsRepository, c *MockCommentsRepository, e *MockEmployeesRepository, _ *MockCommentReportsRepository, m *MockCommentModerationRepository) {
+				n.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(onActivePortal, nil)
+				c.EXPECT().Get(gomock.Any(), commentID).Return(&entityNews.NewsComment{ID: commentID, NewsID: newsID}, nil)
+				m.EXPECT().List(gomock.Any(), []uuid.UUID{commentID}).Return(map[uuid.UUID]*entityNews.CommentModeration{}, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				m.EXPECT().Hide(gomock.Any(), gomock.Any()).Return(errors.New("redis error"))
+			},
+			wantErr: errors.New("commentInteractor.SetHidden: can't hide comment: redis error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			commentsRepository := NewMockCommentsRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			reportsRepository := NewMockCommentReportsRepository(ctrl)
+			moderationRepository := NewMockCommentModerationRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.prepare(newsRepository, commentsRepository, employeesRepository, reportsRepository, moderationRepository)
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewCommentInteractor(commentsRepository, newsRepository, employeesRepository, reportsRepository, nil, moderationRepository, nil, time.Minute, logger)
+			interactor.now = func() time.Time { return now }
+
+			err := interactor.SetHidden(ctx, newsID, commentID, tt.hidden)
+			switch {
+			case errors.Is(tt.wantErr, ErrForeignPortal), errors.Is(tt.wantErr, ErrCommentNotFound):
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErr != nil:
+				assert.EqualError(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func Test_commentInteractor_Update(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	commentsRepository := NewMockCommentsRepository(ctrl)
+	employeesRepository := NewMockEmployeesRepository(ctrl)
+	moderationRepository := NewMockCommentModerationRepository(ctrl)
+	interactor := NewCommentInteractor(commentsRepository, nil, employeesRepository, nil, nil, moderationRepository, nil, 15*time.Minute, nil)
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	interactor.now = func() time.Time { return now }
+	createdAt := now.Add(-time.Minute)
+	newsID := uuid.New()
+	commentID := uuid.New()
+	employee := &entityEmployee.Employee{ID: uuid.New()}
+	updated := &entityNews.NewsComment{ID: commentID, NewsID: newsID, Message: "новый текст", UpdatedAt: &now}
+
+	employeesRepository.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+	commentsRepository.EXPECT().Get(gomock.Any(), commentID).Return(&entityNews.NewsComment{
+		ID:        commentID,
+		NewsID:    newsID,
+		Author:    entityNews.Author{ID: &employee.ID},
+		CreatedAt: &createdAt,
+	}, nil)
+	moderationRepository.EXPECT().List(gomock.Any(), []uuid.UUID{commentID}).Return(map[uuid.UUID]*entityNews.CommentModeration{}, nil)
+	// Правка сохраняется в сервисе новостей, а не поверх его комментария
+	commentsRepository.EXPECT().Update(gomock.Any(), commentID, "новый текст").Return(updated, nil)
+
+	ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+		User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+		ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+	})
+	got, err := interactor.Update(ctx, dtoNews.UpdateComment{NewsID: newsID, CommentID: commentID, Text: "новый текст"})
+	assert.NoError(t, err)
+	assert.Equal(t, updated, got)
+}
+
+func Test_commentInteractor_Create(t *testing.T) {
+	newsID := uuid.New()
+	parentID := uuid.New()
+	createdID := uuid.New()
+	employee := &entityEmployee.Employee{ID: uuid.New()}
+	news := &entityNews.NewsFull{ID: newsID, CanCommented: true}
+
+	tests := []struct {
+		name    string
+		prepare func(comments *MockCommentsRepository, threads *MockCommentThreadsRepository)
+		wantErr error
+	}{
+		{
+			name: "thread is not saved, comment is deleted",
+			prepare: func(c *MockCommentsRepository, th *MockCommentThreadsRepository) {
+				c.EXPECT().Create(gomock.Any(), gomock.Any()).Return(createdID, 2, nil)
+				th.EXPECT().Save(gomock.Any(), gomock.Any()).Return(errors.New("redis error"))
+				c.EXPECT().Delete(gomock.Any(), createdID).Return(nil)
+			},
+			wantErr: errors.New("commentInteractor.Create: can't save comment thread: redis error"),
+		},
+		{
+			name: "reply",
+			prepare: func(c *MockCommentsRepository, th *MockCommentThreadsRepository) {
+				c.EXPECT().Create(gomock.Any(), gomock.Any()).Return(createdID, 2, nil)
+				th.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, thread *entityNews.CommentThread) error {
+					assert.Equal(t, createdID, thread.CommentID)
+					assert.Equal(t, &parentID, thread.ParentID)
+					assert.Equal(t, 1, thread.Depth)
+					return nil
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			commentsRepository := NewMockCommentsRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			threadsRepository := NewMockCommentThreadsRepository(ctrl)
+			moderationRepository := NewMockCommentModerationRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			employeesRepository.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+			newsRepository.EXPECT().GetWithoutComments(gomock.Any(), newsID).Return(news, nil)
+			commentsRepository.EXPECT().Get(gomock.Any(), parentID).Return(&entityNews.NewsComment{ID: parentID, NewsID: newsID}, nil)
+			moderationRepository.EXPECT().List(gomock.Any(), []uuid.UUID{parentID}).Return(map[uuid.UUID]*entityNews.CommentModeration{}, nil)
+			threadsRepository.EXPECT().List(gomock.Any(), []uuid.UUID{parentID}).Return(map[uuid.UUID]*entityNews.CommentThread{}, nil)
+			tt.prepare(commentsRepository, threadsRepository)
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewCommentInteractor(commentsRepository, newsRepository, employeesRepository, nil, threadsRepository, moderationRepository, nil, time.Minute, logger)
+
+			id, _, err := interactor.Create(ctx, dtoNews.NewComment{NewsID: newsID, Text: "ответ", ParentID: &parentID})
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Equal(t, uuid.Nil, id)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, createdID, id)
+		})
+	}
+}
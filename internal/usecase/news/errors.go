@@ -1,10 +1,48 @@
 package news
 
-import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+import (
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
 
 const (
 	ErrAuthorNotFound        diterrors.StringError = "author not found"
 	ErrCategoryAlreadyExists diterrors.StringError = "category already exists"
 	ErrCategoryNotFound      diterrors.StringError = "category not found"
 	ErrAuthorRequired        diterrors.StringError = "author required"
+	ErrStatusTransition      diterrors.StringError = "news status transition is not allowed"
+	ErrPublicationAtRequired diterrors.StringError = "news publication time required"
+	ErrSelfApproval          diterrors.StringError = "news must be approved by another editor"
+	ErrReactionType          diterrors.StringError = "unknown reaction type"
+	ErrReactionsDisabled     diterrors.StringError = "reactions are disabled for news"
+	ErrViewsPeriod           diterrors.StringError = "incorrect news views period"
+	ErrCommentsDisabled      diterrors.StringError = "comments are disabled for news"
+	ErrCommentNotFound       diterrors.StringError = "comment not found"
+	ErrCommentNotAuthor      diterrors.StringError = "only author can change comment"
+	ErrCommentEditExpired    diterrors.StringError = "comment can't be changed anymore"
+	ErrCommentRemoved        diterrors.StringError = "comment is deleted or hidden"
+	ErrForeignPortal         diterrors.StringError = "news is not published on active portal"
+	ErrReportNotFound        diterrors.StringError = "comment report not found"
+	ErrCommentDepth          diterrors.StringError = "comment reply depth exceeded"
+	ErrNewsConflict          diterrors.StringError = "news was changed by another editor"
+	ErrNewsVersionRequired   diterrors.StringError = "news updatedAt is required"
+	ErrRevisionNotFound      diterrors.StringError = "news revision not found"
+	ErrDraftNotFound         diterrors.StringError = "news draft not found"
+	ErrNewsLocked            diterrors.StringError = "news is being changed by another editor"
+	ErrSubmitterUnknown      diterrors.StringError = "news submitter is unknown"
 )
+
+// ConflictError новость изменена другим редактором после того, как редактор начал правки
+type ConflictError struct {
+	// Current текущая версия новости
+	Current *entityNews.NewsFull
+}
+
+func (e *ConflictError) Error() string {
+	return ErrNewsConflict.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrNewsConflict
+}
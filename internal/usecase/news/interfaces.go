@@ -2,12 +2,16 @@ package news
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
 	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	entityPortalV2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portalv2"
 )
 
 //go:generate mockgen -source=interfaces.go -destination=./news_mock.go -package=news
@@ -19,6 +23,17 @@ type CategoryRepository interface {
 	Filter(ctx context.Context, filter *dtoNews.FilterCategory) (*entityNews.CategoriesWithPagination, error)
 }
 
+type ComplexesRepository interface {
+	Filter(ctx context.Context, filters *entityPortalV2.FilterComplexesFilters, options *entityPortalV2.FilterComplexesOptions) ([]*entityPortalV2.Complex, error)
+}
+
+// VisibilityEvaluator проверяет видимость новостей для текущего сотрудника по правилам их категорий
+type VisibilityEvaluator interface {
+	IsVisible(ctx context.Context, news *entityNews.NewsFull) (bool, error)
+	// VisibleCategories названия категорий, видимых текущему сотруднику. nil - поиск новостей не ограничивается
+	VisibleCategories(ctx context.Context) ([]string, error)
+}
+
 type EmployeesRepository interface {
 	Get(ctx context.Context, id uuid.UUID) (*entityEmployee.Employee, error)
 	GetByExtIDAndPortalID(ctx context.Context, extID string, portalID int) (*entityEmployee.Employee, error)
@@ -29,6 +44,8 @@ type NewsRepository interface {
 	Update(ctx context.Context, id uuid.UUID, news *dtoNews.UpdateNews) (*entityNews.News, error)
 	Search(ctx context.Context, search *dtoNews.SearchNews) (*dtoNews.SearchNewsResult, error)
 	Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error)
+	// GetWithoutComments новость без комментариев, когда они не нужны
+	GetWithoutComments(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error)
 	GetBySlug(ctx context.Context, slug string) (*entityNews.NewsFull, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 }
@@ -36,4 +53,94 @@ type NewsRepository interface {
 type CommentsRepository interface {
 	Create(ctx context.Context, in dtoNews.NewComment) (uuid.UUID, int, error)
 	List(ctx context.Context, params *dtoNews.FilterComments) ([]*entityNews.NewsComment, int, error)
+	Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsComment, error)
+	Update(ctx context.Context, id uuid.UUID, text string) (*entityNews.NewsComment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// CommentModerationRepository комментарии, скрытые модераторами
+type CommentModerationRepository interface {
+	Hide(ctx context.Context, moderation *entityNews.CommentModeration) error
+	Unhide(ctx context.Context, commentID uuid.UUID) error
+	// List скрытие комментариев, для не скрытых комментариев записи нет
+	List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*entityNews.CommentModeration, error)
+}
+
+type CommentReportsRepository interface {
+	Add(ctx context.Context, report *entityNews.CommentReport) error
+	List(ctx context.Context, portalID int) ([]*entityNews.CommentReport, error)
+	Delete(ctx context.Context, ids ...uuid.UUID) error
+}
+
+type CommentThreadsRepository interface {
+	Save(ctx context.Context, thread *entityNews.CommentThread) error
+	List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*entityNews.CommentThread, error)
+	// Replies идентификаторы ответов на комментарий в порядке создания и общее количество ответов
+	Replies(ctx context.Context, parentID uuid.UUID, afterID *uuid.UUID, limit int, order dto.OrderDirection) ([]uuid.UUID, int, error)
+	RepliesCount(ctx context.Context, parentIDs []uuid.UUID) (map[uuid.UUID]int, error)
+	// NewsRepliesCount количество ответов среди комментариев новости
+	NewsRepliesCount(ctx context.Context, newsID uuid.UUID) (int, error)
+}
+
+// SchedulerLease аренда роли ведущего планировщика публикаций, общая для всех реплик сервиса
+type SchedulerLease interface {
+	// Acquire захватывает свободную аренду или продлевает свою на ttl. false - аренда у другой реплики
+	Acquire(ctx context.Context, owner string, ttl time.Duration) (bool, error)
+	// Release освобождает аренду, если она принадлежит owner
+	Release(ctx context.Context, owner string) error
+}
+
+// NewsLocker блокировки новостей, общие для всех реплик сервиса.
+//
+//	Проверка текущего состояния новости и ее изменение выполняются под блокировкой,
+//	чтобы между ними новость не изменил другой редактор или планировщик
+type NewsLocker interface {
+	// Lock захватывает блокировку новости на ttl. false - новость заблокирована другим владельцем
+	Lock(ctx context.Context, newsID uuid.UUID, owner string, ttl time.Duration) (bool, error)
+	// Unlock снимает блокировку, если она принадлежит owner
+	Unlock(ctx context.Context, newsID uuid.UUID, owner string) error
+}
+
+type NewsHistoryRepository interface {
+	Append(ctx context.Context, transition *entityNews.StatusTransition) error
+	List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.StatusTransition, error)
+}
+
+// NewsRevisionsRepository версии новости, сохраненные при изменениях
+type NewsRevisionsRepository interface {
+	Add(ctx context.Context, revision *entityNews.Revision) error
+	// List версии новости, новые первыми
+	List(ctx context.Context, newsID uuid.UUID) ([]*entityNews.Revision, error)
+	Get(ctx context.Context, newsID, revisionID uuid.UUID) (*entityNews.Revision, error)
+}
+
+// NewsDraftsRepository автосохраненные черновики правок, у каждого редактора свой черновик новости
+type NewsDraftsRepository interface {
+	Save(ctx context.Context, draft *entityNews.Draft) error
+	// Get черновик редактора, nil если черновика нет
+	Get(ctx context.Context, newsID, editorID uuid.UUID) (*entityNews.Draft, error)
+	Delete(ctx context.Context, newsID, editorID uuid.UUID) error
+}
+
+type ReactionsRepository interface {
+	Add(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error
+	Remove(ctx context.Context, newsID, employeeID uuid.UUID, reaction entityNews.ReactionType) error
+	Get(ctx context.Context, newsID, employeeID uuid.UUID) (*entityNews.Reactions, error)
+}
+
+type ViewsRepository interface {
+	Record(ctx context.Context, views []*entityNews.View, window time.Duration) (int, error)
+	Count(ctx context.Context, newsID uuid.UUID) (int, error)
+	Stats(ctx context.Context, newsID uuid.UUID, from, to time.Time) ([]*entityNews.DailyViews, error)
+}
+
+type ViewsCounter interface {
+	Register(newsID, employeeID uuid.UUID)
+	Count(ctx context.Context, newsID uuid.UUID) (int, error)
+}
+
+// PermissionChecker проверка прав пользователя сессии на ресурсы порталов
+type PermissionChecker interface {
+	// CheckPortalPermissions проверяет наличие прав на всех порталах ресурса
+	CheckPortalPermissions(ctx context.Context, portalIDs []int, permissions ...entityAuth.Permission) error
 }
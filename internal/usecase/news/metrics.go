@@ -0,0 +1,65 @@
+package news
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	schedulerLeaderMetric       prometheus.Gauge
+	schedulerScheduledMetric    prometheus.Gauge
+	schedulerDueMetric          prometheus.Gauge
+	schedulerPublishedMetric    prometheus.Counter
+	schedulerFailuresMetric     prometheus.Counter
+	schedulerPublishDelayMetric prometheus.Histogram
+)
+
+// InitMetrics регистрирует метрики планировщика публикаций новостей
+func InitMetrics() {
+	schedulerLeaderMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "news_scheduler_leader",
+		Help: "1 if this replica holds the news publishing scheduler lease",
+	})
+	schedulerScheduledMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "news_scheduler_scheduled_items",
+		Help: "Number of news waiting for publication at the last scheduler run",
+	})
+	schedulerDueMetric = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "news_scheduler_due_items",
+		Help: "Number of news whose publication time has come but which are not published yet",
+	})
+	schedulerPublishedMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "news_scheduler_published_total",
+		Help: "Number of news published by the scheduler",
+	})
+	schedulerFailuresMetric = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "news_scheduler_failures_total",
+		Help: "Number of failed scheduled publications, failed news are retried with backoff",
+	})
+	schedulerPublishDelayMetric = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "news_scheduler_publish_delay_seconds",
+		Help:    "Delay between the scheduled publication time and the actual publication",
+		Buckets: []float64{1, 5, 15, 30, 60, 120, 300, 900, 1800, 3600},
+	})
+}
+
+func setGauge(gauge prometheus.Gauge, value float64) {
+	if gauge == nil {
+		return
+	}
+	gauge.Set(value)
+}
+
+func incCounter(counter prometheus.Counter) {
+	if counter == nil {
+		return
+	}
+	counter.Inc()
+}
+
+func observeHistogram(histogram prometheus.Histogram, value float64) {
+	if histogram == nil {
+		return
+	}
+	histogram.Observe(value)
+}
@@ -3,25 +3,45 @@ package news
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 )
 
-func NewNewsInteractor(newsRepository NewsRepository, employeeRepository EmployeesRepository, logger ditzap.Logger) *newsInteractor {
+func NewNewsInteractor(
+	newsRepository NewsRepository,
+	employeeRepository EmployeesRepository,
+	reactionsRepository ReactionsRepository,
+	viewsCounter ViewsCounter,
+	visibility VisibilityEvaluator,
+	logger ditzap.Logger,
+) *newsInteractor {
 	return &newsInteractor{
-		newsRepository:     newsRepository,
-		employeeRepository: employeeRepository,
-		logger:             logger,
+		newsRepository:      newsRepository,
+		employeeRepository:  employeeRepository,
+		reactionsRepository: reactionsRepository,
+		viewsCounter:        viewsCounter,
+		visibility:          visibility,
+		logger:              logger,
 	}
 }
 
 type newsInteractor struct {
-	newsRepository     NewsRepository
-	employeeRepository EmployeesRepository
-	logger             ditzap.Logger
+	newsRepository      NewsRepository
+	employeeRepository  EmployeesRepository
+	reactionsRepository ReactionsRepository
+	viewsCounter        ViewsCounter
+	visibility          VisibilityEvaluator
+	logger              ditzap.Logger
 }
 
 func (i *newsInteractor) Get(ctx context.Context, slug string) (*entityNews.NewsFull, error) {
@@ -29,6 +49,39 @@ func (i *newsInteractor) Get(ctx context.Context, slug string) (*entityNews.News
 	if err != nil {
 		return nil, fmt.Errorf("newsInteractor.Get: can't get news: %w", err)
 	}
+
+	visible, err := i.visibility.IsVisible(ctx, news)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.Get: can't check visibility: %w", err)
+	}
+	if !visible {
+		return nil, fmt.Errorf("newsInteractor.Get: news is hidden by category visibility: %w", diterrors.ErrNotFound)
+	}
+
+	// Просмотры и реакции не критичны для отображения новости, поэтому ошибки только логируются
+	news.Views = 0
+	if news.CanDisplayViews {
+		if views, viewsErr := i.viewsCounter.Count(ctx, news.ID); viewsErr != nil {
+			i.logger.Warn("newsInteractor.Get: can't get views", zap.Error(viewsErr))
+		} else {
+			news.Views = views
+		}
+	}
+
+	employee, err := i.currentEmployee(ctx)
+	if err != nil {
+		i.logger.Warn("newsInteractor.Get: can't get employee", zap.Error(err))
+		return news, nil
+	}
+	i.viewsCounter.Register(news.ID, employee.ID)
+
+	reactions, err := i.reactionsRepository.Get(ctx, news.ID, employee.ID)
+	if err != nil {
+		i.logger.Warn("newsInteractor.Get: can't get reactions", zap.Error(err))
+		return news, nil
+	}
+	setReactions(news, reactions)
+
 	return news, nil
 }
 
@@ -38,13 +91,27 @@ func (i *newsInteractor) Search(ctx context.Context, search *dtoNews.SearchNews)
 		return nil, fmt.Errorf("newsInteractor.Update: can't get session: %w", err)
 	}
 
-	// TODO:пока не реализованы клиентские методы в сервисе news-search, фильтрация производится в данном методе
+	if err := search.Validate(); err != nil {
+		return nil, fmt.Errorf("newsInteractor.Search: invalid request: %w", err)
+	}
+
 	if search.GetFilterPtr() == nil {
 		search.Filter = &dtoNews.SearchNewsFilter{}
 	}
 	// Поиск только опубликованных новостей
 	search.Filter.Status = entityNews.NewsStatusPublished
 
+	// Поиск ограничивается видимыми категориями, чтобы страницы и общее количество были полными
+	visible, err := i.visibility.VisibleCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.Search: can't get visible categories: %w", err)
+	}
+	if visible != nil {
+		search.Filter.CategoriesNames = visibleNames(visible, search.Filter.CategoriesNames)
+		if len(search.Filter.CategoriesNames) == 0 {
+			return &dtoNews.SearchNewsResult{}, nil
+		}
+	}
 	search.Visitor = &entityNews.Visitor{
 		PortalID: session.ActivePortal.GetPortalID(),
 	}
@@ -54,26 +121,130 @@ func (i *newsInteractor) Search(ctx context.Context, search *dtoNews.SearchNews)
 		return nil, fmt.Errorf("newsInteractor.Search: can't search news: %w", err)
 	}
 
-	// TODO:пока не реализованы клиентские методы в сервисе news-search, фильтрация производится в данном методе
-	if result == nil || len(result.News) == 0 {
-		return result, nil
-	}
+	return result, nil
+}
 
-	news := make([]*entityNews.NewsFull, 0, len(result.News))
-	for _, n := range result.News {
-		if n.Status != entityNews.NewsStatusPublished {
-			continue
+// visibleNames видимые категории из запрошенных, названия сравниваются без учета регистра. Без запрошенных категорий - все видимые
+func visibleNames(visible, requested []string) []string {
+	if len(requested) == 0 {
+		return visible
+	}
+	names := make([]string, 0, len(requested))
+	for _, name := range visible {
+		if slices.ContainsFunc(requested, func(r string) bool { return strings.EqualFold(name, strings.TrimSpace(r)) }) {
+			names = append(names, name)
 		}
+	}
+	return names
+}
 
-		if search.GetFilter().OnMainPage && !n.OnMain {
-			continue
-		}
+// AddReaction добавляет реакцию текущего сотрудника на новость.
+//
+//	Повторная реакция того же типа не меняет счетчики
+func (i *newsInteractor) AddReaction(ctx context.Context, newsID uuid.UUID, reaction entityNews.ReactionType) (*entityNews.Reactions, error) {
+	if !reaction.IsValid() {
+		return nil, diterrors.NewValidationError(ErrReactionType, diterrors.ErrValidationFields{
+			Field:   "type",
+			Message: fmt.Sprintf("unknown reaction type <%s>", reaction),
+		})
+	}
 
-		news = append(news, n)
+	news, err := i.newsRepository.Get(ctx, newsID)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: can't get news: %w", err)
+	}
+	if news.GetStatus() != entityNews.NewsStatusPublished {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: news is not published: %w", diterrors.ErrNotFound)
+	}
+	visible, err := i.visibility.IsVisible(ctx, news)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: can't check visibility: %w", err)
+	}
+	if !visible {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: news is hidden by category visibility: %w", diterrors.ErrNotFound)
+	}
+	if !news.CanReacts {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: %w", ErrReactionsDisabled)
 	}
 
-	result.News = news
-	result.Total = len(news)
+	employee, err := i.currentEmployee(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: can't get employee: %w", err)
+	}
 
-	return result, nil
+	if err = i.reactionsRepository.Add(ctx, newsID, employee.ID, reaction); err != nil {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: can't add reaction: %w", err)
+	}
+
+	reactions, err := i.reactionsRepository.Get(ctx, newsID, employee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.AddReaction: can't get reactions: %w", err)
+	}
+	return withNewsLikes(news, reactions), nil
+}
+
+// RemoveReaction снимает реакцию текущего сотрудника с новости.
+//
+//	Снять реакцию можно, даже если реакции на новость уже отключены
+func (i *newsInteractor) RemoveReaction(ctx context.Context, newsID uuid.UUID, reaction entityNews.ReactionType) (*entityNews.Reactions, error) {
+	if !reaction.IsValid() {
+		return nil, diterrors.NewValidationError(ErrReactionType, diterrors.ErrValidationFields{
+			Field:   "type",
+			Message: fmt.Sprintf("unknown reaction type <%s>", reaction),
+		})
+	}
+
+	news, err := i.newsRepository.Get(ctx, newsID)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.RemoveReaction: can't get news: %w", err)
+	}
+
+	employee, err := i.currentEmployee(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.RemoveReaction: can't get employee: %w", err)
+	}
+
+	if err = i.reactionsRepository.Remove(ctx, newsID, employee.ID, reaction); err != nil {
+		return nil, fmt.Errorf("newsInteractor.RemoveReaction: can't remove reaction: %w", err)
+	}
+
+	reactions, err := i.reactionsRepository.Get(ctx, newsID, employee.ID)
+	if err != nil {
+		return nil, fmt.Errorf("newsInteractor.RemoveReaction: can't get reactions: %w", err)
+	}
+	return withNewsLikes(news, reactions), nil
+}
+
+// currentEmployee сотрудник из сессии запроса
+func (i *newsInteractor) currentEmployee(ctx context.Context) (*entityEmployee.Employee, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("can't get session: %w", err)
+	}
+
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		return nil, fmt.Errorf("can't get employee: %w", err)
+	}
+	return employee, nil
+}
+
+// setReactions дополняет новость реакциями
+func setReactions(news *entityNews.NewsFull, reactions *entityNews.Reactions) {
+	news.Reactions = withNewsLikes(news, reactions)
+}
+
+// withNewsLikes добавляет к счетчику лайков лайки, которые хранит сервис новостей.
+// Лайки, поставленные через реакции, хранятся в Redis отдельно и дополняют их
+func withNewsLikes(news *entityNews.NewsFull, reactions *entityNews.Reactions) *entityNews.Reactions {
+	if reactions == nil || news.Likes == 0 {
+		return reactions
+	}
+
+	counts := make(map[entityNews.ReactionType]int, len(reactions.Counts)+1)
+	for reaction, count := range reactions.Counts {
+		counts[reaction] = count
+	}
+	counts[entityNews.ReactionLike] += news.Likes
+	return &entityNews.Reactions{Counts: counts, Mine: reactions.Mine}
 }
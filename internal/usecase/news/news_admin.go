@@ -13,21 +13,53 @@ import (
 
 	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
 	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
 )
 
-func NewNewsAdminInteractor(newsRepository NewsRepository, employeeRepository EmployeesRepository, logger ditzap.Logger) *newsAdminInteractor {
+func NewNewsAdminInteractor(
+	newsRepository NewsRepository,
+	employeeRepository EmployeesRepository,
+	historyRepository NewsHistoryRepository,
+	viewsRepository ViewsRepository,
+	categoryRepository CategoryRepository,
+	revisionsRepository NewsRevisionsRepository,
+	draftsRepository NewsDraftsRepository,
+	locker NewsLocker,
+	permissionChecker PermissionChecker,
+	twoPersonReview bool,
+	logger ditzap.Logger,
+) *newsAdminInteractor {
 	return &newsAdminInteractor{
-		newsRepository:     newsRepository,
-		employeeRepository: employeeRepository,
-		logger:             logger,
+		newsRepository:      newsRepository,
+		employeeRepository:  employeeRepository,
+		historyRepository:   historyRepository,
+		viewsRepository:     viewsRepository,
+		categoryRepository:  categoryRepository,
+		revisionsRepository: revisionsRepository,
+		draftsRepository:    draftsRepository,
+		locker:              locker,
+		permissionChecker:   permissionChecker,
+		twoPersonReview:     twoPersonReview,
+		logger:              logger,
 	}
 }
 
 type newsAdminInteractor struct {
-	newsRepository     NewsRepository
-	employeeRepository EmployeesRepository
-	logger             ditzap.Logger
+	newsRepository      NewsRepository
+	employeeRepository  EmployeesRepository
+	historyRepository   NewsHistoryRepository
+	viewsRepository     ViewsRepository
+	categoryRepository  CategoryRepository
+	revisionsRepository NewsRevisionsRepository
+	draftsRepository    NewsDraftsRepository
+	// locker изменения одной новости выполняются по очереди, в том числе на разных репликах
+	locker NewsLocker
+	// permissionChecker права редактора проверяются на всех порталах новости, а не только на активном
+	permissionChecker PermissionChecker
+	// twoPersonReview публиковать новость может только сотрудник, не отправлявший ее на публикацию
+	twoPersonReview bool
+	logger          ditzap.Logger
 }
 
 func (i *newsAdminInteractor) Create(ctx context.Context, news *dtoNews.NewNews) (uuid.UUID, error) {
@@ -37,19 +69,19 @@ func (i *newsAdminInteractor) Create(ctx context.Context, news *dtoNews.NewNews)
 		logger.Debug("newsAdminInteractor.Create: invalid request", zap.Error(err))
 		return uuid.UUID{}, fmt.Errorf("newsAdminInteractor.Create: invalid request: %w", err)
 	}
+	if err := news.SanitizeBody(); err != nil {
+		logger.Debug("newsAdminInteractor.Create: can't sanitize content", zap.Error(err))
+		return uuid.UUID{}, fmt.Errorf("newsAdminInteractor.Create: can't sanitize content: %w", err)
+	}
 
 	session, err := entity.SessionFromContext(ctx)
 	if err != nil || session == nil {
 		return uuid.UUID{}, fmt.Errorf("newsAdminInteractor.Create: can't get session: %w", err)
 	}
 
-	news.Visibility = &entityNews.NewsVisibility{}
-	/**
-	Получаем ID портала из сессии, т.к. получения Категории новостей еще нет (https://oblako.mos.ru/jira/browse/TECH-591).
-	Портал является видимостью новости, а на первом этапе видимость берется от Категории.
-	*/
-	if userPortal := session.ActivePortal.GetPortalID(); userPortal != 0 {
-		news.Visibility.PortalsIDs = []int{userPortal}
+	news.Visibility = i.newsVisibility(ctx, &news.CategoryID, session.ActivePortal.GetPortalID())
+	if err := i.permissionChecker.CheckPortalPermissions(ctx, news.Visibility.PortalsIDs, entityAuth.PermissionNewsWrite); err != nil {
+		return uuid.UUID{}, fmt.Errorf("newsAdminInteractor.Create: %w", err)
 	}
 
 	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
@@ -85,33 +117,95 @@ func (i *newsAdminInteractor) Create(ctx context.Context, news *dtoNews.NewNews)
 		return uuid.UUID{}, fmt.Errorf("newsAdminInteractor.Create: can't create news: %w", err)
 	}
 
+	// Новость уже создана, поэтому ошибка записи первой версии не возвращается клиенту
+	err = i.revisionsRepository.Add(ctx, &entityNews.Revision{
+		ID:        uuid.New(),
+		NewsID:    createdID,
+		Author:    authorFromEmployee(employee),
+		CreatedAt: time.Now(),
+		Snapshot:  news.Snapshot(),
+	})
+	if err != nil {
+		logger.Error("newsAdminInteractor.Create: can't add revision", zap.Error(err))
+	}
+
 	return createdID, nil
 }
 
+// Update сохраняет правки новости. Время изменения, с которого редактор начал правки, обязательно:
+// если новость с тех пор изменена, возвращается ConflictError с текущей версией
 func (i *newsAdminInteractor) Update(ctx context.Context, id uuid.UUID, news *dtoNews.UpdateNews) (*entityNews.News, error) {
+	updatedNews, err := i.update(ctx, id, news, nil)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Update: %w", err)
+	}
+	return updatedNews, nil
+}
+
+// update обновляет новость, записывает ее версию и удаляет черновик редактора.
+//
+//	restoredFrom - ревизия, из которой восстановлены правки
+func (i *newsAdminInteractor) update(ctx context.Context, id uuid.UUID, news *dtoNews.UpdateNews, restoredFrom *uuid.UUID) (*entityNews.News, error) {
 	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id), zap.Any("news", news))
 	if id == uuid.Nil {
-		return nil, fmt.Errorf("newsAdminInteractor.Update: invalid request: empty ID")
+		return nil, fmt.Errorf("invalid request: empty ID")
 	}
 
 	err := news.Validate()
 	if err != nil {
-		logger.Debug("newsAdminInteractor.Update: invalid request", zap.Error(err))
-		return nil, fmt.Errorf("newsAdminInteractor.Update: invalid request: %w", err)
+		logger.Debug("newsAdminInteractor.update: invalid request", zap.Error(err))
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	if err := news.SanitizeBody(); err != nil {
+		logger.Debug("newsAdminInteractor.update: can't sanitize content", zap.Error(err))
+		return nil, fmt.Errorf("can't sanitize content: %w", err)
+	}
+	if news.UpdatedAt == nil {
+		logger.Debug("newsAdminInteractor.update: updatedAt is required")
+		return nil, ErrNewsVersionRequired
 	}
 
 	session, err := entity.SessionFromContext(ctx)
 	if err != nil || session == nil {
-		return nil, fmt.Errorf("newsAdminInteractor.Update: can't get session: %w", err)
+		return nil, fmt.Errorf("can't get session: %w", err)
 	}
 
-	news.Visibility = &entityNews.NewsVisibility{}
-	/**
-	Получаем ID портала из сессии, т.к. получения Категории новостей еще нет (https://oblako.mos.ru/jira/browse/TECH-591).
-	Портал является видимостью новости, а на первом этапе видимость берется от Категории.
-	*/
-	if userPortal := session.ActivePortal.GetPortalID(); userPortal != 0 {
-		news.Visibility.PortalsIDs = []int{userPortal}
+	// Проверка версии и обновление выполняются под блокировкой новости, иначе правки двух редакторов,
+	// начатые с одной версии, могут обе пройти проверку
+	unlock, err := lockNews(ctx, i.locker, id, logger)
+	if err != nil {
+		return nil, err
+	}
+	defer unlock()
+
+	current, err := i.newsRepository.Get(ctx, id)
+	if err != nil {
+		switch {
+		case errors.As(err, new(diterrors.ValidationError)), errors.Is(err, diterrors.ErrNotFound):
+			logger.Debug("newsAdminInteractor.update: can't get news", zap.Error(err))
+		default:
+			logger.Error("newsAdminInteractor.update: can't get news", zap.Error(err))
+		}
+		return nil, fmt.Errorf("can't get news: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsWrite); err != nil {
+		return nil, err
+	}
+	if !entityNews.SameVersion(news.UpdatedAt, current.UpdatedAt) {
+		logger.Debug("newsAdminInteractor.update: news was changed by another editor", zap.Timep("current_updated_at", current.UpdatedAt))
+		return nil, &ConflictError{Current: current}
+	}
+
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		logger.Error("newsAdminInteractor.update: can't get editor", zap.Error(err))
+		return nil, fmt.Errorf("can't get editor: %w", err)
+	}
+
+	news.Visibility = i.newsVisibility(ctx, news.CategoryID, session.ActivePortal.GetPortalID())
+	// Смена категории может перенести новость на порталы, где у редактора нет прав
+	if err := i.permissionChecker.CheckPortalPermissions(ctx, news.Visibility.PortalsIDs, entityAuth.PermissionNewsWrite); err != nil {
+		return nil, fmt.Errorf("can't check permissions: %w", err)
 	}
 
 	// Проверяем, если фронт передал null в publicationDate, то устанавливаем дату публикации в текущее время publishDate,
@@ -126,11 +220,35 @@ func (i *newsAdminInteractor) Update(ctx context.Context, id uuid.UUID, news *dt
 		case errors.As(err, new(diterrors.AlreadyExistsError)):
 			fallthrough
 		case errors.As(err, new(diterrors.ValidationError)):
-			logger.Debug("newsAdminInteractor.Update: can't update news", zap.Error(err))
+			logger.Debug("newsAdminInteractor.update: can't update news", zap.Error(err))
 		default:
-			logger.Error("newsAdminInteractor.Update: can't update news", zap.Error(err))
+			logger.Error("newsAdminInteractor.update: can't update news", zap.Error(err))
+		}
+		return nil, fmt.Errorf("can't update news: %w", err)
+	}
+
+	previous := entityNews.SnapshotFromNews(current)
+	snapshot := news.Apply(previous)
+	if err := i.addRevision(ctx, current, &entityNews.Revision{
+		ID:            uuid.New(),
+		NewsID:        id,
+		Author:        authorFromEmployee(employee),
+		CreatedAt:     time.Now(),
+		ChangedFields: snapshot.ChangedFields(previous),
+		RestoredFrom:  restoredFrom,
+		Snapshot:      snapshot,
+	}); err != nil {
+		// Правки без версии не допускаются: к ним нельзя будет вернуться
+		logger.Error("newsAdminInteractor.update: can't add revision", zap.Error(err))
+		if _, rErr := i.newsRepository.Update(ctx, id, dtoNews.UpdateFromSnapshot(previous, time.Now())); rErr != nil {
+			logger.Error("newsAdminInteractor.update: can't revert news", zap.Error(rErr))
 		}
-		return nil, fmt.Errorf("newsAdminInteractor.Update: can't update news: %w", err)
+		return nil, fmt.Errorf("can't save revision: %w", err)
+	}
+
+	// Новость уже изменена, поэтому ошибка удаления черновика не возвращается клиенту
+	if err := i.draftsRepository.Delete(ctx, id, employee.ID); err != nil {
+		logger.Warn("newsAdminInteractor.update: can't delete draft", zap.Error(err))
 	}
 
 	return updatedNews, nil
@@ -144,6 +262,15 @@ func (i *newsAdminInteractor) UpdateFlags(ctx context.Context, id uuid.UUID, fla
 	if flags == nil {
 		return nil, fmt.Errorf("newsAdminInteractor.UpdateFlags: invalid request: empty flags")
 	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.UpdateFlags: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsWrite); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.UpdateFlags: %w", err)
+	}
+
 	news := &dtoNews.UpdateNews{
 		OnMain:    flags.OnMain,
 		Pinned:    flags.Pinned,
@@ -162,22 +289,151 @@ func (i *newsAdminInteractor) UpdateFlags(ctx context.Context, id uuid.UUID, fla
 	return updatedNews, nil
 }
 
-func (i *newsAdminInteractor) ChangeStatus(ctx context.Context, id uuid.UUID, status entityNews.NewsStatus) (*entityNews.News, error) {
+func (i *newsAdminInteractor) ChangeStatus(ctx context.Context, id uuid.UUID, status entityNews.NewsStatus, comment string) (*entityNews.News, error) {
 	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id), zap.Int("status", int(status)))
 
-	news := &dtoNews.UpdateNews{
-		Status: status,
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't get session: %w", err)
 	}
 
-	updatedNews, err := i.newsRepository.Update(ctx, id, news)
+	unlock, err := lockNews(ctx, i.locker, id, logger)
+	if err != nil {
+		logger.Debug("newsAdminInteractor.ChangeStatus: can't lock news", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: %w", err)
+	}
+	defer unlock()
+
+	current, err := i.newsRepository.Get(ctx, id)
+	if err != nil {
+		switch {
+		case errors.As(err, new(diterrors.ValidationError)), errors.Is(err, diterrors.ErrNotFound):
+			logger.Debug("newsAdminInteractor.ChangeStatus: can't get news", zap.Error(err))
+		default:
+			logger.Error("newsAdminInteractor.ChangeStatus: can't get news", zap.Error(err))
+		}
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't get news: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsPublish); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: %w", err)
+	}
+
+	if err := checkTransition(current, status); err != nil {
+		logger.Debug("newsAdminInteractor.ChangeStatus: invalid transition", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: invalid transition: %w", err)
+	}
+
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		logger.Error("newsAdminInteractor.ChangeStatus: can't get actor", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't get actor: %w", err)
+	}
+	actor := authorFromEmployee(employee)
+
+	if i.twoPersonReview && status == entityNews.NewsStatusPublished {
+		history, err := i.historyRepository.List(ctx, id)
+		if err != nil {
+			logger.Error("newsAdminInteractor.ChangeStatus: can't get history", zap.Error(err))
+			return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't get history: %w", err)
+		}
+		submittedBy := submitter(history)
+		if submittedBy == nil {
+			logger.Warn("newsAdminInteractor.ChangeStatus: news has no submission in history")
+			return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: %w", ErrSubmitterUnknown)
+		}
+		if *submittedBy == employee.ID {
+			logger.Debug("newsAdminInteractor.ChangeStatus: self approval")
+			return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: %w", ErrSelfApproval)
+		}
+	}
+
+	updatedNews, err := i.newsRepository.Update(ctx, id, &dtoNews.UpdateNews{
+		Status: status,
+	})
 	if err != nil {
 		logger.Error("newsAdminInteractor.ChangeStatus: can't update news", zap.Error(err))
 		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't update news: %w", err)
 	}
 
+	err = i.historyRepository.Append(ctx, &entityNews.StatusTransition{
+		NewsID:    id,
+		From:      current.GetStatus(),
+		To:        status,
+		Actor:     actor,
+		Comment:   comment,
+		CreatedAt: time.Now(),
+	})
+	if err != nil {
+		// Переход без записи в истории не допускается: по истории проверяется, кто отправил новость на публикацию
+		logger.Error("newsAdminInteractor.ChangeStatus: can't append history", zap.Error(err))
+		if _, rErr := i.newsRepository.Update(ctx, id, &dtoNews.UpdateNews{Status: current.GetStatus()}); rErr != nil {
+			logger.Error("newsAdminInteractor.ChangeStatus: can't revert status", zap.Error(rErr))
+		}
+		return nil, fmt.Errorf("newsAdminInteractor.ChangeStatus: can't append history: %w", err)
+	}
+
 	return updatedNews, nil
 }
 
+func (i *newsAdminInteractor) History(ctx context.Context, id uuid.UUID) ([]*entityNews.StatusTransition, error) {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.History: invalid request: empty ID")
+	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.History: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsRead); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.History: %w", err)
+	}
+
+	history, err := i.historyRepository.List(ctx, id)
+	if err != nil {
+		logger.Error("newsAdminInteractor.History: can't get history", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.History: can't get history: %w", err)
+	}
+	return history, nil
+}
+
+// ViewsStats статистика просмотров новости по дням с from по to включительно
+func (i *newsAdminInteractor) ViewsStats(ctx context.Context, id uuid.UUID, from, to time.Time) (*entityNews.ViewsStats, error) {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: invalid request: empty ID")
+	}
+
+	from, to = startOfDay(from), startOfDay(to)
+	if err := checkViewsPeriod(from, to); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: invalid request: %w", err)
+	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsRead); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: %w", err)
+	}
+
+	total, err := i.viewsRepository.Count(ctx, id)
+	if err != nil {
+		logger.Error("newsAdminInteractor.ViewsStats: can't get views count", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: can't get views count: %w", err)
+	}
+	days, err := i.viewsRepository.Stats(ctx, id, from, to)
+	if err != nil {
+		logger.Error("newsAdminInteractor.ViewsStats: can't get views stats", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.ViewsStats: can't get views stats: %w", err)
+	}
+
+	return &entityNews.ViewsStats{
+		Total: total,
+		Days:  fillDays(days, from, to),
+	}, nil
+}
+
 func (i *newsAdminInteractor) Get(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error) {
 	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
 	result, err := i.newsRepository.Get(ctx, id)
@@ -190,6 +446,9 @@ func (i *newsAdminInteractor) Get(ctx context.Context, id uuid.UUID) (*entityNew
 		}
 		return nil, fmt.Errorf("newsAdminInteractor.Get: can't get news: %w", err)
 	}
+	if err := i.authorize(ctx, result, entityAuth.PermissionNewsRead); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Get: %w", err)
+	}
 	return result, nil
 }
 
@@ -201,6 +460,11 @@ func (i *newsAdminInteractor) Search(ctx context.Context, search *dtoNews.Search
 		return nil, fmt.Errorf("newsInteractor.Update: can't get session: %w", err)
 	}
 
+	if err := search.Validate(); err != nil {
+		logger.Debug("newsAdminInteractor.Search: invalid request", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.Search: invalid request: %w", err)
+	}
+
 	search.Visitor = &entityNews.Visitor{
 		PortalID: session.ActivePortal.GetPortalID(),
 	}
@@ -224,6 +488,14 @@ func (i *newsAdminInteractor) Delete(ctx context.Context, id uuid.UUID) error {
 		return fmt.Errorf("newsAdminInteractor.Delete: invalid request: empty ID")
 	}
 
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return fmt.Errorf("newsAdminInteractor.Delete: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsDelete); err != nil {
+		return fmt.Errorf("newsAdminInteractor.Delete: %w", err)
+	}
+
 	if err := i.newsRepository.Delete(ctx, id); err != nil {
 		switch {
 		case errors.As(err, new(diterrors.ValidationError)):
@@ -235,3 +507,32 @@ func (i *newsAdminInteractor) Delete(ctx context.Context, id uuid.UUID) error {
 	}
 	return nil
 }
+
+// authorize проверяет права редактора на всех порталах, где опубликована новость
+func (i *newsAdminInteractor) authorize(ctx context.Context, news *entityNews.NewsFull, permissions ...entityAuth.Permission) error {
+	if err := i.permissionChecker.CheckPortalPermissions(ctx, news.PortalIDs(), permissions...); err != nil {
+		return fmt.Errorf("can't check permissions: %w", err)
+	}
+	return nil
+}
+
+// newsVisibility порталы, на которых видна новость: ОИВ из правил категории, а без них активный портал автора
+func (i *newsAdminInteractor) newsVisibility(ctx context.Context, categoryID *uuid.UUID, activePortalID int) *entityNews.NewsVisibility {
+	visibility := &entityNews.NewsVisibility{}
+	if categoryID != nil && *categoryID != uuid.Nil {
+		found, err := i.categoryRepository.Filter(ctx, &dtoNews.FilterCategory{
+			By:  dtoNews.FilterCategoryByIDs,
+			IDs: []uuid.UUID{*categoryID},
+		})
+		if err != nil {
+			i.logger.Warn("newsAdminInteractor.newsVisibility: can't get category", ditzap.UUID("category_id", *categoryID), zap.Error(err))
+		} else if len(found.Categories) > 0 && len(found.Categories[0].Visibility.OIVs) > 0 {
+			visibility.PortalsIDs = found.Categories[0].Visibility.OIVs
+			return visibility
+		}
+	}
+	if activePortalID != 0 {
+		visibility.PortalsIDs = []int{activePortalID}
+	}
+	return visibility
+}
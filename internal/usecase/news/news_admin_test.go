@@ -0,0 +1,143 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_newsAdminInteractor_ChangeStatus(t *testing.T) {
+	newsID := uuid.New()
+	publicationAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	employee := &entityEmployee.Employee{ID: uuid.New(), Person: entityEmployee.Person{LastName: "Иванов", FirstName: "Иван"}}
+	submitterID := uuid.New()
+	current := &entityNews.NewsFull{
+		ID:            newsID,
+		Title:         "title",
+		Status:        entityNews.NewsStatusWaitingPublish,
+		PublicationAt: &publicationAt,
+		Author:        entityNews.Author{ID: &submitterID},
+	}
+	submitted := []*entityNews.StatusTransition{
+		{NewsID: newsID, To: entityNews.NewsStatusWaitingPublish, Actor: entityNews.Author{ID: &submitterID}},
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(news *MockNewsRepository, employees *MockEmployeesRepository, history *MockNewsHistoryRepository, locker *MockNewsLocker)
+		wantErr error
+	}{
+		{
+			name: "news is locked by another editor",
+			prepare: func(_ *MockNewsRepository, _ *MockEmployeesRepository, _ *MockNewsHistoryRepository, l *MockNewsLocker) {
+				l.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(false, nil)
+			},
+			wantErr: ErrNewsLocked,
+		},
+		{
+			name: "self approval",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, h *MockNewsHistoryRepository, l *MockNewsLocker) {
+				l.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(true, nil)
+				l.EXPECT().Unlock(gomock.Any(), newsID, gomock.Any()).Return(nil)
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				h.EXPECT().List(gomock.Any(), newsID).Return([]*entityNews.StatusTransition{
+					{NewsID: newsID, To: entityNews.NewsStatusWaitingPublish, Actor: entityNews.Author{ID: &employee.ID}},
+				}, nil)
+			},
+			wantErr: ErrSelfApproval,
+		},
+		{
+			name: "submitter is not in history, author is not used instead",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, h *MockNewsHistoryRepository, l *MockNewsLocker) {
+				l.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(true, nil)
+				l.EXPECT().Unlock(gomock.Any(), newsID, gomock.Any()).Return(nil)
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				h.EXPECT().List(gomock.Any(), newsID).Return(nil, nil)
+			},
+			wantErr: ErrSubmitterUnknown,
+		},
+		{
+			name: "history is not saved, status is reverted",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, h *MockNewsHistoryRepository, l *MockNewsLocker) {
+				l.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(true, nil)
+				l.EXPECT().Unlock(gomock.Any(), newsID, gomock.Any()).Return(nil)
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				h.EXPECT().List(gomock.Any(), newsID).Return(submitted, nil)
+				gomock.InOrder(
+					n.EXPECT().Update(gomock.Any(), newsID, &dtoNews.UpdateNews{Status: entityNews.NewsStatusPublished}).
+						Return(&entityNews.News{ID: newsID}, nil),
+					h.EXPECT().Append(gomock.Any(), gomock.Any()).Return(errors.New("redis error")),
+					n.EXPECT().Update(gomock.Any(), newsID, &dtoNews.UpdateNews{Status: entityNews.NewsStatusWaitingPublish}).
+						Return(&entityNews.News{ID: newsID}, nil),
+				)
+			},
+			wantErr: errors.New("newsAdminInteractor.ChangeStatus: can't append history: redis error"),
+		},
+		{
+			name: "published by another editor",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, h *MockNewsHistoryRepository, l *MockNewsLocker) {
+				l.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(true, nil)
+				l.EXPECT().Unlock(gomock.Any(), newsID, gomock.Any()).Return(nil)
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				h.EXPECT().List(gomock.Any(), newsID).Return(submitted, nil)
+				n.EXPECT().Update(gomock.Any(), newsID, &dtoNews.UpdateNews{Status: entityNews.NewsStatusPublished}).
+					Return(&entityNews.News{ID: newsID}, nil)
+				h.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, transition *entityNews.StatusTransition) error {
+					assert.Equal(t, entityNews.NewsStatusWaitingPublish, transition.From)
+					assert.Equal(t, entityNews.NewsStatusPublished, transition.To)
+					assert.Equal(t, &employee.ID, transition.Actor.ID)
+					return nil
+				})
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			historyRepository := NewMockNewsHistoryRepository(ctrl)
+			locker := NewMockNewsLocker(ctrl)
+			permissionChecker := NewMockPermissionChecker(ctrl)
+			permissionChecker.EXPECT().CheckPortalPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.prepare(newsRepository, employeesRepository, historyRepository, locker)
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewNewsAdminInteractor(newsRepository, employeesRepository, historyRepository, nil, nil,
+				nil, nil, locker, permissionChecker, true, logger)
+
+			_, err := interactor.ChangeStatus(ctx, newsID, entityNews.NewsStatusPublished, "")
+			switch {
+			case errors.Is(tt.wantErr, ErrNewsLocked), errors.Is(tt.wantErr, ErrSelfApproval), errors.Is(tt.wantErr, ErrSubmitterUnknown):
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErr != nil:
+				assert.EqualError(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
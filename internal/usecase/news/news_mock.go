@@ -12,10 +12,14 @@ package news
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	dto "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto"
 	news "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	auth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
 	employee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
 	news0 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	portalv2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portalv2"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -103,6 +107,99 @@ func (mr *MockCategoryRepositoryMockRecorder) Update(ctx, c any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCategoryRepository)(nil).Update), ctx, c)
 }
 
+// MockComplexesRepository is a mock of ComplexesRepository interface.
+type MockComplexesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockComplexesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockComplexesRepositoryMockRecorder is the mock recorder for MockComplexesRepository.
+type MockComplexesRepositoryMockRecorder struct {
+	mock *MockComplexesRepository
+}
+
+// NewMockComplexesRepository creates a new mock instance.
+func NewMockComplexesRepository(ctrl *gomock.Controller) *MockComplexesRepository {
+	mock := &MockComplexesRepository{ctrl: ctrl}
+	mock.recorder = &MockComplexesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockComplexesRepository) EXPECT() *MockComplexesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Filter mocks base method.
+func (m *MockComplexesRepository) Filter(ctx context.Context, filters *portalv2.FilterComplexesFilters, options *portalv2.FilterComplexesOptions) ([]*portalv2.Complex, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", ctx, filters, options)
+	ret0, _ := ret[0].([]*portalv2.Complex)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockComplexesRepositoryMockRecorder) Filter(ctx, filters, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockComplexesRepository)(nil).Filter), ctx, filters, options)
+}
+
+// MockVisibilityEvaluator is a mock of VisibilityEvaluator interface.
+type MockVisibilityEvaluator struct {
+	ctrl     *gomock.Controller
+	recorder *MockVisibilityEvaluatorMockRecorder
+	isgomock struct{}
+}
+
+// MockVisibilityEvaluatorMockRecorder is the mock recorder for MockVisibilityEvaluator.
+type MockVisibilityEvaluatorMockRecorder struct {
+	mock *MockVisibilityEvaluator
+}
+
+// NewMockVisibilityEvaluator creates a new mock instance.
+func NewMockVisibilityEvaluator(ctrl *gomock.Controller) *MockVisibilityEvaluator {
+	mock := &MockVisibilityEvaluator{ctrl: ctrl}
+	mock.recorder = &MockVisibilityEvaluatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVisibilityEvaluator) EXPECT() *MockVisibilityEvaluatorMockRecorder {
+	return m.recorder
+}
+
+// IsVisible mocks base method.
+func (m *MockVisibilityEvaluator) IsVisible(ctx context.Context, arg1 *news0.NewsFull) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsVisible", ctx, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsVisible indicates an expected call of IsVisible.
+func (mr *MockVisibilityEvaluatorMockRecorder) IsVisible(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVisible", reflect.TypeOf((*MockVisibilityEvaluator)(nil).IsVisible), ctx, arg1)
+}
+
+// VisibleCategories mocks base method.
+func (m *MockVisibilityEvaluator) VisibleCategories(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VisibleCategories", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VisibleCategories indicates an expected call of VisibleCategories.
+func (mr *MockVisibilityEvaluatorMockRecorder) VisibleCategories(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VisibleCategories", reflect.TypeOf((*MockVisibilityEvaluator)(nil).VisibleCategories), ctx)
+}
+
 // MockEmployeesRepository is a mock of EmployeesRepository interface.
 type MockEmployeesRepository struct {
 	ctrl     *gomock.Controller
@@ -240,6 +337,21 @@ func (mr *MockNewsRepositoryMockRecorder) GetBySlug(ctx, slug any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBySlug", reflect.TypeOf((*MockNewsRepository)(nil).GetBySlug), ctx, slug)
 }
 
+// GetWithoutComments mocks base method.
+func (m *MockNewsRepository) GetWithoutComments(ctx context.Context, id uuid.UUID) (*news0.NewsFull, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWithoutComments", ctx, id)
+	ret0, _ := ret[0].(*news0.NewsFull)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWithoutComments indicates an expected call of GetWithoutComments.
+func (mr *MockNewsRepositoryMockRecorder) GetWithoutComments(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWithoutComments", reflect.TypeOf((*MockNewsRepository)(nil).GetWithoutComments), ctx, id)
+}
+
 // Search mocks base method.
 func (m *MockNewsRepository) Search(ctx context.Context, search *news.SearchNews) (*news.SearchNewsResult, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +422,35 @@ func (mr *MockCommentsRepositoryMockRecorder) Create(ctx, in any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCommentsRepository)(nil).Create), ctx, in)
 }
 
+// Delete mocks base method.
+func (m *MockCommentsRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentsRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentsRepository)(nil).Delete), ctx, id)
+}
+
+// Get mocks base method.
+func (m *MockCommentsRepository) Get(ctx context.Context, id uuid.UUID) (*news0.NewsComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*news0.NewsComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCommentsRepositoryMockRecorder) Get(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCommentsRepository)(nil).Get), ctx, id)
+}
+
 // List mocks base method.
 func (m *MockCommentsRepository) List(ctx context.Context, params *news.FilterComments) ([]*news0.NewsComment, int, error) {
 	m.ctrl.T.Helper()
@@ -325,3 +466,780 @@ func (mr *MockCommentsRepositoryMockRecorder) List(ctx, params any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentsRepository)(nil).List), ctx, params)
 }
+
+// Update mocks base method.
+func (m *MockCommentsRepository) Update(ctx context.Context, id uuid.UUID, text string) (*news0.NewsComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, text)
+	ret0, _ := ret[0].(*news0.NewsComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockCommentsRepositoryMockRecorder) Update(ctx, id, text any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockCommentsRepository)(nil).Update), ctx, id, text)
+}
+
+// MockCommentModerationRepository is a mock of CommentModerationRepository interface.
+type MockCommentModerationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentModerationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentModerationRepositoryMockRecorder is the mock recorder for MockCommentModerationRepository.
+type MockCommentModerationRepositoryMockRecorder struct {
+	mock *MockCommentModerationRepository
+}
+
+// NewMockCommentModerationRepository creates a new mock instance.
+func NewMockCommentModerationRepository(ctrl *gomock.Controller) *MockCommentModerationRepository {
+	mock := &MockCommentModerationRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentModerationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentModerationRepository) EXPECT() *MockCommentModerationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Hide mocks base method.
+func (m *MockCommentModerationRepository) Hide(ctx context.Context, moderation *news0.CommentModeration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hide", ctx, moderation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Hide indicates an expected call of Hide.
+func (mr *MockCommentModerationRepositoryMockRecorder) Hide(ctx, moderation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hide", reflect.TypeOf((*MockCommentModerationRepository)(nil).Hide), ctx, moderation)
+}
+
+// List mocks base method.
+func (m *MockCommentModerationRepository) List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*news0.CommentModeration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, commentIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]*news0.CommentModeration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentModerationRepositoryMockRecorder) List(ctx, commentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentModerationRepository)(nil).List), ctx, commentIDs)
+}
+
+// Unhide mocks base method.
+func (m *MockCommentModerationRepository) Unhide(ctx context.Context, commentID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unhide", ctx, commentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unhide indicates an expected call of Unhide.
+func (mr *MockCommentModerationRepositoryMockRecorder) Unhide(ctx, commentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unhide", reflect.TypeOf((*MockCommentModerationRepository)(nil).Unhide), ctx, commentID)
+}
+
+// MockCommentReportsRepository is a mock of CommentReportsRepository interface.
+type MockCommentReportsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentReportsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentReportsRepositoryMockRecorder is the mock recorder for MockCommentReportsRepository.
+type MockCommentReportsRepositoryMockRecorder struct {
+	mock *MockCommentReportsRepository
+}
+
+// NewMockCommentReportsRepository creates a new mock instance.
+func NewMockCommentReportsRepository(ctrl *gomock.Controller) *MockCommentReportsRepository {
+	mock := &MockCommentReportsRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentReportsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentReportsRepository) EXPECT() *MockCommentReportsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCommentReportsRepository) Add(ctx context.Context, report *news0.CommentReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCommentReportsRepositoryMockRecorder) Add(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCommentReportsRepository)(nil).Add), ctx, report)
+}
+
+// Delete mocks base method.
+func (m *MockCommentReportsRepository) Delete(ctx context.Context, ids ...uuid.UUID) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range ids {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Delete", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCommentReportsRepositoryMockRecorder) Delete(ctx any, ids ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, ids...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCommentReportsRepository)(nil).Delete), varargs...)
+}
+
+// List mocks base method.
+func (m *MockCommentReportsRepository) List(ctx context.Context, portalID int) ([]*news0.CommentReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, portalID)
+	ret0, _ := ret[0].([]*news0.CommentReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentReportsRepositoryMockRecorder) List(ctx, portalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentReportsRepository)(nil).List), ctx, portalID)
+}
+
+// MockCommentThreadsRepository is a mock of CommentThreadsRepository interface.
+type MockCommentThreadsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCommentThreadsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCommentThreadsRepositoryMockRecorder is the mock recorder for MockCommentThreadsRepository.
+type MockCommentThreadsRepositoryMockRecorder struct {
+	mock *MockCommentThreadsRepository
+}
+
+// NewMockCommentThreadsRepository creates a new mock instance.
+func NewMockCommentThreadsRepository(ctrl *gomock.Controller) *MockCommentThreadsRepository {
+	mock := &MockCommentThreadsRepository{ctrl: ctrl}
+	mock.recorder = &MockCommentThreadsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCommentThreadsRepository) EXPECT() *MockCommentThreadsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockCommentThreadsRepository) List(ctx context.Context, commentIDs []uuid.UUID) (map[uuid.UUID]*news0.CommentThread, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, commentIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]*news0.CommentThread)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockCommentThreadsRepositoryMockRecorder) List(ctx, commentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockCommentThreadsRepository)(nil).List), ctx, commentIDs)
+}
+
+// NewsRepliesCount mocks base method.
+func (m *MockCommentThreadsRepository) NewsRepliesCount(ctx context.Context, newsID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewsRepliesCount", ctx, newsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewsRepliesCount indicates an expected call of NewsRepliesCount.
+func (mr *MockCommentThreadsRepositoryMockRecorder) NewsRepliesCount(ctx, newsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewsRepliesCount", reflect.TypeOf((*MockCommentThreadsRepository)(nil).NewsRepliesCount), ctx, newsID)
+}
+
+// Replies mocks base method.
+func (m *MockCommentThreadsRepository) Replies(ctx context.Context, parentID uuid.UUID, afterID *uuid.UUID, limit int, order dto.OrderDirection) ([]uuid.UUID, int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replies", ctx, parentID, afterID, limit, order)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Replies indicates an expected call of Replies.
+func (mr *MockCommentThreadsRepositoryMockRecorder) Replies(ctx, parentID, afterID, limit, order any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replies", reflect.TypeOf((*MockCommentThreadsRepository)(nil).Replies), ctx, parentID, afterID, limit, order)
+}
+
+// RepliesCount mocks base method.
+func (m *MockCommentThreadsRepository) RepliesCount(ctx context.Context, parentIDs []uuid.UUID) (map[uuid.UUID]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepliesCount", ctx, parentIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepliesCount indicates an expected call of RepliesCount.
+func (mr *MockCommentThreadsRepositoryMockRecorder) RepliesCount(ctx, parentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepliesCount", reflect.TypeOf((*MockCommentThreadsRepository)(nil).RepliesCount), ctx, parentIDs)
+}
+
+// Save mocks base method.
+func (m *MockCommentThreadsRepository) Save(ctx context.Context, thread *news0.CommentThread) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, thread)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockCommentThreadsRepositoryMockRecorder) Save(ctx, thread any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockCommentThreadsRepository)(nil).Save), ctx, thread)
+}
+
+// MockSchedulerLease is a mock of SchedulerLease interface.
+type MockSchedulerLease struct {
+	ctrl     *gomock.Controller
+	recorder *MockSchedulerLeaseMockRecorder
+	isgomock struct{}
+}
+
+// MockSchedulerLeaseMockRecorder is the mock recorder for MockSchedulerLease.
+type MockSchedulerLeaseMockRecorder struct {
+	mock *MockSchedulerLease
+}
+
+// NewMockSchedulerLease creates a new mock instance.
+func NewMockSchedulerLease(ctrl *gomock.Controller) *MockSchedulerLease {
+	mock := &MockSchedulerLease{ctrl: ctrl}
+	mock.recorder = &MockSchedulerLeaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSchedulerLease) EXPECT() *MockSchedulerLeaseMockRecorder {
+	return m.recorder
+}
+
+// Acquire mocks base method.
+func (m *MockSchedulerLease) Acquire(ctx context.Context, owner string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acquire", ctx, owner, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Acquire indicates an expected call of Acquire.
+func (mr *MockSchedulerLeaseMockRecorder) Acquire(ctx, owner, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acquire", reflect.TypeOf((*MockSchedulerLease)(nil).Acquire), ctx, owner, ttl)
+}
+
+// Release mocks base method.
+func (m *MockSchedulerLease) Release(ctx context.Context, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", ctx, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockSchedulerLeaseMockRecorder) Release(ctx, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockSchedulerLease)(nil).Release), ctx, owner)
+}
+
+// MockNewsLocker is a mock of NewsLocker interface.
+type MockNewsLocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsLockerMockRecorder
+	isgomock struct{}
+}
+
+// MockNewsLockerMockRecorder is the mock recorder for MockNewsLocker.
+type MockNewsLockerMockRecorder struct {
+	mock *MockNewsLocker
+}
+
+// NewMockNewsLocker creates a new mock instance.
+func NewMockNewsLocker(ctrl *gomock.Controller) *MockNewsLocker {
+	mock := &MockNewsLocker{ctrl: ctrl}
+	mock.recorder = &MockNewsLockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsLocker) EXPECT() *MockNewsLockerMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockNewsLocker) Lock(ctx context.Context, newsID uuid.UUID, owner string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, newsID, owner, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockNewsLockerMockRecorder) Lock(ctx, newsID, owner, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockNewsLocker)(nil).Lock), ctx, newsID, owner, ttl)
+}
+
+// Unlock mocks base method.
+func (m *MockNewsLocker) Unlock(ctx context.Context, newsID uuid.UUID, owner string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", ctx, newsID, owner)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockNewsLockerMockRecorder) Unlock(ctx, newsID, owner any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockNewsLocker)(nil).Unlock), ctx, newsID, owner)
+}
+
+// MockNewsHistoryRepository is a mock of NewsHistoryRepository interface.
+type MockNewsHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNewsHistoryRepositoryMockRecorder is the mock recorder for MockNewsHistoryRepository.
+type MockNewsHistoryRepositoryMockRecorder struct {
+	mock *MockNewsHistoryRepository
+}
+
+// NewMockNewsHistoryRepository creates a new mock instance.
+func NewMockNewsHistoryRepository(ctrl *gomock.Controller) *MockNewsHistoryRepository {
+	mock := &MockNewsHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockNewsHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsHistoryRepository) EXPECT() *MockNewsHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Append mocks base method.
+func (m *MockNewsHistoryRepository) Append(ctx context.Context, transition *news0.StatusTransition) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Append", ctx, transition)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Append indicates an expected call of Append.
+func (mr *MockNewsHistoryRepositoryMockRecorder) Append(ctx, transition any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Append", reflect.TypeOf((*MockNewsHistoryRepository)(nil).Append), ctx, transition)
+}
+
+// List mocks base method.
+func (m *MockNewsHistoryRepository) List(ctx context.Context, newsID uuid.UUID) ([]*news0.StatusTransition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, newsID)
+	ret0, _ := ret[0].([]*news0.StatusTransition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNewsHistoryRepositoryMockRecorder) List(ctx, newsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNewsHistoryRepository)(nil).List), ctx, newsID)
+}
+
+// MockNewsRevisionsRepository is a mock of NewsRevisionsRepository interface.
+type MockNewsRevisionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsRevisionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNewsRevisionsRepositoryMockRecorder is the mock recorder for MockNewsRevisionsRepository.
+type MockNewsRevisionsRepositoryMockRecorder struct {
+	mock *MockNewsRevisionsRepository
+}
+
+// NewMockNewsRevisionsRepository creates a new mock instance.
+func NewMockNewsRevisionsRepository(ctrl *gomock.Controller) *MockNewsRevisionsRepository {
+	mock := &MockNewsRevisionsRepository{ctrl: ctrl}
+	mock.recorder = &MockNewsRevisionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsRevisionsRepository) EXPECT() *MockNewsRevisionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockNewsRevisionsRepository) Add(ctx context.Context, revision *news0.Revision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, revision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockNewsRevisionsRepositoryMockRecorder) Add(ctx, revision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockNewsRevisionsRepository)(nil).Add), ctx, revision)
+}
+
+// Get mocks base method.
+func (m *MockNewsRevisionsRepository) Get(ctx context.Context, newsID, revisionID uuid.UUID) (*news0.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, newsID, revisionID)
+	ret0, _ := ret[0].(*news0.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNewsRevisionsRepositoryMockRecorder) Get(ctx, newsID, revisionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNewsRevisionsRepository)(nil).Get), ctx, newsID, revisionID)
+}
+
+// List mocks base method.
+func (m *MockNewsRevisionsRepository) List(ctx context.Context, newsID uuid.UUID) ([]*news0.Revision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, newsID)
+	ret0, _ := ret[0].([]*news0.Revision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockNewsRevisionsRepositoryMockRecorder) List(ctx, newsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockNewsRevisionsRepository)(nil).List), ctx, newsID)
+}
+
+// MockNewsDraftsRepository is a mock of NewsDraftsRepository interface.
+type MockNewsDraftsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNewsDraftsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockNewsDraftsRepositoryMockRecorder is the mock recorder for MockNewsDraftsRepository.
+type MockNewsDraftsRepositoryMockRecorder struct {
+	mock *MockNewsDraftsRepository
+}
+
+// NewMockNewsDraftsRepository creates a new mock instance.
+func NewMockNewsDraftsRepository(ctrl *gomock.Controller) *MockNewsDraftsRepository {
+	mock := &MockNewsDraftsRepository{ctrl: ctrl}
+	mock.recorder = &MockNewsDraftsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNewsDraftsRepository) EXPECT() *MockNewsDraftsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockNewsDraftsRepository) Delete(ctx context.Context, newsID, editorID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, newsID, editorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockNewsDraftsRepositoryMockRecorder) Delete(ctx, newsID, editorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockNewsDraftsRepository)(nil).Delete), ctx, newsID, editorID)
+}
+
+// Get mocks base method.
+func (m *MockNewsDraftsRepository) Get(ctx context.Context, newsID, editorID uuid.UUID) (*news0.Draft, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, newsID, editorID)
+	ret0, _ := ret[0].(*news0.Draft)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockNewsDraftsRepositoryMockRecorder) Get(ctx, newsID, editorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockNewsDraftsRepository)(nil).Get), ctx, newsID, editorID)
+}
+
+// Save mocks base method.
+func (m *MockNewsDraftsRepository) Save(ctx context.Context, draft *news0.Draft) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, draft)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockNewsDraftsRepositoryMockRecorder) Save(ctx, draft any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockNewsDraftsRepository)(nil).Save), ctx, draft)
+}
+
+// MockReactionsRepository is a mock of ReactionsRepository interface.
+type MockReactionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReactionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReactionsRepositoryMockRecorder is the mock recorder for MockReactionsRepository.
+type MockReactionsRepositoryMockRecorder struct {
+	mock *MockReactionsRepository
+}
+
+// NewMockReactionsRepository creates a new mock instance.
+func NewMockReactionsRepository(ctrl *gomock.Controller) *MockReactionsRepository {
+	mock := &MockReactionsRepository{ctrl: ctrl}
+	mock.recorder = &MockReactionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReactionsRepository) EXPECT() *MockReactionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockReactionsRepository) Add(ctx context.Context, newsID, employeeID uuid.UUID, reaction news0.ReactionType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, newsID, employeeID, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockReactionsRepositoryMockRecorder) Add(ctx, newsID, employeeID, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockReactionsRepository)(nil).Add), ctx, newsID, employeeID, reaction)
+}
+
+// Get mocks base method.
+func (m *MockReactionsRepository) Get(ctx context.Context, newsID, employeeID uuid.UUID) (*news0.Reactions, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, newsID, employeeID)
+	ret0, _ := ret[0].(*news0.Reactions)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockReactionsRepositoryMockRecorder) Get(ctx, newsID, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockReactionsRepository)(nil).Get), ctx, newsID, employeeID)
+}
+
+// Remove mocks base method.
+func (m *MockReactionsRepository) Remove(ctx context.Context, newsID, employeeID uuid.UUID, reaction news0.ReactionType) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, newsID, employeeID, reaction)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockReactionsRepositoryMockRecorder) Remove(ctx, newsID, employeeID, reaction any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockReactionsRepository)(nil).Remove), ctx, newsID, employeeID, reaction)
+}
+
+// MockViewsRepository is a mock of ViewsRepository interface.
+type MockViewsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockViewsRepositoryMockRecorder is the mock recorder for MockViewsRepository.
+type MockViewsRepositoryMockRecorder struct {
+	mock *MockViewsRepository
+}
+
+// NewMockViewsRepository creates a new mock instance.
+func NewMockViewsRepository(ctrl *gomock.Controller) *MockViewsRepository {
+	mock := &MockViewsRepository{ctrl: ctrl}
+	mock.recorder = &MockViewsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewsRepository) EXPECT() *MockViewsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockViewsRepository) Count(ctx context.Context, newsID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, newsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockViewsRepositoryMockRecorder) Count(ctx, newsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockViewsRepository)(nil).Count), ctx, newsID)
+}
+
+// Record mocks base method.
+func (m *MockViewsRepository) Record(ctx context.Context, views []*news0.View, window time.Duration) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Record", ctx, views, window)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockViewsRepositoryMockRecorder) Record(ctx, views, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockViewsRepository)(nil).Record), ctx, views, window)
+}
+
+// Stats mocks base method.
+func (m *MockViewsRepository) Stats(ctx context.Context, newsID uuid.UUID, from, to time.Time) ([]*news0.DailyViews, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stats", ctx, newsID, from, to)
+	ret0, _ := ret[0].([]*news0.DailyViews)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Stats indicates an expected call of Stats.
+func (mr *MockViewsRepositoryMockRecorder) Stats(ctx, newsID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stats", reflect.TypeOf((*MockViewsRepository)(nil).Stats), ctx, newsID, from, to)
+}
+
+// MockViewsCounter is a mock of ViewsCounter interface.
+type MockViewsCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockViewsCounterMockRecorder
+	isgomock struct{}
+}
+
+// MockViewsCounterMockRecorder is the mock recorder for MockViewsCounter.
+type MockViewsCounterMockRecorder struct {
+	mock *MockViewsCounter
+}
+
+// NewMockViewsCounter creates a new mock instance.
+func NewMockViewsCounter(ctrl *gomock.Controller) *MockViewsCounter {
+	mock := &MockViewsCounter{ctrl: ctrl}
+	mock.recorder = &MockViewsCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockViewsCounter) EXPECT() *MockViewsCounterMockRecorder {
+	return m.recorder
+}
+
+// Count mocks base method.
+func (m *MockViewsCounter) Count(ctx context.Context, newsID uuid.UUID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Count", ctx, newsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Count indicates an expected call of Count.
+func (mr *MockViewsCounterMockRecorder) Count(ctx, newsID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Count", reflect.TypeOf((*MockViewsCounter)(nil).Count), ctx, newsID)
+}
+
+// Register mocks base method.
+func (m *MockViewsCounter) Register(newsID, employeeID uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", newsID, employeeID)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockViewsCounterMockRecorder) Register(newsID, employeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockViewsCounter)(nil).Register), newsID, employeeID)
+}
+
+// MockPermissionChecker is a mock of PermissionChecker interface.
+type MockPermissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockPermissionCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockPermissionCheckerMockRecorder is the mock recorder for MockPermissionChecker.
+type MockPermissionCheckerMockRecorder struct {
+	mock *MockPermissionChecker
+}
+
+// NewMockPermissionChecker creates a new mock instance.
+func NewMockPermissionChecker(ctrl *gomock.Controller) *MockPermissionChecker {
+	mock := &MockPermissionChecker{ctrl: ctrl}
+	mock.recorder = &MockPermissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPermissionChecker) EXPECT() *MockPermissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckPortalPermissions mocks base method.
+func (m *MockPermissionChecker) CheckPortalPermissions(ctx context.Context, portalIDs []int, permissions ...auth.Permission) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, portalIDs}
+	for _, a := range permissions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckPortalPermissions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPortalPermissions indicates an expected call of CheckPortalPermissions.
+func (mr *MockPermissionCheckerMockRecorder) CheckPortalPermissions(ctx, portalIDs any, permissions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, portalIDs}, permissions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPortalPermissions", reflect.TypeOf((*MockPermissionChecker)(nil).CheckPortalPermissions), varargs...)
+}
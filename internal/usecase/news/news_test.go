@@ -0,0 +1,245 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_newsInteractor_AddReaction(t *testing.T) {
+	newsID := uuid.New()
+	employee := &entityEmployee.Employee{ID: uuid.New()}
+	published := &entityNews.NewsFull{ID: newsID, Status: entityNews.NewsStatusPublished, CanReacts: true}
+	reactions := &entityNews.Reactions{
+		Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 1},
+		Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+	}
+
+	tests := []struct {
+		name    string
+		prepare func(news *MockNewsRepository, employees *MockEmployeesRepository, reactions *MockReactionsRepository, visibility *MockVisibilityEvaluator)
+		want    *entityNews.Reactions
+		wantErr error
+	}{
+		{
+			name: "news is hidden by category visibility",
+			prepare: func(n *MockNewsRepository, _ *MockEmployeesRepository, _ *MockReactionsRepository, v *MockVisibilityEvaluator) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(published, nil)
+				v.EXPECT().IsVisible(gomock.Any(), published).Return(false, nil)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name: "visibility error",
+			prepare: func(n *MockNewsRepository, _ *MockEmployeesRepository, _ *MockReactionsRepository, v *MockVisibilityEvaluator) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(published, nil)
+				v.EXPECT().IsVisible(gomock.Any(), published).Return(false, errors.New("redis error"))
+			},
+			wantErr: errors.New("newsInteractor.AddReaction: can't check visibility: redis error"),
+		},
+		{
+			name: "correct",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, r *MockReactionsRepository, v *MockVisibilityEvaluator) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(published, nil)
+				v.EXPECT().IsVisible(gomock.Any(), published).Return(true, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				r.EXPECT().Add(gomock.Any(), newsID, employee.ID, entityNews.ReactionLike).Return(nil)
+				r.EXPECT().Get(gomock.Any(), newsID, employee.ID).Return(reactions, nil)
+			},
+			want: reactions,
+		},
+		{
+			name: "likes from news service",
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, r *MockReactionsRepository, v *MockVisibilityEvaluator) {
+				liked := &entityNews.NewsFull{ID: newsID, Status: entityNews.NewsStatusPublished, CanReacts: true, Likes: 5}
+				n.EXPECT().Get(gomock.Any(), newsID).Return(liked, nil)
+				v.EXPECT().IsVisible(gomock.Any(), liked).Return(true, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				r.EXPECT().Add(gomock.Any(), newsID, employee.ID, entityNews.ReactionLike).Return(nil)
+				r.EXPECT().Get(gomock.Any(), newsID, employee.ID).Return(reactions, nil)
+			},
+			want: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 6},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			reactionsRepository := NewMockReactionsRepository(ctrl)
+			visibility := NewMockVisibilityEvaluator(ctrl)
+			tt.prepare(newsRepository, employeesRepository, reactionsRepository, visibility)
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewNewsInteractor(newsRepository, employeesRepository, reactionsRepository, nil, visibility, ditzap.NewMockLogger(ctrl))
+
+			got, err := interactor.AddReaction(ctx, newsID, entityNews.ReactionLike)
+			switch {
+			case errors.Is(tt.wantErr, diterrors.ErrNotFound):
+				assert.ErrorIs(t, err, tt.wantErr)
+			case tt.wantErr != nil:
+				assert.EqualError(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
+
+func Test_setReactions(t *testing.T) {
+	tests := []struct {
+		name      string
+		likes     int
+		reactions *entityNews.Reactions
+		want      *entityNews.Reactions
+	}{
+		{
+			name:      "no reactions",
+			likes:     10,
+			reactions: nil,
+			want:      nil,
+		},
+		{
+			name:  "no likes in news service",
+			likes: 0,
+			reactions: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 2},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+			want: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 2},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+		},
+		{
+			name:  "likes merged",
+			likes: 10,
+			reactions: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 2},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+			want: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 12},
+				Mine:   []entityNews.ReactionType{entityNews.ReactionLike},
+			},
+		},
+		{
+			name:      "only likes in news service",
+			likes:     10,
+			reactions: &entityNews.Reactions{Counts: map[entityNews.ReactionType]int{}, Mine: []entityNews.ReactionType{}},
+			want: &entityNews.Reactions{
+				Counts: map[entityNews.ReactionType]int{entityNews.ReactionLike: 10},
+				Mine:   []entityNews.ReactionType{},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			news := &entityNews.NewsFull{Likes: tt.likes}
+
+			setReactions(news, tt.reactions)
+
+			assert.Equal(t, tt.want, news.Reactions)
+			// счетчик сервиса новостей не меняется
+			assert.Equal(t, tt.likes, news.Likes)
+		})
+	}
+}
+
+func Test_newsInteractor_Search(t *testing.T) {
+	result := &dtoNews.SearchNewsResult{News: []*entityNews.NewsFull{{ID: uuid.New()}}, Total: 11}
+
+	tests := []struct {
+		name       string
+		categories []string
+		visible    []string
+		visibleErr error
+		// wantFilter фильтр запроса в сервис новостей, nil - сервис не вызывается
+		wantFilter *dtoNews.SearchNewsFilter
+		want       *dtoNews.SearchNewsResult
+		wantErr    bool
+	}{
+		{
+			name:       "no rules, search is not restricted",
+			wantFilter: &dtoNews.SearchNewsFilter{Status: entityNews.NewsStatusPublished},
+			want:       result,
+		},
+		{
+			name:       "restricted to visible categories",
+			visible:    []string{"Спорт", "Наука"},
+			wantFilter: &dtoNews.SearchNewsFilter{Status: entityNews.NewsStatusPublished, CategoriesNames: []string{"Спорт", "Наука"}},
+			want:       result,
+		},
+		{
+			name:       "requested categories intersect with visible",
+			categories: []string{"спорт", "Закрытая"},
+			visible:    []string{"Спорт", "Наука"},
+			wantFilter: &dtoNews.SearchNewsFilter{Status: entityNews.NewsStatusPublished, CategoriesNames: []string{"Спорт"}},
+			want:       result,
+		},
+		{
+			name:       "requested categories are hidden",
+			categories: []string{"Закрытая"},
+			visible:    []string{"Спорт"},
+			want:       &dtoNews.SearchNewsResult{},
+		},
+		{
+			name:    "no visible categories",
+			visible: []string{},
+			want:    &dtoNews.SearchNewsResult{},
+		},
+		{
+			name:       "visibility err",
+			visibleErr: errors.New("categories error"),
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			visibility := NewMockVisibilityEvaluator(ctrl)
+
+			visibility.EXPECT().VisibleCategories(gomock.Any()).Return(tt.visible, tt.visibleErr)
+			if tt.wantFilter != nil {
+				newsRepository.EXPECT().Search(gomock.Any(), &dtoNews.SearchNews{
+					Filter:     tt.wantFilter,
+					Pagination: dtoNews.SearchNewsPagination{Limit: 10},
+					Visitor:    &entityNews.Visitor{PortalID: 1},
+				}).Return(result, nil)
+			}
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewNewsInteractor(newsRepository, nil, nil, nil, visibility, ditzap.NewMockLogger(ctrl))
+
+			got, err := interactor.Search(ctx, &dtoNews.SearchNews{
+				Filter:     &dtoNews.SearchNewsFilter{CategoriesNames: tt.categories},
+				Pagination: dtoNews.SearchNewsPagination{Limit: 10},
+			})
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
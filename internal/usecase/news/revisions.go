@@ -0,0 +1,250 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+// SaveDraft автосохранение правок редактора. Черновик не меняет новость и виден только его автору
+func (i *newsAdminInteractor) SaveDraft(ctx context.Context, id uuid.UUID, news *dtoNews.UpdateNews) (*entityNews.Draft, error) {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: invalid request: empty ID")
+	}
+
+	if err := news.ValidateDraft(); err != nil {
+		logger.Debug("newsAdminInteractor.SaveDraft: invalid request", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: invalid request: %w", err)
+	}
+	if err := news.SanitizeBody(); err != nil {
+		logger.Debug("newsAdminInteractor.SaveDraft: can't sanitize content", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: can't sanitize content: %w", err)
+	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsWrite); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: %w", err)
+	}
+
+	editor, err := i.editor(ctx)
+	if err != nil {
+		logger.Error("newsAdminInteractor.SaveDraft: can't get editor", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: %w", err)
+	}
+
+	draft := &entityNews.Draft{
+		NewsID:        id,
+		EditorID:      editor.ID,
+		Snapshot:      news.Apply(entityNews.SnapshotFromNews(current)),
+		BaseUpdatedAt: current.UpdatedAt,
+		SavedAt:       time.Now(),
+	}
+	if news.UpdatedAt != nil {
+		draft.BaseUpdatedAt = news.UpdatedAt
+	}
+	if err := i.draftsRepository.Save(ctx, draft); err != nil {
+		logger.Error("newsAdminInteractor.SaveDraft: can't save draft", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.SaveDraft: can't save draft: %w", err)
+	}
+	return draft, nil
+}
+
+// Draft черновик текущего редактора
+func (i *newsAdminInteractor) Draft(ctx context.Context, id uuid.UUID) (*entityNews.Draft, error) {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Draft: invalid request: empty ID")
+	}
+
+	editor, err := i.editor(ctx)
+	if err != nil {
+		logger.Error("newsAdminInteractor.Draft: can't get editor", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.Draft: %w", err)
+	}
+
+	draft, err := i.draftsRepository.Get(ctx, id, editor.ID)
+	if err != nil {
+		logger.Error("newsAdminInteractor.Draft: can't get draft", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.Draft: can't get draft: %w", err)
+	}
+	if draft == nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Draft: %w", ErrDraftNotFound)
+	}
+	return draft, nil
+}
+
+func (i *newsAdminInteractor) DeleteDraft(ctx context.Context, id uuid.UUID) error {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return fmt.Errorf("newsAdminInteractor.DeleteDraft: invalid request: empty ID")
+	}
+
+	editor, err := i.editor(ctx)
+	if err != nil {
+		logger.Error("newsAdminInteractor.DeleteDraft: can't get editor", zap.Error(err))
+		return fmt.Errorf("newsAdminInteractor.DeleteDraft: %w", err)
+	}
+
+	if err := i.draftsRepository.Delete(ctx, id, editor.ID); err != nil {
+		logger.Error("newsAdminInteractor.DeleteDraft: can't delete draft", zap.Error(err))
+		return fmt.Errorf("newsAdminInteractor.DeleteDraft: can't delete draft: %w", err)
+	}
+	return nil
+}
+
+// Revisions версии новости, новые первыми
+func (i *newsAdminInteractor) Revisions(ctx context.Context, id uuid.UUID) ([]*entityNews.Revision, error) {
+	logger := ditzap.WithFields(i.logger, ditzap.UUID("news_id", id))
+	if id == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revisions: invalid request: empty ID")
+	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revisions: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsRead); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revisions: %w", err)
+	}
+
+	revisions, err := i.revisionsRepository.List(ctx, id)
+	if err != nil {
+		logger.Error("newsAdminInteractor.Revisions: can't get revisions", zap.Error(err))
+		return nil, fmt.Errorf("newsAdminInteractor.Revisions: can't get revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+func (i *newsAdminInteractor) Revision(ctx context.Context, id, revisionID uuid.UUID) (*entityNews.Revision, error) {
+	if id == uuid.Nil || revisionID == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revision: invalid request: empty ID")
+	}
+
+	current, err := i.news(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revision: %w", err)
+	}
+	if err := i.authorize(ctx, current, entityAuth.PermissionNewsRead); err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revision: %w", err)
+	}
+
+	revision, err := i.revision(ctx, id, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.Revision: %w", err)
+	}
+	return revision, nil
+}
+
+// RestoreRevision возвращает новость к версии. Восстановление сохраняется как новая версия.
+//
+//	updatedAt - время изменения новости, которую видел редактор, проверяется так же, как при обновлении
+func (i *newsAdminInteractor) RestoreRevision(ctx context.Context, id, revisionID uuid.UUID, updatedAt *time.Time) (*entityNews.News, error) {
+	if id == uuid.Nil || revisionID == uuid.Nil {
+		return nil, fmt.Errorf("newsAdminInteractor.RestoreRevision: invalid request: empty ID")
+	}
+
+	revision, err := i.revision(ctx, id, revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.RestoreRevision: %w", err)
+	}
+
+	update := dtoNews.UpdateFromSnapshot(revision.Snapshot, time.Now())
+	if update == nil {
+		return nil, fmt.Errorf("newsAdminInteractor.RestoreRevision: %w", ErrRevisionNotFound)
+	}
+	update.UpdatedAt = updatedAt
+
+	updatedNews, err := i.update(ctx, id, update, &revision.ID)
+	if err != nil {
+		return nil, fmt.Errorf("newsAdminInteractor.RestoreRevision: %w", err)
+	}
+	return updatedNews, nil
+}
+
+// addRevision записывает версию новости. Для новостей, созданных до появления версий,
+// сначала сохраняется исходная версия, чтобы к ней можно было вернуться
+func (i *newsAdminInteractor) addRevision(ctx context.Context, current *entityNews.NewsFull, revision *entityNews.Revision) error {
+	revisions, err := i.revisionsRepository.List(ctx, revision.NewsID)
+	if err != nil {
+		return fmt.Errorf("can't get revisions: %w", err)
+	}
+	if len(revisions) == 0 {
+		initial := &entityNews.Revision{
+			ID:       uuid.New(),
+			NewsID:   revision.NewsID,
+			Author:   current.Author,
+			Snapshot: entityNews.SnapshotFromNews(current),
+		}
+		switch {
+		case current.UpdatedAt != nil:
+			initial.CreatedAt = *current.UpdatedAt
+		case current.CreatedAt != nil:
+			initial.CreatedAt = *current.CreatedAt
+		}
+		if err := i.revisionsRepository.Add(ctx, initial); err != nil {
+			return fmt.Errorf("can't add initial revision: %w", err)
+		}
+	}
+
+	if err := i.revisionsRepository.Add(ctx, revision); err != nil {
+		return fmt.Errorf("can't add revision: %w", err)
+	}
+	return nil
+}
+
+// news новость для правок редактора
+func (i *newsAdminInteractor) news(ctx context.Context, id uuid.UUID) (*entityNews.NewsFull, error) {
+	news, err := i.newsRepository.Get(ctx, id)
+	if err != nil {
+		switch {
+		case errors.As(err, new(diterrors.ValidationError)), errors.Is(err, diterrors.ErrNotFound):
+			i.logger.Debug("newsAdminInteractor.news: can't get news", ditzap.UUID("news_id", id), zap.Error(err))
+		default:
+			i.logger.Error("newsAdminInteractor.news: can't get news", ditzap.UUID("news_id", id), zap.Error(err))
+		}
+		return nil, fmt.Errorf("can't get news: %w", err)
+	}
+	return news, nil
+}
+
+func (i *newsAdminInteractor) revision(ctx context.Context, id, revisionID uuid.UUID) (*entityNews.Revision, error) {
+	revision, err := i.revisionsRepository.Get(ctx, id, revisionID)
+	if err != nil {
+		if errors.Is(err, diterrors.ErrNotFound) {
+			return nil, ErrRevisionNotFound
+		}
+		i.logger.Error("newsAdminInteractor.revision: can't get revision", ditzap.UUID("news_id", id), zap.Error(err))
+		return nil, fmt.Errorf("can't get revision: %w", err)
+	}
+	return revision, nil
+}
+
+// editor редактор из сессии запроса
+func (i *newsAdminInteractor) editor(ctx context.Context) (*entityEmployee.Employee, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("can't get session: %w", err)
+	}
+
+	employee, err := i.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		return nil, fmt.Errorf("can't get editor: %w", err)
+	}
+	return employee, nil
+}
@@ -0,0 +1,177 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_newsAdminInteractor_update(t *testing.T) {
+	newsID := uuid.New()
+	categoryID := uuid.New()
+	updatedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	staleUpdatedAt := updatedAt.Add(-time.Minute)
+	employee := &entityEmployee.Employee{ID: uuid.New(), Person: entityEmployee.Person{LastName: "Иванов", FirstName: "Иван"}}
+	current := &entityNews.NewsFull{
+		ID:        newsID,
+		Title:     "old title",
+		Slug:      "slug",
+		Category:  &entityNews.Category{ID: categoryID},
+		UpdatedAt: &updatedAt,
+	}
+	restoredFrom := uuid.New()
+	title := "new title"
+	slug := "slug"
+
+	tests := []struct {
+		name         string
+		update       *dtoNews.UpdateNews
+		restoredFrom *uuid.UUID
+		locked       bool
+		prepare      func(news *MockNewsRepository, employees *MockEmployeesRepository, revisions *MockNewsRevisionsRepository, drafts *MockNewsDraftsRepository)
+		wantErr      error
+	}{
+		{
+			name:    "updatedAt is required",
+			update:  &dtoNews.UpdateNews{Title: &title, Slug: &slug},
+			wantErr: ErrNewsVersionRequired,
+		},
+		{
+			name:    "news is changed by another request",
+			update:  &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &updatedAt},
+			locked:  true,
+			wantErr: ErrNewsLocked,
+		},
+		{
+			name:   "news changed by another editor",
+			update: &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &staleUpdatedAt},
+			prepare: func(n *MockNewsRepository, _ *MockEmployeesRepository, _ *MockNewsRevisionsRepository, _ *MockNewsDraftsRepository) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+			},
+			wantErr: ErrNewsConflict,
+		},
+		{
+			name:   "first revision saves initial version",
+			update: &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &updatedAt},
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, r *MockNewsRevisionsRepository, d *MockNewsDraftsRepository) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				n.EXPECT().Update(gomock.Any(), newsID, gomock.Any()).Return(&entityNews.News{ID: newsID}, nil)
+				r.EXPECT().List(gomock.Any(), newsID).Return(nil, nil)
+				gomock.InOrder(
+					r.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision *entityNews.Revision) error {
+						assert.Equal(t, "old title", revision.Snapshot.Title)
+						assert.Equal(t, updatedAt, revision.CreatedAt)
+						assert.Empty(t, revision.ChangedFields)
+						return nil
+					}),
+					r.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision *entityNews.Revision) error {
+						assert.Equal(t, "new title", revision.Snapshot.Title)
+						assert.Equal(t, []string{entityNews.RevisionFieldTitle}, revision.ChangedFields)
+						assert.Equal(t, &employee.ID, revision.Author.ID)
+						assert.Nil(t, revision.RestoredFrom)
+						return nil
+					}),
+				)
+				d.EXPECT().Delete(gomock.Any(), newsID, employee.ID).Return(nil)
+			},
+		},
+		{
+			name:         "restored revision",
+			update:       &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &updatedAt},
+			restoredFrom: &restoredFrom,
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, r *MockNewsRevisionsRepository, d *MockNewsDraftsRepository) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				n.EXPECT().Update(gomock.Any(), newsID, gomock.Any()).Return(&entityNews.News{ID: newsID}, nil)
+				r.EXPECT().List(gomock.Any(), newsID).Return([]*entityNews.Revision{{ID: restoredFrom}}, nil)
+				r.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, revision *entityNews.Revision) error {
+					assert.Equal(t, &restoredFrom, revision.RestoredFrom)
+					return nil
+				})
+				d.EXPECT().Delete(gomock.Any(), newsID, employee.ID).Return(nil)
+			},
+		},
+		{
+			name:   "revision is not saved, news is reverted",
+			update: &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &updatedAt},
+			prepare: func(n *MockNewsRepository, e *MockEmployeesRepository, r *MockNewsRevisionsRepository, _ *MockNewsDraftsRepository) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(current, nil)
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				gomock.InOrder(
+					n.EXPECT().Update(gomock.Any(), newsID, gomock.Any()).Return(&entityNews.News{ID: newsID}, nil),
+					n.EXPECT().Update(gomock.Any(), newsID, gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ uuid.UUID, update *dtoNews.UpdateNews) (*entityNews.News, error) {
+							assert.Equal(t, "old title", *update.Title)
+							return &entityNews.News{ID: newsID}, nil
+						}),
+				)
+				r.EXPECT().List(gomock.Any(), newsID).Return([]*entityNews.Revision{{ID: restoredFrom}}, nil)
+				r.EXPECT().Add(gomock.Any(), gomock.Any()).Return(errors.New("redis error"))
+			},
+			wantErr: errors.New("can't save revision: can't add revision: redis error"),
+		},
+		{
+			name:   "news not found",
+			update: &dtoNews.UpdateNews{Title: &title, Slug: &slug, UpdatedAt: &updatedAt},
+			prepare: func(n *MockNewsRepository, _ *MockEmployeesRepository, _ *MockNewsRevisionsRepository, _ *MockNewsDraftsRepository) {
+				n.EXPECT().Get(gomock.Any(), newsID).Return(nil, errors.New("news error"))
+			},
+			wantErr: errors.New("can't get news: news error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			newsRepository := NewMockNewsRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			categoryRepository := NewMockCategoryRepository(ctrl)
+			categoryRepository.EXPECT().Filter(gomock.Any(), gomock.Any()).Return(&entityNews.CategoriesWithPagination{}, nil).AnyTimes()
+			revisionsRepository := NewMockNewsRevisionsRepository(ctrl)
+			draftsRepository := NewMockNewsDraftsRepository(ctrl)
+			locker := NewMockNewsLocker(ctrl)
+			locker.EXPECT().Lock(gomock.Any(), newsID, gomock.Any(), newsLockTTL).Return(!tt.locked, nil).AnyTimes()
+			locker.EXPECT().Unlock(gomock.Any(), newsID, gomock.Any()).Return(nil).AnyTimes()
+			permissionChecker := NewMockPermissionChecker(ctrl)
+			permissionChecker.EXPECT().CheckPortalPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			if tt.prepare != nil {
+				tt.prepare(newsRepository, employeesRepository, revisionsRepository, draftsRepository)
+			}
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			interactor := NewNewsAdminInteractor(newsRepository, employeesRepository, nil, nil, categoryRepository,
+				revisionsRepository, draftsRepository, locker, permissionChecker, false, logger)
+
+			_, err := interactor.update(ctx, newsID, tt.update, tt.restoredFrom)
+			switch {
+			case errors.Is(tt.wantErr, ErrNewsConflict):
+				conflict := &ConflictError{}
+				assert.ErrorAs(t, err, &conflict)
+				assert.Equal(t, current, conflict.Current)
+			case tt.wantErr != nil:
+				assert.EqualError(t, err, tt.wantErr.Error())
+			default:
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
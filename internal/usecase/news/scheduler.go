@@ -0,0 +1,361 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+const (
+	// schedulerReleaseTimeout время на освобождение аренды при остановке
+	schedulerReleaseTimeout = 5 * time.Second
+	// schedulerMaxBackoff максимальная пауза перед повторной попыткой публикации
+	schedulerMaxBackoff = 30 * time.Minute
+	// schedulerMaxPages сколько страниц ожидающих публикации новостей вычитывается за один запуск
+	schedulerMaxPages = 100
+	// schedulerComment комментарий перехода в истории новости
+	schedulerComment = "Опубликовано по расписанию"
+)
+
+var (
+	// errLeaseLost аренда перешла к другой реплике во время запуска
+	errLeaseLost = errors.New("lease lost")
+	// errNotDue новость изменили после поиска: она больше не ждет публикации или время публикации перенесено
+	errNotDue = errors.New("news is not due for publication")
+)
+
+// schedulerActor исполнитель публикации по расписанию в истории новости
+var schedulerActor = entityNews.Author{LastName: "Планировщик публикаций"}
+
+type publishScheduler struct {
+	newsRepository    NewsRepository
+	historyRepository NewsHistoryRepository
+	lease             SchedulerLease
+	locker            NewsLocker
+	// owner идентификатор реплики в аренде
+	owner        string
+	interval     time.Duration
+	leaseTTL     time.Duration
+	retryBackoff time.Duration
+	// twoPersonReview по расписанию публикуются только новости, поставленные в ожидание публикации не автором
+	twoPersonReview bool
+	logger          ditzap.Logger
+	now             func() time.Time
+
+	// retries неудачные попытки публикации, используются только из Run
+	retries map[uuid.UUID]*publishRetry
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+type publishRetry struct {
+	attempts int
+	next     time.Time
+}
+
+// NewPublishScheduler планировщик публикации новостей, ожидающих публикации.
+//
+//	Раз в interval реплика, удерживающая аренду, публикует новости, время публикации которых наступило.
+//	Новость публикуется под блокировкой, как и при смене статуса редактором.
+//	Неудачная публикация повторяется с экспоненциально растущей паузой от retryBackoff.
+//	При двухэтапной проверке постановка новости в расписание другим редактором считается проверкой,
+//	новости, поставленные в расписание автором, ждут публикации редактором
+func NewPublishScheduler(
+	newsRepository NewsRepository,
+	historyRepository NewsHistoryRepository,
+	lease SchedulerLease,
+	locker NewsLocker,
+	interval time.Duration,
+	leaseTTL time.Duration,
+	retryBackoff time.Duration,
+	twoPersonReview bool,
+	logger ditzap.Logger,
+) *publishScheduler {
+	return &publishScheduler{
+		newsRepository:    newsRepository,
+		historyRepository: historyRepository,
+		lease:             lease,
+		locker:            locker,
+		owner:             uuid.NewString(),
+		interval:          interval,
+		leaseTTL:          leaseTTL,
+		retryBackoff:      retryBackoff,
+		twoPersonReview:   twoPersonReview,
+		logger:            logger,
+		now:               time.Now,
+		retries:           make(map[uuid.UUID]*publishRetry),
+		done:              make(chan struct{}),
+	}
+}
+
+// Run публикует новости по расписанию до завершения ctx или вызова Stop. При остановке освобождает аренду
+func (s *publishScheduler) Run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	s.mu.Lock()
+	s.cancel = cancel
+	s.mu.Unlock()
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	s.tick(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			s.release(ctx)
+			return
+		case <-ticker.C:
+			s.tick(ctx)
+		}
+	}
+}
+
+// Stop останавливает Run и ждет его завершения, но не дольше ctx
+func (s *publishScheduler) Stop(ctx context.Context) error {
+	s.mu.Lock()
+	cancel := s.cancel
+	s.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-s.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("publishScheduler.Stop: %w", ctx.Err())
+	}
+}
+
+// tick продлевает аренду и, если она у этой реплики, публикует наступившие новости
+func (s *publishScheduler) tick(ctx context.Context) {
+	if !s.holdLease(ctx) {
+		return
+	}
+	s.publishDue(ctx)
+}
+
+// holdLease захватывает или продлевает аренду. false - аренда у другой реплики или недоступна,
+// публиковать нельзя. Вызывается перед каждой страницей и каждой публикацией, чтобы запуск,
+// длящийся дольше leaseTTL, не продолжался после перехода аренды к другой реплике
+func (s *publishScheduler) holdLease(ctx context.Context) bool {
+	acquired, err := s.lease.Acquire(ctx, s.owner, s.leaseTTL)
+	if err != nil {
+		s.logger.Warn("publishScheduler: can't acquire lease", zap.Error(err))
+	}
+	if err != nil || !acquired {
+		setGauge(schedulerLeaderMetric, 0)
+		return false
+	}
+	setGauge(schedulerLeaderMetric, 1)
+	return true
+}
+
+func (s *publishScheduler) release(ctx context.Context) {
+	releaseCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), schedulerReleaseTimeout)
+	defer cancel()
+
+	if err := s.lease.Release(releaseCtx, s.owner); err != nil {
+		s.logger.Warn("publishScheduler: can't release lease", zap.Error(err))
+	}
+	setGauge(schedulerLeaderMetric, 0)
+}
+
+// publishDue публикует новости, время публикации которых наступило
+func (s *publishScheduler) publishDue(ctx context.Context) {
+	now := s.now()
+	waiting, err := s.waiting(ctx)
+	if errors.Is(err, errLeaseLost) {
+		s.logger.Warn("publishScheduler: lease lost, run is stopped")
+		return
+	}
+	if err != nil {
+		s.logger.Error("publishScheduler: can't get waiting news", zap.Error(err))
+		return
+	}
+	setGauge(schedulerScheduledMetric, float64(len(waiting)))
+
+	due := 0
+	waitingIDs := make(map[uuid.UUID]struct{}, len(waiting))
+	for _, news := range waiting {
+		waitingIDs[news.ID] = struct{}{}
+		if news.PublicationAt == nil || news.PublicationAt.After(now) {
+			continue
+		}
+		due++
+		if retry := s.retries[news.ID]; retry != nil && now.Before(retry.next) {
+			continue
+		}
+
+		if !s.holdLease(ctx) {
+			s.logger.Warn("publishScheduler: lease lost, run is stopped", ditzap.UUID("news_id", news.ID))
+			return
+		}
+
+		err = s.publish(ctx, news.ID, now)
+		switch {
+		case errors.Is(err, ErrNewsLocked):
+			s.logger.Debug("publishScheduler: news is locked, it will be published on next run", ditzap.UUID("news_id", news.ID))
+			continue
+		case errors.Is(err, errNotDue):
+			due--
+			delete(s.retries, news.ID)
+			s.logger.Debug("publishScheduler: news was changed after search", ditzap.UUID("news_id", news.ID))
+			continue
+		case errors.Is(err, ErrSelfApproval):
+			s.logger.Debug("publishScheduler: news is waiting for review", ditzap.UUID("news_id", news.ID))
+			continue
+		case errors.Is(err, ErrSubmitterUnknown):
+			s.logger.Warn("publishScheduler: news is not published, the submitter is missing in history",
+				ditzap.UUID("news_id", news.ID))
+			continue
+		case err != nil:
+			incCounter(schedulerFailuresMetric)
+			retry := s.failed(news.ID, now)
+			s.logger.Error("publishScheduler: can't publish news", zap.Error(err), ditzap.UUID("news_id", news.ID),
+				zap.Int("attempts", retry.attempts), zap.Time("next_attempt", retry.next))
+			continue
+		}
+
+		due--
+		delete(s.retries, news.ID)
+		incCounter(schedulerPublishedMetric)
+		observeHistogram(schedulerPublishDelayMetric, now.Sub(*news.PublicationAt).Seconds())
+		s.logger.Info("publishScheduler: news published", ditzap.UUID("news_id", news.ID))
+	}
+	setGauge(schedulerDueMetric, float64(due))
+
+	// новости, которые больше не ждут публикации, например опубликованные вручную
+	for id := range s.retries {
+		if _, ok := waitingIDs[id]; !ok {
+			delete(s.retries, id)
+		}
+	}
+}
+
+// waiting новости, ожидающие публикации
+func (s *publishScheduler) waiting(ctx context.Context) ([]*entityNews.NewsFull, error) {
+	search := &dtoNews.SearchNews{
+		Filter:     &dtoNews.SearchNewsFilter{Status: entityNews.NewsStatusWaitingPublish},
+		Pagination: dtoNews.SearchNewsPagination{Limit: dtoNews.SearchNewsMaxLimit},
+		Order:      dtoNews.SearchNewsOrder{By: dtoNews.SearchNewsOrderByCreatedAt},
+	}
+
+	waiting := make([]*entityNews.NewsFull, 0)
+	for page := range schedulerMaxPages {
+		if page > 0 && !s.holdLease(ctx) {
+			return nil, errLeaseLost
+		}
+		found, err := s.newsRepository.Search(ctx, search)
+		if err != nil {
+			return nil, fmt.Errorf("can't search news: %w", err)
+		}
+		for _, news := range found.News {
+			if news != nil && news.GetStatus() == entityNews.NewsStatusWaitingPublish {
+				waiting = append(waiting, news)
+			}
+		}
+		if found.Next == nil || len(found.News) == 0 {
+			return waiting, nil
+		}
+		search.Scroll = found.Next
+	}
+
+	s.logger.Warn("publishScheduler: too many waiting news, the rest will be published on next runs",
+		zap.Int("news", len(waiting)))
+	return waiting, nil
+}
+
+// publish переводит новость в статус опубликована и записывает переход в историю.
+//
+//	Новость перечитывается под блокировкой: между поиском и публикацией ее мог изменить редактор.
+//	Публикация без записи в истории отменяется и повторяется при следующем запуске
+func (s *publishScheduler) publish(ctx context.Context, id uuid.UUID, now time.Time) error {
+	unlock, err := lockNews(ctx, s.locker, id, s.logger)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	news, err := s.newsRepository.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("can't get news: %w", err)
+	}
+	if news.GetStatus() != entityNews.NewsStatusWaitingPublish || news.PublicationAt == nil || news.PublicationAt.After(now) {
+		return errNotDue
+	}
+	if err = checkTransition(news, entityNews.NewsStatusPublished); err != nil {
+		return fmt.Errorf("invalid transition: %w", err)
+	}
+
+	if s.twoPersonReview {
+		history, err := s.historyRepository.List(ctx, id)
+		if err != nil {
+			return fmt.Errorf("can't get history: %w", err)
+		}
+		submittedBy := submitter(history)
+		if submittedBy == nil {
+			return ErrSubmitterUnknown
+		}
+		if news.Author.ID == nil || *submittedBy == *news.Author.ID {
+			return ErrSelfApproval
+		}
+	}
+
+	if _, err = s.newsRepository.Update(ctx, id, &dtoNews.UpdateNews{
+		Status: entityNews.NewsStatusPublished,
+	}); err != nil {
+		return fmt.Errorf("can't update news: %w", err)
+	}
+
+	err = s.historyRepository.Append(ctx, &entityNews.StatusTransition{
+		NewsID:    id,
+		From:      news.GetStatus(),
+		To:        entityNews.NewsStatusPublished,
+		Actor:     schedulerActor,
+		Comment:   schedulerComment,
+		CreatedAt: now,
+	})
+	if err != nil {
+		// Переход без записи в истории не допускается: по истории проверяется, кто отправил новость на публикацию
+		if _, rErr := s.newsRepository.Update(ctx, id, &dtoNews.UpdateNews{Status: news.GetStatus()}); rErr != nil {
+			s.logger.Error("publishScheduler: can't revert status", zap.Error(rErr), ditzap.UUID("news_id", id))
+		}
+		return fmt.Errorf("can't append history: %w", err)
+	}
+	return nil
+}
+
+// failed учитывает неудачную попытку публикации и назначает следующую
+func (s *publishScheduler) failed(id uuid.UUID, now time.Time) *publishRetry {
+	retry := s.retries[id]
+	if retry == nil {
+		retry = &publishRetry{}
+		s.retries[id] = retry
+	}
+	retry.attempts++
+	retry.next = now.Add(publishBackoff(s.retryBackoff, retry.attempts))
+	return retry
+}
+
+// publishBackoff пауза перед следующей попыткой после attempts неудачных: base, 2*base, 4*base... но не больше schedulerMaxBackoff
+func publishBackoff(base time.Duration, attempts int) time.Duration {
+	backoff := base
+	for i := 1; i < attempts && backoff < schedulerMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, schedulerMaxBackoff)
+}
@@ -0,0 +1,266 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_publishScheduler_publishDue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	newsRepository := NewMockNewsRepository(ctrl)
+	historyRepository := NewMockNewsHistoryRepository(ctrl)
+	lease := NewMockSchedulerLease(ctrl)
+	locker := NewMockNewsLocker(ctrl)
+	locker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any(), newsLockTTL).Return(true, nil).AnyTimes()
+	locker.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	logger := ditzap.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	authorID := uuid.New()
+	editorID := uuid.New()
+	waiting := func(publicationAt *time.Time) *entityNews.NewsFull {
+		return &entityNews.NewsFull{
+			ID:            uuid.New(),
+			Title:         "title",
+			Status:        entityNews.NewsStatusWaitingPublish,
+			Author:        entityNews.Author{ID: &authorID},
+			PublicationAt: publicationAt,
+		}
+	}
+	due := waiting(&past)
+	scheduled := waiting(&future)
+	broken := waiting(&past)
+	selfSubmitted := waiting(&past)
+	unknownSubmitter := waiting(&past)
+	published := &entityNews.NewsFull{ID: uuid.New(), Status: entityNews.NewsStatusPublished, PublicationAt: &past}
+	stored := map[uuid.UUID]*entityNews.NewsFull{}
+	for _, news := range []*entityNews.NewsFull{due, scheduled, broken, selfSubmitted, unknownSubmitter, published} {
+		stored[news.ID] = news
+	}
+	newsRepository.EXPECT().Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, id uuid.UUID) (*entityNews.NewsFull, error) {
+			return stored[id], nil
+		}).AnyTimes()
+
+	scheduler := NewPublishScheduler(newsRepository, historyRepository, lease, locker, time.Minute, 3*time.Minute, time.Minute, true, logger)
+	scheduler.now = func() time.Time { return now }
+
+	lease.EXPECT().Acquire(gomock.Any(), scheduler.owner, 3*time.Minute).Return(true, nil).AnyTimes()
+
+	nextPage := &dtoNews.SearchNewsScroll{LastID: &scheduled.ID}
+	newsRepository.EXPECT().Search(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, search *dtoNews.SearchNews) (*dtoNews.SearchNewsResult, error) {
+			assert.Equal(t, entityNews.NewsStatusWaitingPublish, search.Filter.Status)
+			if search.Scroll == nil {
+				return &dtoNews.SearchNewsResult{News: []*entityNews.NewsFull{due, scheduled}, Next: nextPage}, nil
+			}
+			return &dtoNews.SearchNewsResult{News: []*entityNews.NewsFull{broken, selfSubmitted, unknownSubmitter, published}}, nil
+		}).Times(8)
+
+	submitted := func(newsID uuid.UUID, actorID uuid.UUID) []*entityNews.StatusTransition {
+		return []*entityNews.StatusTransition{{NewsID: newsID, To: entityNews.NewsStatusWaitingPublish, Actor: entityNews.Author{ID: &actorID}}}
+	}
+	historyRepository.EXPECT().List(gomock.Any(), due.ID).Return(submitted(due.ID, editorID), nil)
+	historyRepository.EXPECT().List(gomock.Any(), broken.ID).Return(submitted(broken.ID, editorID), nil).Times(2)
+	historyRepository.EXPECT().List(gomock.Any(), selfSubmitted.ID).Return(submitted(selfSubmitted.ID, authorID), nil).Times(4)
+	historyRepository.EXPECT().List(gomock.Any(), unknownSubmitter.ID).Return(nil, nil).Times(4)
+
+	publishedStatus := &dtoNews.UpdateNews{Status: entityNews.NewsStatusPublished}
+	newsRepository.EXPECT().Update(gomock.Any(), due.ID, publishedStatus).Return(&entityNews.News{}, nil)
+	newsRepository.EXPECT().Update(gomock.Any(), broken.ID, publishedStatus).Return(nil, errors.New("unavailable")).Times(2)
+	historyRepository.EXPECT().Append(gomock.Any(), &entityNews.StatusTransition{
+		NewsID:    due.ID,
+		From:      entityNews.NewsStatusWaitingPublish,
+		To:        entityNews.NewsStatusPublished,
+		Actor:     schedulerActor,
+		Comment:   schedulerComment,
+		CreatedAt: now,
+	}).Return(nil)
+
+	scheduler.publishDue(context.TODO())
+	assert.Equal(t, &publishRetry{attempts: 1, next: now.Add(time.Minute)}, scheduler.retries[broken.ID])
+
+	// до следующей попытки упавшая новость не публикуется
+	due.Status = entityNews.NewsStatusPublished
+	scheduler.publishDue(context.TODO())
+
+	now = now.Add(time.Minute)
+	scheduler.publishDue(context.TODO())
+	assert.Equal(t, &publishRetry{attempts: 2, next: now.Add(2 * time.Minute)}, scheduler.retries[broken.ID])
+
+	// новость опубликована вручную, попытки забываются
+	broken.Status = entityNews.NewsStatusPublished
+	scheduler.publishDue(context.TODO())
+	assert.Empty(t, scheduler.retries)
+}
+
+func Test_publishScheduler_publishDue_leaseLost(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	newsRepository := NewMockNewsRepository(ctrl)
+	historyRepository := NewMockNewsHistoryRepository(ctrl)
+	lease := NewMockSchedulerLease(ctrl)
+	locker := NewMockNewsLocker(ctrl)
+	locker.EXPECT().Lock(gomock.Any(), gomock.Any(), gomock.Any(), newsLockTTL).Return(true, nil).AnyTimes()
+	locker.EXPECT().Unlock(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	logger := ditzap.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	past := time.Now().Add(-time.Minute)
+	first := &entityNews.NewsFull{ID: uuid.New(), Title: "title", Status: entityNews.NewsStatusWaitingPublish, PublicationAt: &past}
+	second := &entityNews.NewsFull{ID: uuid.New(), Title: "title", Status: entityNews.NewsStatusWaitingPublish, PublicationAt: &past}
+
+	scheduler := NewPublishScheduler(newsRepository, historyRepository, lease, locker, time.Minute, 3*time.Minute, time.Minute, false, logger)
+
+	newsRepository.EXPECT().Search(gomock.Any(), gomock.Any()).
+		Return(&dtoNews.SearchNewsResult{News: []*entityNews.NewsFull{first, second}}, nil)
+	// аренда продлена перед первой публикацией и перешла к другой реплике перед второй
+	gomock.InOrder(
+		lease.EXPECT().Acquire(gomock.Any(), scheduler.owner, 3*time.Minute).Return(true, nil),
+		lease.EXPECT().Acquire(gomock.Any(), scheduler.owner, 3*time.Minute).Return(false, nil),
+	)
+	newsRepository.EXPECT().Get(gomock.Any(), first.ID).Return(first, nil)
+	newsRepository.EXPECT().Update(gomock.Any(), first.ID, &dtoNews.UpdateNews{Status: entityNews.NewsStatusPublished}).
+		Return(&entityNews.News{}, nil)
+	historyRepository.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
+
+	scheduler.publishDue(context.TODO())
+}
+
+func Test_publishScheduler_publish(t *testing.T) {
+	now := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+	id := uuid.New()
+	waiting := func(status entityNews.NewsStatus, publicationAt *time.Time) *entityNews.NewsFull {
+		return &entityNews.NewsFull{ID: id, Title: "title", Status: status, PublicationAt: publicationAt}
+	}
+	publishedStatus := &dtoNews.UpdateNews{Status: entityNews.NewsStatusPublished}
+
+	type mocks struct {
+		newsRepository    *MockNewsRepository
+		historyRepository *MockNewsHistoryRepository
+		locker            *MockNewsLocker
+	}
+	tests := []struct {
+		name    string
+		prepare func(m mocks)
+		wantErr error
+		isErr   bool
+	}{
+		{
+			name: "success",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(true, nil)
+				m.locker.EXPECT().Unlock(gomock.Any(), id, gomock.Any()).Return(nil)
+				m.newsRepository.EXPECT().Get(gomock.Any(), id).Return(waiting(entityNews.NewsStatusWaitingPublish, &past), nil)
+				m.newsRepository.EXPECT().Update(gomock.Any(), id, publishedStatus).Return(&entityNews.News{}, nil)
+				m.historyRepository.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name: "locked",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(false, nil)
+			},
+			wantErr: ErrNewsLocked,
+			isErr:   true,
+		},
+		{
+			name: "status changed after search",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(true, nil)
+				m.locker.EXPECT().Unlock(gomock.Any(), id, gomock.Any()).Return(nil)
+				m.newsRepository.EXPECT().Get(gomock.Any(), id).Return(waiting(entityNews.NewsStatusDraft, &past), nil)
+			},
+			wantErr: errNotDue,
+			isErr:   true,
+		},
+		{
+			name: "publication postponed after search",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(true, nil)
+				m.locker.EXPECT().Unlock(gomock.Any(), id, gomock.Any()).Return(nil)
+				m.newsRepository.EXPECT().Get(gomock.Any(), id).Return(waiting(entityNews.NewsStatusWaitingPublish, &future), nil)
+			},
+			wantErr: errNotDue,
+			isErr:   true,
+		},
+		{
+			name: "get err",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(true, nil)
+				m.locker.EXPECT().Unlock(gomock.Any(), id, gomock.Any()).Return(nil)
+				m.newsRepository.EXPECT().Get(gomock.Any(), id).Return(nil, errors.New("unavailable"))
+			},
+			isErr: true,
+		},
+		{
+			name: "history err reverts status",
+			prepare: func(m mocks) {
+				m.locker.EXPECT().Lock(gomock.Any(), id, gomock.Any(), newsLockTTL).Return(true, nil)
+				m.locker.EXPECT().Unlock(gomock.Any(), id, gomock.Any()).Return(nil)
+				m.newsRepository.EXPECT().Get(gomock.Any(), id).Return(waiting(entityNews.NewsStatusWaitingPublish, &past), nil)
+				gomock.InOrder(
+					m.newsRepository.EXPECT().Update(gomock.Any(), id, publishedStatus).Return(&entityNews.News{}, nil),
+					m.historyRepository.EXPECT().Append(gomock.Any(), gomock.Any()).Return(errors.New("unavailable")),
+					m.newsRepository.EXPECT().Update(gomock.Any(), id, &dtoNews.UpdateNews{Status: entityNews.NewsStatusWaitingPublish}).
+						Return(&entityNews.News{}, nil),
+				)
+			},
+			isErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			m := mocks{
+				newsRepository:    NewMockNewsRepository(ctrl),
+				historyRepository: NewMockNewsHistoryRepository(ctrl),
+				locker:            NewMockNewsLocker(ctrl),
+			}
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+			tt.prepare(m)
+
+			scheduler := NewPublishScheduler(m.newsRepository, m.historyRepository, NewMockSchedulerLease(ctrl), m.locker,
+				time.Minute, 3*time.Minute, time.Minute, false, logger)
+			err := scheduler.publish(context.TODO(), id, now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+			assert.Equal(t, tt.isErr, err != nil)
+		})
+	}
+}
+
+func Test_publishBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{attempts: 1, want: time.Minute},
+		{attempts: 2, want: 2 * time.Minute},
+		{attempts: 4, want: 8 * time.Minute},
+		{attempts: 100, want: schedulerMaxBackoff},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, publishBackoff(time.Minute, tt.attempts))
+	}
+}
@@ -0,0 +1,177 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+const (
+	// viewsFlushTimeout время на сохранение накопленных просмотров при остановке
+	viewsFlushTimeout = 5 * time.Second
+	// viewsStatsMaxDays максимальная длина периода статистики просмотров
+	viewsStatsMaxDays = 366
+)
+
+type viewsCounter struct {
+	repository ViewsRepository
+	window     time.Duration
+	interval   time.Duration
+	batchSize  int
+	logger     ditzap.Logger
+	now        func() time.Time
+
+	mu      sync.Mutex
+	pending []*entityNews.View
+	flush   chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+// NewViewsCounter счетчик просмотров новостей.
+//
+//	Просмотры копятся в памяти и сохраняются пачками в Run раз в interval, поэтому регистрация просмотра не обращается к хранилищу.
+//	Повторные просмотры сотрудником одной новости в течение window не учитываются
+func NewViewsCounter(repository ViewsRepository, window, interval time.Duration, batchSize int, logger ditzap.Logger) *viewsCounter {
+	return &viewsCounter{
+		repository: repository,
+		window:     window,
+		interval:   interval,
+		batchSize:  max(batchSize, 1),
+		logger:     logger,
+		now:        time.Now,
+		flush:      make(chan struct{}, 1),
+		done:       make(chan struct{}),
+	}
+}
+
+// Register регистрирует просмотр новости сотрудником
+func (c *viewsCounter) Register(newsID, employeeID uuid.UUID) {
+	c.mu.Lock()
+	c.pending = append(c.pending, &entityNews.View{
+		NewsID:     newsID,
+		EmployeeID: employeeID,
+		ViewedAt:   c.now(),
+	})
+	full := len(c.pending) >= c.batchSize
+	c.mu.Unlock()
+
+	if full {
+		select {
+		case c.flush <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Count количество учтенных просмотров новости. Еще не сохраненные просмотры не учитываются
+func (c *viewsCounter) Count(ctx context.Context, newsID uuid.UUID) (int, error) {
+	return c.repository.Count(ctx, newsID)
+}
+
+// Run сохраняет накопленные просмотры раз в interval или при заполнении пачки.
+//
+//	При завершении ctx или вызове Stop сохраняет оставшиеся просмотры
+func (c *viewsCounter) Run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	c.mu.Lock()
+	c.cancel = cancel
+	c.mu.Unlock()
+	defer close(c.done)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), viewsFlushTimeout)
+			c.Flush(flushCtx)
+			cancel()
+			return
+		case <-ticker.C:
+			c.Flush(ctx)
+		case <-c.flush:
+			c.Flush(ctx)
+		}
+	}
+}
+
+// Stop останавливает Run и ждет сохранения оставшихся просмотров, но не дольше ctx
+func (c *viewsCounter) Stop(ctx context.Context) error {
+	c.mu.Lock()
+	cancel := c.cancel
+	c.mu.Unlock()
+	if cancel == nil {
+		return nil
+	}
+
+	cancel()
+	select {
+	case <-c.done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("viewsCounter.Stop: %w", ctx.Err())
+	}
+}
+
+// Flush сохраняет накопленные просмотры пачками по batchSize
+func (c *viewsCounter) Flush(ctx context.Context) {
+	c.mu.Lock()
+	views := c.pending
+	c.pending = nil
+	c.mu.Unlock()
+
+	for len(views) > 0 {
+		batch := views[:min(c.batchSize, len(views))]
+		views = views[len(batch):]
+
+		if _, err := c.repository.Record(ctx, batch, c.window); err != nil {
+			c.logger.Error("viewsCounter.Flush: can't record news views", zap.Int("views", len(batch)), zap.Error(err))
+		}
+	}
+}
+
+// startOfDay начало дня t в UTC
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
+
+// checkViewsPeriod проверяет период статистики просмотров, границы - начала дней
+func checkViewsPeriod(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return diterrors.NewValidationError(ErrViewsPeriod, diterrors.ErrValidationFields{
+			Field:   "from",
+			Message: "from must be before to",
+		})
+	}
+	if to.Sub(from) >= viewsStatsMaxDays*24*time.Hour {
+		return diterrors.NewValidationError(ErrViewsPeriod, diterrors.ErrValidationFields{
+			Field:   "to",
+			Message: fmt.Sprintf("period must be less than %d days", viewsStatsMaxDays),
+		})
+	}
+	return nil
+}
+
+// fillDays просмотры за каждый день с from по to, дни без просмотров заполняются нулем
+func fillDays(days []*entityNews.DailyViews, from, to time.Time) []*entityNews.DailyViews {
+	views := make(map[time.Time]int, len(days))
+	for _, day := range days {
+		views[startOfDay(day.Date)] += day.Views
+	}
+
+	result := make([]*entityNews.DailyViews, 0, int(to.Sub(from)/(24*time.Hour))+1)
+	for date := from; !date.After(to); date = date.Add(24 * time.Hour) {
+		result = append(result, &entityNews.DailyViews{Date: date, Views: views[date]})
+	}
+	return result
+}
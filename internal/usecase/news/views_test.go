@@ -0,0 +1,150 @@
+package news
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_viewsCounter_Flush(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := NewMockViewsRepository(ctrl)
+
+	viewedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	newsID := uuid.New()
+	employees := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+
+	counter := NewViewsCounter(repository, time.Hour, time.Minute, 2, nil)
+	counter.now = func() time.Time { return viewedAt }
+
+	view := func(employeeID uuid.UUID) *entityNews.View {
+		return &entityNews.View{NewsID: newsID, EmployeeID: employeeID, ViewedAt: viewedAt}
+	}
+	gomock.InOrder(
+		repository.EXPECT().Record(gomock.Any(), []*entityNews.View{view(employees[0]), view(employees[1])}, time.Hour).Return(2, nil),
+		repository.EXPECT().Record(gomock.Any(), []*entityNews.View{view(employees[2])}, time.Hour).Return(1, nil),
+	)
+
+	for _, employeeID := range employees {
+		counter.Register(newsID, employeeID)
+	}
+	// Заполненная пачка запрашивает сохранение
+	assert.Len(t, counter.flush, 1)
+
+	counter.Flush(context.TODO())
+	assert.Empty(t, counter.pending)
+
+	// Пустой буфер в хранилище не сохраняется
+	counter.Flush(context.TODO())
+}
+
+func Test_viewsCounter_Stop(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repository := NewMockViewsRepository(ctrl)
+
+	viewedAt := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	newsID := uuid.New()
+	employeeID := uuid.New()
+
+	counter := NewViewsCounter(repository, time.Hour, time.Hour, 10, nil)
+	counter.now = func() time.Time { return viewedAt }
+
+	// Остановка до запуска ничего не ждет
+	assert.NoError(t, counter.Stop(context.TODO()))
+
+	// Оставшиеся просмотры сохраняются до возврата из Stop
+	repository.EXPECT().Record(gomock.Any(), []*entityNews.View{{NewsID: newsID, EmployeeID: employeeID, ViewedAt: viewedAt}}, time.Hour).Return(1, nil)
+	counter.Register(newsID, employeeID)
+
+	go counter.Run(context.TODO())
+	assert.Eventually(t, func() bool {
+		counter.mu.Lock()
+		defer counter.mu.Unlock()
+		return counter.cancel != nil
+	}, time.Second, time.Millisecond)
+
+	assert.NoError(t, counter.Stop(context.TODO()))
+	assert.Empty(t, counter.pending)
+}
+
+func Test_fillDays(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(2 * 24 * time.Hour)
+
+	tests := []struct {
+		name string
+		days []*entityNews.DailyViews
+		want []*entityNews.DailyViews
+	}{
+		{
+			name: "no views",
+			want: []*entityNews.DailyViews{
+				{Date: from},
+				{Date: from.Add(24 * time.Hour)},
+				{Date: to},
+			},
+		},
+		{
+			name: "gaps",
+			days: []*entityNews.DailyViews{
+				{Date: from, Views: 3},
+				{Date: to, Views: 1},
+			},
+			want: []*entityNews.DailyViews{
+				{Date: from, Views: 3},
+				{Date: from.Add(24 * time.Hour)},
+				{Date: to, Views: 1},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, fillDays(tt.days, from, to))
+		})
+	}
+}
+
+func Test_checkViewsPeriod(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		from    time.Time
+		to      time.Time
+		wantErr error
+	}{
+		{
+			name: "one day",
+			from: from,
+			to:   from,
+		},
+		{
+			name: "year",
+			from: from,
+			to:   from.Add((viewsStatsMaxDays - 1) * 24 * time.Hour),
+		},
+		{
+			name:    "to before from",
+			from:    from,
+			to:      from.Add(-24 * time.Hour),
+			wantErr: ErrViewsPeriod,
+		},
+		{
+			name:    "too long",
+			from:    from,
+			to:      from.Add(viewsStatsMaxDays * 24 * time.Hour),
+			wantErr: ErrViewsPeriod,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, checkViewsPeriod(tt.from, tt.to), tt.wantErr)
+		})
+	}
+}
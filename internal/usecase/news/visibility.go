@@ -0,0 +1,191 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	entityPortalV2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portalv2"
+)
+
+func NewVisibilityEvaluator(
+	categoryRepository CategoryRepository,
+	employeeRepository EmployeesRepository,
+	complexesRepository ComplexesRepository,
+	logger ditzap.Logger,
+) *visibilityEvaluator {
+	return &visibilityEvaluator{
+		categoryRepository:  categoryRepository,
+		employeeRepository:  employeeRepository,
+		complexesRepository: complexesRepository,
+		logger:              logger,
+	}
+}
+
+// visibilityEvaluator правила видимости хранятся в категории в сервисе новостей и проверяются по профилю читателя из сессии
+type visibilityEvaluator struct {
+	categoryRepository  CategoryRepository
+	employeeRepository  EmployeesRepository
+	complexesRepository ComplexesRepository
+	logger              ditzap.Logger
+}
+
+// IsVisible видна ли новость сотруднику из сессии по правилам ее категории.
+//
+//	Профиль сотрудника загружается, только если у категории есть правила. Если профиль получить не удалось,
+//	новость категории с правилами скрывается
+func (e *visibilityEvaluator) IsVisible(ctx context.Context, news *entityNews.NewsFull) (bool, error) {
+	if news == nil {
+		return false, nil
+	}
+	visibility := news.GetCategory().Visibility
+	if visibility.IsEmpty() {
+		return true, nil
+	}
+	return visibility.IsVisibleTo(e.profile(ctx, visibility.NeedsComplexes())), nil
+}
+
+// VisibleCategories названия категорий активного портала, видимых сотруднику из сессии.
+//
+//	nil - ни у одной категории нет правил и поиск не нужно ограничивать. Профиль сотрудника загружается,
+//	только если правила есть. Если профиль получить не удалось, категории с правилами скрываются
+func (e *visibilityEvaluator) VisibleCategories(ctx context.Context) ([]string, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return nil, fmt.Errorf("visibilityEvaluator.VisibleCategories: can't get session: %w", err)
+	}
+
+	name := ""
+	categories, err := e.categoryRepository.Filter(ctx, &dtoNews.FilterCategory{
+		By:      dtoNews.FilterCategoryByName,
+		Name:    &name,
+		Visitor: &entityNews.Visitor{PortalID: session.GetActivePortal().GetPortalID()},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("visibilityEvaluator.VisibleCategories: can't get categories: %w", err)
+	}
+
+	restricted, withComplexes := false, false
+	for _, category := range categories.Categories {
+		if category != nil && !category.Visibility.IsEmpty() {
+			restricted = true
+			withComplexes = withComplexes || category.Visibility.NeedsComplexes()
+		}
+	}
+	if !restricted {
+		return nil, nil
+	}
+
+	profile := e.profile(ctx, withComplexes)
+	visible := make([]string, 0, len(categories.Categories))
+	for _, category := range categories.Categories {
+		if category != nil && category.Visibility.IsVisibleTo(profile) {
+			visible = append(visible, category.Name)
+		}
+	}
+	return visible, nil
+}
+
+// profile профиль сотрудника из сессии, nil если сотрудника получить не удалось
+func (e *visibilityEvaluator) profile(ctx context.Context, withComplexes bool) *entityNews.VisibilityProfile {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		e.logger.Warn("visibilityEvaluator.profile: can't get session", zap.Error(err))
+		return nil
+	}
+
+	employee, err := e.employeeRepository.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.ActivePortal.GetPortalID())
+	if err != nil {
+		e.logger.Warn("visibilityEvaluator.profile: can't get employee", zap.Error(err))
+		return nil
+	}
+
+	profile := visibilityProfile(employee)
+	if !withComplexes || len(profile.PortalIDs) == 0 {
+		return profile
+	}
+
+	complexes, err := e.complexesRepository.Filter(ctx, &entityPortalV2.FilterComplexesFilters{PortalIDs: profile.PortalIDs}, nil)
+	if err != nil {
+		e.logger.Warn("visibilityEvaluator.profile: can't get complexes", zap.Error(err))
+		return profile
+	}
+	for _, complex := range complexes {
+		if complex != nil && !slices.Contains(profile.ComplexIDs, complex.ID) {
+			profile.ComplexIDs = append(profile.ComplexIDs, complex.ID)
+		}
+	}
+	return profile
+}
+
+// visibilityProfile собирает профиль из карточки сотрудника. Комплексы заполняются отдельно
+func visibilityProfile(employee *entityEmployee.Employee) *entityNews.VisibilityProfile {
+	profile := &entityNews.VisibilityProfile{EmployeeID: employee.ID}
+
+	addPortal := func(id int) {
+		if id != 0 && !slices.Contains(profile.PortalIDs, id) {
+			profile.PortalIDs = append(profile.PortalIDs, id)
+		}
+	}
+	addOrg := func(id uuid.UUID) {
+		if id != uuid.Nil && !slices.Contains(profile.OrgIDs, id) {
+			profile.OrgIDs = append(profile.OrgIDs, id)
+		}
+	}
+	addProduct := func(product *entityEmployee.Product) {
+		if product == nil {
+			return
+		}
+		if id, err := uuid.Parse(product.ID); err == nil && !slices.Contains(profile.ProductIDs, id) {
+			profile.ProductIDs = append(profile.ProductIDs, id)
+		}
+	}
+	addName := func(names *[]string, name string) {
+		if name != "" && !slices.Contains(*names, name) {
+			*names = append(*names, name)
+		}
+	}
+
+	addPortal(employee.Portal.ID)
+	addOrg(employee.Organization.ID)
+
+	addProduct(employee.MainProduct)
+	for _, product := range employee.Products {
+		addProduct(product)
+	}
+
+	if position := employee.StaffPosition; position != nil {
+		addPortal(position.Portal.ID)
+		addOrg(position.Organization.ID)
+		addName(&profile.SubdivisionNames, position.GetSubdivision().GetName())
+		addName(&profile.PositionNames, position.GetPosition().GetName())
+	}
+
+	// В дереве подразделений сотрудник входит во все вышестоящие подразделения
+	trees := []*entityEmployee.SubdivisionTree{employee.SubdivisionTree}
+	for len(trees) > 0 {
+		tree := trees[0]
+		trees = trees[1:]
+		if tree == nil || tree.IsDeleted {
+			continue
+		}
+		addName(&profile.SubdivisionNames, tree.Name)
+		trees = append(trees, tree.Children...)
+	}
+
+	for _, management := range employee.Managements {
+		if management != nil && !management.IsDeleted {
+			addName(&profile.RoleNames, management.RoleName)
+		}
+	}
+
+	return profile
+}
@@ -0,0 +1,242 @@
+package news
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+	entityPortalV2 "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/portalv2"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+)
+
+func Test_visibilityEvaluator_IsVisible(t *testing.T) {
+	employee := &entityEmployee.Employee{
+		ID:     uuid.New(),
+		Portal: entityEmployee.Portal{ID: 1},
+		StaffPosition: &entityEmployee.StaffPosition{
+			Position: &entityEmployee.Position{Name: "Инженер"},
+		},
+	}
+
+	news := func(visibility entityNews.CategoryVisibility) *entityNews.NewsFull {
+		return &entityNews.NewsFull{ID: uuid.New(), Category: &entityNews.Category{ID: uuid.New(), Visibility: visibility}}
+	}
+
+	tests := []struct {
+		name    string
+		news    *entityNews.NewsFull
+		prepare func(employees *MockEmployeesRepository, complexes *MockComplexesRepository)
+		want    bool
+	}{
+		{
+			name: "no category",
+			news: &entityNews.NewsFull{ID: uuid.New()},
+			want: true,
+		},
+		{
+			name: "no rules, profile is not loaded",
+			news: news(entityNews.CategoryVisibility{}),
+			want: true,
+		},
+		{
+			name: "rules are checked against employee profile",
+			news: news(entityNews.CategoryVisibility{OIVs: []int{1}, PositionNames: []string{"инженер"}}),
+			prepare: func(e *MockEmployeesRepository, _ *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+			},
+			want: true,
+		},
+		{
+			name: "any rule is enough with OR",
+			news: news(entityNews.CategoryVisibility{
+				Condition:   entityNews.VisibilityConditionOr,
+				OIVs:        []int{2},
+				EmployeeIDs: []uuid.UUID{employee.ID},
+			}),
+			prepare: func(e *MockEmployeesRepository, _ *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+			},
+			want: true,
+		},
+		{
+			name: "complexes are resolved by employee portals",
+			news: news(entityNews.CategoryVisibility{ComplexIDs: []int{7}}),
+			prepare: func(e *MockEmployeesRepository, c *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				c.EXPECT().Filter(gomock.Any(), &entityPortalV2.FilterComplexesFilters{PortalIDs: []int{1}}, nil).
+					Return([]*entityPortalV2.Complex{{ID: 3}}, nil)
+			},
+			want: false,
+		},
+		{
+			name: "employee is unknown, restricted news hidden",
+			news: news(entityNews.CategoryVisibility{OIVs: []int{1}}),
+			prepare: func(e *MockEmployeesRepository, _ *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(nil, errors.New("employees error"))
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			complexesRepository := NewMockComplexesRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			if tt.prepare != nil {
+				tt.prepare(employeesRepository, complexesRepository)
+			}
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			evaluator := NewVisibilityEvaluator(nil, employeesRepository, complexesRepository, logger)
+
+			got, err := evaluator.IsVisible(ctx, tt.news)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_visibilityEvaluator_VisibleCategories(t *testing.T) {
+	employee := &entityEmployee.Employee{ID: uuid.New(), Portal: entityEmployee.Portal{ID: 1}}
+	category := func(name string, visibility entityNews.CategoryVisibility) *entityNews.Category {
+		return &entityNews.Category{ID: uuid.New(), Name: name, Visibility: visibility}
+	}
+
+	tests := []struct {
+		name       string
+		categories []*entityNews.Category
+		filterErr  error
+		prepare    func(employees *MockEmployeesRepository, complexes *MockComplexesRepository)
+		want       []string
+		wantErr    bool
+	}{
+		{
+			name:       "no rules, profile is not loaded",
+			categories: []*entityNews.Category{category("Спорт", entityNews.CategoryVisibility{})},
+			want:       nil,
+		},
+		{
+			name: "restricted categories are checked against employee profile",
+			categories: []*entityNews.Category{
+				category("Спорт", entityNews.CategoryVisibility{}),
+				category("Портал", entityNews.CategoryVisibility{OIVs: []int{1}}),
+				category("Другой портал", entityNews.CategoryVisibility{OIVs: []int{2}}),
+			},
+			prepare: func(e *MockEmployeesRepository, _ *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+			},
+			want: []string{"Спорт", "Портал"},
+		},
+		{
+			name:       "complexes are resolved when any category needs them",
+			categories: []*entityNews.Category{category("Комплекс", entityNews.CategoryVisibility{ComplexIDs: []int{7}})},
+			prepare: func(e *MockEmployeesRepository, c *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(employee, nil)
+				c.EXPECT().Filter(gomock.Any(), &entityPortalV2.FilterComplexesFilters{PortalIDs: []int{1}}, nil).
+					Return([]*entityPortalV2.Complex{{ID: 7}}, nil)
+			},
+			want: []string{"Комплекс"},
+		},
+		{
+			name:       "employee is unknown, restricted categories hidden",
+			categories: []*entityNews.Category{category("Портал", entityNews.CategoryVisibility{OIVs: []int{1}})},
+			prepare: func(e *MockEmployeesRepository, _ *MockComplexesRepository) {
+				e.EXPECT().GetByExtIDAndPortalID(gomock.Any(), "ext", 1).Return(nil, errors.New("employees error"))
+			},
+			want: []string{},
+		},
+		{
+			name:      "categories err",
+			filterErr: errors.New("categories error"),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			categoryRepository := NewMockCategoryRepository(ctrl)
+			employeesRepository := NewMockEmployeesRepository(ctrl)
+			complexesRepository := NewMockComplexesRepository(ctrl)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			if tt.prepare != nil {
+				tt.prepare(employeesRepository, complexesRepository)
+			}
+
+			categoryRepository.EXPECT().Filter(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, filter *dtoNews.FilterCategory) (*entityNews.CategoriesWithPagination, error) {
+					assert.Equal(t, 1, filter.GetVisitorPtr().GetPortalID())
+					if tt.filterErr != nil {
+						return nil, tt.filterErr
+					}
+					return &entityNews.CategoriesWithPagination{Categories: tt.categories}, nil
+				})
+
+			ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+				User:         &entityAuth.User{Employee: &entityAuth.Employee{ExtID: "ext"}},
+				ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 1}},
+			})
+			evaluator := NewVisibilityEvaluator(categoryRepository, employeesRepository, complexesRepository, logger)
+
+			got, err := evaluator.VisibleCategories(ctx)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_visibilityProfile(t *testing.T) {
+	employeeID := uuid.New()
+	orgID := uuid.New()
+	positionOrgID := uuid.New()
+	productID := uuid.New()
+
+	employee := &entityEmployee.Employee{
+		ID:           employeeID,
+		Portal:       entityEmployee.Portal{ID: 1},
+		Organization: entityEmployee.Organization{ID: orgID},
+		MainProduct:  &entityEmployee.Product{ID: productID.String()},
+		Products:     []*entityEmployee.Product{{ID: productID.String()}, {ID: "not-uuid"}, nil},
+		StaffPosition: &entityEmployee.StaffPosition{
+			Portal:       entityEmployee.Portal{ID: 2},
+			Organization: entityEmployee.Organization{ID: positionOrgID},
+			Subdivision:  &entityEmployee.Subdivision{Name: "Отдел"},
+			Position:     &entityEmployee.Position{Name: "Инженер"},
+		},
+		SubdivisionTree: &entityEmployee.SubdivisionTree{
+			Name: "Департамент",
+			Children: []*entityEmployee.SubdivisionTree{
+				{Name: "Управление", Children: []*entityEmployee.SubdivisionTree{{Name: "Отдел"}}},
+				{Name: "Удаленное", IsDeleted: true},
+			},
+		},
+		Managements: []*entityEmployee.Management{
+			{RoleName: "Руководитель продукта"},
+			{RoleName: "Бывшая роль", IsDeleted: true},
+		},
+	}
+
+	assert.Equal(t, &entityNews.VisibilityProfile{
+		EmployeeID:       employeeID,
+		PortalIDs:        []int{1, 2},
+		OrgIDs:           []uuid.UUID{orgID, positionOrgID},
+		ProductIDs:       []uuid.UUID{productID},
+		SubdivisionNames: []string{"Отдел", "Департамент", "Управление"},
+		PositionNames:    []string{"Инженер"},
+		RoleNames:        []string{"Руководитель продукта"},
+	}, visibilityProfile(employee))
+}
@@ -0,0 +1,103 @@
+package news
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+// newsTransitions допустимые переходы между статусами новости.
+//
+//	Черновик отправляется на публикацию, ожидающая публикации новость публикуется
+//	или возвращается в черновики, снятая с публикации новость может быть опубликована повторно
+//	только через ожидание публикации
+var newsTransitions = map[entityNews.NewsStatus][]entityNews.NewsStatus{
+	entityNews.NewsStatusDraft:          {entityNews.NewsStatusWaitingPublish},
+	entityNews.NewsStatusWaitingPublish: {entityNews.NewsStatusPublished, entityNews.NewsStatusDraft},
+	entityNews.NewsStatusPublished:      {entityNews.NewsStatusUnpublished},
+	entityNews.NewsStatusUnpublished:    {entityNews.NewsStatusWaitingPublish},
+}
+
+// checkTransition проверяет, что новость может перейти в статус to
+func checkTransition(news *entityNews.NewsFull, to entityNews.NewsStatus) error {
+	from := news.GetStatus()
+	if !slices.Contains(newsTransitions[from], to) {
+		return fmt.Errorf("%w: from %d to %d", ErrStatusTransition, from, to)
+	}
+
+	switch to {
+	case entityNews.NewsStatusWaitingPublish:
+		if news.PublicationAt == nil || news.PublicationAt.IsZero() {
+			return diterrors.NewValidationError(ErrPublicationAtRequired, diterrors.ErrValidationFields{
+				Field:   "publication_at",
+				Message: "publication_at must be set",
+			})
+		}
+		fallthrough
+	case entityNews.NewsStatusPublished:
+		if news.Title == "" {
+			return diterrors.NewValidationError(dtoNews.ErrNewsTitleRequired)
+		}
+	}
+	return nil
+}
+
+// submitter сотрудник, отправивший новость на публикацию.
+//
+//	Берется из последнего перехода в ожидание публикации. Если такого перехода в истории нет, возвращается nil:
+//	автор новости не обязательно ее отправлял, поэтому подставлять его нельзя
+func submitter(history []*entityNews.StatusTransition) *uuid.UUID {
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].To == entityNews.NewsStatusWaitingPublish {
+			return history[i].Actor.ID
+		}
+	}
+	return nil
+}
+
+// newsLockTTL время жизни блокировки новости. Блокировка снимается после изменения, ttl страхует от упавшей реплики
+const newsLockTTL = 30 * time.Second
+
+// lockNews захватывает блокировку новости и возвращает функцию ее снятия. Если новость уже заблокирована - ErrNewsLocked
+func lockNews(ctx context.Context, locker NewsLocker, id uuid.UUID, logger ditzap.Logger) (func(), error) {
+	owner := uuid.NewString()
+	locked, err := locker.Lock(ctx, id, owner, newsLockTTL)
+	if err != nil {
+		return nil, fmt.Errorf("can't lock news: %w", err)
+	}
+	if !locked {
+		return nil, ErrNewsLocked
+	}
+	return func() {
+		// Блокировка снимается и после отмены запроса, иначе новость останется заблокированной до истечения ttl
+		if err := locker.Unlock(context.WithoutCancel(ctx), id, owner); err != nil {
+			logger.Warn("can't unlock news", ditzap.UUID("news_id", id), zap.Error(err))
+		}
+	}, nil
+}
+
+// authorFromEmployee автор перехода по данным сотрудника
+func authorFromEmployee(employee *entityEmployee.Employee) entityNews.Author {
+	author := entityNews.Author{
+		ID:        &employee.ID,
+		LastName:  employee.Person.LastName,
+		FirstName: employee.Person.FirstName,
+	}
+	if employee.Person.MiddleName != "" {
+		author.MiddleName = &employee.Person.MiddleName
+	}
+	if imageID, err := uuid.Parse(employee.Person.ImageID); err == nil {
+		author.ImageID = &imageID
+	}
+	return author
+}
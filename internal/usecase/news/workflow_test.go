@@ -0,0 +1,153 @@
+package news
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	dtoNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/dto/news"
+	entityNews "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/news"
+)
+
+func Test_checkTransition(t *testing.T) {
+	publicationAt := time.Now().Add(time.Hour)
+	news := func(status entityNews.NewsStatus) *entityNews.NewsFull {
+		return &entityNews.NewsFull{
+			Status:        status,
+			Title:         "title",
+			PublicationAt: &publicationAt,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		news    *entityNews.NewsFull
+		to      entityNews.NewsStatus
+		wantErr error
+	}{
+		{
+			name: "draft to waiting publish",
+			news: news(entityNews.NewsStatusDraft),
+			to:   entityNews.NewsStatusWaitingPublish,
+		},
+		{
+			name: "waiting publish to published",
+			news: news(entityNews.NewsStatusWaitingPublish),
+			to:   entityNews.NewsStatusPublished,
+		},
+		{
+			name: "waiting publish to draft",
+			news: news(entityNews.NewsStatusWaitingPublish),
+			to:   entityNews.NewsStatusDraft,
+		},
+		{
+			name: "published to unpublished",
+			news: news(entityNews.NewsStatusPublished),
+			to:   entityNews.NewsStatusUnpublished,
+		},
+		{
+			name: "unpublished to waiting publish",
+			news: news(entityNews.NewsStatusUnpublished),
+			to:   entityNews.NewsStatusWaitingPublish,
+		},
+		{
+			name:    "draft to published",
+			news:    news(entityNews.NewsStatusDraft),
+			to:      entityNews.NewsStatusPublished,
+			wantErr: ErrStatusTransition,
+		},
+		{
+			name:    "unpublished to draft",
+			news:    news(entityNews.NewsStatusUnpublished),
+			to:      entityNews.NewsStatusDraft,
+			wantErr: ErrStatusTransition,
+		},
+		{
+			name:    "same status",
+			news:    news(entityNews.NewsStatusPublished),
+			to:      entityNews.NewsStatusPublished,
+			wantErr: ErrStatusTransition,
+		},
+		{
+			name:    "invalid status",
+			news:    news(entityNews.NewsStatusDraft),
+			to:      entityNews.NewsStatusInvalid,
+			wantErr: ErrStatusTransition,
+		},
+		{
+			name:    "waiting publish without publication time",
+			news:    &entityNews.NewsFull{Status: entityNews.NewsStatusDraft, Title: "title"},
+			to:      entityNews.NewsStatusWaitingPublish,
+			wantErr: ErrPublicationAtRequired,
+		},
+		{
+			name:    "waiting publish with cleared publication time",
+			news:    &entityNews.NewsFull{Status: entityNews.NewsStatusDraft, Title: "title", PublicationAt: &time.Time{}},
+			to:      entityNews.NewsStatusWaitingPublish,
+			wantErr: ErrPublicationAtRequired,
+		},
+		{
+			name:    "waiting publish without title",
+			news:    &entityNews.NewsFull{Status: entityNews.NewsStatusDraft, PublicationAt: &publicationAt},
+			to:      entityNews.NewsStatusWaitingPublish,
+			wantErr: dtoNews.ErrNewsTitleRequired,
+		},
+		{
+			name:    "published without title",
+			news:    &entityNews.NewsFull{Status: entityNews.NewsStatusWaitingPublish},
+			to:      entityNews.NewsStatusPublished,
+			wantErr: dtoNews.ErrNewsTitleRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkTransition(tt.news, tt.to)
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_submitter(t *testing.T) {
+	authorID := uuid.New()
+	firstID := uuid.New()
+	secondID := uuid.New()
+
+	tests := []struct {
+		name    string
+		history []*entityNews.StatusTransition
+		want    *uuid.UUID
+	}{
+		{
+			name: "without history",
+			want: nil,
+		},
+		{
+			name: "without submission",
+			history: []*entityNews.StatusTransition{
+				{To: entityNews.NewsStatusUnpublished, Actor: entityNews.Author{ID: &authorID}},
+			},
+			want: nil,
+		},
+		{
+			name: "last submission",
+			history: []*entityNews.StatusTransition{
+				{To: entityNews.NewsStatusWaitingPublish, Actor: entityNews.Author{ID: &firstID}},
+				{To: entityNews.NewsStatusDraft, Actor: entityNews.Author{ID: &authorID}},
+				{To: entityNews.NewsStatusWaitingPublish, Actor: entityNews.Author{ID: &secondID}},
+				{To: entityNews.NewsStatusDraft, Actor: entityNews.Author{ID: &firstID}},
+			},
+			want: &secondID,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, submitter(tt.history))
+		})
+	}
+}
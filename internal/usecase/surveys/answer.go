@@ -2,25 +2,204 @@ package surveys
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"time"
 
-	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
 )
 
+// answerOptions опрос загружается только с активными вопросами, на которые можно ответить
+var answerOptions = surveys.SurveyFilterOptions{
+	WithQuestions: true,
+	WithAnswers:   true,
+}
+
+// anonymousCompletionPrecision точность времени прохождения анонимного опроса
+const anonymousCompletionPrecision = 24 * time.Hour
+
 type answersUseCase struct {
-	repo AnswersRepository
+	repo            AnswersRepository
+	surveysRepo     SurveyRepository
+	completionsRepo CompletionsRepository
+	employeesRepo   EmployeesRepository
+	salt            []byte
+	now             func() time.Time
+	logger          ditzap.Logger
 }
 
-func NewAnswersUseCase(repository AnswersRepository) *answersUseCase {
-	return &answersUseCase{repo: repository}
+// NewAnswersUseCase ответы на опросы.
+//
+//	Прохождение опроса отмечается ключом респондента - HMAC от опроса и пользователя сессии с солью salt.
+//	По ключу нельзя восстановить пользователя, поэтому он используется и для анонимных опросов.
+//	Для фильтра результатов ответы неанонимного опроса сохраняются с порталом и организацией респондента
+func NewAnswersUseCase(
+	repository AnswersRepository,
+	surveysRepository SurveyRepository,
+	completionsRepository CompletionsRepository,
+	employeesRepository EmployeesRepository,
+	salt string,
+	logger ditzap.Logger,
+) *answersUseCase {
+	return &answersUseCase{
+		repo:            repository,
+		surveysRepo:     surveysRepository,
+		completionsRepo: completionsRepository,
+		employeesRepo:   employeesRepository,
+		salt:            []byte(salt),
+		now:             time.Now,
+		logger:          logger,
+	}
 }
 
-func (auc answersUseCase) Add(ctx context.Context, answers []*surveys.RespondentAnswer) ([]uuid.UUID, error) {
+// Add сохранение ответов на опрос.
+//
+//	Ответы проверяются по правилам вопросов опроса, ошибки возвращаются одной diterrors.ValidationError.
+//	Для опроса RespondentTypeUser респондентом указывается пользователь сессии и его активный портал,
+//	ответы анонимного опроса сохраняются без респондента и портала. Повторное прохождение опроса возвращает ErrSurveyCompleted
+func (auc answersUseCase) Add(ctx context.Context, surveyID surveys.SurveyID, answers []*surveys.RespondentAnswer) ([]uuid.UUID, error) {
+	if uuid.UUID(surveyID) == uuid.Nil {
+		return nil, diterrors.NewValidationError(diterrors.ErrInputEmpty, diterrors.ErrValidationFields{
+			Field:   "survey_id",
+			Message: "survey_id is required",
+		})
+	}
+
+	userID, err := auc.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	survey, err := auc.surveysRepo.Get(ctx, surveyID, answerOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey from repository: %w", err)
+	}
+	now := auc.now()
+	if err := survey.ValidateAnswers(answers, now); err != nil {
+		return nil, err
+	}
+
+	// по порталу, организации и точному времени прохождения можно сопоставить анонимный ответ с сессией,
+	// поэтому для анонимного опроса они не сохраняются, а время округляется до дня
+	var (
+		respondentID   *surveys.RespondentID
+		portalID       int
+		organizationID *uuid.UUID
+		completedAt    = now.UTC().Truncate(anonymousCompletionPrecision)
+	)
+	if survey.Respondent != nil && survey.Respondent.Type == surveys.RespondentTypeUser {
+		id := surveys.RespondentID(userID)
+		respondentID = &id
+		portalID = auc.portalID(ctx)
+		organizationID = auc.organizationID(ctx)
+		completedAt = now
+	}
+	for _, answer := range answers {
+		if answer != nil {
+			answer.RespondentId = respondentID
+			answer.PortalID = portalID
+			answer.OrganizationID = organizationID
+		}
+	}
+
+	key := auc.respondentKey(surveyID, userID)
+	added, err := auc.completionsRepo.Add(ctx, surveyID, key, completedAt)
+	if err != nil {
+		return nil, fmt.Errorf("can't add survey completion to repository: %w", err)
+	}
+	if !added {
+		return nil, ErrSurveyCompleted
+	}
+
 	result, err := auc.repo.Add(ctx, answers)
 	if err != nil {
+		// отметка снимается, чтобы респондент мог повторить отправку ответов
+		if deleteErr := auc.completionsRepo.Delete(ctx, surveyID, key); deleteErr != nil {
+			err = errors.Join(err, fmt.Errorf("can't delete survey completion from repository: %w", deleteErr))
+		}
 		return nil, fmt.Errorf("can't add answers to repository: %w", err)
 	}
 
 	return result, nil
 }
+
+// Completion статус прохождения опроса пользователем сессии
+func (auc answersUseCase) Completion(ctx context.Context, surveyID surveys.SurveyID) (*surveys.Completion, error) {
+	userID, err := auc.userID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	completedAt, err := auc.completionsRepo.Get(ctx, surveyID, auc.respondentKey(surveyID, userID))
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey completion from repository: %w", err)
+	}
+
+	return &surveys.Completion{
+		SurveyID:    surveyID,
+		Completed:   completedAt != nil,
+		CompletedAt: completedAt,
+	}, nil
+}
+
+func (auc answersUseCase) userID(ctx context.Context) (uuid.UUID, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session.GetUser() == nil || session.GetUser().ID == uuid.Nil {
+		return uuid.Nil, usecase.ErrGetSessionFromContext
+	}
+
+	return session.GetUser().ID, nil
+}
+
+// portalID активный портал пользователя сессии, по нему фильтруются результаты опроса
+func (auc answersUseCase) portalID(ctx context.Context) int {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		return 0
+	}
+
+	portal := session.GetActivePortal().GetPortal()
+	return portal.GetID()
+}
+
+// organizationID организация сотрудника сессии на активном портале.
+//
+//	Если карточку сотрудника получить не удалось, ответы сохраняются без организации
+func (auc answersUseCase) organizationID(ctx context.Context) *uuid.UUID {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	employee, err := auc.employeesRepo.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.GetActivePortal().GetPortalID())
+	if err != nil || employee == nil {
+		auc.logger.Warn("can't get employee for survey answers", zap.String("user_id", session.GetUser().ID.String()), zap.Error(err))
+		return nil
+	}
+	if employee.Organization.ID == uuid.Nil {
+		return nil
+	}
+	id := employee.Organization.ID
+	return &id
+}
+
+func (auc answersUseCase) respondentKey(surveyID surveys.SurveyID, userID uuid.UUID) string {
+	return respondentKey(auc.salt, surveyID, userID)
+}
+
+// respondentKey ключ респондента опроса
+func respondentKey(salt []byte, surveyID surveys.SurveyID, userID uuid.UUID) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(surveyID.String() + ":" + userID.String()))
+	return hex.EncodeToString(mac.Sum(nil))
+}
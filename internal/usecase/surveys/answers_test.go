@@ -5,24 +5,65 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
-	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
 )
 
 func Test_answersUseCase_Add(t *testing.T) {
 	type fields struct {
-		repo *MockAnswersRepository
+		repo            *MockAnswersRepository
+		surveysRepo     *MockSurveyRepository
+		completionsRepo *MockCompletionsRepository
+		employeesRepo   *MockEmployeesRepository
 	}
 	type args struct {
-		ctx     context.Context
-		answers []*surveys.RespondentAnswer
+		ctx      context.Context
+		surveyID surveys.SurveyID
+		answers  []*surveys.RespondentAnswer
 	}
 
-	ctx := context.TODO()
+	userID := uuid.New()
+	ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+		User:         &entityAuth.User{ID: userID, Employee: &entityAuth.Employee{ExtID: "ext"}},
+		ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 7}},
+	})
 	testErr := errors.New("some error")
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	organizationID := uuid.New()
+	employee := &entityEmployee.Employee{Organization: entityEmployee.Organization{ID: organizationID}}
+
+	surveyID := surveys.SurveyID(uuid.New())
+	questionID := surveys.QuestionID(uuid.New())
+	variantID := surveys.AnswerID(uuid.New())
+	newSurvey := func(respondentType surveys.RespondentType) *surveys.Survey {
+		return &surveys.Survey{
+			ID:          &surveyID,
+			IsPublished: true,
+			Respondent:  &surveys.SurveyRespondent{Type: respondentType},
+			Questions: []*surveys.Question{
+				{
+					ID:         &questionID,
+					Type:       surveys.QuestionTypeRadio,
+					IsActive:   true,
+					IsRequired: true,
+					Answers:    []*surveys.AnswerVariant{{ID: &variantID}},
+				},
+			},
+		}
+	}
+	respondentID := surveys.RespondentID(userID)
+	key := NewAnswersUseCase(nil, nil, nil, nil, "salt", nil).respondentKey(surveyID, userID)
 
 	tests := []struct {
 		name string
@@ -30,25 +71,142 @@ func Test_answersUseCase_Add(t *testing.T) {
 		want func(a args, f fields) ([]uuid.UUID, error)
 	}{
 		{
-			name: "correct",
+			name: "respondent from session",
 			args: args{
-				ctx:     ctx,
-				answers: []*surveys.RespondentAnswer{},
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{{ChosenVariant: variantID}},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeUser), nil)
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(a.ctx, "ext", 7).Return(employee, nil)
+				f.completionsRepo.EXPECT().Add(a.ctx, a.surveyID, key, now).Return(true, nil)
+				f.repo.EXPECT().Add(a.ctx, []*surveys.RespondentAnswer{{
+					ChosenVariant:  variantID,
+					QuestionID:     &questionID,
+					RespondentId:   &respondentID,
+					PortalID:       7,
+					OrganizationID: &organizationID,
+				}}).Return([]uuid.UUID{}, nil)
+				return []uuid.UUID{}, nil
+			},
+		},
+		{
+			name: "employee is unknown, answers are stored without organization",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{{ChosenVariant: variantID}},
 			},
 			want: func(a args, f fields) ([]uuid.UUID, error) {
-				f.repo.EXPECT().Add(a.ctx, a.answers).Return([]uuid.UUID{}, nil)
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeUser), nil)
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(a.ctx, "ext", 7).Return(nil, testErr)
+				f.completionsRepo.EXPECT().Add(a.ctx, a.surveyID, key, now).Return(true, nil)
+				f.repo.EXPECT().Add(a.ctx, []*surveys.RespondentAnswer{{
+					ChosenVariant: variantID,
+					QuestionID:    &questionID,
+					RespondentId:  &respondentID,
+					PortalID:      7,
+				}}).Return([]uuid.UUID{}, nil)
 				return []uuid.UUID{}, nil
 			},
 		},
+		{
+			name: "anonymous respondent and portal are not stored",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{{ChosenVariant: variantID, RespondentId: &respondentID}},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeAnonymous), nil)
+				f.completionsRepo.EXPECT().Add(a.ctx, a.surveyID, key, time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)).Return(true, nil)
+				f.repo.EXPECT().Add(a.ctx, []*surveys.RespondentAnswer{{
+					ChosenVariant: variantID,
+					QuestionID:    &questionID,
+				}}).Return([]uuid.UUID{}, nil)
+				return []uuid.UUID{}, nil
+			},
+		},
+		{
+			name: "already completed",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{{ChosenVariant: variantID}},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeUser), nil)
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(a.ctx, "ext", 7).Return(employee, nil)
+				f.completionsRepo.EXPECT().Add(a.ctx, a.surveyID, key, now).Return(false, nil)
+				return nil, ErrSurveyCompleted
+			},
+		},
 		{
 			name: "err",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{{ChosenVariant: variantID}},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeUser), nil)
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(a.ctx, "ext", 7).Return(employee, nil)
+				f.completionsRepo.EXPECT().Add(a.ctx, a.surveyID, key, now).Return(true, nil)
+				f.repo.EXPECT().Add(a.ctx, gomock.Any()).Return(nil, testErr)
+				f.completionsRepo.EXPECT().Delete(a.ctx, a.surveyID, key).Return(nil)
+				return nil, fmt.Errorf("can't add answers to repository: %w", testErr)
+			},
+		},
+		{
+			name: "survey err",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(nil, diterrors.ErrNotFound)
+				return nil, fmt.Errorf("can't get survey from repository: %w", diterrors.ErrNotFound)
+			},
+		},
+		{
+			name: "required question is skipped",
+			args: args{
+				ctx:      ctx,
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				f.surveysRepo.EXPECT().Get(a.ctx, a.surveyID, answerOptions).Return(newSurvey(surveys.RespondentTypeUser), nil)
+				return nil, diterrors.NewValidationError(surveys.ErrAnswersInvalid, diterrors.ErrValidationFields{
+					Field:   questionID.String(),
+					Message: "answer is required",
+				})
+			},
+		},
+		{
+			name: "empty survey id",
 			args: args{
 				ctx:     ctx,
 				answers: []*surveys.RespondentAnswer{},
 			},
 			want: func(a args, f fields) ([]uuid.UUID, error) {
-				f.repo.EXPECT().Add(a.ctx, a.answers).Return(nil, testErr)
-				return nil, fmt.Errorf("can't add answers to repository: %w", testErr)
+				return nil, diterrors.NewValidationError(diterrors.ErrInputEmpty, diterrors.ErrValidationFields{
+					Field:   "survey_id",
+					Message: "survey_id is required",
+				})
+			},
+		},
+		{
+			name: "no session",
+			args: args{
+				ctx:      context.TODO(),
+				surveyID: surveyID,
+				answers:  []*surveys.RespondentAnswer{},
+			},
+			want: func(a args, f fields) ([]uuid.UUID, error) {
+				return nil, usecase.ErrGetSessionFromContext
 			},
 		},
 	}
@@ -56,11 +214,17 @@ func Test_answersUseCase_Add(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 			f := fields{
-				repo: NewMockAnswersRepository(ctrl),
+				repo:            NewMockAnswersRepository(ctrl),
+				surveysRepo:     NewMockSurveyRepository(ctrl),
+				completionsRepo: NewMockCompletionsRepository(ctrl),
+				employeesRepo:   NewMockEmployeesRepository(ctrl),
 			}
 			want, wantErr := tt.want(tt.args, f)
-			sr := NewAnswersUseCase(f.repo)
-			got, err := sr.Add(tt.args.ctx, tt.args.answers)
+			logger := ditzap.NewMockLogger(ctrl)
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			sr := NewAnswersUseCase(f.repo, f.surveysRepo, f.completionsRepo, f.employeesRepo, "salt", logger)
+			sr.now = func() time.Time { return now }
+			got, err := sr.Add(tt.args.ctx, tt.args.surveyID, tt.args.answers)
 			if wantErr != nil {
 				assert.EqualError(t, err, wantErr.Error())
 				assert.Nil(t, got)
@@ -71,3 +235,80 @@ func Test_answersUseCase_Add(t *testing.T) {
 		})
 	}
 }
+
+func Test_answersUseCase_Completion(t *testing.T) {
+	userID := uuid.New()
+	ctx := entity.WithSession(context.TODO(), &entityAuth.Session{User: &entityAuth.User{ID: userID}})
+	surveyID := surveys.SurveyID(uuid.New())
+	completedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		prepare func(repo *MockCompletionsRepository, key string)
+		want    *surveys.Completion
+		wantErr error
+	}{
+		{
+			name: "completed",
+			ctx:  ctx,
+			prepare: func(repo *MockCompletionsRepository, key string) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, key).Return(&completedAt, nil)
+			},
+			want: &surveys.Completion{SurveyID: surveyID, Completed: true, CompletedAt: &completedAt},
+		},
+		{
+			name: "not completed",
+			ctx:  ctx,
+			prepare: func(repo *MockCompletionsRepository, key string) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, key).Return(nil, nil)
+			},
+			want: &surveys.Completion{SurveyID: surveyID},
+		},
+		{
+			name: "repository error",
+			ctx:  ctx,
+			prepare: func(repo *MockCompletionsRepository, key string) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, key).Return(nil, testErr)
+			},
+			wantErr: testErr,
+		},
+		{
+			name:    "no session",
+			ctx:     context.TODO(),
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockCompletionsRepository(ctrl)
+			uc := NewAnswersUseCase(nil, nil, repo, nil, "salt", nil)
+			if tt.prepare != nil {
+				tt.prepare(repo, uc.respondentKey(surveyID, userID))
+			}
+
+			got, err := uc.Completion(tt.ctx, surveyID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_answersUseCase_respondentKey(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	userID := uuid.New()
+
+	key := NewAnswersUseCase(nil, nil, nil, nil, "salt", nil).respondentKey(surveyID, userID)
+	assert.Len(t, key, 64)
+	assert.NotContains(t, key, userID.String())
+	assert.Equal(t, key, NewAnswersUseCase(nil, nil, nil, nil, "salt", nil).respondentKey(surveyID, userID))
+	assert.NotEqual(t, key, NewAnswersUseCase(nil, nil, nil, nil, "other", nil).respondentKey(surveyID, userID))
+	assert.NotEqual(t, key, NewAnswersUseCase(nil, nil, nil, nil, "salt", nil).respondentKey(surveys.SurveyID(uuid.New()), userID))
+}
@@ -0,0 +1,14 @@
+package surveys
+
+import "git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+const (
+	ErrSurveysLimit    diterrors.StringError = "incorrect surveys limit"
+	ErrImageEmpty      diterrors.StringError = "image payload is empty"
+	ErrImageEncoding   diterrors.StringError = "image payload is not base64"
+	ErrImageTooLarge   diterrors.StringError = "image is too large"
+	ErrImageType       diterrors.StringError = "image type is not allowed"
+	ErrSurveyCompleted diterrors.StringError = "survey is already completed"
+	// ErrAnonymousFilter ответы анонимного опроса сохраняются без портала и организации респондента
+	ErrAnonymousFilter diterrors.StringError = "anonymous survey answers can't be filtered by respondent"
+)
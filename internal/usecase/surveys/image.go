@@ -2,9 +2,19 @@ package surveys
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"net/http"
+	"slices"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 )
 
+// imageContentTypes допустимые типы изображений, тип определяется по содержимому
+var imageContentTypes = []string{"image/png", "image/jpeg", "image/gif", "image/webp"}
+
 type imagesUseCase struct {
 	repo ImagesRepository
 }
@@ -21,3 +31,54 @@ func (iuc imagesUseCase) Get(ctx context.Context, imageName string) ([]byte, err
 
 	return result, nil
 }
+
+// Add загрузка изображения для вариантов ответа вопросов checkboxImg и radioImg
+func (iuc imagesUseCase) Add(ctx context.Context, image *entitySurveys.Image) (*entitySurveys.Image, error) {
+	if image == nil || image.Data == "" {
+		return nil, diterrors.NewValidationError(ErrImageEmpty, diterrors.ErrValidationFields{
+			Field:   "payload",
+			Message: "payload is required",
+		})
+	}
+	if err := validateImage(image.Data); err != nil {
+		return nil, err
+	}
+
+	result, err := iuc.repo.Add(ctx, image)
+	if err != nil {
+		return nil, fmt.Errorf("can't add image to repository: %w", err)
+	}
+
+	return result, nil
+}
+
+// validateImage проверяет размер и тип изображения в base64
+func validateImage(payload string) error {
+	if base64.StdEncoding.DecodedLen(len(payload)) > entitySurveys.ImageMaxSize+2 {
+		return diterrors.NewValidationError(ErrImageTooLarge, diterrors.ErrValidationFields{
+			Field:   "payload",
+			Message: fmt.Sprintf("image must be at most %d bytes", entitySurveys.ImageMaxSize),
+		})
+	}
+
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return diterrors.NewValidationError(ErrImageEncoding, diterrors.ErrValidationFields{
+			Field:   "payload",
+			Message: "payload must be base64 encoded",
+		})
+	}
+	if len(data) > entitySurveys.ImageMaxSize {
+		return diterrors.NewValidationError(ErrImageTooLarge, diterrors.ErrValidationFields{
+			Field:   "payload",
+			Message: fmt.Sprintf("image must be at most %d bytes", entitySurveys.ImageMaxSize),
+		})
+	}
+	if contentType := http.DetectContentType(data); !slices.Contains(imageContentTypes, contentType) {
+		return diterrors.NewValidationError(ErrImageType, diterrors.ErrValidationFields{
+			Field:   "payload",
+			Message: fmt.Sprintf("image type <%s> is not allowed", contentType),
+		})
+	}
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 )
 
 func Test_imagesUseCase_Get(t *testing.T) {
@@ -69,3 +72,73 @@ func Test_imagesUseCase_Get(t *testing.T) {
 		})
 	}
 }
+
+func Test_imagesUseCase_Add(t *testing.T) {
+	ctx := context.TODO()
+	testErr := errors.New("some error")
+	image := &surveys.Image{Data: "iVBORw0KGgo=", ExternalImageInfo: &surveys.ExternalProperties{FileName: "image.png"}}
+	added := &surveys.Image{ID: "image id", ExternalImageInfo: image.ExternalImageInfo}
+
+	tests := []struct {
+		name    string
+		image   *surveys.Image
+		prepare func(repo *MockImagesRepository)
+		want    *surveys.Image
+		wantErr error
+	}{
+		{
+			name:  "correct",
+			image: image,
+			prepare: func(repo *MockImagesRepository) {
+				repo.EXPECT().Add(ctx, image).Return(added, nil)
+			},
+			want: added,
+		},
+		{
+			name:    "empty payload",
+			image:   &surveys.Image{},
+			wantErr: ErrImageEmpty,
+		},
+		{
+			name:    "not base64",
+			image:   &surveys.Image{Data: "not base64!"},
+			wantErr: ErrImageEncoding,
+		},
+		{
+			name:    "not an image",
+			image:   &surveys.Image{Data: "aW1hZ2U="},
+			wantErr: ErrImageType,
+		},
+		{
+			name:    "too large",
+			image:   &surveys.Image{Data: strings.Repeat("A", surveys.ImageMaxSize/3*4+8)},
+			wantErr: ErrImageTooLarge,
+		},
+		{
+			name:  "err",
+			image: image,
+			prepare: func(repo *MockImagesRepository) {
+				repo.EXPECT().Add(ctx, image).Return(nil, testErr)
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockImagesRepository(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(repo)
+			}
+
+			got, err := NewImagesUseCase(repo).Add(ctx, tt.image)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,7 +2,10 @@ package surveys
 
 import (
 	"context"
+	"time"
 
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
 	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	"github.com/google/uuid"
 )
@@ -15,12 +18,58 @@ type SurveyRepository interface {
 		id entitySurveys.SurveyID,
 		options entitySurveys.SurveyFilterOptions,
 	) (*entitySurveys.Survey, error)
+	GetAll(
+		ctx context.Context,
+		ids entitySurveys.SurveyIDs,
+		respondent *entitySurveys.SurveyRespondent,
+		options entitySurveys.SurveyFilterOptions,
+		pagination entitySurveys.Pagination,
+	) (*entitySurveys.SurveysWithPagination, error)
+	// GetAvailable опубликованные и проводимые опросы, адресованные респонденту
+	GetAvailable(
+		ctx context.Context,
+		filter entitySurveys.AvailableFilter,
+		options entitySurveys.SurveyFilterOptions,
+		pagination entitySurveys.Pagination,
+	) (*entitySurveys.SurveysWithPagination, error)
+	Add(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.SurveyID, error)
+	Update(ctx context.Context, survey *entitySurveys.Survey) error
+	Delete(ctx context.Context, id entitySurveys.SurveyID) error
 }
 
 type AnswersRepository interface {
 	Add(ctx context.Context, answers []*entitySurveys.RespondentAnswer) ([]uuid.UUID, error)
+	GetAll(
+		ctx context.Context,
+		filter entitySurveys.AnswersFilter,
+		pagination entitySurveys.AnswersPagination,
+	) (*entitySurveys.AnswersWithPagination, error)
+}
+
+// CompletionsRepository отметки о прохождении опросов.
+//
+//	Респондент определяется ключом, по которому нельзя восстановить пользователя
+type CompletionsRepository interface {
+	// Add отмечает прохождение опроса. Возвращает false, если опрос уже пройден
+	Add(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string, completedAt time.Time) (bool, error)
+	// Get время прохождения опроса, nil если опрос не пройден
+	Get(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string) (*time.Time, error)
+	// GetMany время прохождения опросов одним запросом, в результате только пройденные опросы
+	GetMany(ctx context.Context, respondentKeys map[entitySurveys.SurveyID]string) (map[entitySurveys.SurveyID]time.Time, error)
+	Delete(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string) error
+}
+
+type EmployeesRepository interface {
+	GetByExtIDAndPortalID(ctx context.Context, extID string, portalID int) (*entityEmployee.Employee, error)
 }
 
 type ImagesRepository interface {
 	Get(ctx context.Context, imageName string) ([]byte, error)
+	Add(ctx context.Context, image *entitySurveys.Image) (*entitySurveys.Image, error)
+}
+
+// PermissionChecker проверка прав пользователя сессии на ресурсы порталов
+type PermissionChecker interface {
+	// CheckPortalPermissions проверяет наличие прав на всех порталах ресурса
+	CheckPortalPermissions(ctx context.Context, portalIDs []int, permissions ...entityAuth.Permission) error
 }
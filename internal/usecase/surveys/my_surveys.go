@@ -0,0 +1,193 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+type mySurveysUseCase struct {
+	repo            SurveyRepository
+	completionsRepo CompletionsRepository
+	employeesRepo   EmployeesRepository
+	salt            []byte
+	pageSize        uint32
+	now             func() time.Time
+	logger          ditzap.Logger
+}
+
+// NewMySurveysUseCase опросы, которые пользователь сессии может пройти.
+//
+//	salt должна совпадать с солью NewAnswersUseCase, иначе пройденные опросы не будут исключены
+func NewMySurveysUseCase(
+	repository SurveyRepository,
+	completionsRepository CompletionsRepository,
+	employeesRepository EmployeesRepository,
+	salt string,
+	logger ditzap.Logger,
+) *mySurveysUseCase {
+	return &mySurveysUseCase{
+		repo:            repository,
+		completionsRepo: completionsRepository,
+		employeesRepo:   employeesRepository,
+		salt:            []byte(salt),
+		pageSize:        SurveysMaxLimit,
+		now:             time.Now,
+		logger:          logger,
+	}
+}
+
+// My опубликованные и проводимые сейчас опросы, адресованные пользователю сессии и еще не пройденные им.
+//
+//	Опросы отбирает сервис опросов, отметки о прохождении запрашиваются одним запросом на страницу.
+//	Опросы упорядочены по окончанию периода проведения, опросы без окончания - в конце
+func (muc mySurveysUseCase) My(ctx context.Context) ([]*entitySurveys.Survey, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session.GetUser() == nil || session.GetUser().ID == uuid.Nil {
+		return nil, usecase.ErrGetSessionFromContext
+	}
+	userID := session.GetUser().ID
+	profile := muc.profile(ctx, session)
+
+	filter := entitySurveys.AvailableFilter{ActiveAt: muc.now(), Respondent: profile}
+	var result []*entitySurveys.Survey
+	pagination := entitySurveys.Pagination{Limit: muc.pageSize}
+	for {
+		page, err := muc.repo.GetAvailable(ctx, filter, entitySurveys.SurveyFilterOptions{}, pagination)
+		if err != nil {
+			return nil, fmt.Errorf("can't get surveys from repository: %w", err)
+		}
+
+		// Повторная проверка защищает от выдачи лишних опросов, если сервис опросов не применил фильтр
+		available := make([]*entitySurveys.Survey, 0, len(page.Surveys))
+		keys := make(map[entitySurveys.SurveyID]string, len(page.Surveys))
+		for _, survey := range page.Surveys {
+			if survey.GetID() == nil || !survey.IsAvailable(filter.ActiveAt) || !survey.IsTargetedTo(profile) {
+				continue
+			}
+			available = append(available, survey)
+			keys[*survey.ID] = respondentKey(muc.salt, *survey.ID, userID)
+		}
+		completed, err := muc.completionsRepo.GetMany(ctx, keys)
+		if err != nil {
+			return nil, fmt.Errorf("can't get survey completions from repository: %w", err)
+		}
+		for _, survey := range available {
+			if _, ok := completed[*survey.ID]; !ok {
+				result = append(result, survey)
+			}
+		}
+
+		if uint32(len(page.Surveys)) < pagination.Limit || page.LastId == nil {
+			break
+		}
+		pagination.LastId = page.LastId
+		pagination.LastDate = page.LastDate
+	}
+
+	slices.SortStableFunc(result, func(a, b *entitySurveys.Survey) int {
+		switch {
+		case a.ActivePeriodEnd.IsZero() && b.ActivePeriodEnd.IsZero():
+			return 0
+		case a.ActivePeriodEnd.IsZero():
+			return 1
+		case b.ActivePeriodEnd.IsZero():
+			return -1
+		}
+		return a.ActivePeriodEnd.Compare(b.ActivePeriodEnd)
+	})
+
+	return result, nil
+}
+
+// profile данные пользователя для проверки адресности опросов.
+//
+//	Если карточку сотрудника получить не удалось, опросы подбираются только по пользователю
+func (muc mySurveysUseCase) profile(ctx context.Context, session *entityAuth.Session) *entitySurveys.RespondentProfile {
+	profile := &entitySurveys.RespondentProfile{UserID: session.GetUser().ID}
+
+	employee, err := muc.employeesRepo.GetByExtIDAndPortalID(ctx, session.GetUser().GetEmployee().GetExtID(), session.GetActivePortal().GetPortalID())
+	if err != nil || employee == nil {
+		muc.logger.Warn("can't get employee for surveys targeting", zap.String("user_id", profile.UserID.String()), zap.Error(err))
+		return profile
+	}
+
+	addPortal := func(id int) {
+		if id != 0 && !slices.Contains(profile.PortalIDs, id) {
+			profile.PortalIDs = append(profile.PortalIDs, id)
+		}
+	}
+	addUUID := func(ids *[]uuid.UUID, id uuid.UUID) {
+		if id != uuid.Nil && !slices.Contains(*ids, id) {
+			*ids = append(*ids, id)
+		}
+	}
+
+	addPortal(employee.Portal.ID)
+	addUUID(&profile.OrganizationIDs, employee.Organization.ID)
+	position := employee.StaffPosition
+	if position == nil {
+		return profile
+	}
+	addPortal(position.Portal.ID)
+	addUUID(&profile.OrganizationIDs, position.Organization.ID)
+
+	// Сотрудник входит в свое подразделение и во все вышестоящие: поднимаемся по ParentID,
+	// вышестоящие подразделения берутся из дерева подразделений карточки сотрудника
+	subdivision := position.GetSubdivision()
+	if subdivision == nil {
+		return profile
+	}
+	tree := subdivisionsByID(employee.SubdivisionTree)
+	if !subdivision.IsDeleted {
+		addUUID(&profile.SubdivisionIDs, subdivision.ID)
+	}
+	visited := map[uuid.UUID]bool{subdivision.ID: true}
+	for parentID := subdivision.ParentID; parentID != ""; {
+		id, err := uuid.Parse(parentID)
+		if err != nil || visited[id] {
+			break
+		}
+		visited[id] = true
+
+		parent, ok := tree[id]
+		if !ok {
+			// Вышестоящего подразделения нет в дереве, дальше цепочку не восстановить
+			addUUID(&profile.SubdivisionIDs, id)
+			break
+		}
+		if !parent.IsDeleted {
+			addUUID(&profile.SubdivisionIDs, id)
+		}
+		parentID = parent.ParentID
+	}
+
+	return profile
+}
+
+// subdivisionsByID подразделения дерева по идентификатору
+func subdivisionsByID(root *entityEmployee.SubdivisionTree) map[uuid.UUID]*entityEmployee.SubdivisionTree {
+	result := make(map[uuid.UUID]*entityEmployee.SubdivisionTree)
+	trees := []*entityEmployee.SubdivisionTree{root}
+	for len(trees) > 0 {
+		tree := trees[0]
+		trees = trees[1:]
+		if tree == nil {
+			continue
+		}
+		result[tree.ID] = tree
+		trees = append(trees, tree.Children...)
+	}
+	return result
+}
@@ -0,0 +1,220 @@
+package surveys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/ditzap.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+func Test_mySurveysUseCase_My(t *testing.T) {
+	type fields struct {
+		repo            *MockSurveyRepository
+		completionsRepo *MockCompletionsRepository
+		employeesRepo   *MockEmployeesRepository
+		logger          *ditzap.MockLogger
+	}
+
+	userID := uuid.New()
+	ctx := entity.WithSession(context.TODO(), &entityAuth.Session{
+		User: &entityAuth.User{
+			ID:       userID,
+			Employee: &entityAuth.Employee{ExtID: "ext"},
+		},
+		ActivePortal: &entityAuth.ActivePortal{Portal: entityAuth.Portal{ID: 7}},
+	})
+	testErr := errors.New("some error")
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	rootSubdivisionID := uuid.New()
+	parentSubdivisionID := uuid.New()
+	subdivisionID := uuid.New()
+	childSubdivisionID := uuid.New()
+	siblingSubdivisionID := uuid.New()
+	employee := &entityEmployee.Employee{
+		Portal: entityEmployee.Portal{ID: 7},
+		StaffPosition: &entityEmployee.StaffPosition{
+			Subdivision: &entityEmployee.Subdivision{ID: subdivisionID, ParentID: parentSubdivisionID.String()},
+		},
+		SubdivisionTree: &entityEmployee.SubdivisionTree{
+			ID: rootSubdivisionID,
+			Children: []*entityEmployee.SubdivisionTree{
+				{
+					ID:       parentSubdivisionID,
+					ParentID: rootSubdivisionID.String(),
+					Children: []*entityEmployee.SubdivisionTree{
+						{
+							ID:       subdivisionID,
+							ParentID: parentSubdivisionID.String(),
+							Children: []*entityEmployee.SubdivisionTree{{ID: childSubdivisionID, ParentID: subdivisionID.String()}},
+						},
+					},
+				},
+				{ID: siblingSubdivisionID, ParentID: rootSubdivisionID.String()},
+			},
+		},
+	}
+	profile := &surveys.RespondentProfile{
+		UserID:         userID,
+		PortalIDs:      []int{7},
+		SubdivisionIDs: []uuid.UUID{subdivisionID, parentSubdivisionID, rootSubdivisionID},
+	}
+	filter := surveys.AvailableFilter{ActiveAt: now, Respondent: profile}
+	newSurvey := func(end time.Time, respondent *surveys.SurveyRespondent) *surveys.Survey {
+		id := surveys.SurveyID(uuid.New())
+		return &surveys.Survey{
+			ID:              &id,
+			IsPublished:     true,
+			ActivePeriodEnd: end,
+			Respondent:      respondent,
+		}
+	}
+	byPortal := newSurvey(now.Add(48*time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, PortalIDs: []int{7}})
+	byParentSubdivision := newSurvey(now.Add(24*time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, SubdivisionIDs: []uuid.UUID{parentSubdivisionID}})
+	withoutEnd := newSurvey(time.Time{}, &surveys.SurveyRespondent{Type: surveys.RespondentTypeAnonymous})
+	byUser := newSurvey(now.Add(time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, Ids: surveys.RespondentIDs{surveys.RespondentID(userID)}})
+	otherPortal := newSurvey(now.Add(time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, PortalIDs: []int{1}})
+	byChildSubdivision := newSurvey(now.Add(time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, SubdivisionIDs: []uuid.UUID{childSubdivisionID}})
+	bySiblingSubdivision := newSurvey(now.Add(time.Hour), &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, SubdivisionIDs: []uuid.UUID{siblingSubdivisionID}})
+	finished := newSurvey(now.Add(-time.Hour), nil)
+	unpublished := newSurvey(now.Add(time.Hour), nil)
+	unpublished.IsPublished = false
+	completed := newSurvey(now.Add(time.Hour), nil)
+
+	keys := func(list ...*surveys.Survey) map[surveys.SurveyID]string {
+		result := make(map[surveys.SurveyID]string, len(list))
+		for _, survey := range list {
+			result[*survey.ID] = respondentKey([]byte("salt"), *survey.ID, userID)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		prepare func(f fields)
+		want    []*surveys.Survey
+		wantErr error
+	}{
+		{
+			name: "targeted, available and not completed",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(employee, nil)
+				lastID := *unpublished.ID
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).
+					Return(&surveys.SurveysWithPagination{
+						Pagination: surveys.Pagination{LastId: &lastID},
+						Surveys:    []*surveys.Survey{withoutEnd, otherPortal, unpublished},
+					}, nil)
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3, LastId: &lastID}).
+					Return(&surveys.SurveysWithPagination{
+						Surveys: []*surveys.Survey{byPortal, finished, byParentSubdivision},
+					}, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys(withoutEnd)).Return(nil, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys(byPortal, byParentSubdivision)).Return(nil, nil)
+			},
+			want: []*surveys.Survey{byParentSubdivision, byPortal, withoutEnd},
+		},
+		{
+			name: "child and sibling subdivisions are not targeted",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(employee, nil)
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).
+					Return(&surveys.SurveysWithPagination{Surveys: []*surveys.Survey{byChildSubdivision, bySiblingSubdivision}}, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys()).Return(nil, nil)
+			},
+			want: nil,
+		},
+		{
+			name: "completed survey is excluded",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(employee, nil)
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).
+					Return(&surveys.SurveysWithPagination{Surveys: []*surveys.Survey{completed, byUser}}, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys(completed, byUser)).
+					Return(map[surveys.SurveyID]time.Time{*completed.ID: now}, nil)
+			},
+			want: []*surveys.Survey{byUser},
+		},
+		{
+			name: "without employee only user targeting",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(nil, testErr)
+				f.logger.EXPECT().Warn("can't get employee for surveys targeting", gomock.Any(), gomock.Any())
+				f.repo.EXPECT().GetAvailable(ctx, surveys.AvailableFilter{
+					ActiveAt:   now,
+					Respondent: &surveys.RespondentProfile{UserID: userID},
+				}, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).
+					Return(&surveys.SurveysWithPagination{Surveys: []*surveys.Survey{byPortal, byUser}}, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys(byUser)).Return(nil, nil)
+			},
+			want: []*surveys.Survey{byUser},
+		},
+		{
+			name: "surveys err",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(employee, nil)
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).Return(nil, testErr)
+			},
+			wantErr: fmt.Errorf("can't get surveys from repository: %w", testErr),
+		},
+		{
+			name: "completions err",
+			ctx:  ctx,
+			prepare: func(f fields) {
+				f.employeesRepo.EXPECT().GetByExtIDAndPortalID(ctx, "ext", 7).Return(employee, nil)
+				f.repo.EXPECT().GetAvailable(ctx, filter, surveys.SurveyFilterOptions{}, surveys.Pagination{Limit: 3}).
+					Return(&surveys.SurveysWithPagination{Surveys: []*surveys.Survey{byUser}}, nil)
+				f.completionsRepo.EXPECT().GetMany(ctx, keys(byUser)).Return(nil, testErr)
+			},
+			wantErr: fmt.Errorf("can't get survey completions from repository: %w", testErr),
+		},
+		{
+			name:    "no session",
+			ctx:     context.TODO(),
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			f := fields{
+				repo:            NewMockSurveyRepository(ctrl),
+				completionsRepo: NewMockCompletionsRepository(ctrl),
+				employeesRepo:   NewMockEmployeesRepository(ctrl),
+				logger:          ditzap.NewMockLogger(ctrl),
+			}
+			if tt.prepare != nil {
+				tt.prepare(f)
+			}
+			muc := NewMySurveysUseCase(f.repo, f.completionsRepo, f.employeesRepo, "salt", f.logger)
+			muc.now = func() time.Time { return now }
+			muc.pageSize = 3
+			got, err := muc.My(tt.ctx)
+			if tt.wantErr != nil {
+				assert.EqualError(t, err, tt.wantErr.Error())
+				assert.Nil(t, got)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.want, got)
+			}
+		})
+	}
+}
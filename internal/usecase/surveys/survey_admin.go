@@ -0,0 +1,217 @@
+package surveys
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+// SurveysMaxLimit максимальный размер страницы списка опросов
+const SurveysMaxLimit = 100
+
+// adminOptions опрос для редактирования выдается со всеми вопросами, включая неактивные
+var adminOptions = entitySurveys.SurveyFilterOptions{
+	WithQuestions:         true,
+	WithAnswers:           true,
+	WithInactiveQuestions: true,
+}
+
+type surveysAdminUseCase struct {
+	repo SurveyRepository
+	// permissionChecker права администратора проверяются на всех порталах респондентов опроса,
+	// опрос без порталов доступен только с правами на все порталы
+	permissionChecker PermissionChecker
+}
+
+func NewSurveysAdminUseCase(repository SurveyRepository, permissionChecker PermissionChecker) *surveysAdminUseCase {
+	return &surveysAdminUseCase{repo: repository, permissionChecker: permissionChecker}
+}
+
+func (suc surveysAdminUseCase) Get(ctx context.Context, id entitySurveys.SurveyID) (*entitySurveys.Survey, error) {
+	return suc.survey(ctx, id, entityAuth.PermissionSurveysRead)
+}
+
+// GetAll список опросов постранично. Следующая страница запрашивается по LastId и LastDate предыдущей.
+//
+//	В список попадают только опросы с правами на чтение на всех порталах респондентов, поэтому страница
+//	может быть короче limit. Курсор следующей страницы не зависит от отфильтрованных опросов
+func (suc surveysAdminUseCase) GetAll(
+	ctx context.Context,
+	ids entitySurveys.SurveyIDs,
+	respondent *entitySurveys.SurveyRespondent,
+	options entitySurveys.SurveyFilterOptions,
+	pagination entitySurveys.Pagination,
+) (*entitySurveys.SurveysWithPagination, error) {
+	if pagination.Limit == 0 || pagination.Limit > SurveysMaxLimit {
+		return nil, diterrors.NewValidationError(ErrSurveysLimit, diterrors.ErrValidationFields{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", SurveysMaxLimit),
+		})
+	}
+
+	result, err := suc.repo.GetAll(ctx, ids, respondent, options, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("can't get surveys from repository: %w", err)
+	}
+
+	permitted := make([]*entitySurveys.Survey, 0, len(result.Surveys))
+	for _, survey := range result.Surveys {
+		err := suc.authorize(ctx, survey, entityAuth.PermissionSurveysRead)
+		switch {
+		case errors.Is(err, usecase.ErrPermissionDenied):
+			continue
+		case err != nil:
+			return nil, err
+		}
+		permitted = append(permitted, survey)
+	}
+	result.Surveys = permitted
+
+	return result, nil
+}
+
+// Create создание опроса. Опрос создается неопубликованным, автором указывается текущий пользователь
+func (suc surveysAdminUseCase) Create(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.SurveyID, error) {
+	if survey == nil {
+		return nil, diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	author, err := suc.author(ctx)
+	if err != nil {
+		return nil, err
+	}
+	survey.Author = author
+	survey.IsPublished = false
+	if err := suc.authorize(ctx, survey, entityAuth.PermissionSurveysWrite); err != nil {
+		return nil, err
+	}
+
+	survey.NormalizeWeights()
+	if err := survey.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := suc.repo.Add(ctx, survey)
+	if err != nil {
+		return nil, fmt.Errorf("can't add survey to repository: %w", err)
+	}
+
+	return id, nil
+}
+
+// Update изменение опроса вместе со списком вопросов и вариантов ответа.
+//
+//	Статус публикации и автор не меняются, опубликованный опрос должен оставаться пригодным к публикации
+func (suc surveysAdminUseCase) Update(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.Survey, error) {
+	if survey == nil || survey.ID == nil {
+		return nil, diterrors.NewValidationError(diterrors.ErrInputEmpty)
+	}
+
+	current, err := suc.survey(ctx, *survey.ID, entityAuth.PermissionSurveysWrite)
+	if err != nil {
+		return nil, err
+	}
+	// Смена респондентов может перенести опрос на порталы, где у администратора нет прав
+	if err := suc.authorize(ctx, survey, entityAuth.PermissionSurveysWrite); err != nil {
+		return nil, err
+	}
+	survey.Author = current.Author
+	survey.IsPublished = current.IsPublished
+	survey.CreatedAt = current.CreatedAt
+
+	survey.NormalizeWeights()
+	if survey.IsPublished {
+		err = survey.ValidatePublish()
+	} else {
+		err = survey.Validate()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return suc.update(ctx, survey)
+}
+
+// SetPublished публикация опроса или снятие с публикации
+func (suc surveysAdminUseCase) SetPublished(ctx context.Context, id entitySurveys.SurveyID, published bool) (*entitySurveys.Survey, error) {
+	survey, err := suc.survey(ctx, id, entityAuth.PermissionSurveysPublish)
+	if err != nil {
+		return nil, err
+	}
+	if survey.IsPublished == published {
+		return survey, nil
+	}
+
+	if published {
+		if err := survey.ValidatePublish(); err != nil {
+			return nil, err
+		}
+	}
+	survey.IsPublished = published
+
+	return suc.update(ctx, survey)
+}
+
+func (suc surveysAdminUseCase) Delete(ctx context.Context, id entitySurveys.SurveyID) error {
+	if _, err := suc.survey(ctx, id, entityAuth.PermissionSurveysDelete); err != nil {
+		return err
+	}
+	if err := suc.repo.Delete(ctx, id); err != nil {
+		return fmt.Errorf("can't delete survey from repository: %w", err)
+	}
+
+	return nil
+}
+
+func (suc surveysAdminUseCase) update(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.Survey, error) {
+	if err := suc.repo.Update(ctx, survey); err != nil {
+		return nil, fmt.Errorf("can't update survey in repository: %w", err)
+	}
+
+	result, err := suc.repo.Get(ctx, *survey.ID, adminOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey from repository: %w", err)
+	}
+	return result, nil
+}
+
+// survey опрос для администратора с проверкой прав на порталах опроса
+func (suc surveysAdminUseCase) survey(ctx context.Context, id entitySurveys.SurveyID, permissions ...entityAuth.Permission) (*entitySurveys.Survey, error) {
+	result, err := suc.repo.Get(ctx, id, adminOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey from repository: %w", err)
+	}
+	if err := suc.authorize(ctx, result, permissions...); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// authorize проверяет права администратора на всех порталах респондентов опроса
+func (suc surveysAdminUseCase) authorize(ctx context.Context, survey *entitySurveys.Survey, permissions ...entityAuth.Permission) error {
+	if err := suc.permissionChecker.CheckPortalPermissions(ctx, survey.PortalIDs(), permissions...); err != nil {
+		return fmt.Errorf("can't check permissions: %w", err)
+	}
+	return nil
+}
+
+// author автор опроса из сессии запроса
+func (suc surveysAdminUseCase) author(ctx context.Context) (string, error) {
+	session, err := entity.SessionFromContext(ctx)
+	if err != nil || session == nil {
+		return "", usecase.ErrGetSessionFromContext
+	}
+
+	if login := session.GetUser().GetLogin(); login != "" {
+		return login, nil
+	}
+	return session.GetUser().GetEmail(), nil
+}
@@ -0,0 +1,403 @@
+package surveys
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity"
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+func testAdminSurvey(id surveys.SurveyID) *surveys.Survey {
+	return &surveys.Survey{
+		ID:         &id,
+		Title:      "Опрос",
+		Respondent: &surveys.SurveyRespondent{Type: surveys.RespondentTypeAnonymous},
+		Questions: []*surveys.Question{
+			{Text: "Второй", Type: surveys.QuestionTypeRadio, IsActive: true, Weight: 5, Answers: []*surveys.AnswerVariant{{Text: "Да"}}},
+			{Text: "Первый", Type: surveys.QuestionTypeText, Weight: 1, Answers: []*surveys.AnswerVariant{{WithContent: true, ContentType: surveys.ContentTypeText}}},
+		},
+	}
+}
+
+// allowAll проверка прав, пропускающая любые порталы
+func allowAll(ctrl *gomock.Controller) *MockPermissionChecker {
+	checker := NewMockPermissionChecker(ctrl)
+	checker.EXPECT().CheckPortalPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return checker
+}
+
+func Test_surveysAdminUseCase_Create(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	sessionCtx := entity.WithSession(context.TODO(), &entityAuth.Session{User: &entityAuth.User{Login: "hr", Email: "hr@mos.ru"}})
+	testErr := errors.New("some error")
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		survey  func() *surveys.Survey
+		prepare func(repo *MockSurveyRepository)
+		wantErr error
+	}{
+		{
+			name: "created unpublished with author from session and ordered questions",
+			ctx:  sessionCtx,
+			survey: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Author = "someone"
+				s.IsPublished = true
+				return s
+			},
+			prepare: func(repo *MockSurveyRepository) {
+				repo.EXPECT().Add(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, s *surveys.Survey) (*surveys.SurveyID, error) {
+						assert.Equal(t, "hr", s.Author)
+						assert.False(t, s.IsPublished)
+						assert.Equal(t, "Первый", s.Questions[0].Text)
+						assert.Equal(t, 2, s.Questions[1].Weight)
+						return &surveyID, nil
+					})
+			},
+		},
+		{
+			name:    "no session",
+			ctx:     context.TODO(),
+			survey:  func() *surveys.Survey { return testAdminSurvey(surveyID) },
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name: "invalid survey",
+			ctx:  sessionCtx,
+			survey: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Title = ""
+				return s
+			},
+			wantErr: surveys.ErrSurveyInvalid,
+		},
+		{
+			name:   "repository error",
+			ctx:    sessionCtx,
+			survey: func() *surveys.Survey { return testAdminSurvey(surveyID) },
+			prepare: func(repo *MockSurveyRepository) {
+				repo.EXPECT().Add(gomock.Any(), gomock.Any()).Return(nil, testErr)
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockSurveyRepository(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(repo)
+			}
+
+			got, err := NewSurveysAdminUseCase(repo, allowAll(ctrl)).Create(tt.ctx, tt.survey())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &surveyID, got)
+		})
+	}
+}
+
+func Test_surveysAdminUseCase_Update(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	current := testAdminSurvey(surveyID)
+	current.Author = "hr"
+	current.IsPublished = true
+	current.CreatedAt = &createdAt
+
+	tests := []struct {
+		name    string
+		survey  func() *surveys.Survey
+		prepare func(repo *MockSurveyRepository, checker *MockPermissionChecker)
+		wantErr error
+	}{
+		{
+			name: "author and publication status are kept",
+			survey: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Author = "someone"
+				return s
+			},
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker) {
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), gomock.Any(), entityAuth.PermissionSurveysWrite).Return(nil).Times(2)
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *surveys.Survey) error {
+					assert.Equal(t, "hr", s.Author)
+					assert.True(t, s.IsPublished)
+					assert.Equal(t, &createdAt, s.CreatedAt)
+					return nil
+				})
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+			},
+		},
+		{
+			name: "published survey must keep an active question",
+			survey: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Questions[0].IsActive = false
+				return s
+			},
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker) {
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+			},
+			wantErr: surveys.ErrSurveyNoQuestions,
+		},
+		{
+			name: "survey is moved to a portal without permissions",
+			survey: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Respondent.PortalIDs = []int{2}
+				return s
+			},
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int(nil), entityAuth.PermissionSurveysWrite).Return(nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{2}, entityAuth.PermissionSurveysWrite).Return(usecase.ErrPermissionDenied)
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name:   "not found",
+			survey: func() *surveys.Survey { return testAdminSurvey(surveyID) },
+			prepare: func(repo *MockSurveyRepository, _ *MockPermissionChecker) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(nil, diterrors.ErrNotFound)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockSurveyRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			tt.prepare(repo, checker)
+
+			got, err := NewSurveysAdminUseCase(repo, checker).Update(context.TODO(), tt.survey())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, current, got)
+		})
+	}
+}
+
+func Test_surveysAdminUseCase_SetPublished(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+
+	tests := []struct {
+		name      string
+		current   func() *surveys.Survey
+		published bool
+		update    bool
+		wantErr   error
+	}{
+		{
+			name:      "publish",
+			current:   func() *surveys.Survey { return testAdminSurvey(surveyID) },
+			published: true,
+			update:    true,
+		},
+		{
+			name: "unpublish",
+			current: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.IsPublished = true
+				return s
+			},
+			update: true,
+		},
+		{
+			name:    "status is not changed",
+			current: func() *surveys.Survey { return testAdminSurvey(surveyID) },
+		},
+		{
+			name: "survey without active questions can't be published",
+			current: func() *surveys.Survey {
+				s := testAdminSurvey(surveyID)
+				s.Questions[0].IsActive = false
+				return s
+			},
+			published: true,
+			wantErr:   surveys.ErrSurveyNoQuestions,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockSurveyRepository(ctrl)
+			current := tt.current()
+			repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+			if tt.update {
+				repo.EXPECT().Update(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, s *surveys.Survey) error {
+					assert.Equal(t, tt.published, s.IsPublished)
+					return nil
+				})
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(current, nil)
+			}
+
+			got, err := NewSurveysAdminUseCase(repo, allowAll(ctrl)).SetPublished(context.TODO(), surveyID, tt.published)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.published, got.IsPublished)
+		})
+	}
+}
+
+func Test_surveysAdminUseCase_GetAll(t *testing.T) {
+	lastID := surveys.SurveyID(uuid.New())
+	lastDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	respondent := &surveys.SurveyRespondent{Type: surveys.RespondentTypeAll}
+	options := surveys.SurveyFilterOptions{WithQuestions: true}
+
+	own := testAdminSurvey(surveys.SurveyID(uuid.New()))
+	own.Respondent.PortalIDs = []int{1}
+	foreign := testAdminSurvey(surveys.SurveyID(uuid.New()))
+	foreign.Respondent.PortalIDs = []int{2}
+
+	tests := []struct {
+		name       string
+		pagination surveys.Pagination
+		prepare    func(repo *MockSurveyRepository, checker *MockPermissionChecker, pagination surveys.Pagination)
+		want       []*surveys.Survey
+		wantErr    error
+	}{
+		{
+			name:       "next page by cursor",
+			pagination: surveys.Pagination{Limit: 20, LastId: &lastID, LastDate: &lastDate},
+			prepare: func(repo *MockSurveyRepository, _ *MockPermissionChecker, pagination surveys.Pagination) {
+				repo.EXPECT().GetAll(gomock.Any(), surveys.SurveyIDs(nil), respondent, options, pagination).
+					Return(&surveys.SurveysWithPagination{Pagination: pagination}, nil)
+			},
+			want: []*surveys.Survey{},
+		},
+		{
+			name:       "surveys of other portals are skipped",
+			pagination: surveys.Pagination{Limit: 20},
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker, pagination surveys.Pagination) {
+				repo.EXPECT().GetAll(gomock.Any(), surveys.SurveyIDs(nil), respondent, options, pagination).
+					Return(&surveys.SurveysWithPagination{Pagination: pagination, Surveys: []*surveys.Survey{own, foreign}}, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{1}, entityAuth.PermissionSurveysRead).Return(nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{2}, entityAuth.PermissionSurveysRead).
+					Return(usecase.ErrPermissionDenied)
+			},
+			want: []*surveys.Survey{own},
+		},
+		{
+			name:       "permission check err",
+			pagination: surveys.Pagination{Limit: 20},
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker, pagination surveys.Pagination) {
+				repo.EXPECT().GetAll(gomock.Any(), surveys.SurveyIDs(nil), respondent, options, pagination).
+					Return(&surveys.SurveysWithPagination{Pagination: pagination, Surveys: []*surveys.Survey{own}}, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{1}, entityAuth.PermissionSurveysRead).
+					Return(usecase.ErrGetSessionFromContext)
+			},
+			wantErr: usecase.ErrGetSessionFromContext,
+		},
+		{
+			name:       "empty limit",
+			pagination: surveys.Pagination{},
+			wantErr:    ErrSurveysLimit,
+		},
+		{
+			name:       "limit too big",
+			pagination: surveys.Pagination{Limit: SurveysMaxLimit + 1},
+			wantErr:    ErrSurveysLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockSurveyRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(repo, checker, tt.pagination)
+			}
+
+			got, err := NewSurveysAdminUseCase(repo, checker).GetAll(context.TODO(), nil, respondent, options, tt.pagination)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.pagination, got.Pagination)
+			assert.Equal(t, tt.want, got.Surveys)
+		})
+	}
+}
+
+func Test_surveysAdminUseCase_Delete(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	survey := testAdminSurvey(surveyID)
+	survey.Respondent.PortalIDs = []int{1, 2}
+
+	tests := []struct {
+		name    string
+		prepare func(repo *MockSurveyRepository, checker *MockPermissionChecker)
+		wantErr error
+	}{
+		{
+			name: "deleted",
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(survey, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{1, 2}, entityAuth.PermissionSurveysDelete).Return(nil)
+				repo.EXPECT().Delete(gomock.Any(), surveyID).Return(nil)
+			},
+		},
+		{
+			name: "survey of another portal",
+			prepare: func(repo *MockSurveyRepository, checker *MockPermissionChecker) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(survey, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{1, 2}, entityAuth.PermissionSurveysDelete).
+					Return(usecase.ErrPermissionDenied)
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name: "not found",
+			prepare: func(repo *MockSurveyRepository, _ *MockPermissionChecker) {
+				repo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(nil, diterrors.ErrNotFound)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := NewMockSurveyRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			tt.prepare(repo, checker)
+
+			err := NewSurveysAdminUseCase(repo, checker).Delete(context.TODO(), surveyID)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
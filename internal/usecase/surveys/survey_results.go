@@ -0,0 +1,180 @@
+package surveys
+
+import (
+	"context"
+	"fmt"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+)
+
+// answersPageSize размер страницы ответов при расчете статистики и выгрузке
+const answersPageSize = 500
+
+type surveysResultsUseCase struct {
+	surveysRepo       SurveyRepository
+	answersRepo       AnswersRepository
+	permissionChecker PermissionChecker
+	pageSize          uint32
+}
+
+// NewSurveysResultsUseCase результаты опросов.
+//
+//	Ответы читаются из хранилища постранично, в памяти держится только статистика и текущая страница.
+//	Результаты доступны администратору с правами на чтение на всех порталах респондентов опроса
+func NewSurveysResultsUseCase(
+	surveysRepository SurveyRepository,
+	answersRepository AnswersRepository,
+	permissionChecker PermissionChecker,
+) *surveysResultsUseCase {
+	return &surveysResultsUseCase{
+		surveysRepo:       surveysRepository,
+		answersRepo:       answersRepository,
+		permissionChecker: permissionChecker,
+		pageSize:          answersPageSize,
+	}
+}
+
+// Results статистика ответов на опрос по вопросам.
+// Без порталов и организаций в фильтре учитываются ответы всех респондентов
+func (suc surveysResultsUseCase) Results(ctx context.Context, filter entitySurveys.AnswersFilter) (*entitySurveys.Results, error) {
+	survey, err := suc.survey(ctx, filter.SurveyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAnswersFilter(survey, filter); err != nil {
+		return nil, err
+	}
+
+	results := entitySurveys.NewResults(survey)
+	err = suc.eachPage(ctx, filter, func(answers []*entitySurveys.RespondentAnswer) error {
+		results.Add(answers)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	results.Complete()
+
+	return results, nil
+}
+
+// TextAnswers страница ответов с произвольным текстом на вопрос.
+//
+//	Ответы без текста пропускаются, поэтому страница может быть короче limit
+func (suc surveysResultsUseCase) TextAnswers(
+	ctx context.Context,
+	filter entitySurveys.AnswersFilter,
+	pagination entitySurveys.AnswersPagination,
+) (*entitySurveys.AnswersWithPagination, error) {
+	if pagination.Limit == 0 || pagination.Limit > SurveysMaxLimit {
+		return nil, diterrors.NewValidationError(ErrSurveysLimit, diterrors.ErrValidationFields{
+			Field:   "limit",
+			Message: fmt.Sprintf("limit must be between 1 and %d", SurveysMaxLimit),
+		})
+	}
+	if filter.QuestionID == nil {
+		return nil, diterrors.NewValidationError(diterrors.ErrInputEmpty, diterrors.ErrValidationFields{
+			Field:   "question_id",
+			Message: "question_id is required",
+		})
+	}
+
+	survey, err := suc.survey(ctx, filter.SurveyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAnswersFilter(survey, filter); err != nil {
+		return nil, err
+	}
+
+	page, err := suc.answersRepo.GetAll(ctx, filter, pagination)
+	if err != nil {
+		return nil, fmt.Errorf("can't get answers from repository: %w", err)
+	}
+
+	texts := make([]*entitySurveys.RespondentAnswer, 0, len(page.Answers))
+	for _, answer := range page.Answers {
+		if answer != nil && answer.Content != "" {
+			texts = append(texts, answer)
+		}
+	}
+	page.Answers = texts
+
+	return page, nil
+}
+
+// Export выгрузка ответов на опрос. write вызывается для каждой страницы ответов по мере чтения из хранилища
+func (suc surveysResultsUseCase) Export(
+	ctx context.Context,
+	filter entitySurveys.AnswersFilter,
+	write func(rows []*entitySurveys.ResponseRow) error,
+) error {
+	survey, err := suc.survey(ctx, filter.SurveyID)
+	if err != nil {
+		return err
+	}
+	if err := checkAnswersFilter(survey, filter); err != nil {
+		return err
+	}
+
+	return suc.eachPage(ctx, filter, func(answers []*entitySurveys.RespondentAnswer) error {
+		return write(survey.ResponseRows(answers))
+	})
+}
+
+// survey опрос, результаты которого доступны администратору сессии
+func (suc surveysResultsUseCase) survey(ctx context.Context, id entitySurveys.SurveyID) (*entitySurveys.Survey, error) {
+	survey, err := suc.surveysRepo.Get(ctx, id, adminOptions)
+	if err != nil {
+		return nil, fmt.Errorf("can't get survey from repository: %w", err)
+	}
+	if err := suc.permissionChecker.CheckPortalPermissions(ctx, survey.PortalIDs(), entityAuth.PermissionSurveysRead); err != nil {
+		return nil, fmt.Errorf("can't check permissions: %w", err)
+	}
+
+	return survey, nil
+}
+
+// checkAnswersFilter ответы анонимного опроса сохраняются без портала и организации,
+// поэтому фильтр по респондентам для них не допускается
+func checkAnswersFilter(survey *entitySurveys.Survey, filter entitySurveys.AnswersFilter) error {
+	respondent := survey.GetRespondent()
+	if respondent == nil || respondent.Type != entitySurveys.RespondentTypeAnonymous || !filter.IsByRespondent() {
+		return nil
+	}
+	field := "portal_id"
+	if len(filter.PortalIDs) == 0 {
+		field = "organization_id"
+	}
+	return diterrors.NewValidationError(ErrAnonymousFilter, diterrors.ErrValidationFields{
+		Field:   field,
+		Message: "anonymous survey answers can't be filtered by portal or organization",
+	})
+}
+
+// eachPage последовательно читает все страницы ответов
+func (suc surveysResultsUseCase) eachPage(
+	ctx context.Context,
+	filter entitySurveys.AnswersFilter,
+	fn func(answers []*entitySurveys.RespondentAnswer) error,
+) error {
+	pagination := entitySurveys.AnswersPagination{Limit: suc.pageSize}
+	for {
+		page, err := suc.answersRepo.GetAll(ctx, filter, pagination)
+		if err != nil {
+			return fmt.Errorf("can't get answers from repository: %w", err)
+		}
+		if len(page.Answers) > 0 {
+			if err := fn(page.Answers); err != nil {
+				return err
+			}
+		}
+		if uint32(len(page.Answers)) < pagination.Limit || page.LastID == nil {
+			return nil
+		}
+		pagination.LastID = page.LastID
+	}
+}
@@ -0,0 +1,271 @@
+package surveys
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"git.mos.ru/buch-cloud/moscow-team-2.0/build/diterrors.git"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	surveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
+	"git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/usecase"
+)
+
+func testResultsSurvey(surveyID surveys.SurveyID, questionID surveys.QuestionID, variantID surveys.AnswerID) *surveys.Survey {
+	return &surveys.Survey{
+		ID:         &surveyID,
+		Respondent: &surveys.SurveyRespondent{Type: surveys.RespondentTypeUser, PortalIDs: []int{7, 8}},
+		Questions: []*surveys.Question{
+			{ID: &questionID, Text: "Нравится?", Type: surveys.QuestionTypeRadio, Answers: []*surveys.AnswerVariant{{ID: &variantID, Text: "Да"}}},
+		},
+	}
+}
+
+func Test_surveysResultsUseCase_Results(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	questionID := surveys.QuestionID(uuid.New())
+	variantID := surveys.AnswerID(uuid.New())
+	lastID := uuid.New()
+	organizationID := uuid.New()
+	filter := surveys.AnswersFilter{SurveyID: surveyID, PortalIDs: []int{7}, OrganizationIDs: []uuid.UUID{organizationID}}
+	testErr := errors.New("some error")
+
+	answer := func() *surveys.RespondentAnswer {
+		return &surveys.RespondentAnswer{QuestionID: &questionID, ChosenVariant: variantID}
+	}
+
+	anonymousSurvey := testResultsSurvey(surveyID, questionID, variantID)
+	anonymousSurvey.Respondent.Type = surveys.RespondentTypeAnonymous
+
+	tests := []struct {
+		name      string
+		filter    *surveys.AnswersFilter
+		prepare   func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository)
+		checkErr  error
+		wantCount int
+		wantErr   error
+	}{
+		{
+			name: "answers are read page by page",
+			prepare: func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(testResultsSurvey(surveyID, questionID, variantID), nil)
+				gomock.InOrder(
+					answersRepo.EXPECT().GetAll(gomock.Any(), filter, surveys.AnswersPagination{Limit: 2}).
+						Return(&surveys.AnswersWithPagination{
+							AnswersPagination: surveys.AnswersPagination{Limit: 2, LastID: &lastID},
+							Answers:           []*surveys.RespondentAnswer{answer(), answer()},
+						}, nil),
+					answersRepo.EXPECT().GetAll(gomock.Any(), filter, surveys.AnswersPagination{Limit: 2, LastID: &lastID}).
+						Return(&surveys.AnswersWithPagination{
+							AnswersPagination: surveys.AnswersPagination{Limit: 2},
+							Answers:           []*surveys.RespondentAnswer{answer()},
+						}, nil),
+				)
+			},
+			wantCount: 3,
+		},
+		{
+			name:   "anonymous survey answers without filter",
+			filter: &surveys.AnswersFilter{SurveyID: surveyID},
+			prepare: func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(anonymousSurvey, nil)
+				answersRepo.EXPECT().GetAll(gomock.Any(), surveys.AnswersFilter{SurveyID: surveyID}, surveys.AnswersPagination{Limit: 2}).
+					Return(&surveys.AnswersWithPagination{Answers: []*surveys.RespondentAnswer{answer()}}, nil)
+			},
+			wantCount: 1,
+		},
+		{
+			name:   "anonymous survey answers can't be filtered by portal",
+			filter: &surveys.AnswersFilter{SurveyID: surveyID, PortalIDs: []int{7}},
+			prepare: func(surveysRepo *MockSurveyRepository, _ *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(anonymousSurvey, nil)
+			},
+			wantErr: ErrAnonymousFilter,
+		},
+		{
+			name:   "anonymous survey answers can't be filtered by organization",
+			filter: &surveys.AnswersFilter{SurveyID: surveyID, OrganizationIDs: []uuid.UUID{organizationID}},
+			prepare: func(surveysRepo *MockSurveyRepository, _ *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(anonymousSurvey, nil)
+			},
+			wantErr: ErrAnonymousFilter,
+		},
+		{
+			name: "survey of another portal",
+			prepare: func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(testResultsSurvey(surveyID, questionID, variantID), nil)
+			},
+			checkErr: usecase.ErrPermissionDenied,
+			wantErr:  usecase.ErrPermissionDenied,
+		},
+		{
+			name: "survey not found",
+			prepare: func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(nil, diterrors.ErrNotFound)
+			},
+			wantErr: diterrors.ErrNotFound,
+		},
+		{
+			name: "answers error",
+			prepare: func(surveysRepo *MockSurveyRepository, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(testResultsSurvey(surveyID, questionID, variantID), nil)
+				answersRepo.EXPECT().GetAll(gomock.Any(), filter, gomock.Any()).Return(nil, testErr)
+			},
+			wantErr: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			surveysRepo := NewMockSurveyRepository(ctrl)
+			answersRepo := NewMockAnswersRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{7, 8}, entityAuth.PermissionSurveysRead).Return(tt.checkErr).AnyTimes()
+			tt.prepare(surveysRepo, answersRepo)
+
+			uc := NewSurveysResultsUseCase(surveysRepo, answersRepo, checker)
+			uc.pageSize = 2
+			f := filter
+			if tt.filter != nil {
+				f = *tt.filter
+			}
+			got, err := uc.Results(context.TODO(), f)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantCount, got.AnswersCount)
+			assert.Equal(t, tt.wantCount, got.Questions[0].Variants[0].Count)
+			assert.Equal(t, float64(100), got.Questions[0].Variants[0].Percent)
+		})
+	}
+}
+
+func Test_surveysResultsUseCase_TextAnswers(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	questionID := surveys.QuestionID(uuid.New())
+
+	variantID := surveys.AnswerID(uuid.New())
+	survey := testResultsSurvey(surveyID, questionID, variantID)
+
+	tests := []struct {
+		name       string
+		filter     surveys.AnswersFilter
+		pagination surveys.AnswersPagination
+		prepare    func(surveysRepo *MockSurveyRepository, checker *MockPermissionChecker, answersRepo *MockAnswersRepository)
+		want       []*surveys.RespondentAnswer
+		wantErr    error
+	}{
+		{
+			name:       "answers without content are skipped",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID, QuestionID: &questionID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			prepare: func(surveysRepo *MockSurveyRepository, checker *MockPermissionChecker, answersRepo *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(survey, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{7, 8}, entityAuth.PermissionSurveysRead).Return(nil)
+				answersRepo.EXPECT().GetAll(gomock.Any(), surveys.AnswersFilter{SurveyID: surveyID, QuestionID: &questionID}, surveys.AnswersPagination{Limit: 10}).
+					Return(&surveys.AnswersWithPagination{Answers: []*surveys.RespondentAnswer{{Content: "текст"}, {}}}, nil)
+			},
+			want: []*surveys.RespondentAnswer{{Content: "текст"}},
+		},
+		{
+			name:       "survey of another portal",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID, QuestionID: &questionID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			prepare: func(surveysRepo *MockSurveyRepository, checker *MockPermissionChecker, _ *MockAnswersRepository) {
+				surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(survey, nil)
+				checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{7, 8}, entityAuth.PermissionSurveysRead).
+					Return(usecase.ErrPermissionDenied)
+			},
+			wantErr: usecase.ErrPermissionDenied,
+		},
+		{
+			name:       "question is required",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID},
+			pagination: surveys.AnswersPagination{Limit: 10},
+			wantErr:    diterrors.ErrInputEmpty,
+		},
+		{
+			name:       "limit too big",
+			filter:     surveys.AnswersFilter{SurveyID: surveyID, QuestionID: &questionID},
+			pagination: surveys.AnswersPagination{Limit: SurveysMaxLimit + 1},
+			wantErr:    ErrSurveysLimit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			surveysRepo := NewMockSurveyRepository(ctrl)
+			answersRepo := NewMockAnswersRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			if tt.prepare != nil {
+				tt.prepare(surveysRepo, checker, answersRepo)
+			}
+
+			got, err := NewSurveysResultsUseCase(surveysRepo, answersRepo, checker).TextAnswers(context.TODO(), tt.filter, tt.pagination)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Nil(t, got)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got.Answers)
+		})
+	}
+}
+
+func Test_surveysResultsUseCase_Export(t *testing.T) {
+	surveyID := surveys.SurveyID(uuid.New())
+	questionID := surveys.QuestionID(uuid.New())
+	variantID := surveys.AnswerID(uuid.New())
+	filter := surveys.AnswersFilter{SurveyID: surveyID}
+	writeErr := errors.New("write error")
+
+	tests := []struct {
+		name     string
+		write    error
+		wantRows int
+		wantErr  error
+	}{
+		{
+			name:     "rows are written per page",
+			wantRows: 1,
+		},
+		{
+			name:    "write error stops export",
+			write:   writeErr,
+			wantErr: writeErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			surveysRepo := NewMockSurveyRepository(ctrl)
+			answersRepo := NewMockAnswersRepository(ctrl)
+			checker := NewMockPermissionChecker(ctrl)
+			surveysRepo.EXPECT().Get(gomock.Any(), surveyID, adminOptions).Return(testResultsSurvey(surveyID, questionID, variantID), nil)
+			checker.EXPECT().CheckPortalPermissions(gomock.Any(), []int{7, 8}, entityAuth.PermissionSurveysRead).Return(nil)
+			answersRepo.EXPECT().GetAll(gomock.Any(), filter, surveys.AnswersPagination{Limit: answersPageSize}).
+				Return(&surveys.AnswersWithPagination{Answers: []*surveys.RespondentAnswer{{QuestionID: &questionID, ChosenVariant: variantID}}}, nil)
+
+			var rows []*surveys.ResponseRow
+			err := NewSurveysResultsUseCase(surveysRepo, answersRepo, checker).Export(context.TODO(), filter, func(page []*surveys.ResponseRow) error {
+				rows = append(rows, page...)
+				return tt.write
+			})
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, rows, tt.wantRows)
+			assert.Equal(t, "Да", rows[0].VariantText)
+		})
+	}
+}
@@ -12,7 +12,10 @@ package surveys
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	entityAuth "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/auth"
+	entityEmployee "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/employee"
 	entitySurveys "git.mos.ru/buch-cloud/moscow-team-2.0/backend/web-api.git/internal/entity/survey"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +45,35 @@ func (m *MockSurveyRepository) EXPECT() *MockSurveyRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Add mocks base method.
+func (m *MockSurveyRepository) Add(ctx context.Context, survey *entitySurveys.Survey) (*entitySurveys.SurveyID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, survey)
+	ret0, _ := ret[0].(*entitySurveys.SurveyID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSurveyRepositoryMockRecorder) Add(ctx, survey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSurveyRepository)(nil).Add), ctx, survey)
+}
+
+// Delete mocks base method.
+func (m *MockSurveyRepository) Delete(ctx context.Context, id entitySurveys.SurveyID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSurveyRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSurveyRepository)(nil).Delete), ctx, id)
+}
+
 // Get mocks base method.
 func (m *MockSurveyRepository) Get(ctx context.Context, id entitySurveys.SurveyID, options entitySurveys.SurveyFilterOptions) (*entitySurveys.Survey, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +89,50 @@ func (mr *MockSurveyRepositoryMockRecorder) Get(ctx, id, options any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSurveyRepository)(nil).Get), ctx, id, options)
 }
 
+// GetAll mocks base method.
+func (m *MockSurveyRepository) GetAll(ctx context.Context, ids entitySurveys.SurveyIDs, respondent *entitySurveys.SurveyRespondent, options entitySurveys.SurveyFilterOptions, pagination entitySurveys.Pagination) (*entitySurveys.SurveysWithPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, ids, respondent, options, pagination)
+	ret0, _ := ret[0].(*entitySurveys.SurveysWithPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSurveyRepositoryMockRecorder) GetAll(ctx, ids, respondent, options, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSurveyRepository)(nil).GetAll), ctx, ids, respondent, options, pagination)
+}
+
+// GetAvailable mocks base method.
+func (m *MockSurveyRepository) GetAvailable(ctx context.Context, filter entitySurveys.AvailableFilter, options entitySurveys.SurveyFilterOptions, pagination entitySurveys.Pagination) (*entitySurveys.SurveysWithPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAvailable", ctx, filter, options, pagination)
+	ret0, _ := ret[0].(*entitySurveys.SurveysWithPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAvailable indicates an expected call of GetAvailable.
+func (mr *MockSurveyRepositoryMockRecorder) GetAvailable(ctx, filter, options, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailable", reflect.TypeOf((*MockSurveyRepository)(nil).GetAvailable), ctx, filter, options, pagination)
+}
+
+// Update mocks base method.
+func (m *MockSurveyRepository) Update(ctx context.Context, survey *entitySurveys.Survey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, survey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockSurveyRepositoryMockRecorder) Update(ctx, survey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSurveyRepository)(nil).Update), ctx, survey)
+}
+
 // MockAnswersRepository is a mock of AnswersRepository interface.
 type MockAnswersRepository struct {
 	ctrl     *gomock.Controller
@@ -96,6 +172,143 @@ func (mr *MockAnswersRepositoryMockRecorder) Add(ctx, answers any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockAnswersRepository)(nil).Add), ctx, answers)
 }
 
+// GetAll mocks base method.
+func (m *MockAnswersRepository) GetAll(ctx context.Context, filter entitySurveys.AnswersFilter, pagination entitySurveys.AnswersPagination) (*entitySurveys.AnswersWithPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, filter, pagination)
+	ret0, _ := ret[0].(*entitySurveys.AnswersWithPagination)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAnswersRepositoryMockRecorder) GetAll(ctx, filter, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAnswersRepository)(nil).GetAll), ctx, filter, pagination)
+}
+
+// MockCompletionsRepository is a mock of CompletionsRepository interface.
+type MockCompletionsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCompletionsRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockCompletionsRepositoryMockRecorder is the mock recorder for MockCompletionsRepository.
+type MockCompletionsRepositoryMockRecorder struct {
+	mock *MockCompletionsRepository
+}
+
+// NewMockCompletionsRepository creates a new mock instance.
+func NewMockCompletionsRepository(ctrl *gomock.Controller) *MockCompletionsRepository {
+	mock := &MockCompletionsRepository{ctrl: ctrl}
+	mock.recorder = &MockCompletionsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCompletionsRepository) EXPECT() *MockCompletionsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Add mocks base method.
+func (m *MockCompletionsRepository) Add(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string, completedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, surveyID, respondentKey, completedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockCompletionsRepositoryMockRecorder) Add(ctx, surveyID, respondentKey, completedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockCompletionsRepository)(nil).Add), ctx, surveyID, respondentKey, completedAt)
+}
+
+// Delete mocks base method.
+func (m *MockCompletionsRepository) Delete(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, surveyID, respondentKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockCompletionsRepositoryMockRecorder) Delete(ctx, surveyID, respondentKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCompletionsRepository)(nil).Delete), ctx, surveyID, respondentKey)
+}
+
+// Get mocks base method.
+func (m *MockCompletionsRepository) Get(ctx context.Context, surveyID entitySurveys.SurveyID, respondentKey string) (*time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, surveyID, respondentKey)
+	ret0, _ := ret[0].(*time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockCompletionsRepositoryMockRecorder) Get(ctx, surveyID, respondentKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCompletionsRepository)(nil).Get), ctx, surveyID, respondentKey)
+}
+
+// GetMany mocks base method.
+func (m *MockCompletionsRepository) GetMany(ctx context.Context, respondentKeys map[entitySurveys.SurveyID]string) (map[entitySurveys.SurveyID]time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMany", ctx, respondentKeys)
+	ret0, _ := ret[0].(map[entitySurveys.SurveyID]time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMany indicates an expected call of GetMany.
+func (mr *MockCompletionsRepositoryMockRecorder) GetMany(ctx, respondentKeys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMany", reflect.TypeOf((*MockCompletionsRepository)(nil).GetMany), ctx, respondentKeys)
+}
+
+// MockEmployeesRepository is a mock of EmployeesRepository interface.
+type MockEmployeesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmployeesRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEmployeesRepositoryMockRecorder is the mock recorder for MockEmployeesRepository.
+type MockEmployeesRepositoryMockRecorder struct {
+	mock *MockEmployeesRepository
+}
+
+// NewMockEmployeesRepository creates a new mock instance.
+func NewMockEmployeesRepository(ctrl *gomock.Controller) *MockEmployeesRepository {
+	mock := &MockEmployeesRepository{ctrl: ctrl}
+	mock.recorder = &MockEmployeesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmployeesRepository) EXPECT() *MockEmployeesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetByExtIDAndPortalID mocks base method.
+func (m *MockEmployeesRepository) GetByExtIDAndPortalID(ctx context.Context, extID string, portalID int) (*entityEmployee.Employee, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByExtIDAndPortalID", ctx, extID, portalID)
+	ret0, _ := ret[0].(*entityEmployee.Employee)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByExtIDAndPortalID indicates an expected call of GetByExtIDAndPortalID.
+func (mr *MockEmployeesRepositoryMockRecorder) GetByExtIDAndPortalID(ctx, extID, portalID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByExtIDAndPortalID", reflect.TypeOf((*MockEmployeesRepository)(nil).GetByExtIDAndPortalID), ctx, extID, portalID)
+}
+
 // MockImagesRepository is a mock of ImagesRepository interface.
 type MockImagesRepository struct {
 	ctrl     *gomock.Controller
@@ -120,6 +333,21 @@ func (m *MockImagesRepository) EXPECT() *MockImagesRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Add mocks base method.
+func (m *MockImagesRepository) Add(ctx context.Context, image *entitySurveys.Image) (*entitySurveys.Image, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Add", ctx, image)
+	ret0, _ := ret[0].(*entitySurveys.Image)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockImagesRepositoryMockRecorder) Add(ctx, image any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockImagesRepository)(nil).Add), ctx, image)
+}
+
 // Get mocks base method.
 func (m *MockImagesRepository) Get(ctx context.Context, imageName string) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -134,3 +362,46 @@ func (mr *MockImagesRepositoryMockRecorder) Get(ctx, imageName any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockImagesRepository)(nil).Get), ctx, imageName)
 }
+
+// MockPermissionChecker is a mock of PermissionChecker interface.
+type MockPermissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockPermissionCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockPermissionCheckerMockRecorder is the mock recorder for MockPermissionChecker.
+type MockPermissionCheckerMockRecorder struct {
+	mock *MockPermissionChecker
+}
+
+// NewMockPermissionChecker creates a new mock instance.
+func NewMockPermissionChecker(ctrl *gomock.Controller) *MockPermissionChecker {
+	mock := &MockPermissionChecker{ctrl: ctrl}
+	mock.recorder = &MockPermissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPermissionChecker) EXPECT() *MockPermissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckPortalPermissions mocks base method.
+func (m *MockPermissionChecker) CheckPortalPermissions(ctx context.Context, portalIDs []int, permissions ...entityAuth.Permission) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, portalIDs}
+	for _, a := range permissions {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckPortalPermissions", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckPortalPermissions indicates an expected call of CheckPortalPermissions.
+func (mr *MockPermissionCheckerMockRecorder) CheckPortalPermissions(ctx, portalIDs any, permissions ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, portalIDs}, permissions...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPortalPermissions", reflect.TypeOf((*MockPermissionChecker)(nil).CheckPortalPermissions), varargs...)
+}